@@ -0,0 +1,156 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// alterTableRe matches an ALTER TABLE statement and its list of alterations
+	alterTableRe = regexp.MustCompile(`(?is)ALTER\s+TABLE\s+(?:ONLY\s+)?(?:IF\s+EXISTS\s+)?([^\s;]+)\s+([^;]+)`)
+	// alterDropRe matches an alteration dropping a constraint or an index
+	alterDropRe = regexp.MustCompile(`(?is)^DROP\s+(CONSTRAINT|FOREIGN\s+KEY|CHECK|INDEX|KEY|PRIMARY\s+KEY)(?:\s+IF\s+EXISTS)?(?:\s+([^\s,;]+))?(?:\s+(?:CASCADE|RESTRICT))?$`)
+	// alterColumnRe matches a PostgreSQL alteration of a column, capturing the column,
+	// the new or dropped default, the new data type and its USING expression, and the
+	// change of nullability
+	alterColumnRe = regexp.MustCompile(`(?is)^ALTER\s+(?:COLUMN\s+)?(\S+)\s+(?:SET\s+DEFAULT\s+(.+)|(DROP\s+DEFAULT)|(?:SET\s+DATA\s+)?TYPE\s+(.+?)(?:\s+USING\s+(.+))?|(SET|DROP)\s+NOT\s+NULL)$`)
+)
+
+// ApplyAlterDrops removes the constraints and indexes dropped by the ALTER TABLE
+// statements in a normalized SQL dump whose statements are terminated by semicolons.
+// Alterations of tables that are not part of the schema are ignored.
+func (s *Schema) ApplyAlterDrops(content string) {
+	for _, match := range alterTableRe.FindAllStringSubmatch(content, -1) {
+		table := s.findTable(match[1])
+		if table == nil {
+			continue
+		}
+		for _, clause := range splitTopLevel(match[2]) {
+			table.ApplyAlterDrop(clause)
+		}
+	}
+}
+
+// ApplyAlterColumns applies the PostgreSQL ALTER COLUMN alterations of the ALTER TABLE
+// statements in a normalized SQL dump whose statements are terminated by semicolons,
+// changing the defaults, data types and nullability of the columns. Alterations of
+// tables that are not part of the schema are ignored.
+func (s *Schema) ApplyAlterColumns(content string) {
+	for _, match := range alterTableRe.FindAllStringSubmatch(content, -1) {
+		table := s.findTable(match[1])
+		if table == nil {
+			continue
+		}
+		for _, clause := range splitTopLevel(match[2]) {
+			table.ApplyAlterColumn(clause)
+		}
+	}
+}
+
+// findTable returns the table with the given, optionally schema qualified, name
+func (s *Schema) findTable(name string) *Table {
+	var schema string
+	if parts := strings.Split(name, "."); len(parts) > 1 {
+		schema = trimIdentifier(parts[0])
+		name = parts[1]
+	}
+	name = trimIdentifier(name)
+
+	for i, table := range s.Tables {
+		if strings.EqualFold(table.Name, name) && (schema == "" || table.Schema == "" || strings.EqualFold(table.Schema, schema)) {
+			return &s.Tables[i]
+		}
+	}
+	return nil
+}
+
+// ApplyAlterDrop applies a single alteration of an ALTER TABLE statement that drops a
+// constraint or an index, such as "DROP CONSTRAINT uq_email" or MySQL's
+// "DROP FOREIGN KEY fk_user" and "DROP INDEX idx_name". It reports whether the
+// alteration was such a drop.
+//
+// A dropped constraint may have been parsed as an index, and a dropped index as a
+// UNIQUE constraint, so DROP CONSTRAINT, DROP INDEX and DROP KEY remove either.
+func (t *Table) ApplyAlterDrop(clause string) bool {
+	match := alterDropRe.FindStringSubmatch(strings.TrimSpace(clause))
+	if match == nil {
+		return false
+	}
+
+	kind := strings.ToUpper(spacesRe.ReplaceAllString(match[1], " "))
+	name := trimIdentifier(match[2])
+
+	switch kind {
+	case "PRIMARY KEY":
+		t.removeConstraints(func(c Constraint) bool { return c.Type == "PRIMARY KEY" })
+		for i := range t.Columns {
+			t.Columns[i].IsPrimaryKey = false
+		}
+	case "FOREIGN KEY", "CHECK":
+		t.removeConstraints(func(c Constraint) bool {
+			return c.Type == kind && strings.EqualFold(c.Name, name)
+		})
+	default:
+		t.removeConstraints(func(c Constraint) bool { return strings.EqualFold(c.Name, name) })
+		t.removeIndexes(func(i Index) bool { return strings.EqualFold(i.Name, name) })
+	}
+
+	return true
+}
+
+// ApplyAlterColumn applies a single PostgreSQL alteration of a column, such as
+// "ALTER COLUMN status SET DEFAULT 'active'", "ALTER COLUMN status DROP DEFAULT",
+// "ALTER COLUMN price SET DATA TYPE NUMERIC(12,2) USING price::numeric" or
+// "ALTER COLUMN email SET NOT NULL". The USING expression of a type change is kept
+// as the Using of the column. It reports whether the alteration changed a column of
+// the table.
+func (t *Table) ApplyAlterColumn(clause string) bool {
+	match := alterColumnRe.FindStringSubmatch(strings.TrimSpace(clause))
+	if match == nil {
+		return false
+	}
+
+	name := trimIdentifier(match[1])
+	for i := range t.Columns {
+		column := &t.Columns[i]
+		if !strings.EqualFold(column.Name, name) {
+			continue
+		}
+
+		switch {
+		case match[2] != "":
+			column.DefaultValue = UnquoteLiteral(strings.TrimSpace(match[2]))
+		case match[3] != "":
+			column.DefaultValue = ""
+		case match[4] != "":
+			column.DataType, column.Length, column.Scale = ParseDataType(CompactTypeParameters(strings.TrimSpace(match[4])))
+			column.Using = strings.TrimSpace(match[5])
+		case match[6] != "":
+			column.IsNullable = strings.EqualFold(match[6], "DROP")
+		}
+		return true
+	}
+	return false
+}
+
+// removeConstraints removes the constraints matching the predicate
+func (t *Table) removeConstraints(match func(Constraint) bool) {
+	constraints := t.Constraints[:0]
+	for _, constraint := range t.Constraints {
+		if !match(constraint) {
+			constraints = append(constraints, constraint)
+		}
+	}
+	t.Constraints = constraints
+}
+
+// removeIndexes removes the indexes matching the predicate
+func (t *Table) removeIndexes(match func(Index) bool) {
+	indexes := t.Indexes[:0]
+	for _, index := range t.Indexes {
+		if !match(index) {
+			indexes = append(indexes, index)
+		}
+	}
+	t.Indexes = indexes
+}
@@ -0,0 +1,157 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_ApplyAlterDrops(t *testing.T) {
+	newSchema := func() *Schema {
+		return &Schema{Tables: []Table{{
+			Name:   "orders",
+			Schema: "shop",
+			Columns: []Column{
+				{Name: "id", IsPrimaryKey: true},
+				{Name: "user_id"},
+				{Name: "code"},
+			},
+			Indexes: []Index{{Name: "idx_orders_user", Columns: IndexColumns("user_id")}},
+			Constraints: []Constraint{
+				{Name: "pk_orders", Type: "PRIMARY KEY", Columns: []string{"id"}},
+				{Name: "fk_orders_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+				{Name: "uq_orders_code", Type: "UNIQUE", Columns: []string{"code"}},
+				{Name: "chk_orders_code", Type: "CHECK", CheckExpression: "code <> ''"},
+			},
+		}}}
+	}
+
+	constraintNames := func(table Table) []string {
+		var names []string
+		for _, constraint := range table.Constraints {
+			names = append(names, constraint.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		name        string
+		content     string
+		constraints []string
+		indexes     int
+	}{
+		{
+			name:        "Drop foreign key",
+			content:     "ALTER TABLE orders DROP FOREIGN KEY fk_orders_user;",
+			constraints: []string{"pk_orders", "uq_orders_code", "chk_orders_code"},
+			indexes:     1,
+		},
+		{
+			name:        "Drop named unique constraint",
+			content:     `ALTER TABLE shop."orders" DROP CONSTRAINT IF EXISTS uq_orders_code CASCADE;`,
+			constraints: []string{"pk_orders", "fk_orders_user", "chk_orders_code"},
+			indexes:     1,
+		},
+		{
+			name:        "Drop index and check",
+			content:     "ALTER TABLE `orders` DROP INDEX idx_orders_user, DROP CHECK chk_orders_code;",
+			constraints: []string{"pk_orders", "fk_orders_user", "uq_orders_code"},
+			indexes:     0,
+		},
+		{
+			name:        "Foreign key drop ignores other constraint types",
+			content:     "ALTER TABLE orders DROP FOREIGN KEY uq_orders_code;",
+			constraints: []string{"pk_orders", "fk_orders_user", "uq_orders_code", "chk_orders_code"},
+			indexes:     1,
+		},
+		{
+			name:        "Unknown table",
+			content:     "ALTER TABLE users DROP CONSTRAINT fk_orders_user;",
+			constraints: []string{"pk_orders", "fk_orders_user", "uq_orders_code", "chk_orders_code"},
+			indexes:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := newSchema()
+			schema.ApplyAlterDrops(tt.content)
+
+			assert.Equal(t, tt.constraints, constraintNames(schema.Tables[0]))
+			assert.Len(t, schema.Tables[0].Indexes, tt.indexes)
+		})
+	}
+
+	t.Run("Drop primary key", func(t *testing.T) {
+		schema := newSchema()
+		schema.ApplyAlterDrops("ALTER TABLE orders DROP PRIMARY KEY;")
+
+		assert.Equal(t, []string{"fk_orders_user", "uq_orders_code", "chk_orders_code"}, constraintNames(schema.Tables[0]))
+		assert.False(t, schema.Tables[0].Columns[0].IsPrimaryKey)
+	})
+}
+
+func TestTable_ApplyAlterDrop(t *testing.T) {
+	table := Table{Name: "orders"}
+
+	assert.True(t, table.ApplyAlterDrop("DROP CONSTRAINT fk_orders_user"))
+	assert.True(t, table.ApplyAlterDrop("drop key idx_orders_user"))
+	assert.False(t, table.ApplyAlterDrop("DROP COLUMN code"))
+	assert.False(t, table.ApplyAlterDrop("ADD CONSTRAINT uq_code UNIQUE (code)"))
+}
+
+func TestTable_ApplyAlterColumn(t *testing.T) {
+	newTable := func() Table {
+		return Table{Name: "orders", Columns: []Column{
+			{Name: "status", DataType: "VARCHAR", Length: 20, IsNullable: true, DefaultValue: "pending"},
+			{Name: "price", DataType: "INTEGER", IsNullable: true},
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		clause string
+		column int
+		want   Column
+	}{
+		{
+			name:   "Set default",
+			clause: "ALTER COLUMN status SET DEFAULT 'active'",
+			want:   Column{Name: "status", DataType: "VARCHAR", Length: 20, IsNullable: true, DefaultValue: "active"},
+		},
+		{
+			name:   "Drop default",
+			clause: "alter column status drop default",
+			want:   Column{Name: "status", DataType: "VARCHAR", Length: 20, IsNullable: true},
+		},
+		{
+			name:   "Set data type with using",
+			clause: "ALTER COLUMN price SET DATA TYPE NUMERIC (12, 2) USING price::numeric / 100",
+			column: 1,
+			want:   Column{Name: "price", DataType: "NUMERIC", Length: 12, Scale: 2, IsNullable: true, Using: "price::numeric / 100"},
+		},
+		{
+			name:   "Type without using",
+			clause: `ALTER "status" TYPE TEXT`,
+			want:   Column{Name: "status", DataType: "TEXT", IsNullable: true, DefaultValue: "pending"},
+		},
+		{
+			name:   "Set not null",
+			clause: "ALTER COLUMN price SET NOT NULL",
+			column: 1,
+			want:   Column{Name: "price", DataType: "INTEGER"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := newTable()
+			assert.True(t, table.ApplyAlterColumn(tt.clause))
+			assert.Equal(t, tt.want, table.Columns[tt.column])
+		})
+	}
+
+	table := newTable()
+	assert.False(t, table.ApplyAlterColumn("ALTER COLUMN missing DROP DEFAULT"))
+	assert.False(t, table.ApplyAlterColumn("DROP CONSTRAINT fk_orders_user"))
+}
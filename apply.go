@@ -0,0 +1,233 @@
+package sqlmapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TransactionStrategy controls how Apply groups the executed statements into
+// transactions
+type TransactionStrategy int
+
+const (
+	// DefaultStrategy uses SingleTransaction for dialects with transactional DDL,
+	// such as PostgreSQL, and PerStatement for the others, such as MySQL, which
+	// commits every DDL statement implicitly
+	DefaultStrategy TransactionStrategy = iota
+	// SingleTransaction executes all statements in one transaction
+	SingleTransaction
+	// PerObject executes the statements of every object in a transaction of its own:
+	// the statement creating the object together with the indexes, comments, ALTER
+	// TABLE and GRANT statements following it
+	PerObject
+	// PerBatch executes every ApplyOptions.BatchSize statements in a transaction
+	PerBatch
+	// PerStatement executes the statements outside transactions, so that each is
+	// committed on its own
+	PerStatement
+)
+
+// DefaultApplyBatchSize is the number of statements of a PerBatch transaction when
+// ApplyOptions.BatchSize is not set
+const DefaultApplyBatchSize = 100
+
+// ApplyOptions controls how Apply executes the generated DDL
+type ApplyOptions struct {
+	// Transaction executes the statements in a single transaction, which is rolled
+	// back when a statement fails. Only databases with transactional DDL, such as
+	// PostgreSQL and SQLite, undo the statements executed before the failure.
+	// It is equivalent to the SingleTransaction strategy.
+	Transaction bool
+
+	// Strategy groups the statements into transactions. A failing statement rolls
+	// back the statements of its transaction.
+	Strategy TransactionStrategy
+
+	// BatchSize is the number of statements of a PerBatch transaction, or
+	// DefaultApplyBatchSize when zero
+	BatchSize int
+
+	// ContinueOnError executes the remaining transactions, or statements of the
+	// PerStatement strategy, after a statement failed, and returns the errors of all
+	// failed statements. It has no effect on a single transaction.
+	ContinueOnError bool
+}
+
+// strategy returns the transaction strategy selected by the options for the dialect
+func (o ApplyOptions) strategy(dbType DatabaseType) TransactionStrategy {
+	switch {
+	case o.Strategy != DefaultStrategy:
+		return o.Strategy
+	case o.Transaction || Capabilities(dbType).TransactionalDDL:
+		return SingleTransaction
+	}
+	return PerStatement
+}
+
+// Apply generates the schema with the dialect and executes the statements on db,
+// creating tables after the tables their foreign keys reference. The error names the
+// statement that failed.
+func Apply(ctx context.Context, db *sql.DB, schema *Schema, dialect Dialect, options ApplyOptions) error {
+	if db == nil || schema == nil || dialect == nil {
+		return &ValidationError{Problems: []string{"database, schema and dialect are required"}}
+	}
+
+	ordered := schema.Clone()
+	ordered.Tables = sortTables(ordered.Tables)
+	output, err := dialect.Generate(ordered)
+	if err != nil {
+		return fmt.Errorf("failed to generate schema: %w", err)
+	}
+
+	var statements []*Statement
+	for _, statement := range splitGenerated(output, dialect.Type()) {
+		if strings.TrimSpace(statement.Text) != "" {
+			statements = append(statements, statement)
+		}
+	}
+
+	strategy := options.strategy(dialect.Type())
+	var errs []error
+	for _, group := range groupStatements(statements, strategy, options.BatchSize) {
+		if err := applyGroup(ctx, db, group, strategy != PerStatement); err != nil {
+			if !options.ContinueOnError || strategy == SingleTransaction {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// splitGenerated splits the output of a generator into statements, keeping the
+// dollar-quoted bodies of PostgreSQL and the backslash escapes of MySQL, and
+// honouring the batch separators of SQL Server and Oracle
+func splitGenerated(output string, dbType DatabaseType) []*Statement {
+	switch dbType {
+	case PostgreSQL:
+		return SplitPostgresStatements(output)
+	case MySQL:
+		return SplitMySQLStatements(output)
+	case SQLServer:
+		return SplitStatements(output, "GO")
+	case Oracle:
+		return SplitStatements(output, "/")
+	}
+	return SplitStatements(output, "")
+}
+
+// applyGroup executes a group of statements, in a transaction unless transaction is
+// false, stopping at the first failing statement
+func applyGroup(ctx context.Context, db *sql.DB, statements []*Statement, transaction bool) error {
+	exec := db.ExecContext
+	var tx *sql.Tx
+	if transaction {
+		var err error
+		if tx, err = db.BeginTx(ctx, nil); err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		exec = tx.ExecContext
+	}
+
+	for _, statement := range statements {
+		if _, err := exec(ctx, statement.Text); err != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+			return fmt.Errorf("failed to execute statement at line %d: %w\n%s", statement.Line, err, statement.Text)
+		}
+	}
+
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+	}
+	return nil
+}
+
+// groupStatements splits the statements into the groups executed together by the
+// strategy
+func groupStatements(statements []*Statement, strategy TransactionStrategy, batchSize int) [][]*Statement {
+	var groups [][]*Statement
+	switch strategy {
+	case SingleTransaction:
+		if len(statements) > 0 {
+			groups = append(groups, statements)
+		}
+	case PerObject:
+		for _, statement := range statements {
+			if len(groups) == 0 || !objectStatement(statement.Kind) {
+				groups = append(groups, nil)
+			}
+			groups[len(groups)-1] = append(groups[len(groups)-1], statement)
+		}
+	case PerBatch:
+		if batchSize <= 0 {
+			batchSize = DefaultApplyBatchSize
+		}
+		for start := 0; start < len(statements); start += batchSize {
+			groups = append(groups, statements[start:min(start+batchSize, len(statements))])
+		}
+	default:
+		for i := range statements {
+			groups = append(groups, statements[i:i+1])
+		}
+	}
+	return groups
+}
+
+// objectStatement reports whether statements of the kind complete the object
+// created by the statements preceding them
+func objectStatement(kind StatementKind) bool {
+	switch kind {
+	case CreateIndexStatement, CommentStatement, AlterTableStatement, GrantStatement, RevokeStatement:
+		return true
+	}
+	return false
+}
+
+// sortTables orders tables so that every table follows the tables its foreign keys
+// reference. Tables keep their order otherwise, and tables of a reference cycle are
+// left in their original order.
+func sortTables(tables []Table) []Table {
+	index := make(map[string]int, len(tables))
+	for i, table := range tables {
+		index[strings.ToLower(table.Name)] = i
+		index[strings.ToLower(table.QualifiedName())] = i
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(tables))
+	sorted := make([]Table, 0, len(tables))
+
+	var visit func(i int)
+	visit = func(i int) {
+		if state[i] != unvisited {
+			return
+		}
+		state[i] = visiting
+		for _, constraint := range tables[i].Constraints {
+			if !strings.EqualFold(constraint.Type, "FOREIGN KEY") {
+				continue
+			}
+			if j, ok := index[strings.ToLower(constraint.RefTable)]; ok && j != i {
+				visit(j)
+			}
+		}
+		state[i] = visited
+		sorted = append(sorted, tables[i])
+	}
+
+	for i := range tables {
+		visit(i)
+	}
+	return sorted
+}
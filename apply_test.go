@@ -0,0 +1,80 @@
+package sqlmapper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortTables(t *testing.T) {
+	tables := []Table{
+		{Name: "order_items", Constraints: []Constraint{
+			{Type: "FOREIGN KEY", Columns: []string{"order_id"}, RefTable: "orders"},
+			{Type: "FOREIGN KEY", Columns: []string{"product_id"}, RefTable: "shop.products"},
+		}},
+		{Name: "orders", Constraints: []Constraint{
+			{Type: "FOREIGN KEY", Columns: []string{"customer_id"}, RefTable: "customers"},
+		}},
+		{Name: "products", Schema: "shop"},
+		{Name: "customers"},
+		{Name: "employees", Constraints: []Constraint{
+			{Type: "FOREIGN KEY", Columns: []string{"manager_id"}, RefTable: "employees"},
+		}},
+	}
+
+	var names []string
+	for _, table := range sortTables(tables) {
+		names = append(names, table.Name)
+	}
+	assert.Equal(t, []string{"customers", "orders", "products", "order_items", "employees"}, names)
+
+	// Tables of a reference cycle are all kept
+	cycle := []Table{
+		{Name: "a", Constraints: []Constraint{{Type: "FOREIGN KEY", RefTable: "b"}}},
+		{Name: "b", Constraints: []Constraint{{Type: "FOREIGN KEY", RefTable: "a"}}},
+	}
+	assert.Len(t, sortTables(cycle), 2)
+}
+
+func TestApply_Validation(t *testing.T) {
+	err := Apply(context.Background(), nil, &Schema{}, nil, ApplyOptions{})
+	assert.EqualError(t, err, "database, schema and dialect are required")
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestApplyOptions_Strategy(t *testing.T) {
+	assert.Equal(t, SingleTransaction, ApplyOptions{}.strategy(PostgreSQL))
+	assert.Equal(t, PerStatement, ApplyOptions{}.strategy(MySQL))
+	assert.Equal(t, SingleTransaction, ApplyOptions{Transaction: true}.strategy(MySQL))
+	assert.Equal(t, PerBatch, ApplyOptions{Strategy: PerBatch}.strategy(PostgreSQL))
+}
+
+func TestGroupStatements(t *testing.T) {
+	statements := SplitStatements("CREATE TABLE users (id INT);\n"+
+		"CREATE INDEX idx_users ON users(id);\n"+
+		"COMMENT ON TABLE users IS 'People';\n"+
+		"CREATE TABLE posts (id INT);\n"+
+		"CREATE VIEW active AS SELECT id FROM users;\n", "")
+
+	kinds := func(groups [][]*Statement) [][]StatementKind {
+		var result [][]StatementKind
+		for _, group := range groups {
+			var kinds []StatementKind
+			for _, statement := range group {
+				kinds = append(kinds, statement.Kind)
+			}
+			result = append(result, kinds)
+		}
+		return result
+	}
+
+	table, index, comment, view := CreateTableStatement, CreateIndexStatement, CommentStatement, CreateViewStatement
+	assert.Equal(t, [][]StatementKind{{table, index, comment, table, view}}, kinds(groupStatements(statements, SingleTransaction, 0)))
+	assert.Equal(t, [][]StatementKind{{table, index, comment}, {table}, {view}}, kinds(groupStatements(statements, PerObject, 0)))
+	assert.Equal(t, [][]StatementKind{{table, index}, {comment, table}, {view}}, kinds(groupStatements(statements, PerBatch, 2)))
+	assert.Len(t, groupStatements(statements, PerBatch, 0), 1)
+	assert.Len(t, groupStatements(statements, PerStatement, 0), 5)
+	assert.Empty(t, groupStatements(nil, SingleTransaction, 0))
+}
@@ -0,0 +1,152 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// BlockScanner tracks the nesting of BEGIN ... END blocks in the bodies of triggers,
+// procedures and functions, so that the semicolons separating the statements of a body
+// are not mistaken for the end of the CREATE statement. Words must be passed to Word in
+// order, leaving out those inside string literals, quoted identifiers and comments.
+type BlockScanner struct {
+	depth      int
+	words      int
+	definition bool
+	routine    bool
+	trigger    bool
+	declared   bool
+	compound   bool
+	opened     bool
+	closed     bool
+}
+
+// Word advances the scanner past the next word of the statement
+func (b *BlockScanner) Word(word string) {
+	word = strings.ToUpper(word)
+	opened, closed, compound := b.opened, b.closed, b.compound
+	b.opened, b.closed, b.compound = false, false, false
+	b.words++
+
+	switch word {
+	case "CREATE", "ALTER":
+		b.definition = b.definition || b.words == 1
+	case "TRIGGER", "PROCEDURE", "PROC", "FUNCTION", "EVENT":
+		if compound {
+			// An Oracle COMPOUND TRIGGER is a block closed by END <name>
+			b.depth++
+			break
+		}
+		b.routine = b.routine || b.definition
+		b.trigger = b.trigger || b.definition && word == "TRIGGER"
+	case "COMPOUND":
+		b.compound = b.trigger && b.depth == 0
+	case "DECLARE":
+		// The declaration section of an Oracle trigger body belongs to the block
+		// opened by the following BEGIN
+		if b.trigger && b.depth == 0 {
+			b.depth++
+			b.declared = true
+		}
+	case "BEGIN":
+		if b.declared {
+			b.declared = false
+			break
+		}
+		// Only routine bodies contain blocks; elsewhere BEGIN may be a column name
+		if b.routine || b.depth > 0 {
+			b.depth++
+			b.opened = true
+		}
+	case "TRANSACTION", "TRAN", "WORK":
+		// BEGIN TRANSACTION starts a transaction rather than a block
+		if opened {
+			b.depth--
+		}
+	case "CASE":
+		if b.depth > 0 && !closed {
+			b.depth++
+		}
+	case "IF", "LOOP", "WHILE", "REPEAT":
+		// END IF, END LOOP, ... close compound statements that never opened a block
+		if closed {
+			b.depth++
+		}
+	case "END":
+		if b.depth > 0 {
+			b.depth--
+			b.closed = true
+		}
+	}
+}
+
+// Depth returns the number of blocks that are currently open
+func (b *BlockScanner) Depth() int {
+	return b.depth
+}
+
+// Reset prepares the scanner for the next statement
+func (b *BlockScanner) Reset() {
+	*b = BlockScanner{}
+}
+
+// FindBlocks returns the submatches of every match of re in content, followed by the
+// body of the BEGIN ... END block the match ends with. re must match up to and including
+// the BEGIN keyword. The body ends at the END matching that BEGIN, so nested blocks and
+// compound statements are kept intact. Matches without a terminated block are skipped.
+func FindBlocks(re *regexp.Regexp, content string) [][]string {
+	var blocks [][]string
+	for _, loc := range re.FindAllStringSubmatchIndex(content, -1) {
+		body, ok := blockBody(content[loc[1]:])
+		if !ok {
+			continue
+		}
+
+		match := make([]string, 0, len(loc)/2+1)
+		for i := 0; i < len(loc); i += 2 {
+			if loc[i] < 0 {
+				match = append(match, "")
+				continue
+			}
+			match = append(match, content[loc[i]:loc[i+1]])
+		}
+		blocks = append(blocks, append(match, body))
+	}
+	return blocks
+}
+
+// blockBody returns the content preceding the END that closes an already opened block
+func blockBody(content string) (string, bool) {
+	scanner := BlockScanner{depth: 1, routine: true}
+	end := -1
+
+	scanWords(content, func(word string, start int) bool {
+		closing := end >= 0
+		scanner.Word(word)
+		if scanner.Depth() > 0 {
+			// The END was followed by IF, LOOP, ... and did not close the block
+			end = -1
+			return true
+		}
+		if !closing {
+			end = start
+		}
+		return !closing
+	})
+
+	if end < 0 {
+		return "", false
+	}
+	return strings.TrimSpace(content[:end]), true
+}
+
+// scanWords calls fn with every word in content and its offset, skipping string
+// literals, quoted identifiers and comments. Scanning stops when fn returns false.
+func scanWords(content string, fn func(word string, start int) bool) {
+	lexer := NewLexer(content)
+	for token, ok := lexer.Next(); ok; token, ok = lexer.Next() {
+		if token.Type == WordToken && !fn(token.Text, token.Offset) {
+			return
+		}
+	}
+}
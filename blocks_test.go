@@ -0,0 +1,91 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindBlocks(t *testing.T) {
+	triggerRe := regexp.MustCompile(`(?i)CREATE\s+TRIGGER\s+(\w+)\s+.*?\bBEGIN\b`)
+
+	tests := []struct {
+		name    string
+		content string
+		want    [][]string
+	}{
+		{
+			name: "Body with three statements",
+			content: "CREATE TRIGGER audit AFTER UPDATE ON users FOR EACH ROW BEGIN " +
+				"INSERT INTO log VALUES (NEW.id); UPDATE stats SET n = n + 1; DELETE FROM cache; END;",
+			want: [][]string{{
+				"CREATE TRIGGER audit AFTER UPDATE ON users FOR EACH ROW BEGIN",
+				"audit",
+				"INSERT INTO log VALUES (NEW.id); UPDATE stats SET n = n + 1; DELETE FROM cache;",
+			}},
+		},
+		{
+			name: "Nested blocks and compound statements",
+			content: "CREATE TRIGGER check_total BEFORE INSERT ON orders FOR EACH ROW BEGIN " +
+				"IF NEW.total < 0 THEN BEGIN SET NEW.total = 0; END; END IF; " +
+				"SET NEW.kind = CASE WHEN NEW.total > 100 THEN 'end' ELSE 'small' END; END",
+			want: [][]string{{
+				"CREATE TRIGGER check_total BEFORE INSERT ON orders FOR EACH ROW BEGIN",
+				"check_total",
+				"IF NEW.total < 0 THEN BEGIN SET NEW.total = 0; END; END IF; " +
+					"SET NEW.kind = CASE WHEN NEW.total > 100 THEN 'end' ELSE 'small' END;",
+			}},
+		},
+		{
+			name: "Multiple triggers",
+			content: "CREATE TRIGGER a AFTER INSERT ON t BEGIN SELECT 1; END; " +
+				"CREATE TRIGGER b AFTER DELETE ON t BEGIN SELECT 2; END;",
+			want: [][]string{
+				{"CREATE TRIGGER a AFTER INSERT ON t BEGIN", "a", "SELECT 1;"},
+				{"CREATE TRIGGER b AFTER DELETE ON t BEGIN", "b", "SELECT 2;"},
+			},
+		},
+		{
+			name:    "Unterminated block",
+			content: "CREATE TRIGGER a AFTER INSERT ON t BEGIN SELECT 1;",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FindBlocks(triggerRe, tt.content))
+		})
+	}
+}
+
+func TestBlockScanner(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string
+		want  int
+	}{
+		{name: "Routine body", words: []string{"CREATE", "PROCEDURE", "p", "BEGIN", "SELECT", "1"}, want: 1},
+		{name: "Closed body", words: []string{"CREATE", "TRIGGER", "t", "BEGIN", "SELECT", "1", "END"}, want: 0},
+		{name: "END IF does not close the body", words: []string{"CREATE", "FUNCTION", "f", "BEGIN", "IF", "x", "THEN", "y", "END", "IF"}, want: 1},
+		{name: "Transaction inside body", words: []string{"CREATE", "PROC", "p", "AS", "BEGIN", "BEGIN", "TRANSACTION"}, want: 1},
+		{name: "Transaction statement", words: []string{"BEGIN"}, want: 0},
+		{name: "Column named begin", words: []string{"CREATE", "TABLE", "t", "begin", "DATE"}, want: 0},
+		{name: "Trigger declarations", words: []string{"CREATE", "TRIGGER", "t", "DECLARE", "v", "DATE"}, want: 1},
+		{name: "Trigger declarations and body", words: []string{"CREATE", "TRIGGER", "t", "DECLARE", "v", "DATE", "BEGIN", "NULL", "END"}, want: 0},
+		{name: "Declaration inside body", words: []string{"CREATE", "TRIGGER", "t", "BEGIN", "DECLARE", "v", "INT"}, want: 1},
+		{name: "Compound trigger", words: []string{"CREATE", "TRIGGER", "t", "FOR", "INSERT", "ON", "x", "COMPOUND", "TRIGGER", "BEFORE", "EACH", "ROW", "IS", "BEGIN", "NULL", "END", "BEFORE", "EACH", "ROW"}, want: 1},
+		{name: "Closed compound trigger", words: []string{"CREATE", "TRIGGER", "t", "FOR", "INSERT", "ON", "x", "COMPOUND", "TRIGGER", "AFTER", "STATEMENT", "IS", "BEGIN", "NULL", "END", "AFTER", "STATEMENT", "END", "t"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scanner BlockScanner
+			for _, word := range tt.words {
+				scanner.Word(word)
+			}
+			assert.Equal(t, tt.want, scanner.Depth())
+		})
+	}
+}
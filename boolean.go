@@ -0,0 +1,45 @@
+package sqlmapper
+
+import "strings"
+
+// booleanDefaults maps the defaults of a MySQL boolean column to boolean literals
+var booleanDefaults = map[string]string{
+	"0":     "false",
+	"1":     "true",
+	"'0'":   "false",
+	"'1'":   "true",
+	"b'0'":  "false",
+	"b'1'":  "true",
+	"false": "false",
+	"true":  "true",
+	"null":  "NULL",
+}
+
+// IsTinyIntBoolean reports whether a column is a TINYINT(1), which MySQL uses for
+// booleans
+func IsTinyIntBoolean(column Column) bool {
+	return strings.EqualFold(column.DataType, "TINYINT") && column.Length == 1
+}
+
+// TinyIntBoolean converts a TINYINT(1) column to BOOLEAN for dialects having a
+// boolean type, turning a default of 0 or 1 into false or true. A column whose
+// default is not a boolean is returned unchanged, as are other columns; the second
+// return value reports whether the column was converted.
+func TinyIntBoolean(column Column) (Column, bool) {
+	if !IsTinyIntBoolean(column) {
+		return column, false
+	}
+
+	if column.DefaultValue != "" {
+		value, ok := booleanDefaults[strings.ToLower(strings.TrimSpace(column.DefaultValue))]
+		if !ok {
+			return column, false
+		}
+		column.DefaultValue = value
+	}
+
+	column.DataType = "BOOLEAN"
+	column.Length, column.Scale, column.Precision = 0, 0, 0
+	column.Unsigned, column.Zerofill = false, false
+	return column, true
+}
@@ -0,0 +1,53 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTinyIntBoolean(t *testing.T) {
+	tests := []struct {
+		name      string
+		column    Column
+		want      Column
+		converted bool
+	}{
+		{
+			name:      "Default 1",
+			column:    Column{Name: "active", DataType: "tinyint", Length: 1, DefaultValue: "1"},
+			want:      Column{Name: "active", DataType: "BOOLEAN", DefaultValue: "true"},
+			converted: true,
+		},
+		{
+			name:      "Quoted default 0",
+			column:    Column{Name: "deleted", DataType: "TINYINT", Length: 1, DefaultValue: "'0'", IsNullable: true},
+			want:      Column{Name: "deleted", DataType: "BOOLEAN", DefaultValue: "false", IsNullable: true},
+			converted: true,
+		},
+		{
+			name:      "No default",
+			column:    Column{Name: "flag", DataType: "TINYINT", Length: 1, Unsigned: true},
+			want:      Column{Name: "flag", DataType: "BOOLEAN"},
+			converted: true,
+		},
+		{
+			name:   "Non-boolean default",
+			column: Column{Name: "level", DataType: "TINYINT", Length: 1, DefaultValue: "5"},
+			want:   Column{Name: "level", DataType: "TINYINT", Length: 1, DefaultValue: "5"},
+		},
+		{
+			name:   "Wider TINYINT",
+			column: Column{Name: "level", DataType: "TINYINT", Length: 4, DefaultValue: "0"},
+			want:   Column{Name: "level", DataType: "TINYINT", Length: 4, DefaultValue: "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, converted := TinyIntBoolean(tt.column)
+			assert.Equal(t, tt.converted, converted)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
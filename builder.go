@@ -0,0 +1,149 @@
+package sqlmapper
+
+// TableBuilder adds columns, indexes and constraints to a table of a schema built in
+// code, such as in tests or by code generators. Its methods return the builder so
+// that calls can be chained:
+//
+//	schema := &Schema{}
+//	schema.AddTable("users").
+//		AddColumn("id", "INT", WithPrimaryKey(), WithAutoIncrement()).
+//		AddColumn("email", "VARCHAR(255)", WithNotNull(), WithUnique())
+type TableBuilder struct {
+	schema *Schema
+	index  int // Position of the table in schema.Tables, which may be reallocated
+}
+
+// ColumnOption sets a property of a column added by TableBuilder.AddColumn
+type ColumnOption func(*Column)
+
+// AddTable appends a table with the given name to the schema and returns a builder
+// for it
+func (s *Schema) AddTable(name string) *TableBuilder {
+	s.Tables = append(s.Tables, Table{Name: name})
+	return &TableBuilder{schema: s, index: len(s.Tables) - 1}
+}
+
+// AddTable appends another table to the schema of the builder and returns a builder
+// for it
+func (b *TableBuilder) AddTable(name string) *TableBuilder {
+	return b.schema.AddTable(name)
+}
+
+// Table returns the table being built. The pointer is invalidated by tables added to
+// the schema afterwards.
+func (b *TableBuilder) Table() *Table {
+	return &b.schema.Tables[b.index]
+}
+
+// AddColumn appends a nullable column to the table. The parameters of the data type,
+// such as VARCHAR(255) or DECIMAL(10,2), are stored as its length and scale. Like the
+// parsers, it records the primary key, unique and check options of the column as
+// table constraints too.
+func (b *TableBuilder) AddColumn(name, dataType string, options ...ColumnOption) *TableBuilder {
+	table := b.Table()
+	column := Column{Name: name, IsNullable: true, Order: len(table.Columns) + 1}
+	column.DataType, column.Length, column.Scale = ParseDataType(dataType)
+	for _, option := range options {
+		option(&column)
+	}
+	table.Columns = append(table.Columns, column)
+
+	if column.IsPrimaryKey {
+		table.Constraints = append(table.Constraints, Constraint{Type: "PRIMARY KEY", Columns: []string{name}})
+	}
+	if column.IsUnique {
+		table.Constraints = append(table.Constraints, Constraint{Type: "UNIQUE", Columns: []string{name}})
+	}
+	if column.CheckExpression != "" {
+		table.Constraints = append(table.Constraints, Constraint{Type: "CHECK", Columns: []string{name}, CheckExpression: column.CheckExpression})
+	}
+	return b
+}
+
+// AddIndex appends an index on the given columns to the table
+func (b *TableBuilder) AddIndex(name string, columns ...string) *TableBuilder {
+	table := b.Table()
+	table.Indexes = append(table.Indexes, Index{Name: name, Columns: IndexColumns(columns...)})
+	return b
+}
+
+// AddUniqueIndex appends a unique index on the given columns to the table
+func (b *TableBuilder) AddUniqueIndex(name string, columns ...string) *TableBuilder {
+	table := b.Table()
+	table.Indexes = append(table.Indexes, Index{Name: name, Columns: IndexColumns(columns...), IsUnique: true})
+	return b
+}
+
+// AddPrimaryKey appends a primary key constraint on the given columns to the table,
+// for keys spanning several columns
+func (b *TableBuilder) AddPrimaryKey(name string, columns ...string) *TableBuilder {
+	table := b.Table()
+	table.Constraints = append(table.Constraints, Constraint{Name: name, Type: "PRIMARY KEY", Columns: columns})
+	return b
+}
+
+// AddForeignKey appends a foreign key constraint from the given column of the table
+// to a column of the referenced table
+func (b *TableBuilder) AddForeignKey(name, column, refTable, refColumn string) *TableBuilder {
+	table := b.Table()
+	table.Constraints = append(table.Constraints, Constraint{
+		Name:       name,
+		Type:       "FOREIGN KEY",
+		Columns:    []string{column},
+		RefTable:   refTable,
+		RefColumns: []string{refColumn},
+	})
+	return b
+}
+
+// WithPrimaryKey makes the column the primary key of its table, which also makes it
+// NOT NULL
+func WithPrimaryKey() ColumnOption {
+	return func(c *Column) {
+		c.IsPrimaryKey = true
+		c.IsNullable = false
+	}
+}
+
+// WithNotNull makes the column NOT NULL
+func WithNotNull() ColumnOption {
+	return func(c *Column) {
+		c.IsNullable = false
+	}
+}
+
+// WithUnique makes the values of the column unique
+func WithUnique() ColumnOption {
+	return func(c *Column) {
+		c.IsUnique = true
+	}
+}
+
+// WithAutoIncrement makes the column generate its values
+func WithAutoIncrement() ColumnOption {
+	return func(c *Column) {
+		c.AutoIncrement = true
+	}
+}
+
+// WithDefault sets the default value of the column, written as given, e.g. 0 or
+// CURRENT_TIMESTAMP
+func WithDefault(value string) ColumnOption {
+	return func(c *Column) {
+		c.DefaultValue = value
+	}
+}
+
+// WithCheck sets the CHECK expression of the column
+func WithCheck(expression string) ColumnOption {
+	return func(c *Column) {
+		c.CheckExpression = expression
+	}
+}
+
+// WithComment sets the comment of the column
+func WithComment(comment string) ColumnOption {
+	return func(c *Column) {
+		c.Comment = comment
+	}
+}
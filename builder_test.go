@@ -0,0 +1,67 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_AddTable(t *testing.T) {
+	schema := &Schema{}
+	schema.AddTable("users").
+		AddColumn("id", "INT", WithPrimaryKey(), WithAutoIncrement()).
+		AddColumn("email", "VARCHAR(255)", WithNotNull(), WithUnique()).
+		AddColumn("balance", "DECIMAL(10,2)", WithDefault("0"), WithCheck("balance >= 0"), WithComment("In cents")).
+		AddIndex("idx_users_balance", "balance").
+		AddTable("posts").
+		AddColumn("id", "INT", WithPrimaryKey()).
+		AddColumn("user_id", "INT", WithNotNull()).
+		AddUniqueIndex("uq_posts_user", "user_id", "id").
+		AddForeignKey("fk_posts_user", "user_id", "users", "id")
+
+	assert.Len(t, schema.Tables, 2)
+
+	users := schema.Tables[0]
+	assert.Equal(t, "users", users.Name)
+	assert.Equal(t, []Column{
+		{Name: "id", DataType: "INT", IsPrimaryKey: true, AutoIncrement: true, Order: 1},
+		{Name: "email", DataType: "VARCHAR", Length: 255, IsUnique: true, Order: 2},
+		{Name: "balance", DataType: "DECIMAL", Length: 10, Scale: 2, IsNullable: true, DefaultValue: "0",
+			CheckExpression: "balance >= 0", Comment: "In cents", Order: 3},
+	}, users.Columns)
+	assert.Equal(t, []Index{{Name: "idx_users_balance", Columns: IndexColumns("balance")}}, users.Indexes)
+	assert.Equal(t, []Constraint{
+		{Type: "PRIMARY KEY", Columns: []string{"id"}},
+		{Type: "UNIQUE", Columns: []string{"email"}},
+		{Type: "CHECK", Columns: []string{"balance"}, CheckExpression: "balance >= 0"},
+	}, users.Constraints)
+
+	posts := schema.Tables[1]
+	assert.Equal(t, "posts", posts.Name)
+	assert.Len(t, posts.Columns, 2)
+	assert.Equal(t, []Index{{Name: "uq_posts_user", Columns: IndexColumns("user_id", "id"), IsUnique: true}}, posts.Indexes)
+	assert.Equal(t, []Constraint{{Type: "PRIMARY KEY", Columns: []string{"id"}}, {
+		Name:       "fk_posts_user",
+		Type:       "FOREIGN KEY",
+		Columns:    []string{"user_id"},
+		RefTable:   "users",
+		RefColumns: []string{"id"},
+	}}, posts.Constraints)
+}
+
+func TestTableBuilder_AddPrimaryKey(t *testing.T) {
+	schema := &Schema{}
+	builder := schema.AddTable("memberships").
+		AddColumn("user_id", "INT", WithNotNull()).
+		AddColumn("group_id", "INT", WithNotNull()).
+		AddPrimaryKey("pk_memberships", "user_id", "group_id")
+
+	// Tables added later reallocate the slice without detaching the builder
+	schema.AddTable("groups")
+	builder.AddColumn("joined_at", "TIMESTAMP")
+
+	table := builder.Table()
+	assert.Equal(t, "memberships", table.Name)
+	assert.Len(t, table.Columns, 3)
+	assert.Equal(t, []Constraint{{Name: "pk_memberships", Type: "PRIMARY KEY", Columns: []string{"user_id", "group_id"}}}, table.Constraints)
+}
@@ -0,0 +1,101 @@
+package sqlmapper
+
+// DialectCapabilities lists the features a dialect supports natively, so that tools
+// can tell in advance which constructs a conversion has to fall back on
+type DialectCapabilities struct {
+	Sequences         bool // CREATE SEQUENCE
+	Enums             bool // ENUM columns or CREATE TYPE ... AS ENUM
+	PartialIndexes    bool // Indexes with a WHERE clause
+	MaterializedViews bool // CREATE MATERIALIZED VIEW
+	CheckConstraints  bool // CHECK constraints are enforced
+	TransactionalDDL  bool // DDL statements can be rolled back
+	IfNotExists       bool // CREATE TABLE IF NOT EXISTS
+	UnsignedIntegers  bool // UNSIGNED integer types
+	Schemas           bool // Objects qualified by a schema other than the database
+	Permissions       bool // GRANT and REVOKE
+
+	// MaxIdentifierLength is the longest name of an object in bytes, 0 when unlimited
+	MaxIdentifierLength int
+
+	// Indexes
+	FulltextIndexes bool // FULLTEXT indexes
+	SpatialIndexes  bool // SPATIAL indexes
+	IncludeColumns  bool // Non-key columns of covering indexes (INCLUDE)
+	NullsOrdering   bool // NULLS FIRST/LAST in index keys
+
+	// Tables
+	TableInheritance        bool // INHERITS
+	DeclarativePartitioning bool // Partitions created as tables with PARTITION OF
+
+	// Triggers
+	InsteadOfTriggers bool // INSTEAD OF triggers on views
+	TruncateTriggers  bool // Triggers fired by TRUNCATE
+}
+
+// capabilities holds the capabilities of the supported dialects
+var capabilities = map[DatabaseType]DialectCapabilities{
+	MySQL: {
+		Enums:               true,
+		CheckConstraints:    true,
+		IfNotExists:         true,
+		UnsignedIntegers:    true,
+		Permissions:         true,
+		FulltextIndexes:     true,
+		SpatialIndexes:      true,
+		MaxIdentifierLength: 64,
+	},
+	PostgreSQL: {
+		Sequences:               true,
+		Enums:                   true,
+		PartialIndexes:          true,
+		MaterializedViews:       true,
+		CheckConstraints:        true,
+		TransactionalDDL:        true,
+		IfNotExists:             true,
+		Schemas:                 true,
+		Permissions:             true,
+		IncludeColumns:          true,
+		NullsOrdering:           true,
+		TableInheritance:        true,
+		DeclarativePartitioning: true,
+		InsteadOfTriggers:       true,
+		TruncateTriggers:        true,
+		MaxIdentifierLength:     63,
+	},
+	SQLite: {
+		PartialIndexes:    true,
+		CheckConstraints:  true,
+		TransactionalDDL:  true,
+		IfNotExists:       true,
+		NullsOrdering:     true,
+		InsteadOfTriggers: true,
+	},
+	SQLServer: {
+		Sequences:           true,
+		PartialIndexes:      true,
+		CheckConstraints:    true,
+		TransactionalDDL:    true,
+		Schemas:             true,
+		Permissions:         true,
+		SpatialIndexes:      true,
+		IncludeColumns:      true,
+		InsteadOfTriggers:   true,
+		MaxIdentifierLength: 128,
+	},
+	Oracle: {
+		Sequences:           true,
+		MaterializedViews:   true,
+		CheckConstraints:    true,
+		IfNotExists:         true,
+		Schemas:             true,
+		Permissions:         true,
+		InsteadOfTriggers:   true,
+		MaxIdentifierLength: 128,
+	},
+}
+
+// Capabilities returns the features supported by a dialect, or no features for an
+// unknown dialect
+func Capabilities(dbType DatabaseType) DialectCapabilities {
+	return capabilities[dbType]
+}
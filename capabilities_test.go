@@ -0,0 +1,86 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	tests := []struct {
+		name   string
+		dbType DatabaseType
+		check  func(t *testing.T, c DialectCapabilities)
+	}{
+		{
+			name:   "MySQL",
+			dbType: MySQL,
+			check: func(t *testing.T, c DialectCapabilities) {
+				assert.True(t, c.Enums)
+				assert.True(t, c.UnsignedIntegers)
+				assert.True(t, c.FulltextIndexes)
+				assert.False(t, c.Sequences)
+				assert.False(t, c.TransactionalDDL)
+				assert.False(t, c.PartialIndexes)
+			},
+		},
+		{
+			name:   "PostgreSQL",
+			dbType: PostgreSQL,
+			check: func(t *testing.T, c DialectCapabilities) {
+				assert.True(t, c.Sequences)
+				assert.True(t, c.Enums)
+				assert.True(t, c.MaterializedViews)
+				assert.True(t, c.TableInheritance)
+				assert.True(t, c.DeclarativePartitioning)
+				assert.False(t, c.UnsignedIntegers)
+			},
+		},
+		{
+			name:   "SQLite",
+			dbType: SQLite,
+			check: func(t *testing.T, c DialectCapabilities) {
+				assert.False(t, c.Enums)
+				assert.False(t, c.Sequences)
+				assert.False(t, c.Permissions)
+				assert.False(t, c.TruncateTriggers)
+				assert.True(t, c.PartialIndexes)
+				assert.True(t, c.TransactionalDDL)
+			},
+		},
+		{
+			name:   "SQL Server",
+			dbType: SQLServer,
+			check: func(t *testing.T, c DialectCapabilities) {
+				assert.True(t, c.Sequences)
+				assert.True(t, c.SpatialIndexes)
+				assert.True(t, c.IncludeColumns)
+				assert.False(t, c.Enums)
+				assert.False(t, c.IfNotExists)
+			},
+		},
+		{
+			name:   "Oracle",
+			dbType: Oracle,
+			check: func(t *testing.T, c DialectCapabilities) {
+				assert.True(t, c.Sequences)
+				assert.True(t, c.MaterializedViews)
+				assert.False(t, c.TransactionalDDL)
+				assert.False(t, c.Enums)
+			},
+		},
+		{
+			name:   "Unknown dialect",
+			dbType: DatabaseType("db2"),
+			check: func(t *testing.T, c DialectCapabilities) {
+				assert.Equal(t, DialectCapabilities{}, c)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, Capabilities(tt.dbType))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package sqlmapper
+
+// Clone returns a deep copy of the schema that shares no slices, maps or
+// pointers with the original, so the copy can be transformed without
+// affecting the original schema
+func (s *Schema) Clone() *Schema {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+
+	c.Tables = nil
+	for _, table := range s.Tables {
+		c.Tables = append(c.Tables, cloneTable(table))
+	}
+
+	c.Procedures = nil
+	for _, procedure := range s.Procedures {
+		procedure.Parameters = cloneSlice(procedure.Parameters)
+		c.Procedures = append(c.Procedures, procedure)
+	}
+
+	c.Functions = nil
+	for _, function := range s.Functions {
+		function.Parameters = cloneSlice(function.Parameters)
+		c.Functions = append(c.Functions, function)
+	}
+
+	c.Triggers = nil
+	for _, trigger := range s.Triggers {
+		trigger.Events = cloneSlice(trigger.Events)
+		c.Triggers = append(c.Triggers, trigger)
+	}
+
+	c.Views = cloneSlice(s.Views)
+	c.Sequences = cloneSlice(s.Sequences)
+	c.Extensions = cloneSlice(s.Extensions)
+	c.DatabaseLinks = cloneSlice(s.DatabaseLinks)
+	c.Tablespaces = cloneSlice(s.Tablespaces)
+	c.Types = cloneSlice(s.Types)
+	c.Drops = cloneSlice(s.Drops)
+	c.Settings = cloneSlice(s.Settings)
+
+	c.Permissions = nil
+	for _, permission := range s.Permissions {
+		c.Permissions = append(c.Permissions, clonePermission(permission))
+	}
+
+	c.UserDefinedTypes = nil
+	for _, udt := range s.UserDefinedTypes {
+		udt.Properties = cloneMap(udt.Properties)
+		c.UserDefinedTypes = append(c.UserDefinedTypes, udt)
+	}
+
+	if s.Partitions != nil {
+		c.Partitions = make(map[string][]Partition, len(s.Partitions))
+		for table, partitions := range s.Partitions {
+			var copied []Partition
+			for _, partition := range partitions {
+				copied = append(copied, clonePartition(partition))
+			}
+			c.Partitions[table] = copied
+		}
+	}
+
+	c.Roles = nil
+	for _, role := range s.Roles {
+		role.Members = cloneSlice(role.Members)
+		role.Permissions = clonePermissions(role.Permissions)
+		c.Roles = append(c.Roles, role)
+	}
+
+	c.Users = nil
+	for _, user := range s.Users {
+		user.Roles = cloneSlice(user.Roles)
+		user.Permissions = clonePermissions(user.Permissions)
+		c.Users = append(c.Users, user)
+	}
+
+	c.Clusters = nil
+	for _, cluster := range s.Clusters {
+		cluster.Key = cloneSlice(cluster.Key)
+		cluster.Tables = cloneSlice(cluster.Tables)
+		cluster.Storage = cloneStorage(cluster.Storage)
+		c.Clusters = append(c.Clusters, cluster)
+	}
+
+	c.MaterializedLogs = nil
+	for _, log := range s.MaterializedLogs {
+		log.Columns = cloneSlice(log.Columns)
+		log.Storage = cloneStorage(log.Storage)
+		c.MaterializedLogs = append(c.MaterializedLogs, log)
+	}
+
+	return &c
+}
+
+// cloneTable returns a deep copy of a table
+func cloneTable(table Table) Table {
+	table.Columns = cloneSlice(table.Columns)
+	for i := range table.Columns {
+		table.Columns[i].Members = cloneSlice(table.Columns[i].Members)
+	}
+	table.Inherits = cloneSlice(table.Inherits)
+	table.Storage = cloneStorage(table.Storage)
+
+	indexes := table.Indexes
+	table.Indexes = nil
+	for _, index := range indexes {
+		index.Columns = cloneSlice(index.Columns)
+		index.IncludeColumns = cloneSlice(index.IncludeColumns)
+		index.Storage = cloneStorage(index.Storage)
+		table.Indexes = append(table.Indexes, index)
+	}
+
+	constraints := table.Constraints
+	table.Constraints = nil
+	for _, constraint := range constraints {
+		constraint.Columns = cloneSlice(constraint.Columns)
+		constraint.RefColumns = cloneSlice(constraint.RefColumns)
+		table.Constraints = append(table.Constraints, constraint)
+	}
+
+	rows := table.Data
+	table.Data = nil
+	for _, row := range rows {
+		table.Data = append(table.Data, Row{Values: cloneMap(row.Values)})
+	}
+
+	return table
+}
+
+// clonePartition returns a deep copy of a partition
+func clonePartition(partition Partition) Partition {
+	partition.Values = cloneSlice(partition.Values)
+	partition.Storage = cloneStorage(partition.Storage)
+
+	subPartitions := partition.SubPartitions
+	partition.SubPartitions = nil
+	for _, sub := range subPartitions {
+		sub.Values = cloneSlice(sub.Values)
+		sub.Storage = cloneStorage(sub.Storage)
+		partition.SubPartitions = append(partition.SubPartitions, sub)
+	}
+
+	return partition
+}
+
+// clonePermission returns a deep copy of a permission
+func clonePermission(permission Permission) Permission {
+	permission.Privileges = cloneSlice(permission.Privileges)
+	return permission
+}
+
+// clonePermissions returns a deep copy of a permission list
+func clonePermissions(permissions []Permission) []Permission {
+	if permissions == nil {
+		return nil
+	}
+	copied := make([]Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		copied = append(copied, clonePermission(permission))
+	}
+	return copied
+}
+
+// cloneStorage returns a copy of a storage clause
+func cloneStorage(storage *StorageClause) *StorageClause {
+	if storage == nil {
+		return nil
+	}
+	copied := *storage
+	return &copied
+}
+
+// cloneSlice returns a shallow copy of a slice, preserving nil
+func cloneSlice[T any](items []T) []T {
+	if items == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(items)), items...)
+}
+
+// cloneMap returns a shallow copy of a map, preserving nil
+func cloneMap(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,68 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_Clone(t *testing.T) {
+	newSchema := func() *Schema {
+		return &Schema{
+			Name: "shop",
+			Tables: []Table{{
+				Name: "orders",
+				Columns: []Column{
+					{Name: "id", DataType: "INT", IsPrimaryKey: true},
+					{Name: "flags", DataType: "SET", Members: []string{"gift", "express"}},
+				},
+				Indexes: []Index{{
+					Name:           "idx_orders_user",
+					Columns:        []IndexColumn{{Name: "user_id"}, {Name: "created_at", Descending: true}},
+					IncludeColumns: []string{"total"},
+					Storage:        &StorageClause{Initial: 65536},
+				}},
+				Constraints: []Constraint{{Name: "fk_orders_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefColumns: []string{"id"}}},
+				Data:        []Row{{Values: map[string]interface{}{"id": 1}}},
+				Storage:     &StorageClause{Initial: 1048576},
+			}},
+			Procedures:  []Procedure{{Name: "cleanup", Parameters: []Parameter{{Name: "days", DataType: "INT"}}}},
+			Functions:   []Function{{Name: "total", Parameters: []Parameter{{Name: "order_id", DataType: "INT"}}}},
+			Triggers:    []Trigger{{Name: "audit", Events: []string{"INSERT"}}},
+			Permissions: []Permission{{Type: "GRANT", Privileges: []string{"SELECT"}, Object: "orders"}},
+			Partitions:  map[string][]Partition{"orders": {{Name: "p2024", Values: []string{"2024"}}}},
+			Roles:       []Role{{Name: "reader", Members: []string{"alice"}}},
+			Drops:       []Drop{{Type: "TABLE", Name: "orders", IfExists: true}},
+			Settings:    []SessionSetting{{Name: "NAMES", Value: "utf8mb4"}},
+		}
+	}
+
+	original := newSchema()
+	clone := original.Clone()
+	assert.Equal(t, original, clone)
+
+	clone.Name = "copy"
+	clone.Tables[0].Name = "orders_copy"
+	clone.Tables[0].Columns[0].DataType = "BIGINT"
+	clone.Tables[0].Columns[1].Members[0] = "fragile"
+	clone.Tables[0].Indexes[0].Columns[0].Name = "tenant_id"
+	clone.Tables[0].Indexes[0].Columns[1].Descending = false
+	clone.Tables[0].Indexes[0].IncludeColumns[0] = "status"
+	clone.Tables[0].Indexes[0].Storage.Initial = 131072
+	clone.Tables[0].Constraints[0].Columns[0] = "tenant_id"
+	clone.Tables[0].Constraints[0].RefColumns[0] = "tenant_id"
+	clone.Tables[0].Data[0].Values["id"] = 2
+	clone.Tables[0].Storage.Initial = 2097152
+	clone.Tables = append(clone.Tables, Table{Name: "users"})
+	clone.Procedures[0].Parameters[0].Name = "weeks"
+	clone.Functions[0].Parameters[0].DataType = "BIGINT"
+	clone.Triggers[0].Events[0] = "DELETE"
+	clone.Permissions[0].Privileges[0] = "INSERT"
+	clone.Partitions["orders"][0].Values[0] = "2025"
+	clone.Roles[0].Members[0] = "bob"
+	clone.Drops[0].IfExists = false
+	clone.Settings[0].Value = "latin1"
+
+	assert.Equal(t, newSchema(), original)
+	assert.Nil(t, (*Schema)(nil).Clone())
+}
@@ -0,0 +1,120 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// commentOnRe matches a COMMENT ON TABLE or COMMENT ON COLUMN statement
+var commentOnRe = regexp.MustCompile(`(?is)COMMENT\s+ON\s+(TABLE|COLUMN)\s+([^\s;]+)\s+IS\s+('(?:[^']|'')*'|NULL)`)
+
+// Comment is a comment set on a table or a column by a separate COMMENT ON
+// statement, as used by PostgreSQL and Oracle
+type Comment struct {
+	Schema string
+	Table  string
+	Column string // Empty for a table comment
+	Text   string // Empty when the comment is removed with IS NULL
+}
+
+// ParseCommentOn recognizes a COMMENT ON TABLE or COMMENT ON COLUMN statement and
+// returns the comment it sets. The second return value is false for other statements.
+func ParseCommentOn(statement string) (*Comment, bool) {
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(statement)), "COMMENT") {
+		return nil, false
+	}
+
+	match := commentOnRe.FindStringSubmatch(statement)
+	if match == nil {
+		return nil, false
+	}
+	return newComment(match), true
+}
+
+// ParseComments returns the COMMENT ON statements found in a SQL dump
+func ParseComments(content string) []Comment {
+	var comments []Comment
+	for _, match := range commentOnRe.FindAllStringSubmatch(content, -1) {
+		comments = append(comments, *newComment(match))
+	}
+	return comments
+}
+
+// newComment creates a comment from a match of commentOnRe
+func newComment(match []string) *Comment {
+	comment := &Comment{}
+
+	parts := strings.Split(match[2], ".")
+	if strings.EqualFold(match[1], "COLUMN") {
+		comment.Column = trimIdentifier(parts[len(parts)-1])
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) > 1 {
+		comment.Schema = trimIdentifier(parts[len(parts)-2])
+	}
+	if len(parts) > 0 {
+		comment.Table = trimIdentifier(parts[len(parts)-1])
+	}
+
+	if text := match[3]; !strings.EqualFold(text, "NULL") {
+		comment.Text = strings.ReplaceAll(text[1:len(text)-1], "''", "'")
+	}
+
+	return comment
+}
+
+// ApplyComment sets the comment on the table or column it refers to. It returns
+// false when the table or column is not part of the schema, so that the comment
+// can be kept until the object has been parsed.
+func (s *Schema) ApplyComment(comment Comment) bool {
+	name := comment.Table
+	if comment.Schema != "" {
+		name = comment.Schema + "." + name
+	}
+
+	table := s.findTable(name)
+	if table == nil {
+		return false
+	}
+
+	if comment.Column == "" {
+		table.Comment = comment.Text
+		return true
+	}
+
+	for i := range table.Columns {
+		if strings.EqualFold(table.Columns[i].Name, comment.Column) {
+			table.Columns[i].Comment = comment.Text
+			return true
+		}
+	}
+	return false
+}
+
+// CommentStatements returns the COMMENT ON statements, without terminating
+// semicolons, setting the comments of the table and its columns
+func CommentStatements(table Table) []string {
+	return QuotedCommentStatements(table, QuoteLiteral)
+}
+
+// QuotedCommentStatements returns the COMMENT ON statements of a table like
+// CommentStatements, quoting the comments with the string literals of a dialect
+func QuotedCommentStatements(table Table, quote func(string) string) []string {
+	var statements []string
+	if table.Comment != "" {
+		statements = append(statements, "COMMENT ON TABLE "+table.Name+" IS "+quote(table.Comment))
+	}
+	for _, column := range table.Columns {
+		if column.Comment != "" {
+			statements = append(statements, "COMMENT ON COLUMN "+table.Name+"."+column.Name+" IS "+quote(column.Comment))
+		}
+	}
+	return statements
+}
+
+// QuoteLiteral quotes a value as a standard SQL string literal, doubling its single
+// quotes. Backslashes and line breaks are kept as they are, which standard string
+// literals allow.
+func QuoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,100 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommentOn(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      *Comment
+	}{
+		{
+			name:      "Table comment",
+			statement: "COMMENT ON TABLE users IS 'Registered users';",
+			want:      &Comment{Table: "users", Text: "Registered users"},
+		},
+		{
+			name:      "Qualified column comment",
+			statement: `comment on column public."users".email is 'User''s login'`,
+			want:      &Comment{Schema: "public", Table: "users", Column: "email", Text: "User's login"},
+		},
+		{
+			name:      "Multiline text",
+			statement: "COMMENT ON COLUMN users.bio IS 'First line;\nsecond line'",
+			want:      &Comment{Table: "users", Column: "bio", Text: "First line;\nsecond line"},
+		},
+		{
+			name:      "Removed comment",
+			statement: "COMMENT ON TABLE users IS NULL",
+			want:      &Comment{Table: "users"},
+		},
+		{
+			name:      "Other statement",
+			statement: "CREATE TABLE notes (body TEXT); COMMENT ON TABLE notes IS 'x'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment, ok := ParseCommentOn(tt.statement)
+			assert.Equal(t, tt.want != nil, ok)
+			assert.Equal(t, tt.want, comment)
+		})
+	}
+}
+
+func TestSchema_ApplyComment(t *testing.T) {
+	schema := &Schema{Tables: []Table{{
+		Name:    "users",
+		Columns: []Column{{Name: "id"}, {Name: "email"}},
+	}}}
+
+	content := `COMMENT ON TABLE public.users IS 'Registered users';
+COMMENT ON COLUMN users.email IS 'Login address';
+COMMENT ON COLUMN users.missing IS 'Unknown column';
+COMMENT ON TABLE orders IS 'Not parsed yet';`
+
+	var applied []bool
+	for _, comment := range ParseComments(content) {
+		applied = append(applied, schema.ApplyComment(comment))
+	}
+
+	assert.Equal(t, []bool{true, true, false, false}, applied)
+	assert.Equal(t, "Registered users", schema.Tables[0].Comment)
+	assert.Equal(t, "Login address", schema.Tables[0].Columns[1].Comment)
+
+	assert.Equal(t, []string{
+		"COMMENT ON TABLE users IS 'Registered users'",
+		"COMMENT ON COLUMN users.email IS 'Login address'",
+	}, CommentStatements(schema.Tables[0]))
+}
+
+func TestQuotedCommentStatements(t *testing.T) {
+	table := Table{
+		Name:    "users",
+		Comment: "Registered users",
+		Columns: []Column{{Name: "name", Comment: "Owner's name"}, {Name: "id"}},
+	}
+
+	assert.Equal(t, []string{
+		"COMMENT ON TABLE users IS 'Registered users'",
+		"COMMENT ON COLUMN users.name IS 'Owner''s name'",
+	}, CommentStatements(table))
+
+	quote := func(value string) string { return "<" + value + ">" }
+	assert.Equal(t, []string{
+		"COMMENT ON TABLE users IS <Registered users>",
+		"COMMENT ON COLUMN users.name IS <Owner's name>",
+	}, QuotedCommentStatements(table, quote))
+}
+
+func TestQuoteLiteral(t *testing.T) {
+	assert.Equal(t, "'plain'", QuoteLiteral("plain"))
+	assert.Equal(t, "'it''s'", QuoteLiteral("it's"))
+	assert.Equal(t, `'C:\temp'`, QuoteLiteral(`C:\temp`))
+	assert.Equal(t, "'two\nlines'", QuoteLiteral("two\nlines"))
+}
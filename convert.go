@@ -0,0 +1,134 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// droppedRe matches a warning about a construct the target dropped or skipped
+var droppedRe = regexp.MustCompile(`\b(?:was|were) (?:dropped|skipped)\b`)
+
+// OptionsSetter is implemented by the dialects accepting GenerateOptions
+type OptionsSetter interface {
+	SetGenerateOptions(options GenerateOptions)
+}
+
+// ColumnMapping records how a column of the source was generated in the target
+type ColumnMapping struct {
+	Table      string
+	Column     string
+	SourceType string
+	TargetType string
+
+	// Warnings are the warnings reported about the column
+	Warnings []string
+}
+
+// Changed reports whether the column was generated with a different data type
+func (m ColumnMapping) Changed() bool {
+	return !strings.EqualFold(m.SourceType, m.TargetType)
+}
+
+// ConversionReport is an audit of the mapping decisions made by Convert
+type ConversionReport struct {
+	// Columns lists every generated column in the order of the output
+	Columns []ColumnMapping
+
+	// Dropped lists the warnings about constructs the target dropped or skipped
+	Dropped []string
+
+	// Warnings lists every warning reported by the target, in the order of the output
+	Warnings []string
+}
+
+// String returns the report as a human-readable listing of the changed columns,
+// the dropped constructs and the other warnings
+func (r *ConversionReport) String() string {
+	var b strings.Builder
+	for _, column := range r.Columns {
+		if column.Changed() {
+			fmt.Fprintf(&b, "Column %s.%s: %s -> %s\n", column.Table, column.Column, column.SourceType, column.TargetType)
+		}
+	}
+	for _, dropped := range r.Dropped {
+		fmt.Fprintf(&b, "Dropped: %s\n", dropped)
+	}
+	for _, warning := range r.Warnings {
+		if !droppedRe.MatchString(warning) {
+			fmt.Fprintf(&b, "Warning: %s\n", warning)
+		}
+	}
+	return b.String()
+}
+
+// Convert parses a dump with the source dialect and generates it with the target
+// dialect, returning the generated DDL and the warnings about every construct the
+// target could not reproduce faithfully. The warnings are also passed to
+// options.OnWarning, if set.
+func Convert(content string, source, target Database, options GenerateOptions) (string, []string, error) {
+	output, report, err := ConvertWithReport(content, source, target, options)
+	return output, report.Warnings, err
+}
+
+// ConvertWithReport converts a dump like Convert, returning a report of the data type
+// of every column in the source and the target along with the dropped constructs.
+// The report is returned with the warnings collected so far on a generation error.
+func ConvertWithReport(content string, source, target Database, options GenerateOptions) (string, *ConversionReport, error) {
+	report := &ConversionReport{}
+	schema, err := source.Parse(content)
+	if err != nil {
+		return "", report, fmt.Errorf("failed to parse source: %w", err)
+	}
+
+	onWarning := options.OnWarning
+	options.OnWarning = func(message string) {
+		report.Warnings = append(report.Warnings, message)
+		if droppedRe.MatchString(message) {
+			report.Dropped = append(report.Dropped, message)
+		}
+		if onWarning != nil {
+			onWarning(message)
+		}
+	}
+	onColumn := options.OnColumn
+	options.OnColumn = func(table string, column Column, targetType string) {
+		report.Columns = append(report.Columns, ColumnMapping{
+			Table:      table,
+			Column:     column.Name,
+			SourceType: sourceType(column),
+			TargetType: targetType,
+		})
+		if onColumn != nil {
+			onColumn(table, column, targetType)
+		}
+	}
+	if setter, ok := target.(OptionsSetter); ok {
+		setter.SetGenerateOptions(options)
+	}
+
+	output, err := target.Generate(schema)
+
+	// Warnings about a column name it as table.column
+	for i, column := range report.Columns {
+		columnRe := regexp.MustCompile(`\bcolumn ` + regexp.QuoteMeta(column.Table+"."+column.Column) + `(?:\s|$)`)
+		for _, warning := range report.Warnings {
+			if columnRe.MatchString(warning) {
+				report.Columns[i].Warnings = append(report.Columns[i].Warnings, warning)
+			}
+		}
+	}
+
+	if err != nil {
+		return "", report, fmt.Errorf("failed to generate target: %w", err)
+	}
+	return output, report, nil
+}
+
+// sourceType returns the data type of a parsed column with its parameters
+func sourceType(column Column) string {
+	if column.Unsigned {
+		return FormatDataType(column) + " UNSIGNED"
+	}
+	return FormatDataType(column)
+}
@@ -0,0 +1,87 @@
+package sqlmapper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// warningDatabase is a dialect reporting a warning for every table it generates
+type warningDatabase struct {
+	options GenerateOptions
+}
+
+func (d *warningDatabase) SetGenerateOptions(options GenerateOptions) {
+	d.options = options
+}
+
+func (d *warningDatabase) Parse(content string) (*Schema, error) {
+	if content == "" {
+		return nil, errors.New("empty content")
+	}
+	if content == "users" {
+		return &Schema{Tables: []Table{{Name: content}}}, nil
+	}
+	return &Schema{Tables: []Table{{Name: content, Columns: []Column{
+		{Name: "id", DataType: "INT", Unsigned: true},
+		{Name: "name", DataType: "TEXT"},
+	}}}}, nil
+}
+
+func (d *warningDatabase) Generate(schema *Schema) (string, error) {
+	for _, table := range schema.Tables {
+		d.options.Warnf("table %s was generated", table.Name)
+		for _, column := range table.Columns {
+			d.options.MapColumn(table.Name, column, "TEXT")
+			d.options.Warnf("the comment of column %s.%s was dropped", table.Name, column.Name)
+		}
+	}
+	return "-- generated", nil
+}
+
+func TestConvert(t *testing.T) {
+	var reported []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		reported = append(reported, message)
+	}}
+
+	output, warnings, err := Convert("users", &warningDatabase{}, &warningDatabase{}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, "-- generated", output)
+	assert.Equal(t, []string{"table users was generated"}, warnings)
+	assert.Equal(t, warnings, reported)
+
+	_, _, err = Convert("", &warningDatabase{}, &warningDatabase{}, GenerateOptions{})
+	assert.EqualError(t, err, "failed to parse source: empty content")
+}
+
+func TestConvertWithReport(t *testing.T) {
+	var columns []string
+	options := GenerateOptions{OnColumn: func(table string, column Column, targetType string) {
+		columns = append(columns, table+"."+column.Name)
+	}}
+
+	output, report, err := ConvertWithReport("items", &warningDatabase{}, &warningDatabase{}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, "-- generated", output)
+	assert.Equal(t, []string{"items.id", "items.name"}, columns)
+	assert.Equal(t, []ColumnMapping{
+		{Table: "items", Column: "id", SourceType: "INT UNSIGNED", TargetType: "TEXT", Warnings: []string{"the comment of column items.id was dropped"}},
+		{Table: "items", Column: "name", SourceType: "TEXT", TargetType: "TEXT", Warnings: []string{"the comment of column items.name was dropped"}},
+	}, report.Columns)
+	assert.True(t, report.Columns[0].Changed())
+	assert.False(t, report.Columns[1].Changed())
+	assert.Equal(t, []string{
+		"the comment of column items.id was dropped",
+		"the comment of column items.name was dropped",
+	}, report.Dropped)
+	assert.Equal(t, "Column items.id: INT UNSIGNED -> TEXT\n"+
+		"Dropped: the comment of column items.id was dropped\n"+
+		"Dropped: the comment of column items.name was dropped\n"+
+		"Warning: table items was generated\n", report.String())
+
+	_, report, err = ConvertWithReport("", &warningDatabase{}, &warningDatabase{}, GenerateOptions{})
+	assert.EqualError(t, err, "failed to parse source: empty content")
+	assert.Empty(t, report.Columns)
+}
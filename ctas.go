@@ -0,0 +1,204 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// ctasRe matches CREATE TABLE ... AS SELECT statements with an optional column name
+	// list and the ON COMMIT clause of temporary tables
+	ctasRe = regexp.MustCompile(`(?is)^\s*CREATE\s+((?:(?:GLOBAL|LOCAL)\s+)?(?:TEMPORARY|TEMP)\s+)?(UNLOGGED\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([^\s(]+)\s*(?:\(([^()]*)\)\s*)?(?:ON\s+COMMIT\s+(DELETE\s+ROWS|PRESERVE\s+ROWS|DROP)\s+)?(?:AS\s+)?(\(?\s*(?:SELECT|WITH)\b.*?)\s*;?\s*$`)
+	// identifierRe matches a plain, optionally qualified or quoted identifier
+	identifierRe = regexp.MustCompile("^[`\"\\[]?\\w+[`\"\\]]?(?:\\.[`\"\\[]?\\w+[`\"\\]]?)*$")
+)
+
+// ParseCreateTableAs recognizes a CREATE TABLE ... AS SELECT statement and returns a table
+// whose SourceQuery holds the defining query. Column names are taken from an explicit
+// column list when present, otherwise they are inferred from the select list where possible.
+// The second return value is false when the statement is not a CTAS statement.
+func ParseCreateTableAs(statement string) (*Table, bool) {
+	match := ctasRe.FindStringSubmatch(statement)
+	if match == nil {
+		return nil, false
+	}
+
+	table := &Table{
+		SourceQuery: match[6],
+		Temporary:   match[1] != "",
+		Unlogged:    match[2] != "",
+		OnCommit:    strings.ToUpper(spacesRe.ReplaceAllString(match[5], " ")),
+		IfNotExists: HasIfNotExists(statement),
+	}
+
+	// Parse schema if exists
+	tableName := match[3]
+	if parts := strings.Split(tableName, "."); len(parts) > 1 {
+		table.Schema = trimIdentifier(parts[0])
+		table.Name = trimIdentifier(parts[1])
+	} else {
+		table.Name = trimIdentifier(tableName)
+	}
+
+	var names []string
+	if strings.TrimSpace(match[4]) != "" {
+		for _, name := range strings.Split(match[4], ",") {
+			names = append(names, trimIdentifier(strings.TrimSpace(name)))
+		}
+	} else {
+		names = InferQueryColumns(table.SourceQuery)
+	}
+
+	for i, name := range names {
+		table.Columns = append(table.Columns, Column{
+			Name:       name,
+			IsNullable: true,
+			Order:      i + 1,
+		})
+	}
+
+	return table, true
+}
+
+// CreateTableAsSQL generates the CREATE TABLE ... AS statement of a table created from
+// its SourceQuery. temporary is the keyword of the dialect for temporary tables, such as
+// TEMPORARY or GLOBAL TEMPORARY. Dialects accepting them, such as PostgreSQL and Oracle,
+// pass clauses to write the column names, unless the query yields the same names, and
+// the ON COMMIT behaviour of a temporary table.
+func CreateTableAsSQL(table Table, temporary, ifNotExists string, clauses bool) string {
+	var sql strings.Builder
+	sql.WriteString("CREATE ")
+	if table.Temporary {
+		sql.WriteString(temporary + " ")
+	}
+	sql.WriteString("TABLE " + ifNotExists + table.Name)
+	if clauses {
+		if names := CreateTableAsColumns(table); names != nil {
+			sql.WriteString(" (" + strings.Join(names, ", ") + ")")
+		}
+		if table.Temporary && table.OnCommit != "" {
+			sql.WriteString(" ON COMMIT " + table.OnCommit)
+		}
+	}
+	sql.WriteString(" AS " + table.SourceQuery)
+	return sql.String()
+}
+
+// CreateTableAsColumns returns the column names of a table created from its SourceQuery,
+// or nil when the query yields the same names and no column list is needed
+func CreateTableAsColumns(table Table) []string {
+	var names []string
+	for _, column := range table.Columns {
+		names = append(names, column.Name)
+	}
+	if slices.Equal(names, InferQueryColumns(table.SourceQuery)) {
+		return nil
+	}
+	return names
+}
+
+// InferQueryColumns returns the output column names of a simple SELECT query.
+// Names come from column aliases or plain column references. It returns nil when
+// any column cannot be named, for example because of a wildcard or an unaliased expression.
+func InferQueryColumns(query string) []string {
+	query = strings.TrimSpace(query)
+	upper := strings.ToUpper(query)
+	if !strings.HasPrefix(upper, "SELECT") {
+		return nil
+	}
+
+	list := query[len("SELECT"):]
+	if end := topLevelKeyword(list, "FROM"); end >= 0 {
+		list = list[:end]
+	}
+
+	fields := strings.Fields(list)
+	if len(fields) > 0 && (strings.EqualFold(fields[0], "DISTINCT") || strings.EqualFold(fields[0], "ALL")) {
+		list = strings.TrimSpace(list)[len(fields[0]):]
+	}
+
+	var names []string
+	for _, item := range splitTopLevel(list) {
+		item = strings.TrimSpace(item)
+		tokens := strings.Fields(item)
+
+		switch {
+		case len(tokens) >= 3 && strings.EqualFold(tokens[len(tokens)-2], "AS"):
+			names = append(names, trimIdentifier(tokens[len(tokens)-1]))
+		case len(tokens) == 1 && identifierRe.MatchString(item):
+			parts := strings.Split(item, ".")
+			names = append(names, trimIdentifier(parts[len(parts)-1]))
+		case len(tokens) == 2 && identifierRe.MatchString(tokens[0]) && identifierRe.MatchString(tokens[1]):
+			names = append(names, trimIdentifier(tokens[1]))
+		default:
+			return nil
+		}
+	}
+
+	return names
+}
+
+// topLevelKeyword returns the index of the first occurrence of keyword outside of
+// parentheses and string literals, or -1 if it does not occur
+func topLevelKeyword(s, keyword string) int {
+	depth := 0
+	inString := false
+	upper := strings.ToUpper(s)
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && strings.HasPrefix(upper[i:], keyword):
+			before := i == 0 || !isWordByte(s[i-1])
+			after := i+len(keyword) >= len(s) || !isWordByte(s[i+len(keyword)])
+			if before && after {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// splitTopLevel splits s on commas that are outside of parentheses, brackets and
+// string literals
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth := 0
+	inString := false
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// isWordByte reports whether c can be part of an identifier
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// trimIdentifier removes identifier quoting characters
+func trimIdentifier(name string) string {
+	return strings.Trim(name, "`\"[]")
+}
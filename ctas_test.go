@@ -0,0 +1,104 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCreateTableAs(t *testing.T) {
+	tests := []struct {
+		name        string
+		statement   string
+		wantOK      bool
+		wantSchema  string
+		wantName    string
+		wantQuery   string
+		wantColumns []string
+		wantTemp    bool
+		wantCommit  string
+	}{
+		{
+			name:        "Simple CTAS",
+			statement:   "CREATE TABLE summary AS SELECT customer_id, SUM(total) AS revenue FROM orders GROUP BY customer_id;",
+			wantOK:      true,
+			wantName:    "summary",
+			wantQuery:   "SELECT customer_id, SUM(total) AS revenue FROM orders GROUP BY customer_id",
+			wantColumns: []string{"customer_id", "revenue"},
+		},
+		{
+			name:        "Explicit column list",
+			statement:   "CREATE TABLE IF NOT EXISTS reports.totals (id, amount) AS SELECT o.id, o.total * 2 FROM orders o",
+			wantOK:      true,
+			wantSchema:  "reports",
+			wantName:    "totals",
+			wantQuery:   "SELECT o.id, o.total * 2 FROM orders o",
+			wantColumns: []string{"id", "amount"},
+		},
+		{
+			name:      "Wildcard cannot be inferred",
+			statement: "CREATE TABLE backup AS SELECT * FROM users",
+			wantOK:    true,
+			wantName:  "backup",
+			wantQuery: "SELECT * FROM users",
+		},
+		{
+			name:        "MySQL form without AS",
+			statement:   "CREATE TABLE copy SELECT id, name FROM users",
+			wantOK:      true,
+			wantName:    "copy",
+			wantQuery:   "SELECT id, name FROM users",
+			wantColumns: []string{"id", "name"},
+		},
+		{
+			name:        "Temporary table with ON COMMIT",
+			statement:   "CREATE GLOBAL TEMPORARY TABLE staged (id, amount) ON COMMIT  preserve rows AS SELECT id, total FROM orders",
+			wantOK:      true,
+			wantName:    "staged",
+			wantQuery:   "SELECT id, total FROM orders",
+			wantColumns: []string{"id", "amount"},
+			wantTemp:    true,
+			wantCommit:  "PRESERVE ROWS",
+		},
+		{
+			name:      "Regular table",
+			statement: "CREATE TABLE users (id INT, name VARCHAR(50))",
+			wantOK:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, ok := ParseCreateTableAs(tt.statement)
+			assert.Equal(t, tt.wantOK, ok)
+			if !tt.wantOK {
+				return
+			}
+
+			assert.Equal(t, tt.wantSchema, table.Schema)
+			assert.Equal(t, tt.wantName, table.Name)
+			assert.Equal(t, tt.wantQuery, table.SourceQuery)
+			assert.Equal(t, tt.wantTemp, table.Temporary)
+			assert.Equal(t, tt.wantCommit, table.OnCommit)
+
+			var columns []string
+			for _, column := range table.Columns {
+				columns = append(columns, column.Name)
+			}
+			assert.Equal(t, tt.wantColumns, columns)
+		})
+	}
+}
+
+func TestCreateTableAsSQL(t *testing.T) {
+	table, ok := ParseCreateTableAs("CREATE TEMPORARY TABLE staged (id, amount) ON COMMIT DROP AS SELECT id, total FROM orders")
+	assert.True(t, ok)
+	assert.Equal(t, "CREATE TEMPORARY TABLE staged (id, amount) ON COMMIT DROP AS SELECT id, total FROM orders", CreateTableAsSQL(*table, "TEMPORARY", "", true))
+	assert.Equal(t, "CREATE TEMPORARY TABLE IF NOT EXISTS staged AS SELECT id, total FROM orders", CreateTableAsSQL(*table, "TEMPORARY", "IF NOT EXISTS ", false))
+
+	// Names the query already yields need no column list
+	table, ok = ParseCreateTableAs("CREATE TABLE totals AS SELECT id, SUM(total) AS amount FROM orders GROUP BY id")
+	assert.True(t, ok)
+	assert.Nil(t, CreateTableAsColumns(*table))
+	assert.Equal(t, "CREATE TABLE totals AS SELECT id, SUM(total) AS amount FROM orders GROUP BY id", CreateTableAsSQL(*table, "TEMPORARY", "", true))
+}
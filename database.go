@@ -0,0 +1,73 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// useRe matches a USE statement and captures the selected database
+	useRe = regexp.MustCompile("(?is)^USE\\s+([`\"\\[]?[^\\s;`\"\\]]+[`\"\\]]?)\\s*;?$")
+	// createDatabaseRe matches a CREATE DATABASE statement and captures its name
+	createDatabaseRe = regexp.MustCompile("(?is)^CREATE\\s+(?:DATABASE|SCHEMA)\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?([`\"\\[]?[^\\s;`\"\\]]+[`\"\\]]?)")
+)
+
+// ParseUseStatement returns the database selected by a USE statement, such as shop
+// for USE `shop`. The second result is false if the statement is not a USE statement.
+func ParseUseStatement(statement string) (string, bool) {
+	matches := useRe.FindStringSubmatch(strings.TrimSpace(StripComments(statement)))
+	if matches == nil {
+		return "", false
+	}
+	return trimIdentifier(matches[1]), true
+}
+
+// ParseCreateDatabase returns the name of the database created by a CREATE DATABASE
+// statement, or by its MySQL synonym CREATE SCHEMA. The second result is false if the
+// statement creates no database.
+func ParseCreateDatabase(statement string) (string, bool) {
+	matches := createDatabaseRe.FindStringSubmatch(strings.TrimSpace(StripComments(statement)))
+	if matches == nil {
+		return "", false
+	}
+	return trimIdentifier(matches[1]), true
+}
+
+// SetDefaultSchema sets the schema of the tables, views, functions, procedures,
+// triggers and drops that do not name one, as for the objects created after a USE
+// statement selecting a database
+func (s *Schema) SetDefaultSchema(schema string) {
+	if schema == "" {
+		return
+	}
+	for i := range s.Tables {
+		if s.Tables[i].Schema == "" {
+			s.Tables[i].Schema = schema
+		}
+	}
+	for i := range s.Views {
+		if s.Views[i].Schema == "" {
+			s.Views[i].Schema = schema
+		}
+	}
+	for i := range s.Functions {
+		if s.Functions[i].Schema == "" {
+			s.Functions[i].Schema = schema
+		}
+	}
+	for i := range s.Procedures {
+		if s.Procedures[i].Schema == "" {
+			s.Procedures[i].Schema = schema
+		}
+	}
+	for i := range s.Triggers {
+		if s.Triggers[i].Schema == "" {
+			s.Triggers[i].Schema = schema
+		}
+	}
+	for i := range s.Drops {
+		if s.Drops[i].Schema == "" {
+			s.Drops[i].Schema = schema
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUseStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+		ok        bool
+	}{
+		{"USE shop", "shop", true},
+		{"use `shop`;", "shop", true},
+		{"USE [Sales]", "Sales", true},
+		{"/* switch */ USE \"blog\"", "blog", true},
+		{"USE", "", false},
+		{"SELECT use FROM t", "", false},
+		{"CREATE TABLE use_log (id INT)", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			got, ok := ParseUseStatement(tt.statement)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCreateDatabase(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+		ok        bool
+	}{
+		{"CREATE DATABASE shop", "shop", true},
+		{"CREATE DATABASE /*!32312 IF NOT EXISTS*/ `shop` /*!40100 DEFAULT CHARACTER SET utf8mb4 */", "shop", true},
+		{"create schema if not exists blog", "blog", true},
+		{"CREATE TABLE shop (id INT)", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			got, ok := ParseCreateDatabase(tt.statement)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitStatements_Database(t *testing.T) {
+	statements := SplitStatements("CREATE TABLE a (id INT);\nUSE shop;\nCREATE TABLE b (id INT);\nUSE blog;\nCREATE TABLE c (id INT);", "")
+
+	var databases []string
+	for _, statement := range statements {
+		databases = append(databases, statement.Database)
+	}
+	assert.Equal(t, []string{"", "shop", "shop", "blog", "blog"}, databases)
+	assert.Equal(t, UseStatement, statements[1].Kind)
+}
+
+func TestSchema_SetDefaultSchema(t *testing.T) {
+	schema := &Schema{
+		Tables: []Table{{Name: "users"}, {Name: "posts", Schema: "blog"}},
+		Views:  []View{{Name: "active_users"}},
+	}
+	schema.SetDefaultSchema("shop")
+
+	assert.Equal(t, "shop", schema.Tables[0].Schema)
+	assert.Equal(t, "blog", schema.Tables[1].Schema)
+	assert.Equal(t, "shop", schema.Views[0].Schema)
+}
@@ -0,0 +1,93 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// dataTypeRe matches a data type ending with its parameters, such as DECIMAL(10,2)
+	dataTypeRe = regexp.MustCompile(`(?i)^(\w[\w ]*?)\s*\(\s*(\d+|MAX)\s*(?:,\s*(\d+)\s*)?\)$`)
+	// typeParametersRe matches the parameter list following a data type, such as ( 10, 2 )
+	typeParametersRe = regexp.MustCompile(`(?i)(\w)\s*\(\s*(\d+|MAX)\s*(?:,\s*(\d+)\s*)?\)`)
+)
+
+// ParseDataType splits a data type such as DECIMAL(10,2), VARCHAR(255) or TIMESTAMP(6)
+// into its name and parameters: the length of character types or the precision of
+// numeric and temporal types, and the scale. A length of MAX is returned as -1. Data
+// types without trailing parameters are returned unchanged.
+func ParseDataType(dataType string) (name string, length, scale int) {
+	match := dataTypeRe.FindStringSubmatch(strings.TrimSpace(dataType))
+	if match == nil {
+		return dataType, 0, 0
+	}
+
+	if strings.EqualFold(match[2], "MAX") {
+		length = -1
+	} else {
+		length, _ = strconv.Atoi(match[2])
+	}
+	if match[3] != "" {
+		scale, _ = strconv.Atoi(match[3])
+	}
+	return match[1], length, scale
+}
+
+// FormatDataType returns the data type of a column together with its parameters.
+// Length holds the length or precision of the type; Precision is used when a column
+// built by hand sets only the precision of a numeric type. A length of MAX is omitted,
+// since only SQL Server supports it. The members of a MySQL SET type are written quoted.
+func FormatDataType(column Column) string {
+	if len(column.Members) > 0 {
+		members := make([]string, len(column.Members))
+		for i, member := range column.Members {
+			members[i] = QuoteLiteral(member)
+		}
+		return column.DataType + "(" + strings.Join(members, ",") + ")"
+	}
+
+	length := column.Length
+	if length == 0 {
+		length = column.Precision
+	}
+
+	switch {
+	case length <= 0:
+		return column.DataType
+	case column.Scale > 0:
+		return column.DataType + "(" + strconv.Itoa(length) + "," + strconv.Itoa(column.Scale) + ")"
+	default:
+		return column.DataType + "(" + strconv.Itoa(length) + ")"
+	}
+}
+
+// CompactTypeParameters removes the whitespace around and inside the parameter lists
+// of data types in a column definition, so that "price DECIMAL (10, 2) NOT NULL" can be
+// split into its words like "price DECIMAL(10,2) NOT NULL"
+func CompactTypeParameters(definition string) string {
+	return typeParametersRe.ReplaceAllStringFunc(definition, func(parameters string) string {
+		match := typeParametersRe.FindStringSubmatch(parameters)
+		if match[3] != "" {
+			return match[1] + "(" + match[2] + "," + match[3] + ")"
+		}
+		return match[1] + "(" + match[2] + ")"
+	})
+}
+
+// Nullability returns the NULL or NOT NULL keywords written after the data type of a
+// column, preceded by a space. By default only NOT NULL is written, and not where the
+// caller reports it implied, such as by a primary key or a default. With
+// ExplicitNullability every column gets one, primary keys being NOT NULL.
+func (o GenerateOptions) Nullability(column Column, implied bool) string {
+	if !o.ExplicitNullability {
+		if column.IsNullable || implied {
+			return ""
+		}
+		return " NOT NULL"
+	}
+	if column.IsNullable && !column.IsPrimaryKey {
+		return " NULL"
+	}
+	return " NOT NULL"
+}
@@ -0,0 +1,80 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDataType(t *testing.T) {
+	tests := []struct {
+		dataType   string
+		wantName   string
+		wantLength int
+		wantScale  int
+	}{
+		{dataType: "INT", wantName: "INT"},
+		{dataType: "DECIMAL(10,2)", wantName: "DECIMAL", wantLength: 10, wantScale: 2},
+		{dataType: "numeric( 5 , 3 )", wantName: "numeric", wantLength: 5, wantScale: 3},
+		{dataType: "VARCHAR(255)", wantName: "VARCHAR", wantLength: 255},
+		{dataType: "CHAR(1)", wantName: "CHAR", wantLength: 1},
+		{dataType: "TIMESTAMP(6)", wantName: "TIMESTAMP", wantLength: 6},
+		{dataType: "NVARCHAR(MAX)", wantName: "NVARCHAR", wantLength: -1},
+		{dataType: "CHARACTER VARYING(100)", wantName: "CHARACTER VARYING", wantLength: 100},
+		{dataType: "TIMESTAMP(3) WITH TIME ZONE", wantName: "TIMESTAMP(3) WITH TIME ZONE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dataType, func(t *testing.T) {
+			name, length, scale := ParseDataType(tt.dataType)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantLength, length)
+			assert.Equal(t, tt.wantScale, scale)
+		})
+	}
+}
+
+func TestFormatDataType(t *testing.T) {
+	tests := []struct {
+		name   string
+		column Column
+		want   string
+	}{
+		{name: "No parameters", column: Column{DataType: "TEXT"}, want: "TEXT"},
+		{name: "Precision and scale", column: Column{DataType: "DECIMAL", Length: 10, Scale: 2}, want: "DECIMAL(10,2)"},
+		{name: "Precision field", column: Column{DataType: "NUMERIC", Precision: 12, Scale: 4}, want: "NUMERIC(12,4)"},
+		{name: "Length", column: Column{DataType: "VARCHAR", Length: 255}, want: "VARCHAR(255)"},
+		{name: "Single character", column: Column{DataType: "CHAR", Length: 1}, want: "CHAR(1)"},
+		{name: "Fractional seconds", column: Column{DataType: "TIMESTAMP", Length: 6}, want: "TIMESTAMP(6)"},
+		{name: "Maximum length", column: Column{DataType: "VARCHAR", Length: -1}, want: "VARCHAR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatDataType(tt.column))
+		})
+	}
+}
+
+func TestCompactTypeParameters(t *testing.T) {
+	assert.Equal(t, "price DECIMAL(10,2) NOT NULL", CompactTypeParameters("price DECIMAL (10, 2) NOT NULL"))
+	assert.Equal(t, "name VARCHAR(255)", CompactTypeParameters("name VARCHAR( 255 )"))
+	assert.Equal(t, "status TEXT CHECK (status <> '')", CompactTypeParameters("status TEXT CHECK (status <> '')"))
+}
+
+func TestGenerateOptions_Nullability(t *testing.T) {
+	nullable := Column{Name: "bio", IsNullable: true}
+	notNull := Column{Name: "email"}
+	primaryKey := Column{Name: "id", IsNullable: true, IsPrimaryKey: true}
+
+	var defaults GenerateOptions
+	assert.Equal(t, "", defaults.Nullability(nullable, false))
+	assert.Equal(t, " NOT NULL", defaults.Nullability(notNull, false))
+	assert.Equal(t, "", defaults.Nullability(notNull, true))
+	assert.Equal(t, "", defaults.Nullability(primaryKey, true))
+
+	explicit := GenerateOptions{ExplicitNullability: true}
+	assert.Equal(t, " NULL", explicit.Nullability(nullable, false))
+	assert.Equal(t, " NOT NULL", explicit.Nullability(notNull, true))
+	assert.Equal(t, " NOT NULL", explicit.Nullability(primaryKey, true))
+}
@@ -0,0 +1,186 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ToDOT returns a Graphviz digraph of the tables of the schema and the foreign keys
+// between them. Each table is a node named after its qualified name, and each
+// foreign key a directed edge from the referencing table to the referenced one,
+// labeled with the referencing columns. Referenced tables missing from the schema
+// are drawn as plain nodes by Graphviz.
+func (s *Schema) ToDOT() string {
+	var b strings.Builder
+	b.WriteString("digraph schema {\n")
+	b.WriteString("    rankdir=LR;\n")
+	b.WriteString("    node [shape=box];\n")
+
+	for _, table := range s.Tables {
+		fmt.Fprintf(&b, "    %s;\n", dotID(table.QualifiedName()))
+	}
+	for _, table := range s.Tables {
+		for _, constraint := range table.Constraints {
+			if !strings.EqualFold(constraint.Type, "FOREIGN KEY") || constraint.RefTable == "" {
+				continue
+			}
+			fmt.Fprintf(&b, "    %s -> %s [label=%s];\n", dotID(table.QualifiedName()),
+				dotID(constraint.RefTable), dotID(strings.Join(constraint.Columns, ", ")))
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotID quotes a name as a Graphviz ID
+func dotID(name string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(name) + `"`
+}
+
+// ToMermaidER returns a Mermaid erDiagram of the schema, which can be embedded in
+// Markdown. Each table is an entity listing its columns with their types and PK, FK
+// and UK markers, and each foreign key a relationship from the referenced table to
+// the referencing one, labeled with the name of the foreign key or its columns. A
+// foreign key on nullable columns refers to zero or one row, and one on unique
+// columns is referred to by at most one row.
+func (s *Schema) ToMermaidER() string {
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+
+	for _, table := range s.Tables {
+		keys := columnKeys(table)
+		fmt.Fprintf(&b, "    %s {\n", mermaidName(table.QualifiedName()))
+		for _, column := range table.Columns {
+			fmt.Fprintf(&b, "        %s %s", mermaidName(column.DataType), mermaidName(column.Name))
+			if markers := keys[strings.ToLower(column.Name)]; len(markers) > 0 {
+				b.WriteString(" " + strings.Join(markers, ", "))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("    }\n")
+	}
+
+	for _, table := range s.Tables {
+		for _, constraint := range table.Constraints {
+			if !strings.EqualFold(constraint.Type, "FOREIGN KEY") || constraint.RefTable == "" {
+				continue
+			}
+
+			// The referenced side: exactly one row, or none for nullable columns
+			parent := "||"
+			// The referencing side: any number of rows, or at most one for unique columns
+			child := "o{"
+			for _, name := range constraint.Columns {
+				if column, ok := findColumn(table, name); ok && column.IsNullable {
+					parent = "|o"
+				}
+			}
+			if uniqueColumns(table, constraint.Columns) {
+				child = "o|"
+			}
+
+			label := constraint.Name
+			if label == "" {
+				label = strings.Join(constraint.Columns, ", ")
+			}
+			fmt.Fprintf(&b, "    %s %s--%s %s : %q\n", mermaidName(constraint.RefTable), parent, child,
+				mermaidName(table.QualifiedName()), label)
+		}
+	}
+
+	return b.String()
+}
+
+// columnKeys returns the PK, FK and UK markers of the columns of a table by their
+// lower case name
+func columnKeys(table Table) map[string][]string {
+	primary := make(map[string]bool)
+	foreign := make(map[string]bool)
+	for _, column := range table.Columns {
+		if column.IsPrimaryKey {
+			primary[strings.ToLower(column.Name)] = true
+		}
+	}
+	for _, constraint := range table.Constraints {
+		for _, name := range constraint.Columns {
+			switch {
+			case strings.EqualFold(constraint.Type, "PRIMARY KEY"):
+				primary[strings.ToLower(name)] = true
+			case strings.EqualFold(constraint.Type, "FOREIGN KEY"):
+				foreign[strings.ToLower(name)] = true
+			}
+		}
+	}
+
+	keys := make(map[string][]string)
+	for _, column := range table.Columns {
+		name := strings.ToLower(column.Name)
+		if primary[name] {
+			keys[name] = append(keys[name], "PK")
+		}
+		if foreign[name] {
+			keys[name] = append(keys[name], "FK")
+		}
+		if !primary[name] && uniqueColumns(table, []string{column.Name}) {
+			keys[name] = append(keys[name], "UK")
+		}
+	}
+	return keys
+}
+
+// findColumn returns the column of a table with the given name
+func findColumn(table Table, name string) (Column, bool) {
+	for _, column := range table.Columns {
+		if strings.EqualFold(column.Name, name) {
+			return column, true
+		}
+	}
+	return Column{}, false
+}
+
+// uniqueColumns reports whether a list of columns is the primary key of a table or
+// one of its unique constraints or indexes
+func uniqueColumns(table Table, columns []string) bool {
+	same := func(other []string) bool {
+		if len(other) != len(columns) {
+			return false
+		}
+		for i := range other {
+			if !strings.EqualFold(other[i], columns[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if len(columns) == 1 {
+		if column, ok := findColumn(table, columns[0]); ok && (column.IsUnique || column.IsPrimaryKey) {
+			return true
+		}
+	}
+	for _, constraint := range table.Constraints {
+		if (strings.EqualFold(constraint.Type, "UNIQUE") || strings.EqualFold(constraint.Type, "PRIMARY KEY")) && same(constraint.Columns) {
+			return true
+		}
+	}
+	for _, index := range table.Indexes {
+		if index.IsUnique && same(index.ColumnNames()) {
+			return true
+		}
+	}
+	return false
+}
+
+// mermaidName replaces the characters Mermaid does not allow in entity, attribute and
+// type names, such as the dot of a qualified name or the space of DOUBLE PRECISION,
+// with underscores
+func mermaidName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,74 @@
+package sqlmapper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_ToDOT(t *testing.T) {
+	schema := &Schema{Tables: []Table{
+		{Name: "users", Columns: []Column{{Name: "id", DataType: "INT"}}},
+		{
+			Name:    "orders",
+			Schema:  "shop",
+			Columns: []Column{{Name: "id", DataType: "INT"}, {Name: "user_id", DataType: "INT"}},
+			Constraints: []Constraint{
+				{Type: "PRIMARY KEY", Columns: []string{"id"}},
+				{Name: "fk_orders_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name:    "order_items",
+			Columns: []Column{{Name: "order_id", DataType: "INT"}, {Name: "shop_id", DataType: "INT"}},
+			Constraints: []Constraint{
+				{Type: "FOREIGN KEY", Columns: []string{"shop_id", "order_id"}, RefTable: "shop.orders", RefColumns: []string{"shop_id", "id"}},
+			},
+		},
+	}}
+
+	assert.Equal(t, `digraph schema {
+    rankdir=LR;
+    node [shape=box];
+    "users";
+    "shop.orders";
+    "order_items";
+    "shop.orders" -> "users" [label="user_id"];
+    "order_items" -> "shop.orders" [label="shop_id, order_id"];
+}
+`, schema.ToDOT())
+}
+
+func TestSchema_ToMermaidER(t *testing.T) {
+	schema := &Schema{Tables: []Table{
+		{
+			Name: "users",
+			Columns: []Column{
+				{Name: "id", DataType: "BIGINT", IsPrimaryKey: true},
+				{Name: "email", DataType: "VARCHAR", Length: 255, IsUnique: true},
+				{Name: "display_name", DataType: "VARCHAR", Length: 100, IsNullable: true},
+			},
+		},
+		{
+			Name: "user_sessions",
+			Columns: []Column{
+				{Name: "session_uuid", DataType: "UUID"},
+				{Name: "user_id", DataType: "BIGINT"},
+				{Name: "replaced_by", DataType: "UUID", IsNullable: true},
+				{Name: "score", DataType: "DOUBLE PRECISION", IsNullable: true},
+			},
+			Constraints: []Constraint{
+				{Type: "PRIMARY KEY", Columns: []string{"session_uuid"}},
+				{Name: "fk_sessions_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+				{Type: "FOREIGN KEY", Columns: []string{"replaced_by"}, RefTable: "user_sessions", RefColumns: []string{"session_uuid"}},
+				{Type: "UNIQUE", Columns: []string{"replaced_by"}},
+			},
+		},
+	}}
+
+	want, err := os.ReadFile(filepath.Join("testdata", "mermaid_er.golden"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), schema.ToMermaidER())
+}
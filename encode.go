@@ -0,0 +1,35 @@
+package sqlmapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteTo writes the schema to w as indented JSON, implementing io.WriterTo.
+// Field names are those of the Go types; numbers held in interface{} values such as
+// Row.Values are read back as float64.
+func (s *Schema) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode schema: %w", err)
+	}
+	n, err := w.Write(append(data, '\n'))
+	return int64(n), err
+}
+
+// ReadFrom replaces the schema with the JSON written by WriteTo, reading r until
+// EOF. It implements io.ReaderFrom.
+func (s *Schema) ReadFrom(r io.Reader) (int64, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return int64(len(data)), err
+	}
+
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return int64(len(data)), fmt.Errorf("failed to decode schema: %w", err)
+	}
+	*s = schema
+	return int64(len(data)), nil
+}
@@ -0,0 +1,58 @@
+package sqlmapper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ io.WriterTo   = (*Schema)(nil)
+	_ io.ReaderFrom = (*Schema)(nil)
+)
+
+func TestSchema_WriteToReadFrom(t *testing.T) {
+	original := &Schema{
+		Name: "shop",
+		Tables: []Table{{
+			Name: "orders",
+			Columns: []Column{
+				{Name: "id", DataType: "INT", IsPrimaryKey: true, AutoIncrement: true},
+				{Name: "total", DataType: "DECIMAL", Length: 10, Scale: 2, IsNullable: true},
+			},
+			Indexes:     []Index{{Name: "idx_orders_total", Columns: IndexColumns("total"), Storage: &StorageClause{Initial: 65536}}},
+			Constraints: []Constraint{{Name: "pk_orders", Type: "PRIMARY KEY", Columns: []string{"id"}}},
+			Data:        []Row{{Values: map[string]interface{}{"id": 1.0, "total": "9.99"}}},
+		}},
+		Views:      []View{{Name: "big_orders", Definition: "SELECT * FROM orders WHERE total > 100"}},
+		Triggers:   []Trigger{{Name: "audit", Table: "orders", Events: []string{"INSERT"}}},
+		Partitions: map[string][]Partition{"orders": {{Name: "p2024", Values: []string{"2024"}}}},
+	}
+
+	var buf bytes.Buffer
+	written, err := original.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), written)
+	assert.Contains(t, buf.String(), `"Name": "orders"`)
+
+	var decoded Schema
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, written, read)
+	assert.Equal(t, original, &decoded)
+}
+
+func TestSchema_ReadFrom_Invalid(t *testing.T) {
+	schema := &Schema{Name: "kept"}
+	_, err := schema.ReadFrom(strings.NewReader("CREATE TABLE t (id INT);"))
+	assert.Error(t, err)
+	assert.Equal(t, "kept", schema.Name)
+
+	var syntaxErr *json.SyntaxError
+	assert.True(t, errors.As(err, &syntaxErr))
+}
@@ -0,0 +1,118 @@
+package sqlmapper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// snippetLength is the number of bytes of source text quoted by parse errors
+const snippetLength = 40
+
+// Snippet returns the start of a statement or definition on a single line, as quoted
+// by parse errors. Text longer than a few words is cut and ends with an ellipsis.
+func Snippet(source string) string {
+	snippet := strings.Join(strings.Fields(source), " ")
+	if len(snippet) <= snippetLength {
+		return snippet
+	}
+
+	end := snippetLength
+	for end > 0 && !utf8.RuneStart(snippet[end]) {
+		end--
+	}
+	return snippet[:end] + "..."
+}
+
+// ParseError describes a statement or an object of a dump that could not be parsed.
+// Errors parsing an object nested in another, such as a column of a table, are
+// wrapped by the error of the enclosing object, so errors.As finds the outermost one
+// and the inner ones are reached with errors.Unwrap.
+type ParseError struct {
+	Kind string // Kind of the object, e.g. table or column, empty for a statement
+	Name string // Name of the object, empty when it is not known
+	Line int    // Line the statement starts on, 0 for an object
+	Near string // Start of the source of the statement or object, as Snippet returns it
+	Err  error
+}
+
+// Error implements the error interface
+func (e *ParseError) Error() string {
+	location := e.Kind
+	if e.Kind == "" {
+		location = fmt.Sprintf("line %d", e.Line)
+	} else if e.Name != "" {
+		location += " " + e.Name
+	}
+	return fmt.Sprintf("%s near %q: %v", location, e.Near, e.Err)
+}
+
+// Unwrap returns the cause of the parse error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// UnsupportedFeatureError is returned for a feature the package does not provide,
+// such as introspecting a database type it has no catalog queries for. It matches
+// errors.ErrUnsupported with errors.Is.
+type UnsupportedFeatureError struct {
+	Feature string
+	Dialect DatabaseType // Database type lacking the feature, empty if not specific to one
+}
+
+// Error implements the error interface
+func (e *UnsupportedFeatureError) Error() string {
+	if e.Dialect != "" {
+		return fmt.Sprintf("%s of %s is not supported", e.Feature, e.Dialect)
+	}
+	return e.Feature + " is not supported"
+}
+
+// Is reports whether target is errors.ErrUnsupported
+func (e *UnsupportedFeatureError) Is(target error) bool {
+	return target == errors.ErrUnsupported
+}
+
+// ValidationError lists the problems that keep a schema from being generated for a
+// target database as it is, or the invalid input of an operation such as a merge
+type ValidationError struct {
+	Target   DatabaseType // Database the schema was validated for, empty if not specific to one
+	Reason   string       // Summary of the problems when Target is empty, such as merge conflicts
+	Problems []string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	problems := strings.Join(e.Problems, "; ")
+	switch {
+	case e.Target != "":
+		return fmt.Sprintf("schema is not valid for %s: %s", e.Target, problems)
+	case e.Reason != "":
+		return e.Reason + ": " + problems
+	}
+	return problems
+}
+
+// ObjectError wraps an error parsing an object, such as a table or one of its
+// columns, in a ParseError with the kind and name of the object and the start of its
+// source. The name is left out when it is not known.
+func ObjectError(kind, name, source string, err error) error {
+	return &ParseError{Kind: kind, Name: name, Near: Snippet(source), Err: err}
+}
+
+// ColumnError wraps an error parsing a column definition with ObjectError, naming the
+// column after the first word of the definition
+func ColumnError(definition string, err error) error {
+	var name string
+	if fields := strings.Fields(definition); len(fields) > 0 {
+		name = fields[0]
+	}
+	return ObjectError("column", name, definition, err)
+}
+
+// WrapError wraps an error parsing the statement in a ParseError with the line it
+// starts on and the start of its text
+func (s Statement) WrapError(err error) error {
+	return &ParseError{Line: s.Line, Near: Snippet(s.Text), Err: err}
+}
@@ -0,0 +1,76 @@
+package sqlmapper
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnippet(t *testing.T) {
+	assert.Equal(t, "id INT NOT NULL", Snippet("  id INT\n\tNOT NULL "))
+	assert.Equal(t, "CREATE TABLE users ( id INT NOT NULL, na...",
+		Snippet("CREATE TABLE users (\n  id INT NOT NULL,\n  name VARCHAR(100)\n)"))
+	// Multi-byte characters are not cut
+	assert.Equal(t, "COMMENT ON TABLE users IS 'Sipariş ve ...", Snippet("COMMENT ON TABLE users IS 'Sipariş ve ödeme'"))
+}
+
+func TestObjectError(t *testing.T) {
+	cause := errors.New("invalid column definition: price")
+
+	err := ObjectError("table", "users", "CREATE TABLE users (price)", ColumnError("price", cause))
+	assert.EqualError(t, err, `table users near "CREATE TABLE users (price)": column price near "price": invalid column definition: price`)
+	assert.True(t, errors.Is(err, cause))
+
+	err = ObjectError("constraint", "", "CHECK", cause)
+	assert.EqualError(t, err, `constraint near "CHECK": invalid column definition: price`)
+
+	err = Statement{Text: "CREATE INDEX ix ON (x)", Line: 3}.WrapError(cause)
+	assert.EqualError(t, err, `line 3 near "CREATE INDEX ix ON (x)": invalid column definition: price`)
+	assert.True(t, errors.Is(err, cause))
+}
+
+func TestParseError(t *testing.T) {
+	cause := errors.New("invalid column definition: price")
+	err := fmt.Errorf("error parsing tables: %w", ObjectError("table", "users", "CREATE TABLE users (price)", ColumnError("price", cause)))
+
+	var parseErr *ParseError
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Equal(t, "table", parseErr.Kind)
+		assert.Equal(t, "users", parseErr.Name)
+		assert.Equal(t, "CREATE TABLE users (price)", parseErr.Near)
+		assert.Zero(t, parseErr.Line)
+	}
+	assert.False(t, errors.Is(err, errors.ErrUnsupported))
+
+	err = Statement{Text: "CREATE INDEX ix ON (x)", Line: 3}.WrapError(cause)
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Empty(t, parseErr.Kind)
+		assert.Equal(t, 3, parseErr.Line)
+		assert.Equal(t, cause, parseErr.Err)
+	}
+}
+
+func TestUnsupportedFeatureError(t *testing.T) {
+	err := fmt.Errorf("failed to generate target: %w", &UnsupportedFeatureError{Feature: "introspection", Dialect: Oracle})
+	assert.EqualError(t, err, "failed to generate target: introspection of oracle is not supported")
+	assert.True(t, errors.Is(err, errors.ErrUnsupported))
+
+	var unsupported *UnsupportedFeatureError
+	if assert.True(t, errors.As(err, &unsupported)) {
+		assert.Equal(t, "introspection", unsupported.Feature)
+		assert.Equal(t, Oracle, unsupported.Dialect)
+	}
+	assert.False(t, errors.As(err, new(*ParseError)))
+
+	assert.EqualError(t, &UnsupportedFeatureError{Feature: "schema object *sqlmapper.Index"}, "schema object *sqlmapper.Index is not supported")
+}
+
+func TestValidationError(t *testing.T) {
+	err := &ValidationError{Target: Oracle, Problems: []string{"first", "second"}}
+	assert.EqualError(t, err, "schema is not valid for oracle: first; second")
+
+	assert.EqualError(t, &ValidationError{Reason: "merge conflicts", Problems: []string{"table users"}}, "merge conflicts: table users")
+	assert.EqualError(t, &ValidationError{Problems: []string{"reader cannot be nil"}}, "reader cannot be nil")
+}
@@ -69,25 +69,25 @@ func (c *Converter) Convert(sourceType, targetType DatabaseType, content string)
 	// Create source parser
 	sourceParser, err := c.factory.NewParser(sourceType)
 	if err != nil {
-		return "", fmt.Errorf("source parser error: %v", err)
+		return "", fmt.Errorf("source parser error: %w", err)
 	}
 
 	// Parse source content
 	schema, err := sourceParser.Parse(content)
 	if err != nil {
-		return "", fmt.Errorf("parse error: %v", err)
+		return "", fmt.Errorf("parse error: %w", err)
 	}
 
 	// Create target parser
 	targetParser, err := c.factory.NewParser(targetType)
 	if err != nil {
-		return "", fmt.Errorf("target parser error: %v", err)
+		return "", fmt.Errorf("target parser error: %w", err)
 	}
 
 	// Generate target content
 	result, err := targetParser.Generate(schema)
 	if err != nil {
-		return "", fmt.Errorf("generate error: %v", err)
+		return "", fmt.Errorf("generate error: %w", err)
 	}
 
 	return result, nil
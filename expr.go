@@ -0,0 +1,367 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TrimParens removes the parentheses enclosing a whole expression, such as the condition
+// of a trigger WHEN clause, so that dialects can add them back as their syntax requires
+func TrimParens(expr string) string {
+	expr = strings.TrimSpace(expr)
+	for strings.HasPrefix(expr, "(") && closingParen(expr) == len(expr)-1 {
+		expr = strings.TrimSpace(expr[1 : len(expr)-1])
+	}
+	return expr
+}
+
+// closingParen returns the index of the parenthesis closing the one that opens expr,
+// ignoring parentheses inside string literals, or -1 if it is never closed
+func closingParen(expr string) int {
+	depth := 0
+	inString := false
+
+	for i := 0; i < len(expr); i++ {
+		switch c := expr[i]; {
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// Parenthesized returns the text between the first opening parenthesis of s and the
+// parenthesis closing it, with the index following the closing one. Parentheses
+// nested in the text or inside string literals are kept; ok is false if the group is
+// never closed.
+func Parenthesized(s string) (inner string, end int, ok bool) {
+	start := strings.IndexByte(s, '(')
+	if start == -1 {
+		return "", -1, false
+	}
+	closing := closingParen(s[start:])
+	if closing == -1 {
+		return "", -1, false
+	}
+	return s[start+1 : start+closing], start + closing + 1, true
+}
+
+var checkClauseRe = regexp.MustCompile(`(?i)\bCHECK\s*\(`)
+
+// CheckExpression returns the expression of the CHECK clause in a column or table
+// constraint definition, such as status IN (1, 2) for CHECK (status IN (1, 2))
+func CheckExpression(definition string) (string, bool) {
+	loc := checkClauseRe.FindStringIndex(definition)
+	if loc == nil {
+		return "", false
+	}
+	inner, _, ok := Parenthesized(definition[loc[1]-1:])
+	return strings.TrimSpace(inner), ok
+}
+
+// inlineConstraintRe matches a CONSTRAINT prefix naming an inline constraint of a
+// column, capturing the name and the keywords of the constraint
+var inlineConstraintRe = regexp.MustCompile("(?i)\\bCONSTRAINT\\s+(\"[^\"]+\"|\\[[^\\]]+\\]|`[^`]+`|[\\w$#]+)\\s+(PRIMARY\\s+KEY|UNIQUE|CHECK|REFERENCES|NOT\\s+NULL|NULL|DEFAULT)\\b")
+
+// InlineConstraintName returns the name given by a CONSTRAINT prefix to the inline
+// constraint of a column definition introduced by keyword, such as qty_positive for
+// CHECK in qty INT CONSTRAINT qty_positive CHECK (qty > 0), or an empty string
+func InlineConstraintName(definition, keyword string) string {
+	for _, match := range inlineConstraintRe.FindAllStringSubmatch(definition, -1) {
+		if strings.EqualFold(strings.Join(strings.Fields(match[2]), " "), keyword) {
+			return trimIdentifier(match[1])
+		}
+	}
+	return ""
+}
+
+// ParseConstraintNames records the names of the inline PRIMARY KEY, UNIQUE and CHECK
+// constraints of a column definition
+func (c *Column) ParseConstraintNames(definition string) {
+	c.PrimaryKeyName = InlineConstraintName(definition, "PRIMARY KEY")
+	c.UniqueName = InlineConstraintName(definition, "UNIQUE")
+	c.CheckName = InlineConstraintName(definition, "CHECK")
+}
+
+// ConstraintPrefix returns the CONSTRAINT clause naming an inline constraint, followed
+// by a space, or an empty string for a constraint without a name
+func ConstraintPrefix(name string) string {
+	if name == "" {
+		return ""
+	}
+	return "CONSTRAINT " + name + " "
+}
+
+// SplitDefinitions splits the body of a CREATE TABLE statement into its column and
+// constraint definitions. Commas inside parentheses, such as those of DECIMAL(10,2)
+// or CHECK (a IN (1, 2)), and inside string literals do not separate definitions.
+func SplitDefinitions(body string) []string {
+	var definitions []string
+	for _, definition := range splitTopLevel(body) {
+		if definition = strings.TrimSpace(definition); definition != "" {
+			definitions = append(definitions, definition)
+		}
+	}
+	return definitions
+}
+
+// defaultTerminators are the column attributes that can follow a DEFAULT expression
+var defaultTerminators = []string{
+	"NOT", "NULL", "CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "REFERENCES", "COLLATE",
+	"GENERATED", "AUTO_INCREMENT", "AUTOINCREMENT", "IDENTITY", "COMMENT", "ON",
+}
+
+// DefaultExpression returns the expression of the DEFAULT clause in a column
+// definition as written, such as nextval('seq'::regclass), ARRAY[]::text[] or
+// (now() + interval '1 day'). The expression ends at the next column attribute, such
+// as NOT NULL or REFERENCES, outside of parentheses, brackets and string literals.
+func DefaultExpression(definition string) (string, bool) {
+	depth := 0
+	inString, inIdentifier := false, false
+	start := -1
+
+	for i := 0; i < len(definition); i++ {
+		c := definition[i]
+		switch {
+		case inString:
+			inString = c != '\''
+			continue
+		case inIdentifier:
+			inIdentifier = c != '"'
+			continue
+		case c == '\'':
+			inString = true
+			continue
+		case c == '"':
+			inIdentifier = true
+			continue
+		case c == '(' || c == '[':
+			depth++
+			continue
+		case c == ')' || c == ']':
+			depth--
+			continue
+		}
+		if depth > 0 || !isWordByte(c) || i > 0 && isWordByte(definition[i-1]) {
+			continue
+		}
+
+		word := definition[i:]
+		if end := strings.IndexFunc(word, func(r rune) bool { return r > 127 || !isWordByte(byte(r)) }); end >= 0 {
+			word = word[:end]
+		}
+		if start == -1 {
+			// Words preceding DEFAULT are the column name and its data type
+			if i > 0 && strings.EqualFold(word, "DEFAULT") {
+				start = i + len(word)
+			}
+			i += len(word) - 1
+			continue
+		}
+
+		// NULL is the expression itself in DEFAULT NULL
+		if strings.TrimSpace(definition[start:i]) != "" {
+			for _, terminator := range defaultTerminators {
+				if strings.EqualFold(word, terminator) {
+					return strings.TrimSpace(definition[start:i]), true
+				}
+			}
+		}
+		i += len(word) - 1
+	}
+
+	if start == -1 {
+		return "", false
+	}
+	expr := strings.TrimSpace(definition[start:])
+	return expr, expr != ""
+}
+
+// UnquoteLiteral removes the quotes of a value that is a single string literal and
+// unescapes its doubled quotes. Other values are returned unchanged.
+func UnquoteLiteral(value string) string {
+	if len(value) < 2 || value[0] != '\'' || value[len(value)-1] != '\'' {
+		return value
+	}
+	inner := value[1 : len(value)-1]
+	if strings.Contains(strings.ReplaceAll(inner, "''", ""), "'") {
+		return value
+	}
+	return strings.ReplaceAll(inner, "''", "'")
+}
+
+// castTypeWords are the words continuing a multi-word type name after its first word
+var castTypeWords = map[string][]string{
+	"character": {"varying"},
+	"double":    {"precision"},
+	"bit":       {"varying"},
+	"time":      {"with", "without", "time", "zone"},
+	"timestamp": {"with", "without", "time", "zone"},
+}
+
+// RewriteCasts rewrites the PostgreSQL casts of an expression, such as '{}'::jsonb,
+// to standard CAST('{}' AS jsonb) for dialects without the :: operator. Cast types
+// are renamed as listed in types, keyed by lower-case type name without parameters;
+// other types are kept as written. Casts inside string literals are left unchanged.
+func RewriteCasts(expr string, types map[string]string) string {
+	if !strings.Contains(expr, "::") {
+		return expr
+	}
+
+	var out []byte
+	inString := false
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		if c == '\'' {
+			inString = !inString
+		}
+		if inString || c != ':' || i+1 >= len(expr) || expr[i+1] != ':' {
+			out = append(out, c)
+			continue
+		}
+
+		operandEnd := len(strings.TrimRight(string(out), " \t\n"))
+		operandStart := castOperandStart(string(out[:operandEnd]))
+		castType, end := castTypeAt(expr, i+2)
+		if operandStart == operandEnd || castType == "" {
+			out = append(out, c)
+			continue
+		}
+
+		name, params := castType, ""
+		if p := strings.IndexAny(castType, "(["); p >= 0 {
+			name, params = strings.TrimSpace(castType[:p]), castType[p:]
+		}
+		if mapped, ok := types[strings.ToLower(strings.Join(strings.Fields(name), " "))]; ok {
+			castType = mapped
+			if p := strings.IndexByte(params, '('); p >= 0 && !strings.Contains(mapped, "(") {
+				castType += params[p : closingParen(params[p:])+p+1]
+			}
+		}
+
+		operand := string(out[operandStart:operandEnd])
+		out = append(out[:operandStart], "CAST("+operand+" AS "+castType+")"...)
+		i = end - 1
+	}
+	return string(out)
+}
+
+// castOperandStart returns the index where the operand of a cast ending s begins:
+// a string literal, a parenthesized or bracketed group with the function name or
+// ARRAY keyword preceding it, or an identifier or number
+func castOperandStart(s string) int {
+	i := len(s)
+	if i == 0 {
+		return i
+	}
+
+	switch s[i-1] {
+	case '\'':
+		// Skip back over the literal, including its doubled quotes
+		i--
+		for {
+			j := strings.LastIndexByte(s[:i], '\'')
+			if j < 0 {
+				return len(s)
+			}
+			if j > 0 && s[j-1] == '\'' {
+				i = j - 1
+				continue
+			}
+			return j
+		}
+	case ')', ']':
+		depth, inString := 0, false
+		for i--; i >= 0; i-- {
+			switch c := s[i]; {
+			case c == '\'':
+				inString = !inString
+			case inString:
+			case c == ')' || c == ']':
+				depth++
+			case c == '(' || c == '[':
+				depth--
+			}
+			if depth == 0 {
+				break
+			}
+		}
+		if i < 0 {
+			return len(s)
+		}
+	}
+
+	for i > 0 && (isWordByte(s[i-1]) || s[i-1] == '.') {
+		i--
+	}
+	return i
+}
+
+// castTypeAt reads the type name of a cast starting at index start of expr,
+// including multi-word names, parameters and array brackets. It returns the type and
+// the index following it.
+func castTypeAt(expr string, start int) (string, int) {
+	i := start
+	for i < len(expr) && expr[i] == ' ' {
+		i++
+	}
+
+	word := func(i int) int {
+		if i < len(expr) && expr[i] == '"' {
+			if end := strings.IndexByte(expr[i+1:], '"'); end >= 0 {
+				return i + end + 2
+			}
+			return i
+		}
+		for i < len(expr) && (isWordByte(expr[i]) || expr[i] == '.') {
+			i++
+		}
+		return i
+	}
+
+	end := word(i)
+	if end == i {
+		return "", start
+	}
+	if continuations, ok := castTypeWords[strings.ToLower(expr[i:end])]; ok {
+		for {
+			next := end
+			for next < len(expr) && expr[next] == ' ' {
+				next++
+			}
+			nextEnd := word(next)
+			if nextEnd == next || !containsFold(continuations, expr[next:nextEnd]) {
+				break
+			}
+			end = nextEnd
+		}
+	}
+
+	if end < len(expr) && expr[end] == '(' {
+		if closing := closingParen(expr[end:]); closing >= 0 {
+			end += closing + 1
+		}
+	}
+	for strings.HasPrefix(expr[end:], "[]") {
+		end += 2
+	}
+	return expr[i:end], end
+}
+
+// containsFold reports whether words contains word, ignoring case
+func containsFold(words []string, word string) bool {
+	for _, w := range words {
+		if strings.EqualFold(w, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrimParens(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "Enclosed", expr: "(NEW.x > 0)", want: "NEW.x > 0"},
+		{name: "Doubly enclosed", expr: " ((NEW.x > 0)) ", want: "NEW.x > 0"},
+		{name: "Not enclosed", expr: "NEW.x > 0", want: "NEW.x > 0"},
+		{name: "Separate groups", expr: "(a > 0) AND (b > 0)", want: "(a > 0) AND (b > 0)"},
+		{name: "Parenthesis in string", expr: "(name <> ')')", want: "name <> ')'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TrimParens(tt.expr))
+		})
+	}
+}
+
+func TestParenthesized(t *testing.T) {
+	inner, end, ok := Parenthesized("CREATE TABLE t (a DECIMAL(10,2), b TEXT DEFAULT ')') TABLESPACE ts")
+	assert.True(t, ok)
+	assert.Equal(t, "a DECIMAL(10,2), b TEXT DEFAULT ')'", inner)
+	assert.Equal(t, 52, end)
+
+	_, _, ok = Parenthesized("CREATE TABLE t (a INT")
+	assert.False(t, ok)
+	_, _, ok = Parenthesized("DROP TABLE t")
+	assert.False(t, ok)
+}
+
+func TestCheckExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       string
+		ok         bool
+	}{
+		{name: "Column check", definition: "status INT check (status IN (1, 2, 3)) NOT NULL", want: "status IN (1, 2, 3)", ok: true},
+		{name: "Table check", definition: "CONSTRAINT chk CHECK(price > 0 AND kind IN ('a', 'b'))", want: "price > 0 AND kind IN ('a', 'b')", ok: true},
+		{name: "No check", definition: "checked BOOLEAN", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := CheckExpression(tt.definition)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInlineConstraintName(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		keyword    string
+		want       string
+	}{
+		{name: "Named check", definition: "qty INT CONSTRAINT qty_positive CHECK (qty > 0)", keyword: "CHECK", want: "qty_positive"},
+		{name: "Quoted name", definition: `id INT CONSTRAINT "pk_users" PRIMARY KEY`, keyword: "PRIMARY KEY", want: "pk_users"},
+		{name: "Second constraint", definition: "email TEXT CONSTRAINT nn_email NOT NULL CONSTRAINT uq_email UNIQUE", keyword: "UNIQUE", want: "uq_email"},
+		{name: "Other keyword", definition: "email TEXT CONSTRAINT uq_email UNIQUE", keyword: "CHECK"},
+		{name: "Unnamed", definition: "qty INT CHECK (qty > 0)", keyword: "CHECK"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, InlineConstraintName(tt.definition, tt.keyword))
+		})
+	}
+}
+
+func TestSplitDefinitions(t *testing.T) {
+	body := `
+    id INT NOT NULL,
+    price DECIMAL(10,2),
+    status INT CHECK (status IN (1,2,3)),
+    note VARCHAR(20) DEFAULT 'a,b',
+    CONSTRAINT chk_price CHECK (price > 0 AND status IN (1, 2))
+`
+	assert.Equal(t, []string{
+		"id INT NOT NULL",
+		"price DECIMAL(10,2)",
+		"status INT CHECK (status IN (1,2,3))",
+		"note VARCHAR(20) DEFAULT 'a,b'",
+		"CONSTRAINT chk_price CHECK (price > 0 AND status IN (1, 2))",
+	}, SplitDefinitions(body))
+}
+
+func TestDefaultExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       string
+		ok         bool
+	}{
+		{name: "Sequence", definition: "id BIGINT DEFAULT nextval('orders_id_seq'::regclass) NOT NULL", want: "nextval('orders_id_seq'::regclass)", ok: true},
+		{name: "Cast", definition: "status VARCHAR(20) DEFAULT 'new'::character varying", want: "'new'::character varying", ok: true},
+		{name: "Array", definition: "tags TEXT[] DEFAULT ARRAY['a', 'b']::text[] NOT NULL", want: "ARRAY['a', 'b']::text[]", ok: true},
+		{name: "Expression", definition: "due TIMESTAMP DEFAULT (now() + interval '1 day') CHECK (due > now())", want: "(now() + interval '1 day')", ok: true},
+		{name: "Null", definition: "note TEXT DEFAULT NULL", want: "NULL", ok: true},
+		{name: "Literal with keyword", definition: "note TEXT DEFAULT 'NOT NULL' NULL", want: "'NOT NULL'", ok: true},
+		{name: "Column named default", definition: "default_role TEXT NOT NULL", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DefaultExpression(tt.definition)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnquoteLiteral(t *testing.T) {
+	assert.Equal(t, "it's", UnquoteLiteral("'it''s'"))
+	assert.Equal(t, "'a'::text", UnquoteLiteral("'a'::text"))
+	assert.Equal(t, "'a' || 'b'", UnquoteLiteral("'a' || 'b'"))
+}
+
+func TestRewriteCasts(t *testing.T) {
+	types := map[string]string{"jsonb": "JSON", "character varying": "CHAR", "numeric": "DECIMAL", "text": "NVARCHAR(MAX)"}
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "Literal", expr: "'{}'::jsonb", want: "CAST('{}' AS JSON)"},
+		{name: "Multi-word type", expr: "'new'::character varying", want: "CAST('new' AS CHAR)"},
+		{name: "Escaped literal", expr: "'it''s'::text", want: "CAST('it''s' AS NVARCHAR(MAX))"},
+		{name: "Parameters", expr: "0::numeric(10,2)", want: "CAST(0 AS DECIMAL(10,2))"},
+		{name: "Function call", expr: "nextval('seq'::regclass)", want: "nextval(CAST('seq' AS regclass))"},
+		{name: "Chained", expr: "payload::text::jsonb", want: "CAST(CAST(payload AS NVARCHAR(MAX)) AS JSON)"},
+		{name: "Group", expr: "(a + b)::bigint > 0", want: "CAST((a + b) AS bigint) > 0"},
+		{name: "Timestamp with time zone", expr: "now()::timestamp with time zone", want: "CAST(now() AS timestamp with time zone)"},
+		{name: "Array", expr: "ARRAY['a', 'b']::text[]", want: "CAST(ARRAY['a', 'b'] AS NVARCHAR(MAX))"},
+		{name: "Inside literal", expr: "'a::b'", want: "'a::b'"},
+		{name: "No cast", expr: "CURRENT_TIMESTAMP", want: "CURRENT_TIMESTAMP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RewriteCasts(tt.expr, types))
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package sqlmapper
+
+import (
+	"io"
+	"strings"
+)
+
+// KeywordCase is the letter case of the keywords in generated DDL
+type KeywordCase int
+
+const (
+	// UpperCaseKeywords writes keywords in upper case, as the generators do by default
+	UpperCaseKeywords KeywordCase = iota
+	// LowerCaseKeywords writes keywords in lower case
+	LowerCaseKeywords
+)
+
+// CommaStyle is the placement of the commas separating the definitions of a table
+type CommaStyle int
+
+const (
+	// TrailingCommas ends every definition but the last with a comma, the default
+	TrailingCommas CommaStyle = iota
+	// LeadingCommas starts every definition but the first with a comma
+	LeadingCommas
+)
+
+// defaultIndent is the indentation of the definitions of a table written by the generators
+const defaultIndent = "    "
+
+// FormatOptions controls the layout of generated DDL. The zero value keeps the
+// output of the generators unchanged: four spaces of indentation, upper-case
+// keywords, unaligned definitions and trailing commas.
+type FormatOptions struct {
+	// Indent is the indentation of the column and constraint definitions of a table,
+	// four spaces if empty
+	Indent string
+
+	// Keywords is the letter case of SQL keywords and built-in type names.
+	// Identifiers, literals and comments are never changed.
+	Keywords KeywordCase
+
+	// AlignColumns pads column names so that the data types of a table line up
+	AlignColumns bool
+
+	// Commas is the placement of the commas between definitions
+	Commas CommaStyle
+}
+
+// formatKeywords are the words whose case FormatOptions changes
+var formatKeywords = toSet(
+	// Statements and clauses
+	"CREATE", "ALTER", "DROP", "TABLE", "VIEW", "INDEX", "UNIQUE", "SEQUENCE", "TRIGGER",
+	"FUNCTION", "PROCEDURE", "TYPE", "SCHEMA", "DATABASE", "EXTENSION", "TEMPORARY", "TEMP",
+	"GLOBAL", "LOCAL", "OR", "REPLACE", "IF", "NOT", "EXISTS", "AS", "ON", "TO", "FROM",
+	"ADD", "COLUMN", "CONSTRAINT", "PRIMARY", "FOREIGN", "KEY", "REFERENCES", "CHECK",
+	"DEFAULT", "NULL", "CASCADE", "RESTRICT", "SET", "NO", "ACTION", "DELETE", "UPDATE",
+	"INSERT", "INTO", "VALUES", "SELECT", "WHERE", "AND", "IN", "IS", "BEGIN", "END",
+	"COMMIT", "TRANSACTION", "GRANT", "REVOKE", "WITH", "OPTION", "FOR", "EACH", "ROW",
+	"STATEMENT", "BEFORE", "AFTER", "INSTEAD", "OF", "WHEN", "EXECUTE", "RETURNS",
+	"LANGUAGE", "START", "INCREMENT", "BY", "MINVALUE", "MAXVALUE", "CACHE", "CYCLE",
+	"AUTO_INCREMENT", "AUTOINCREMENT", "IDENTITY", "COMMENT", "INCLUDE", "INHERITS",
+	"PARTITION", "RANGE", "LIST", "HASH", "TABLESPACE", "PRESERVE", "ROWS", "CLUSTERED",
+	"NONCLUSTERED", "GENERATED", "ALWAYS", "STORED", "VIRTUAL", "USING", "ASC", "DESC",
+	"UNSIGNED", "ZEROFILL", "COLLATE", "CHARACTER",
+	// Built-in data types
+	"INT", "INTEGER", "SMALLINT", "TINYINT", "MEDIUMINT", "BIGINT", "SERIAL", "BIGSERIAL",
+	"DECIMAL", "NUMERIC", "NUMBER", "FLOAT", "REAL", "DOUBLE", "PRECISION", "BIT", "BOOLEAN",
+	"BOOL", "CHAR", "VARCHAR", "VARCHAR2", "NCHAR", "NVARCHAR", "NVARCHAR2", "TEXT",
+	"TINYTEXT", "MEDIUMTEXT", "LONGTEXT", "NTEXT", "CLOB", "NCLOB", "BLOB", "BINARY",
+	"VARBINARY", "BYTEA", "DATE", "TIME", "TIMETZ", "TIMESTAMP", "TIMESTAMPTZ", "DATETIME",
+	"DATETIME2", "DATETIMEOFFSET", "INTERVAL", "YEAR", "JSON", "JSONB", "UUID", "XML",
+	"UNIQUEIDENTIFIER", "MAX",
+)
+
+// toSet returns a set of the given words
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// isDefault reports whether the options keep the output of the generators unchanged
+func (f FormatOptions) isDefault() bool {
+	return (f.Indent == "" || f.Indent == defaultIndent) && f.Keywords == UpperCaseKeywords &&
+		!f.AlignColumns && f.Commas == TrailingCommas
+}
+
+// Apply formats DDL written by a generator. Statements that are not CREATE TABLE
+// statements only have the case of their keywords changed.
+func (f FormatOptions) Apply(sql string) string {
+	if f.isDefault() {
+		return sql
+	}
+	if f.Keywords == LowerCaseKeywords {
+		sql = lowerKeywords(sql)
+	}
+	if f.Indent == "" && !f.AlignColumns && f.Commas == TrailingCommas {
+		return sql
+	}
+
+	lines := strings.Split(sql, "\n")
+	for start := 0; start < len(lines); start++ {
+		if !opensDefinitions(lines[start]) {
+			continue
+		}
+		end := start + 1
+		for end < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[end]), ")") {
+			end++
+		}
+		if end == len(lines) {
+			break
+		}
+		f.layoutDefinitions(lines[start+1 : end])
+		start = end
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Writer returns a writer applying the options to every write of a generator, each
+// of which holds whole statements. The writer itself is returned for the default options.
+func (f FormatOptions) Writer(w io.Writer) io.Writer {
+	if f.isDefault() {
+		return w
+	}
+	return &formatWriter{w: w, format: f}
+}
+
+// formatWriter formats the statements written to it
+type formatWriter struct {
+	w      io.Writer
+	format FormatOptions
+}
+
+// Write formats p and writes it to the underlying writer
+func (fw *formatWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(fw.w, fw.format.Apply(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// opensDefinitions reports whether a line opens the definition list of a CREATE TABLE
+func opensDefinitions(line string) bool {
+	upper := strings.ToUpper(strings.TrimSpace(line))
+	return strings.HasPrefix(upper, "CREATE ") && strings.Contains(upper, " TABLE ") && strings.HasSuffix(upper, "(")
+}
+
+// layoutDefinitions rewrites the lines of a definition list in place
+func (f FormatOptions) layoutDefinitions(lines []string) {
+	indent := f.Indent
+	if indent == "" {
+		indent = defaultIndent
+	}
+
+	definitions := make([]string, len(lines))
+	width := 0
+	for i, line := range lines {
+		definitions[i] = strings.TrimSuffix(strings.TrimSpace(line), ",")
+		if name, ok := columnName(definitions[i]); ok && len(name) > width {
+			width = len(name)
+		}
+	}
+
+	for i, definition := range definitions {
+		if name, ok := columnName(definition); ok && f.AlignColumns {
+			definition = name + strings.Repeat(" ", width-len(name)) + definition[len(name):]
+		}
+
+		switch {
+		case f.Commas == LeadingCommas && i == 0:
+			lines[i] = indent + "  " + definition
+		case f.Commas == LeadingCommas:
+			lines[i] = indent + ", " + definition
+		case i < len(definitions)-1:
+			lines[i] = indent + definition + ","
+		default:
+			lines[i] = indent + definition
+		}
+	}
+}
+
+// tableConstraintKeywords start the definitions of a table that are not columns
+var tableConstraintKeywords = toSet("CONSTRAINT", "PRIMARY", "FOREIGN", "UNIQUE", "CHECK", "KEY", "INDEX", "FULLTEXT", "SPATIAL")
+
+// columnName returns the name of the column a definition declares, reporting false
+// for table constraints
+func columnName(definition string) (string, bool) {
+	token, ok := NewLexer(definition).Next()
+	if !ok || token.Offset != 0 || token.Type == CommentToken {
+		return "", false
+	}
+	if token.Type == WordToken && tableConstraintKeywords[strings.ToUpper(token.Text)] {
+		return "", false
+	}
+	return token.Text, len(token.Text) < len(definition)
+}
+
+// lowerKeywords writes the keywords of sql in lower case, leaving literals, quoted
+// identifiers and comments untouched
+func lowerKeywords(sql string) string {
+	result := []byte(sql)
+	for _, token := range Tokenize(sql) {
+		if token.Type == WordToken && formatKeywords[token.Text] {
+			copy(result[token.Offset:], strings.ToLower(token.Text))
+		}
+	}
+	return string(result)
+}
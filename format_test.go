@@ -0,0 +1,73 @@
+package sqlmapper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatOptions_Apply(t *testing.T) {
+	// DDL as written by the generators
+	input := `CREATE TABLE users (
+    id INTEGER NOT NULL,
+    email VARCHAR(255) DEFAULT 'NOT NULL',
+    "Display Name" TEXT,
+    CONSTRAINT pk_users PRIMARY KEY (id)
+);
+CREATE INDEX idx_users_email ON users(email);
+`
+
+	tests := []struct {
+		name   string
+		format FormatOptions
+		want   string
+	}{
+		{
+			name:   "Default",
+			format: FormatOptions{},
+			want:   input,
+		},
+		{
+			name:   "Lower case, two spaces and leading commas",
+			format: FormatOptions{Indent: "  ", Keywords: LowerCaseKeywords, Commas: LeadingCommas},
+			want: `create table users (
+    id integer not null
+  , email varchar(255) default 'NOT NULL'
+  , "Display Name" text
+  , constraint pk_users primary key (id)
+);
+create index idx_users_email on users(email);
+`,
+		},
+		{
+			name:   "Aligned columns indented with a tab",
+			format: FormatOptions{Indent: "\t", AlignColumns: true},
+			want: `CREATE TABLE users (
+	id             INTEGER NOT NULL,
+	email          VARCHAR(255) DEFAULT 'NOT NULL',
+	"Display Name" TEXT,
+	CONSTRAINT pk_users PRIMARY KEY (id)
+);
+CREATE INDEX idx_users_email ON users(email);
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.format.Apply(input))
+		})
+	}
+}
+
+func TestFormatOptions_Writer(t *testing.T) {
+	var output strings.Builder
+	assert.Equal(t, &output, FormatOptions{}.Writer(&output))
+
+	writer := FormatOptions{Keywords: LowerCaseKeywords}.Writer(&output)
+	n, err := writer.Write([]byte("DROP TABLE users;\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 18, n)
+	assert.Equal(t, "drop table users;\n", output.String())
+}
@@ -0,0 +1,114 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// generatedStorageRe matches the keyword following the expression of a generated
+// column, which tells whether its values are stored
+var generatedStorageRe = regexp.MustCompile(`(?i)^\s*(STORED|PERSISTED|VIRTUAL)\b`)
+
+// castAsRe matches the target type ending the arguments of a CAST
+var castAsRe = regexp.MustCompile(`(?is)\bAS\s+(\w+(?:\s*\([^()]*\))?)\s*$`)
+
+// ParseGenerated recognizes the GENERATED ALWAYS AS (expr) clause of a column
+// definition, or its AS (expr) shorthand used by MySQL and by SQL Server computed
+// columns, together with the STORED, PERSISTED or VIRTUAL keyword following it,
+// setting GeneratedExpression and GeneratedStored. It returns the definition without
+// the clause, so that the words of the expression are not read as column options,
+// and whether the clause was found.
+func (c *Column) ParseGenerated(definition string) (string, bool) {
+	tokens := Tokenize(definition)
+	depth := 0
+	for i, token := range tokens {
+		switch {
+		case token.Text == "(":
+			depth++
+		case token.Text == ")":
+			depth--
+		case depth == 0 && strings.EqualFold(token.Text, "AS") && i+1 < len(tokens) && tokens[i+1].Text == "(":
+			inner, end, ok := Parenthesized(definition[token.Offset:])
+			if !ok {
+				return definition, false
+			}
+			start, end := token.Offset, token.Offset+end
+			if i >= 2 && strings.EqualFold(tokens[i-1].Text, "ALWAYS") && strings.EqualFold(tokens[i-2].Text, "GENERATED") {
+				start = tokens[i-2].Offset
+			}
+
+			c.GeneratedExpression = strings.TrimSpace(inner)
+			if match := generatedStorageRe.FindStringSubmatchIndex(definition[end:]); match != nil {
+				c.GeneratedStored = !strings.EqualFold(definition[end+match[2]:end+match[3]], "VIRTUAL")
+				end += match[1]
+			}
+			return strings.TrimRight(definition[:start], " \t\r\n") + definition[end:], true
+		}
+	}
+	return definition, false
+}
+
+// GeneratedClause returns the GENERATED ALWAYS AS (expr) STORED or VIRTUAL clause of
+// a generated column, or an empty string for other columns
+func GeneratedClause(column Column) string {
+	if column.GeneratedExpression == "" {
+		return ""
+	}
+	if column.GeneratedStored {
+		return "GENERATED ALWAYS AS (" + column.GeneratedExpression + ") STORED"
+	}
+	return "GENERATED ALWAYS AS (" + column.GeneratedExpression + ") VIRTUAL"
+}
+
+// GeneratedType returns a generated column with a data type for the dialects that
+// require one. SQL Server computed columns have no data type: the type of an
+// expression converted as a whole by CAST or CONVERT is used, or else the fallback,
+// which is reported.
+func (o GenerateOptions) GeneratedType(table string, column Column, fallback string) Column {
+	if column.GeneratedExpression == "" || column.DataType != "" {
+		return column
+	}
+
+	dataType := castType(column.GeneratedExpression)
+	if dataType == "" {
+		o.Warnf("generated column %s.%s has no data type and was generated as %s", table, column.Name, fallback)
+		dataType = fallback
+	}
+	column.DataType, column.Length, column.Scale = ParseDataType(dataType)
+	return column
+}
+
+// castType returns the data type of an expression that is a CAST(... AS type) or a
+// CONVERT(type, ...) as a whole, or an empty string
+func castType(expression string) string {
+	inner, end, ok := Parenthesized(expression)
+	if !ok || end != len(expression) {
+		return ""
+	}
+
+	switch strings.ToUpper(strings.TrimSpace(expression[:strings.IndexByte(expression, '(')])) {
+	case "CAST":
+		if match := castAsRe.FindStringSubmatch(inner); match != nil {
+			return match[1]
+		}
+	case "CONVERT":
+		if arguments := splitTopLevel(inner); len(arguments) > 1 {
+			return strings.TrimSpace(arguments[0])
+		}
+	}
+	return ""
+}
+
+// TranslateGenerated translates the expression of a generated column of a table with
+// TranslateExpression, warning about the operators kept as written
+func (o GenerateOptions) TranslateGenerated(table string, column Column, rules ExpressionRules) Column {
+	if column.GeneratedExpression == "" {
+		return column
+	}
+	translated, unsupported := TranslateExpression(column.GeneratedExpression, rules)
+	for _, operator := range unsupported {
+		o.Warnf("generated column %s.%s uses the %s operator, which has no equivalent and was kept as written", table, column.Name, operator)
+	}
+	column.GeneratedExpression = translated
+	return column
+}
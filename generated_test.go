@@ -0,0 +1,93 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumn_ParseGenerated(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       Column
+		rest       string
+		ok         bool
+	}{
+		{
+			name:       "Computed persisted",
+			definition: "total AS (price * (quantity - 1)) PERSISTED NOT NULL",
+			want:       Column{GeneratedExpression: "price * (quantity - 1)", GeneratedStored: true},
+			rest:       "total NOT NULL",
+			ok:         true,
+		},
+		{
+			name:       "Computed",
+			definition: "[code] AS ('#' + CAST(id AS VARCHAR(10)))",
+			want:       Column{GeneratedExpression: "'#' + CAST(id AS VARCHAR(10))"},
+			rest:       "[code]",
+			ok:         true,
+		},
+		{
+			name:       "Generated always stored",
+			definition: "total NUMERIC generated always as (price * qty) stored",
+			want:       Column{GeneratedExpression: "price * qty", GeneratedStored: true},
+			rest:       "total NUMERIC",
+			ok:         true,
+		},
+		{
+			name:       "Generated virtual",
+			definition: "full_name VARCHAR(100) AS (CONCAT(first, ' ', last)) VIRTUAL COMMENT 'Display'",
+			want:       Column{GeneratedExpression: "CONCAT(first, ' ', last)"},
+			rest:       "full_name VARCHAR(100) COMMENT 'Display'",
+			ok:         true,
+		},
+		{
+			name:       "Identity column",
+			definition: "id INT GENERATED ALWAYS AS IDENTITY",
+			rest:       "id INT GENERATED ALWAYS AS IDENTITY",
+		},
+		{
+			name:       "Cast in a check",
+			definition: "code VARCHAR(10) CHECK (CAST(code AS INT) > 0) DEFAULT 'AS (x)'",
+			rest:       "code VARCHAR(10) CHECK (CAST(code AS INT) > 0) DEFAULT 'AS (x)'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var column Column
+			rest, ok := column.ParseGenerated(tt.definition)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.rest, rest)
+			assert.Equal(t, tt.want, column)
+		})
+	}
+}
+
+func TestGeneratedClause(t *testing.T) {
+	assert.Equal(t, "", GeneratedClause(Column{Name: "total"}))
+	assert.Equal(t, "GENERATED ALWAYS AS (price * qty) STORED", GeneratedClause(Column{GeneratedExpression: "price * qty", GeneratedStored: true}))
+	assert.Equal(t, "GENERATED ALWAYS AS (price * qty) VIRTUAL", GeneratedClause(Column{GeneratedExpression: "price * qty"}))
+}
+
+func TestGenerateOptions_GeneratedType(t *testing.T) {
+	var warnings []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}}
+
+	column := Column{Name: "total", DataType: "DECIMAL", Length: 12, Scale: 2, GeneratedExpression: "price * qty"}
+	assert.Equal(t, column, options.GeneratedType("orders", column, "TEXT"))
+
+	column = Column{Name: "code", GeneratedExpression: "CAST(id AS VARCHAR(10))"}
+	assert.Equal(t, "VARCHAR(10)", FormatDataType(options.GeneratedType("orders", column, "TEXT")))
+
+	column = Column{Name: "code", GeneratedExpression: "CONVERT(DECIMAL(10,2), price)"}
+	assert.Equal(t, "DECIMAL(10,2)", FormatDataType(options.GeneratedType("orders", column, "TEXT")))
+
+	// Only an expression converted as a whole gives the type
+	column = Column{Name: "total", GeneratedExpression: "CAST(price AS INT) * CAST(qty AS INT)"}
+	assert.Equal(t, "TEXT", options.GeneratedType("orders", column, "TEXT").DataType)
+	assert.Equal(t, []string{"generated column orders.total has no data type and was generated as TEXT"}, warnings)
+}
@@ -0,0 +1,179 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// GoStructOptions configures the Go code generated by GenerateGoStructs
+type GoStructOptions struct {
+	Package       string           // Package clause of the generated file, models when empty
+	Tags          []string         // Struct tag keys holding the column name, db and json when empty
+	JSONCamelCase bool             // Writes json tags in lowerCamelCase instead of the column name
+	OmitEmpty     bool             // Adds omitempty to the json tags of nullable columns
+	Nullable      NullableStrategy // Go types of nullable columns
+}
+
+// goInitialisms are the words written in upper case in Go identifiers
+var goInitialisms = map[string]bool{
+	"API": true, "HTML": true, "HTTP": true, "ID": true, "IP": true, "JSON": true,
+	"SQL": true, "UID": true, "URL": true, "UUID": true, "XML": true,
+}
+
+// goImports maps the package qualifiers of Go types to import paths
+var goImports = map[string]string{
+	"sql":  "database/sql",
+	"json": "encoding/json",
+	"time": "time",
+}
+
+// GenerateGoStructs generates a Go source file declaring a struct for each table of
+// the schema, with a field for each column tagged with the column name. Field types
+// are chosen by Column.GoType. It returns an error when two tables or two columns of
+// a table map to the same Go name.
+func GenerateGoStructs(schema *Schema, options GoStructOptions) (string, error) {
+	if schema == nil {
+		return "", &ValidationError{Problems: []string{"schema is required"}}
+	}
+
+	pkg := options.Package
+	if pkg == "" {
+		pkg = "models"
+	}
+	if !token.IsIdentifier(pkg) {
+		return "", &ValidationError{Problems: []string{"invalid package name: " + pkg}}
+	}
+
+	tags := options.Tags
+	if len(tags) == 0 {
+		tags = []string{"db", "json"}
+	}
+
+	imports := make(map[string]bool)
+	structNames := make(map[string]string)
+	var body strings.Builder
+
+	for _, table := range schema.Tables {
+		structName := goIdentifier(table.Name)
+		if previous, ok := structNames[structName]; ok {
+			return "", fmt.Errorf("tables %s and %s both map to struct %s", previous, table.Name, structName)
+		}
+		structNames[structName] = table.Name
+
+		body.WriteString("\n")
+		fmt.Fprintf(&body, "// %s is a row of the %s table\n", structName, table.Name)
+		if table.Comment != "" {
+			fmt.Fprintf(&body, "//\n// %s\n", strings.Join(strings.Fields(table.Comment), " "))
+		}
+		fmt.Fprintf(&body, "type %s struct {\n", structName)
+
+		fieldNames := make(map[string]string)
+		for _, column := range table.Columns {
+			fieldName := goIdentifier(column.Name)
+			if previous, ok := fieldNames[fieldName]; ok {
+				return "", fmt.Errorf("columns %s and %s of table %s both map to field %s", previous, column.Name, table.Name, fieldName)
+			}
+			fieldNames[fieldName] = column.Name
+
+			goType := column.GoType(options.Nullable)
+			if i := strings.Index(goType, "."); i >= 0 {
+				imports[goImports[strings.TrimLeft(goType[:i], "*")]] = true
+			}
+
+			fmt.Fprintf(&body, "\t%s %s `%s`", fieldName, goType, goStructTag(column, tags, options))
+			if column.Comment != "" {
+				fmt.Fprintf(&body, " // %s", strings.Join(strings.Fields(column.Comment), " "))
+			}
+			body.WriteString("\n")
+		}
+		body.WriteString("}\n")
+	}
+
+	var file strings.Builder
+	file.WriteString("// Code generated by sqlmapper. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&file, "package %s\n", pkg)
+
+	if len(imports) > 0 {
+		paths := make([]string, 0, len(imports))
+		for path := range imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		file.WriteString("\nimport (\n")
+		for _, path := range paths {
+			fmt.Fprintf(&file, "\t%q\n", path)
+		}
+		file.WriteString(")\n")
+	}
+	file.WriteString(body.String())
+
+	source, err := format.Source([]byte(file.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return string(source), nil
+}
+
+// goStructTag returns the struct tag of the field of a column
+func goStructTag(column Column, tags []string, options GoStructOptions) string {
+	parts := make([]string, 0, len(tags))
+	for _, key := range tags {
+		value := column.Name
+		if key == "json" {
+			if options.JSONCamelCase {
+				value = lowerCamelCase(column.Name)
+			}
+			if options.OmitEmpty && column.IsNullable && !column.IsPrimaryKey {
+				value += ",omitempty"
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%s:%q", key, value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// identifierWords splits a table or column name into its words
+func identifierWords(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// lowerCamelCase converts a column name such as created_at to createdAt
+func lowerCamelCase(name string) string {
+	words := identifierWords(name)
+	if len(words) == 0 {
+		return name
+	}
+	return strings.ToLower(words[0]) + camelCase(words[1:])
+}
+
+// goIdentifier converts a table or column name such as user_id to an exported Go
+// identifier such as UserID
+func goIdentifier(name string) string {
+	identifier := camelCase(identifierWords(name))
+	if identifier == "" || !unicode.IsLetter([]rune(identifier)[0]) {
+		identifier = "X" + identifier
+	}
+	return identifier
+}
+
+// camelCase joins words capitalizing each of them, and writing initialisms in upper case
+func camelCase(words []string) string {
+	var identifier strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); goInitialisms[upper] {
+			identifier.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		identifier.WriteRune(unicode.ToUpper(runes[0]))
+		identifier.WriteString(string(runes[1:]))
+	}
+	return identifier.String()
+}
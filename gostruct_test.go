@@ -0,0 +1,96 @@
+package sqlmapper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// goStructSchema is a two-table schema covering the mapped column types
+var goStructSchema = &Schema{
+	Tables: []Table{
+		{
+			Name:    "users",
+			Comment: "Registered users",
+			Columns: []Column{
+				{Name: "id", DataType: "BIGINT", IsPrimaryKey: true, AutoIncrement: true},
+				{Name: "email", DataType: "VARCHAR", Length: 255},
+				{Name: "display_name", DataType: "VARCHAR", Length: 100, IsNullable: true},
+				{Name: "is_active", DataType: "TINYINT", Length: 1, Comment: "Whether the user can log in"},
+				{Name: "balance", DataType: "DECIMAL", Length: 10, Scale: 2},
+				{Name: "avatar", DataType: "BLOB", IsNullable: true},
+				{Name: "created_at", DataType: "TIMESTAMP"},
+			},
+		},
+		{
+			Name: "user_sessions",
+			Columns: []Column{
+				{Name: "session_uuid", DataType: "UUID", IsPrimaryKey: true},
+				{Name: "user_id", DataType: "BIGINT"},
+				{Name: "payload", DataType: "JSONB", IsNullable: true},
+				{Name: "score", DataType: "DOUBLE PRECISION", IsNullable: true},
+				{Name: "expires_at", DataType: "TIMESTAMPTZ", IsNullable: true},
+			},
+		},
+	},
+}
+
+func TestGenerateGoStructs(t *testing.T) {
+	tests := []struct {
+		name    string
+		options GoStructOptions
+		golden  string
+	}{
+		{
+			name:    "Null types",
+			options: GoStructOptions{},
+			golden:  "go_structs_null.golden",
+		},
+		{
+			name: "Pointers",
+			options: GoStructOptions{
+				Package:       "store",
+				Tags:          []string{"json", "db"},
+				JSONCamelCase: true,
+				OmitEmpty:     true,
+				Nullable:      NullPointers,
+			},
+			golden: "go_structs_pointers.golden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateGoStructs(goStructSchema, tt.options)
+			assert.NoError(t, err)
+
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			assert.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestGenerateGoStructs_Errors(t *testing.T) {
+	_, err := GenerateGoStructs(nil, GoStructOptions{})
+	assert.EqualError(t, err, "schema is required")
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	_, err = GenerateGoStructs(goStructSchema, GoStructOptions{Package: "my-models"})
+	assert.EqualError(t, err, "invalid package name: my-models")
+	if assert.ErrorAs(t, err, &validationErr) {
+		assert.Equal(t, []string{"invalid package name: my-models"}, validationErr.Problems)
+	}
+
+	_, err = GenerateGoStructs(&Schema{Tables: []Table{{Name: "user_roles"}, {Name: "UserRoles"}}}, GoStructOptions{})
+	assert.EqualError(t, err, "tables user_roles and UserRoles both map to struct UserRoles")
+
+	_, err = GenerateGoStructs(&Schema{Tables: []Table{{
+		Name:    "users",
+		Columns: []Column{{Name: "user_id", DataType: "INT"}, {Name: "User_ID", DataType: "INT"}},
+	}}}, GoStructOptions{})
+	assert.EqualError(t, err, "columns user_id and User_ID of table users both map to field UserID")
+}
@@ -0,0 +1,124 @@
+package sqlmapper
+
+import "strings"
+
+// NullableStrategy selects the Go type of nullable columns
+type NullableStrategy int
+
+const (
+	// NullSQLTypes maps nullable columns to the sql.NullXxx types, such as
+	// sql.NullString, or to sql.Null[T] for types without one
+	NullSQLTypes NullableStrategy = iota
+	// NullPointers maps nullable columns to pointers, such as *string
+	NullPointers
+)
+
+// goTypes maps upper-case data type names to Go types
+var goTypes = map[string]string{
+	"TINYINT":     "int64",
+	"SMALLINT":    "int64",
+	"MEDIUMINT":   "int64",
+	"INT":         "int64",
+	"INTEGER":     "int64",
+	"BIGINT":      "int64",
+	"INT2":        "int64",
+	"INT4":        "int64",
+	"INT8":        "int64",
+	"SMALLSERIAL": "int64",
+	"SERIAL":      "int64",
+	"BIGSERIAL":   "int64",
+
+	"FLOAT":            "float64",
+	"FLOAT4":           "float64",
+	"FLOAT8":           "float64",
+	"REAL":             "float64",
+	"DOUBLE":           "float64",
+	"DOUBLE PRECISION": "float64",
+	"BINARY_FLOAT":     "float64",
+	"BINARY_DOUBLE":    "float64",
+
+	"BOOL":    "bool",
+	"BOOLEAN": "bool",
+
+	"DATE":           "time.Time",
+	"DATETIME":       "time.Time",
+	"DATETIME2":      "time.Time",
+	"SMALLDATETIME":  "time.Time",
+	"DATETIMEOFFSET": "time.Time",
+	"TIMESTAMP":      "time.Time",
+	"TIMESTAMPTZ":    "time.Time",
+
+	"BINARY":     "[]byte",
+	"VARBINARY":  "[]byte",
+	"BLOB":       "[]byte",
+	"TINYBLOB":   "[]byte",
+	"MEDIUMBLOB": "[]byte",
+	"LONGBLOB":   "[]byte",
+	"BYTEA":      "[]byte",
+	"RAW":        "[]byte",
+	"LONG RAW":   "[]byte",
+	"IMAGE":      "[]byte",
+
+	"JSON":  "json.RawMessage",
+	"JSONB": "json.RawMessage",
+}
+
+// nullGoTypes maps Go types to the sql.NullXxx type used for nullable columns
+var nullGoTypes = map[string]string{
+	"string":    "sql.NullString",
+	"int64":     "sql.NullInt64",
+	"float64":   "sql.NullFloat64",
+	"bool":      "sql.NullBool",
+	"time.Time": "sql.NullTime",
+}
+
+// GoType returns the Go type holding the values of the column, qualified with its
+// package, such as int64, string, time.Time or sql.NullString. Exact numerics such
+// as DECIMAL are mapped to string to keep their precision, TINYINT(1) and BIT(1) to
+// bool and unsigned BIGINT to uint64; unknown types are mapped to string. Nullable
+// columns other than primary keys are mapped according to the strategy, except byte
+// slices and json.RawMessage, which are nil for NULL.
+func (c Column) GoType(strategy NullableStrategy) string {
+	goType := c.baseGoType()
+	if !c.IsNullable || c.IsPrimaryKey || goType == "[]byte" || goType == "json.RawMessage" {
+		return goType
+	}
+
+	if strategy == NullPointers {
+		return "*" + goType
+	}
+	if nullType, ok := nullGoTypes[goType]; ok {
+		return nullType
+	}
+	return "sql.Null[" + goType + "]"
+}
+
+// baseGoType returns the Go type of a non-nullable column
+func (c Column) baseGoType() string {
+	name, length, scale := ParseDataType(c.DataType)
+	if length == 0 {
+		length, scale = c.Length, c.Scale
+	}
+	name = strings.ToUpper(strings.Join(strings.Fields(name), " "))
+
+	switch {
+	case name == "TINYINT" && length == 1, name == "BIT" && length <= 1:
+		return "bool"
+	case name == "BIGINT" && (c.Unsigned || c.Zerofill):
+		return "uint64"
+	case name == "NUMBER":
+		// Oracle NUMBER(p) holds integers, other NUMBERs are exact decimals
+		if length > 0 && scale == 0 {
+			return "int64"
+		}
+		return "string"
+	case strings.HasPrefix(name, "TIMESTAMP"):
+		// TIMESTAMP WITH TIME ZONE and its variants
+		return "time.Time"
+	}
+
+	if goType, ok := goTypes[name]; ok {
+		return goType
+	}
+	return "string"
+}
@@ -0,0 +1,45 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumn_GoType(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   Column
+		strategy NullableStrategy
+		want     string
+	}{
+		{name: "Integer", column: Column{DataType: "INT"}, want: "int64"},
+		{name: "Unsigned bigint", column: Column{DataType: "BIGINT", Unsigned: true}, want: "uint64"},
+		{name: "Oracle integer", column: Column{DataType: "NUMBER", Length: 10}, want: "int64"},
+		{name: "Oracle decimal", column: Column{DataType: "NUMBER(10,2)"}, want: "string"},
+		{name: "Decimal", column: Column{DataType: "DECIMAL", Length: 10, Scale: 2}, want: "string"},
+		{name: "Double", column: Column{DataType: "double precision"}, want: "float64"},
+		{name: "Varchar", column: Column{DataType: "VARCHAR", Length: 255}, want: "string"},
+		{name: "Unknown type", column: Column{DataType: "GEOMETRY"}, want: "string"},
+		{name: "Boolean", column: Column{DataType: "BOOLEAN"}, want: "bool"},
+		{name: "Tinyint boolean", column: Column{DataType: "TINYINT", Length: 1}, want: "bool"},
+		{name: "Bit", column: Column{DataType: "BIT"}, want: "bool"},
+		{name: "Timestamp with time zone", column: Column{DataType: "TIMESTAMP WITH TIME ZONE"}, want: "time.Time"},
+		{name: "Datetime", column: Column{DataType: "DATETIME2", Length: 3}, want: "time.Time"},
+		{name: "Blob", column: Column{DataType: "BYTEA", IsNullable: true}, want: "[]byte"},
+		{name: "JSON", column: Column{DataType: "JSONB", IsNullable: true}, want: "json.RawMessage"},
+		{name: "Nullable string", column: Column{DataType: "TEXT", IsNullable: true}, want: "sql.NullString"},
+		{name: "Nullable integer", column: Column{DataType: "BIGINT", IsNullable: true}, want: "sql.NullInt64"},
+		{name: "Nullable time", column: Column{DataType: "DATE", IsNullable: true}, want: "sql.NullTime"},
+		{name: "Nullable unsigned", column: Column{DataType: "BIGINT", Unsigned: true, IsNullable: true}, want: "sql.Null[uint64]"},
+		{name: "Nullable pointer", column: Column{DataType: "FLOAT", IsNullable: true}, strategy: NullPointers, want: "*float64"},
+		{name: "Nullable boolean pointer", column: Column{DataType: "BOOL", IsNullable: true}, strategy: NullPointers, want: "*bool"},
+		{name: "Primary key", column: Column{DataType: "INTEGER", IsNullable: true, IsPrimaryKey: true}, want: "int64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.column.GoType(tt.strategy))
+		})
+	}
+}
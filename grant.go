@@ -0,0 +1,83 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// permissionRe matches a GRANT or REVOKE statement on a single object and grantee
+var permissionRe = regexp.MustCompile(`(?is)^\s*(GRANT|REVOKE)\s+(?:GRANT\s+OPTION\s+FOR\s+)?(.+?)\s+ON\s+(?:(TABLE|FUNCTION|PROCEDURE|SCHEMA|ALL\s+TABLES\s+IN\s+SCHEMA)\s+|OBJECT::|(SCHEMA)::)?([^\s;(]+)(?:\s*\([^()]*\))?\s+(?:TO|FROM)\s+([^\s,;]+)(\s+WITH\s+GRANT\s+OPTION)?(?:\s+(?:CASCADE|RESTRICT))?\s*;?\s*$`)
+
+// ParsePermission recognizes a GRANT or REVOKE statement on an object, such as
+// "GRANT SELECT, INSERT ON orders TO 'app'@'localhost'", and returns the permission.
+// The second return value is false for other statements, including role grants.
+//
+// MySQL accounts are stored as user@host, and grants on every table of a MySQL
+// database (db.*) are stored with the ALL TABLES IN SCHEMA object type.
+func ParsePermission(statement string) (*Permission, bool) {
+	match := permissionRe.FindStringSubmatch(statement)
+	if match == nil {
+		return nil, false
+	}
+
+	permission := &Permission{
+		Type:       strings.ToUpper(match[1]),
+		ObjectType: strings.ToUpper(spacesRe.ReplaceAllString(match[3]+match[4], " ")),
+		Grantee:    trimGrantee(match[6]),
+		WithGrant:  match[7] != "",
+	}
+	if permission.ObjectType == "TABLE" {
+		permission.ObjectType = ""
+	}
+
+	for _, privilege := range splitTopLevel(match[2]) {
+		privilege = spacesRe.ReplaceAllString(strings.TrimSpace(privilege), " ")
+		if strings.EqualFold(privilege, "ALL") || strings.EqualFold(privilege, "ALL PRIVILEGES") {
+			privilege = "ALL PRIVILEGES"
+		}
+		permission.Privileges = append(permission.Privileges, privilege)
+	}
+
+	parts := strings.Split(match[5], ".")
+	for i := range parts {
+		parts[i] = trimIdentifier(parts[i])
+	}
+	if len(parts) == 2 && parts[1] == "*" && parts[0] != "*" {
+		permission.ObjectType = "ALL TABLES IN SCHEMA"
+		parts = parts[:1]
+	}
+	permission.Object = strings.Join(parts, ".")
+
+	return permission, true
+}
+
+// trimGrantee removes the quoting of a grantee, keeping the host of a MySQL account
+func trimGrantee(grantee string) string {
+	parts := strings.SplitN(grantee, "@", 2)
+	for i := range parts {
+		parts[i] = strings.Trim(trimIdentifier(parts[i]), "'")
+	}
+	return strings.Join(parts, "@")
+}
+
+// Account splits the grantee into the user or role name and the host of a MySQL
+// account. The host is empty for grantees that are not MySQL accounts.
+func (p Permission) Account() (user, host string) {
+	user, host, _ = strings.Cut(p.Grantee, "@")
+	return user, host
+}
+
+// PermissionStatement returns the GRANT or REVOKE statement, without a terminating
+// semicolon, for the permission on the object and grantee as written by a dialect
+func PermissionStatement(permission Permission, object, grantee string) string {
+	sql := permission.Type + " " + strings.Join(permission.Privileges, ", ") + " ON " + object
+	if permission.Type == "REVOKE" {
+		return sql + " FROM " + grantee
+	}
+
+	sql += " TO " + grantee
+	if permission.WithGrant {
+		sql += " WITH GRANT OPTION"
+	}
+	return sql
+}
@@ -0,0 +1,94 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      *Permission
+	}{
+		{
+			name:      "MySQL table grant",
+			statement: "GRANT SELECT, INSERT ON shop.orders TO 'app'@'localhost' WITH GRANT OPTION;",
+			want: &Permission{
+				Type:       "GRANT",
+				Privileges: []string{"SELECT", "INSERT"},
+				Object:     "shop.orders",
+				Grantee:    "app@localhost",
+				WithGrant:  true,
+			},
+		},
+		{
+			name:      "MySQL database grant",
+			statement: "GRANT ALL ON `shop`.* TO 'admin'@'%'",
+			want: &Permission{
+				Type:       "GRANT",
+				Privileges: []string{"ALL PRIVILEGES"},
+				ObjectType: "ALL TABLES IN SCHEMA",
+				Object:     "shop",
+				Grantee:    "admin@%",
+			},
+		},
+		{
+			name:      "PostgreSQL function grant",
+			statement: "GRANT EXECUTE ON FUNCTION calculate_salary(integer) TO payroll",
+			want: &Permission{
+				Type:       "GRANT",
+				Privileges: []string{"EXECUTE"},
+				ObjectType: "FUNCTION",
+				Object:     "calculate_salary",
+				Grantee:    "payroll",
+			},
+		},
+		{
+			name:      "Column privileges and revoke",
+			statement: "revoke update (email,  name) on table public.users from \"intern\" cascade",
+			want: &Permission{
+				Type:       "REVOKE",
+				Privileges: []string{"update (email, name)"},
+				Object:     "public.users",
+				Grantee:    "intern",
+			},
+		},
+		{
+			name:      "SQL Server schema grant",
+			statement: "GRANT SELECT ON SCHEMA::[sales] TO [reporting]",
+			want: &Permission{
+				Type:       "GRANT",
+				Privileges: []string{"SELECT"},
+				ObjectType: "SCHEMA",
+				Object:     "sales",
+				Grantee:    "reporting",
+			},
+		},
+		{
+			name:      "Role grant",
+			statement: "GRANT reader TO alice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParsePermission(tt.statement)
+			assert.Equal(t, tt.want != nil, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPermissionStatement(t *testing.T) {
+	grant := Permission{Type: "GRANT", Privileges: []string{"SELECT", "UPDATE"}, Object: "orders", Grantee: "app@localhost", WithGrant: true}
+	assert.Equal(t, "GRANT SELECT, UPDATE ON orders TO app WITH GRANT OPTION", PermissionStatement(grant, "orders", "app"))
+
+	user, host := grant.Account()
+	assert.Equal(t, "app", user)
+	assert.Equal(t, "localhost", host)
+
+	revoke := Permission{Type: "REVOKE", Privileges: []string{"DELETE"}, Object: "orders", Grantee: "app", WithGrant: true}
+	assert.Equal(t, "REVOKE DELETE ON orders FROM app", PermissionStatement(revoke, "orders", "app"))
+}
@@ -0,0 +1,178 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ifNotExistsRe matches an IF NOT EXISTS guard in the header of a CREATE statement
+	ifNotExistsRe = regexp.MustCompile(`(?is)^\s*CREATE\s[^(;]*?\bIF\s+NOT\s+EXISTS\b`)
+	// dropRe matches a DROP statement for a single object
+	dropRe = regexp.MustCompile(`(?is)^[\s/]*DROP\s+(TABLE|VIEW|MATERIALIZED\s+VIEW|INDEX|SEQUENCE|FUNCTION|PROCEDURE|PROC|TRIGGER|TYPE)\s+(IF\s+EXISTS\s+)?([^\s(;,]+)(?:\s*\([^()]*\))?(?:\s+ON\s+([^\s;,]+))?(?:\s+(?:CASCADE(?:\s+CONSTRAINTS)?|RESTRICT|PURGE))*\s*;?\s*$`)
+	// spacesRe matches runs of whitespace
+	spacesRe = regexp.MustCompile(`\s+`)
+)
+
+// HasIfNotExists reports whether the header of a CREATE statement contains an IF NOT EXISTS guard
+func HasIfNotExists(statement string) bool {
+	return ifNotExistsRe.MatchString(statement)
+}
+
+// ParseDrop recognizes a DROP statement and returns the dropped object.
+// The second return value is false when the statement is not a supported DROP statement.
+func ParseDrop(statement string) (*Drop, bool) {
+	match := dropRe.FindStringSubmatch(statement)
+	if match == nil {
+		return nil, false
+	}
+
+	drop := &Drop{
+		Type:     strings.ToUpper(spacesRe.ReplaceAllString(match[1], " ")),
+		IfExists: match[2] != "",
+		Table:    trimIdentifier(match[4]),
+	}
+	if drop.Type == "PROC" {
+		drop.Type = "PROCEDURE"
+	}
+
+	// Parse schema if exists
+	if parts := strings.Split(match[3], "."); len(parts) > 1 {
+		drop.Schema = trimIdentifier(parts[0])
+		drop.Name = trimIdentifier(parts[1])
+	} else {
+		drop.Name = trimIdentifier(match[3])
+	}
+
+	return drop, true
+}
+
+// ParseDrops returns the DROP statements found in a normalized SQL dump
+// whose statements are terminated by semicolons
+func ParseDrops(content string) []Drop {
+	var drops []Drop
+	for _, statement := range SplitStatements(content, "") {
+		if drop, ok := ParseDrop(statement.Text); ok {
+			drops = append(drops, *drop)
+		}
+	}
+	return drops
+}
+
+// IfNotExists returns the IF NOT EXISTS clause to emit for an object, followed by a
+// space, or an empty string when the object has no guard or guards are not preserved
+func (o GenerateOptions) IfNotExists(guarded bool) string {
+	if o.PreserveGuards && guarded {
+		return "IF NOT EXISTS "
+	}
+	return ""
+}
+
+// IfExists returns the IF EXISTS clause to emit for a drop, followed by a space,
+// or an empty string when the drop has no guard or guards are not preserved
+func (o GenerateOptions) IfExists(guarded bool) string {
+	if o.PreserveGuards && guarded {
+		return "IF EXISTS "
+	}
+	return ""
+}
+
+// Warnf reports a generation warning to OnWarning, if set
+func (o GenerateOptions) Warnf(format string, args ...interface{}) {
+	if o.OnWarning != nil {
+		o.OnWarning(fmt.Sprintf(format, args...))
+	}
+}
+
+// MapColumn reports the data type a column was generated with to OnColumn, if set
+func (o GenerateOptions) MapColumn(table string, column Column, targetType string) {
+	if o.OnColumn != nil {
+		o.OnColumn(table, column, targetType)
+	}
+}
+
+// WarnInherits reports the INHERITS and PARTITION BY clauses of a table as dropped,
+// for the dialects without PostgreSQL table inheritance. The columns of the parent
+// tables are not copied.
+func (o GenerateOptions) WarnInherits(table Table) {
+	if len(table.Inherits) > 0 {
+		o.Warnf("table %s inherits from %s, inheritance is not supported and was dropped", table.Name, strings.Join(table.Inherits, ", "))
+	}
+	if table.PartitionBy != "" {
+		o.Warnf("table %s is partitioned by %s, the partitioning was dropped", table.Name, table.PartitionBy)
+	}
+}
+
+// WithoutPartitions returns the tables that are not a PostgreSQL partition, reporting
+// the partitions as skipped, for the dialects without declarative partitioning. The
+// rows of a partition belong to its parent table.
+func (o GenerateOptions) WithoutPartitions(tables []Table) []Table {
+	var result []Table
+	for _, table := range tables {
+		if table.PartitionOf != "" {
+			o.Warnf("table %s is a partition of %s and was skipped", table.Name, table.PartitionOf)
+			continue
+		}
+		result = append(result, table)
+	}
+	return result
+}
+
+// ObjectKinds is a set of kinds of schema objects besides tables, see WarnSkipped
+type ObjectKinds int
+
+const (
+	ViewObjects ObjectKinds = 1 << iota
+	FunctionObjects
+	ProcedureObjects
+	TriggerObjects
+	SequenceObjects
+	TypeObjects
+	ExtensionObjects
+)
+
+// WarnSkipped reports every view, function, procedure, trigger, sequence, type and
+// extension of the schema whose kind is not among those the generator writes as
+// skipped, so that no object disappears from a conversion without a warning
+func (o GenerateOptions) WarnSkipped(schema *Schema, written ObjectKinds) {
+	skip := func(kind ObjectKinds, noun, name string) {
+		if written&kind == 0 {
+			o.Warnf("%s %s was skipped, %ss are not generated for this dialect", noun, name, noun)
+		}
+	}
+
+	for _, view := range schema.Views {
+		skip(ViewObjects, "view", view.Name)
+	}
+	for _, function := range schema.Functions {
+		if function.IsProc {
+			skip(ProcedureObjects, "procedure", function.Name)
+		} else {
+			skip(FunctionObjects, "function", function.Name)
+		}
+	}
+	for _, procedure := range schema.Procedures {
+		skip(ProcedureObjects, "procedure", procedure.Name)
+	}
+	for _, trigger := range schema.Triggers {
+		skip(TriggerObjects, "trigger", trigger.Name)
+	}
+	for _, sequence := range schema.Sequences {
+		skip(SequenceObjects, "sequence", sequence.Name)
+	}
+	for _, typ := range schema.Types {
+		skip(TypeObjects, "type", typ.Name)
+	}
+	for _, extension := range schema.Extensions {
+		skip(ExtensionObjects, "extension", extension.Name)
+	}
+}
+
+// QualifiedName returns the schema-qualified name of the dropped object
+func (d Drop) QualifiedName() string {
+	if d.Schema != "" {
+		return d.Schema + "." + d.Name
+	}
+	return d.Name
+}
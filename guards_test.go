@@ -0,0 +1,100 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDrop(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		wantOK    bool
+		want      Drop
+	}{
+		{
+			name:      "Guarded table",
+			statement: "DROP TABLE IF EXISTS users;",
+			wantOK:    true,
+			want:      Drop{Type: "TABLE", Name: "users", IfExists: true},
+		},
+		{
+			name:      "Qualified view with cascade",
+			statement: "DROP VIEW IF EXISTS reports.active_users CASCADE",
+			wantOK:    true,
+			want:      Drop{Type: "VIEW", Name: "active_users", Schema: "reports", IfExists: true},
+		},
+		{
+			name:      "Materialized view",
+			statement: "drop materialized view user_stats",
+			wantOK:    true,
+			want:      Drop{Type: "MATERIALIZED VIEW", Name: "user_stats"},
+		},
+		{
+			name:      "Index on table",
+			statement: "DROP INDEX idx_users_email ON users",
+			wantOK:    true,
+			want:      Drop{Type: "INDEX", Name: "idx_users_email", Table: "users"},
+		},
+		{
+			name:      "Function with signature",
+			statement: "DROP FUNCTION IF EXISTS add_one(integer);",
+			wantOK:    true,
+			want:      Drop{Type: "FUNCTION", Name: "add_one", IfExists: true},
+		},
+		{
+			name:      "Column drop is not an object drop",
+			statement: "ALTER TABLE users DROP COLUMN email",
+		},
+		{
+			name:      "Multiple objects",
+			statement: "DROP TABLE users, orders",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drop, ok := ParseDrop(tt.statement)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, *drop)
+			}
+		})
+	}
+}
+
+func TestHasIfNotExists(t *testing.T) {
+	assert.True(t, HasIfNotExists("CREATE TABLE IF NOT EXISTS users (id INT)"))
+	assert.True(t, HasIfNotExists("create unique index if not exists idx ON users (id)"))
+	assert.False(t, HasIfNotExists("CREATE TABLE users (id INT)"))
+	assert.False(t, HasIfNotExists("CREATE TABLE users (note VARCHAR(50) DEFAULT 'IF NOT EXISTS')"))
+}
+
+func TestGenerateOptions_Guards(t *testing.T) {
+	assert.Equal(t, "", GenerateOptions{}.IfNotExists(true))
+	assert.Equal(t, "", GenerateOptions{PreserveGuards: true}.IfNotExists(false))
+	assert.Equal(t, "IF NOT EXISTS ", GenerateOptions{PreserveGuards: true}.IfNotExists(true))
+	assert.Equal(t, "IF EXISTS ", GenerateOptions{PreserveGuards: true}.IfExists(true))
+}
+
+func TestGenerateOptions_WarnSkipped(t *testing.T) {
+	schema := &Schema{
+		Views:     []View{{Name: "active_users"}},
+		Functions: []Function{{Name: "add_one"}, {Name: "cleanup", IsProc: true}},
+		Triggers:  []Trigger{{Name: "audit_users"}},
+		Sequences: []Sequence{{Name: "users_seq"}},
+	}
+
+	var warnings []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}}
+	options.WarnSkipped(schema, ViewObjects|TriggerObjects)
+
+	assert.Equal(t, []string{
+		"function add_one was skipped, functions are not generated for this dialect",
+		"procedure cleanup was skipped, procedures are not generated for this dialect",
+		"sequence users_seq was skipped, sequences are not generated for this dialect",
+	}, warnings)
+}
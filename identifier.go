@@ -0,0 +1,79 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// plainIdentifierRe matches an identifier that needs no quotes unless it is reserved
+var plainIdentifierRe = regexp.MustCompile(`^\w+$`)
+
+// reservedWords are the keywords reserved by the dialects that are also common
+// column names, which are only identifiers when quoted
+var reservedWords = map[string]bool{
+	"ALL": true, "ALTER": true, "AND": true, "AS": true, "ASC": true, "BETWEEN": true,
+	"BY": true, "CASE": true, "CHECK": true, "COLUMN": true, "CONSTRAINT": true,
+	"CREATE": true, "CROSS": true, "DEFAULT": true, "DELETE": true, "DESC": true,
+	"DISTINCT": true, "DROP": true, "ELSE": true, "END": true, "FOR": true,
+	"FOREIGN": true, "FROM": true, "GRANT": true, "GROUP": true, "HAVING": true,
+	"IN": true, "INDEX": true, "INSERT": true, "INTO": true, "IS": true, "JOIN": true,
+	"KEY": true, "LEFT": true, "LEVEL": true, "LIKE": true, "LIMIT": true, "NOT": true,
+	"NULL": true, "OF": true, "ON": true, "OR": true, "ORDER": true, "PRIMARY": true,
+	"RANGE": true, "REFERENCES": true, "RIGHT": true, "ROW": true, "ROWS": true,
+	"SELECT": true, "SET": true, "SIZE": true, "TABLE": true, "THEN": true, "TO": true,
+	"UNION": true, "UNIQUE": true, "UPDATE": true, "USER": true, "VALUES": true,
+	"WHEN": true, "WHERE": true, "WITH": true,
+}
+
+// IsReservedWord reports whether a name must be quoted to be used as an identifier
+func IsReservedWord(name string) bool {
+	return reservedWords[strings.ToUpper(name)]
+}
+
+// UnquoteIdentifier removes the "", “ or [] quotes around an identifier that needs
+// none but for being a reserved word, such as `order`. Other quoted names, such as
+// [order date], keep their quotes.
+func UnquoteIdentifier(name string) string {
+	if len(name) < 2 {
+		return name
+	}
+	switch name[0] {
+	case '"', '`':
+		if name[len(name)-1] != name[0] {
+			return name
+		}
+	case '[':
+		if name[len(name)-1] != ']' {
+			return name
+		}
+	default:
+		return name
+	}
+	if inner := name[1 : len(name)-1]; plainIdentifierRe.MatchString(inner) {
+		return inner
+	}
+	return name
+}
+
+// QuoteIdentifier quotes a reserved word used as an identifier with the quote of the
+// dialect, ` for MySQL, [ for SQL Server and " for the others. Other names are
+// returned unchanged.
+func QuoteIdentifier(name, quote string) string {
+	if !IsReservedWord(name) {
+		return name
+	}
+	if quote == "[" {
+		return "[" + name + "]"
+	}
+	return quote + name + quote
+}
+
+// QuoteIdentifiers quotes each name with QuoteIdentifier and joins them with commas,
+// as in the column list of a key
+func QuoteIdentifiers(names []string, quote string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = QuoteIdentifier(name, quote)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,51 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnquoteIdentifier(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "order", want: "order"},
+		{name: `"order"`, want: "order"},
+		{name: "`order`", want: "order"},
+		{name: "[order]", want: "order"},
+		{name: "[order date]", want: "[order date]"},
+		{name: `"order`, want: `"order`},
+		{name: `"`, want: `"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, UnquoteIdentifier(tt.name))
+		})
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "`order`", QuoteIdentifier("order", "`"))
+	assert.Equal(t, `"Key"`, QuoteIdentifier("Key", `"`))
+	assert.Equal(t, "[user]", QuoteIdentifier("user", "["))
+	assert.Equal(t, "email", QuoteIdentifier("email", "`"))
+	assert.Equal(t, "lower(email)", QuoteIdentifier("lower(email)", `"`))
+}
+
+func TestQuoteIdentifiers(t *testing.T) {
+	assert.Equal(t, "id, `order`", QuoteIdentifiers([]string{"id", "order"}, "`"))
+	assert.Equal(t, "[key]", QuoteIdentifiers([]string{"key"}, "["))
+	assert.Equal(t, "", QuoteIdentifiers(nil, `"`))
+}
+
+func TestIndex_ColumnListQuotesReservedWords(t *testing.T) {
+	index := Index{Columns: SplitIndexColumns("`order` DESC, t.\"key\", [name]")}
+
+	assert.Equal(t, []string{"order", "key", "name"}, index.ColumnNames())
+	assert.Equal(t, "`order` DESC, `key`, name", index.ColumnList("`", false))
+	assert.Equal(t, `"order" DESC, "key", name`, index.ColumnList(`"`, true))
+	assert.Equal(t, "[order] DESC, [key], name", index.ColumnList("[", false))
+}
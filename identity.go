@@ -0,0 +1,79 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// identityRe matches the GENERATED ... AS IDENTITY clause of a column definition and
+// its sequence options
+var identityRe = regexp.MustCompile(`(?is)\s*\bGENERATED\s+(ALWAYS|BY\s+DEFAULT)\s+AS\s+IDENTITY\b(?:\s*\(([^)]*)\))?`)
+
+// identityOptionRe matches the START WITH and INCREMENT BY options of an identity column
+var identityOptionRe = regexp.MustCompile(`(?i)\b(START|INCREMENT)\s+(?:WITH\s+|BY\s+)?([-+]?\d+)`)
+
+// ParseIdentity recognizes the GENERATED ALWAYS AS IDENTITY or GENERATED BY DEFAULT
+// AS IDENTITY clause of a column definition, setting Identity, IdentityStart,
+// IdentityIncrement and AutoIncrement. It returns the definition without the clause,
+// so that its DEFAULT keyword is not read as a default value, and whether the clause
+// was found. Sequence options other than START WITH and INCREMENT BY are ignored.
+func (c *Column) ParseIdentity(definition string) (string, bool) {
+	match := identityRe.FindStringSubmatchIndex(definition)
+	if match == nil {
+		return definition, false
+	}
+
+	c.Identity = strings.ToUpper(spacesRe.ReplaceAllString(definition[match[2]:match[3]], " "))
+	c.AutoIncrement = true
+	if match[4] >= 0 {
+		for _, option := range identityOptionRe.FindAllStringSubmatch(definition[match[4]:match[5]], -1) {
+			value, err := strconv.ParseInt(option[2], 10, 64)
+			if err != nil {
+				continue
+			}
+			if strings.EqualFold(option[1], "START") {
+				c.IdentityStart = value
+			} else {
+				c.IdentityIncrement = value
+			}
+		}
+	}
+	return definition[:match[0]] + definition[match[1]:], true
+}
+
+// IdentityClause returns the GENERATED ... AS IDENTITY clause of an identity column,
+// with its START WITH and INCREMENT BY options when given, or an empty string for
+// other columns
+func IdentityClause(column Column) string {
+	if column.Identity == "" {
+		return ""
+	}
+
+	clause := "GENERATED " + column.Identity + " AS IDENTITY"
+	var options []string
+	if column.IdentityStart != 0 {
+		options = append(options, fmt.Sprintf("START WITH %d", column.IdentityStart))
+	}
+	if column.IdentityIncrement != 0 {
+		options = append(options, fmt.Sprintf("INCREMENT BY %d", column.IdentityIncrement))
+	}
+	if len(options) > 0 {
+		clause += " (" + strings.Join(options, " ") + ")"
+	}
+	return clause
+}
+
+// IdentitySeed returns the first value and the increment of an auto-increment column,
+// both 1 unless given by an identity clause
+func IdentitySeed(column Column) (int64, int64) {
+	start, increment := column.IdentityStart, column.IdentityIncrement
+	if start == 0 {
+		start = 1
+	}
+	if increment == 0 {
+		increment = 1
+	}
+	return start, increment
+}
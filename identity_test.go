@@ -0,0 +1,68 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumn_ParseIdentity(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       Column
+		rest       string
+		ok         bool
+	}{
+		{
+			name:       "Always",
+			definition: "id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY",
+			want:       Column{Identity: "ALWAYS", AutoIncrement: true},
+			rest:       "id BIGINT PRIMARY KEY",
+			ok:         true,
+		},
+		{
+			name:       "By default with options",
+			definition: "id integer generated by  default as identity (start with 100 increment by 5 cache 10) NOT NULL",
+			want:       Column{Identity: "BY DEFAULT", IdentityStart: 100, IdentityIncrement: 5, AutoIncrement: true},
+			rest:       "id integer NOT NULL",
+			ok:         true,
+		},
+		{
+			name:       "Options without keywords",
+			definition: "id INT GENERATED BY DEFAULT AS IDENTITY (INCREMENT -1 START 0)",
+			want:       Column{Identity: "BY DEFAULT", IdentityIncrement: -1, AutoIncrement: true},
+			rest:       "id INT",
+			ok:         true,
+		},
+		{
+			name:       "Generated column",
+			definition: "total NUMERIC GENERATED ALWAYS AS (price * qty) STORED",
+			rest:       "total NUMERIC GENERATED ALWAYS AS (price * qty) STORED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var column Column
+			rest, ok := column.ParseIdentity(tt.definition)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.rest, rest)
+			assert.Equal(t, tt.want, column)
+		})
+	}
+}
+
+func TestIdentityClause(t *testing.T) {
+	assert.Equal(t, "", IdentityClause(Column{AutoIncrement: true}))
+	assert.Equal(t, "GENERATED ALWAYS AS IDENTITY", IdentityClause(Column{Identity: "ALWAYS"}))
+	assert.Equal(t, "GENERATED BY DEFAULT AS IDENTITY (START WITH 100 INCREMENT BY 5)",
+		IdentityClause(Column{Identity: "BY DEFAULT", IdentityStart: 100, IdentityIncrement: 5}))
+
+	start, increment := IdentitySeed(Column{AutoIncrement: true})
+	assert.Equal(t, int64(1), start)
+	assert.Equal(t, int64(1), increment)
+	start, increment = IdentitySeed(Column{Identity: "ALWAYS", IdentityStart: 100, IdentityIncrement: 5})
+	assert.Equal(t, int64(100), start)
+	assert.Equal(t, int64(5), increment)
+}
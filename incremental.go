@@ -0,0 +1,82 @@
+package sqlmapper
+
+import "reflect"
+
+// NewObjects returns a schema holding only the objects of s absent from the
+// baseline, such as a dump that was already generated, so that they can be appended
+// to it. Objects are matched by their schema-qualified name, case-insensitively,
+// and permissions by their definition. Objects present in the baseline are left out
+// even if their definition changed, as are drops and session settings.
+func (s *Schema) NewObjects(baseline *Schema) *Schema {
+	if baseline == nil {
+		baseline = &Schema{}
+	}
+
+	objects := &Schema{
+		Name: s.Name,
+		Tables: missingObjects(s.Tables, baseline.Tables, func(t Table) string {
+			return mergeKey(t.Schema, t.Name)
+		}),
+		Procedures: missingObjects(s.Procedures, baseline.Procedures, func(p Procedure) string {
+			return mergeKey(p.Schema, p.Name)
+		}),
+		Functions: missingObjects(s.Functions, baseline.Functions, func(f Function) string {
+			return mergeKey(f.Schema, f.Name)
+		}),
+		Triggers: missingObjects(s.Triggers, baseline.Triggers, func(t Trigger) string {
+			return mergeKey(t.Schema, t.Name)
+		}),
+		Views: missingObjects(s.Views, baseline.Views, func(v View) string {
+			return mergeKey(v.Schema, v.Name)
+		}),
+		Sequences: missingObjects(s.Sequences, baseline.Sequences, func(q Sequence) string {
+			return mergeKey(q.Schema, q.Name)
+		}),
+		Extensions: missingObjects(s.Extensions, baseline.Extensions, func(e Extension) string {
+			return mergeKey("", e.Name)
+		}),
+		UserDefinedTypes: missingObjects(s.UserDefinedTypes, baseline.UserDefinedTypes, func(t UserDefinedType) string {
+			return mergeKey(t.Schema, t.Name)
+		}),
+		Types: missingObjects(s.Types, baseline.Types, func(t Type) string {
+			return mergeKey(t.Schema, t.Name)
+		}),
+	}
+
+permissions:
+	for _, permission := range s.Permissions {
+		for _, existing := range baseline.Permissions {
+			if reflect.DeepEqual(permission, existing) {
+				continue permissions
+			}
+		}
+		objects.Permissions = append(objects.Permissions, permission)
+	}
+
+	return objects
+}
+
+// missingObjects returns the objects whose key is not the key of a baseline object
+func missingObjects[T any](objects, baseline []T, key func(T) string) []T {
+	existing := make(map[string]bool, len(baseline))
+	for _, object := range baseline {
+		existing[key(object)] = true
+	}
+
+	var missing []T
+	for _, object := range objects {
+		if !existing[key(object)] {
+			missing = append(missing, object)
+		}
+	}
+	return missing
+}
+
+// Incremental returns the part of the schema a generator should write: the objects
+// absent from the Baseline, if one is set, or the whole schema otherwise
+func (o GenerateOptions) Incremental(schema *Schema) *Schema {
+	if o.Baseline == nil || schema == nil {
+		return schema
+	}
+	return schema.NewObjects(o.Baseline)
+}
@@ -0,0 +1,34 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_NewObjects(t *testing.T) {
+	baseline := &Schema{
+		Tables:      []Table{{Name: "users"}, {Name: "logs", Schema: "audit"}},
+		Views:       []View{{Name: "active_users"}},
+		Permissions: []Permission{{Type: "GRANT", Privileges: []string{"SELECT"}, Object: "users", Grantee: "app"}},
+	}
+	schema := &Schema{
+		Name:   "shop",
+		Tables: []Table{{Name: "USERS", Comment: "changed"}, {Name: "logs"}, {Name: "orders"}},
+		Views:  []View{{Name: "active_users"}, {Name: "recent_orders"}},
+		Permissions: []Permission{
+			{Type: "GRANT", Privileges: []string{"SELECT"}, Object: "users", Grantee: "app"},
+			{Type: "GRANT", Privileges: []string{"SELECT"}, Object: "orders", Grantee: "app"},
+		},
+		Drops: []Drop{{Type: "TABLE", Name: "carts"}},
+	}
+
+	assert.Equal(t, &Schema{
+		Name:        "shop",
+		Tables:      []Table{{Name: "logs"}, {Name: "orders"}},
+		Views:       []View{{Name: "recent_orders"}},
+		Permissions: []Permission{{Type: "GRANT", Privileges: []string{"SELECT"}, Object: "orders", Grantee: "app"}},
+	}, schema.NewObjects(baseline))
+
+	assert.Equal(t, schema, GenerateOptions{}.Incremental(schema))
+}
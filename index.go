@@ -0,0 +1,225 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// prefixLengthRe matches a column followed by the length of its indexed prefix, such
+// as name(10)
+var prefixLengthRe = regexp.MustCompile(`^(.+?)\s*\(\s*(\d+)\s*\)$`)
+
+// IndexColumn is a key column of an index with its sort order and the length of its
+// indexed prefix
+type IndexColumn struct {
+	Name       string
+	Descending bool
+	Nulls      string // FIRST or LAST, empty for the default ordering
+	Length     int    // Length of the indexed prefix (MySQL), 0 for the whole column
+}
+
+// ParseIndexColumn parses a single column of an index key such as
+// "created_at DESC NULLS LAST" or MySQL's "name(10)" into the column, its sort order
+// and the length of its indexed prefix. A column qualified by its table, such as
+// orders."order", is named without the table and unquoted by UnquoteIdentifier.
+func ParseIndexColumn(definition string) IndexColumn {
+	fields := strings.Fields(definition)
+	column := IndexColumn{}
+
+	// Options are read from the end so that expressions keep their own spaces
+	for len(fields) > 1 {
+		last := strings.ToUpper(fields[len(fields)-1])
+		switch {
+		case (last == "FIRST" || last == "LAST") && len(fields) > 2 && strings.EqualFold(fields[len(fields)-2], "NULLS"):
+			column.Nulls = last
+			fields = fields[:len(fields)-2]
+			continue
+		case last == "DESC":
+			column.Descending = true
+		case last != "ASC":
+			column.Name, column.Length = prefixColumn(strings.Join(fields, " "))
+			return column
+		}
+		fields = fields[:len(fields)-1]
+	}
+
+	column.Name, column.Length = prefixColumn(strings.Join(fields, " "))
+	return column
+}
+
+// prefixColumn splits a column reference followed by a prefix length, such as
+// name(10), into the unqualified column and the length. Expressions, such as
+// lower(email), are returned unchanged with a length of 0.
+func prefixColumn(reference string) (string, int) {
+	if match := prefixLengthRe.FindStringSubmatch(reference); match != nil && isColumnReference(Tokenize(match[1])) {
+		length, _ := strconv.Atoi(match[2])
+		return unqualifiedColumn(match[1]), length
+	}
+	return unqualifiedColumn(reference), 0
+}
+
+// unqualifiedColumn returns the column of a reference qualified by its table or
+// schema, such as t.col or `orders`.`key`, unquoted by UnquoteIdentifier.
+// Expressions, such as lower(email), are returned unchanged.
+func unqualifiedColumn(reference string) string {
+	tokens := Tokenize(reference)
+	if !isColumnReference(tokens) {
+		return reference
+	}
+	return UnquoteIdentifier(tokens[len(tokens)-1].Text)
+}
+
+// isColumnReference reports whether the tokens are names separated by dots
+func isColumnReference(tokens []Token) bool {
+	if len(tokens)%2 == 0 {
+		return false
+	}
+	for i, token := range tokens {
+		if i%2 == 1 {
+			if token.Text != "." {
+				return false
+			}
+		} else if token.Type != WordToken && token.Type != QuotedIdentifierToken {
+			return false
+		}
+	}
+	return true
+}
+
+// SplitIndexColumns splits the column list of an index into its key columns
+func SplitIndexColumns(list string) []IndexColumn {
+	var columns []IndexColumn
+	for _, definition := range splitTopLevel(list) {
+		columns = append(columns, ParseIndexColumn(definition))
+	}
+	return columns
+}
+
+// IndexColumns returns the key columns of an index on the given columns in the
+// default ascending order
+func IndexColumns(names ...string) []IndexColumn {
+	if names == nil {
+		return nil
+	}
+	columns := make([]IndexColumn, len(names))
+	for i, name := range names {
+		columns[i] = IndexColumn{Name: name}
+	}
+	return columns
+}
+
+// IndexColumnNames returns the names of the key columns in order
+func IndexColumnNames(columns []IndexColumn) []string {
+	if columns == nil {
+		return nil
+	}
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name
+	}
+	return names
+}
+
+// ColumnNames returns the names of the key columns of the index in order
+func (i Index) ColumnNames() []string {
+	return IndexColumnNames(i.Columns)
+}
+
+// ColumnList joins the columns of the index with their prefix lengths and sort order
+// for a CREATE INDEX statement, quoting reserved words with the quote of the dialect
+// as QuoteIdentifier does. NULLS FIRST/LAST is only written for dialects supporting
+// it, while prefix lengths are removed by WholeColumnIndex beforehand.
+func (i Index) ColumnList(quote string, nullsOrdering bool) string {
+	columns := make([]string, len(i.Columns))
+	for j, column := range i.Columns {
+		columns[j] = QuoteIdentifier(column.Name, quote)
+		if column.Length > 0 {
+			columns[j] += "(" + strconv.Itoa(column.Length) + ")"
+		}
+		if column.Descending {
+			columns[j] += " DESC"
+		}
+		if column.Nulls != "" && nullsOrdering {
+			columns[j] += " NULLS " + column.Nulls
+		}
+	}
+	return strings.Join(columns, ", ")
+}
+
+// IndexKind is the kind of an index beyond its key columns
+type IndexKind int
+
+const (
+	// NormalIndex is a regular B-tree or dialect default index
+	NormalIndex IndexKind = iota
+	// UniqueIndex is a unique index, which also has IsUnique set
+	UniqueIndex
+	// FulltextIndex is a MySQL FULLTEXT index for text search
+	FulltextIndex
+	// SpatialIndex is a MySQL SPATIAL index on geometry columns
+	SpatialIndex
+)
+
+// String returns the keyword of the index kind, empty for a normal index
+func (k IndexKind) String() string {
+	switch k {
+	case UniqueIndex:
+		return "UNIQUE"
+	case FulltextIndex:
+		return "FULLTEXT"
+	case SpatialIndex:
+		return "SPATIAL"
+	}
+	return ""
+}
+
+// ParseIndexKind returns the index kind of a keyword such as FULLTEXT, or NormalIndex
+func ParseIndexKind(keyword string) IndexKind {
+	switch strings.ToUpper(strings.TrimSpace(keyword)) {
+	case "UNIQUE":
+		return UniqueIndex
+	case "FULLTEXT":
+		return FulltextIndex
+	case "SPATIAL":
+		return SpatialIndex
+	}
+	return NormalIndex
+}
+
+// PlainIndex returns a fulltext or spatial index as a regular index, reporting the
+// conversion, for the dialects without an equivalent index. Other indexes are
+// returned unchanged.
+func (o GenerateOptions) PlainIndex(tableName string, index Index) Index {
+	if index.Kind != FulltextIndex && index.Kind != SpatialIndex {
+		return index
+	}
+	o.Warnf("%s index %s on %s is not supported and was generated as a regular index", index.Kind, index.Name, tableName)
+	index.Kind = NormalIndex
+	return index
+}
+
+// WholeColumnIndex returns an index on prefixes of its columns as an index on the
+// whole columns, reporting the conversion, for the dialects without prefix indexes.
+// Other indexes are returned unchanged.
+func (o GenerateOptions) WholeColumnIndex(tableName string, index Index) Index {
+	var columns []IndexColumn
+	for i, column := range index.Columns {
+		if column.Length == 0 {
+			continue
+		}
+		if index.IsUnique {
+			o.Warnf("prefix length %d of column %s in unique index %s on %s is not supported, the whole column was indexed, which only rejects duplicates of whole values", column.Length, column.Name, index.Name, tableName)
+		} else {
+			o.Warnf("prefix length %d of column %s in index %s on %s is not supported, the whole column was indexed", column.Length, column.Name, index.Name, tableName)
+		}
+		if columns == nil {
+			columns = append([]IndexColumn(nil), index.Columns...)
+		}
+		columns[i].Length = 0
+	}
+	if columns != nil {
+		index.Columns = columns
+	}
+	return index
+}
@@ -0,0 +1,98 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIndexColumn(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       IndexColumn
+	}{
+		{name: "Bare column", definition: " email ", want: IndexColumn{Name: "email"}},
+		{name: "Ascending", definition: "email ASC", want: IndexColumn{Name: "email"}},
+		{name: "Descending", definition: "created_at desc", want: IndexColumn{Name: "created_at", Descending: true}},
+		{name: "Nulls last", definition: "score DESC NULLS LAST", want: IndexColumn{Name: "score", Descending: true, Nulls: "LAST"}},
+		{name: "Nulls first", definition: "score nulls first", want: IndexColumn{Name: "score", Nulls: "FIRST"}},
+		{name: "Operator class", definition: "document jsonb_path_ops", want: IndexColumn{Name: "document jsonb_path_ops"}},
+		{name: "Quoted reserved word", definition: `"order" DESC`, want: IndexColumn{Name: "order", Descending: true}},
+		{name: "Qualified", definition: "t.col", want: IndexColumn{Name: "col"}},
+		{name: "Qualified and quoted", definition: "`shop`.`orders`.`key` desc", want: IndexColumn{Name: "key", Descending: true}},
+		{name: "Bracketed with spaces", definition: "[dbo].[order date]", want: IndexColumn{Name: "[order date]"}},
+		{name: "Function of a qualified column", definition: "lower(t.email)", want: IndexColumn{Name: "lower(t.email)"}},
+		{name: "Prefix length", definition: "name(10)", want: IndexColumn{Name: "name", Length: 10}},
+		{name: "Quoted prefix descending", definition: "`users`.`bio` ( 255 ) DESC", want: IndexColumn{Name: "bio", Descending: true, Length: 255}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseIndexColumn(tt.definition))
+		})
+	}
+}
+
+func TestSplitIndexColumns(t *testing.T) {
+	columns := SplitIndexColumns("tenant_id, lower(email), created_at DESC NULLS LAST")
+
+	assert.Equal(t, []IndexColumn{{Name: "tenant_id"}, {Name: "lower(email)"}, {Name: "created_at", Descending: true, Nulls: "LAST"}}, columns)
+
+	index := Index{Columns: columns}
+	assert.Equal(t, []string{"tenant_id", "lower(email)", "created_at"}, index.ColumnNames())
+	assert.Equal(t, "tenant_id, lower(email), created_at DESC NULLS LAST", index.ColumnList("`", true))
+	assert.Equal(t, "tenant_id, lower(email), created_at DESC", index.ColumnList("`", false))
+}
+
+func TestIndex_PrefixLengths(t *testing.T) {
+	columns := SplitIndexColumns("name(10), bio(255) DESC, id")
+
+	assert.Equal(t, []IndexColumn{{Name: "name", Length: 10}, {Name: "bio", Descending: true, Length: 255}, {Name: "id"}}, columns)
+
+	index := Index{Name: "idx_profile", Columns: columns}
+	assert.Equal(t, "name(10), bio(255) DESC, id", index.ColumnList("`", false))
+
+	var warnings []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}}
+
+	whole := options.WholeColumnIndex("users", index)
+	assert.Equal(t, "name, bio DESC, id", whole.ColumnList("`", false))
+	assert.Equal(t, []string{
+		"prefix length 10 of column name in index idx_profile on users is not supported, the whole column was indexed",
+		"prefix length 255 of column bio in index idx_profile on users is not supported, the whole column was indexed",
+	}, warnings)
+	assert.Equal(t, "name(10), bio(255) DESC, id", index.ColumnList("`", false))
+
+	plain := Index{Name: "idx_id", Columns: []IndexColumn{{Name: "id", Descending: true}}}
+	assert.Equal(t, plain, options.WholeColumnIndex("users", plain))
+	assert.Len(t, warnings, 2)
+
+	unique := Index{Name: "uq_email", Columns: []IndexColumn{{Name: "email", Length: 20}}, IsUnique: true}
+	assert.Equal(t, "email", options.WholeColumnIndex("users", unique).ColumnList("`", false))
+	assert.Equal(t, "prefix length 20 of column email in unique index uq_email on users is not supported, the whole column was indexed, which only rejects duplicates of whole values", warnings[2])
+}
+
+func TestParseIndexKind(t *testing.T) {
+	for _, kind := range []IndexKind{NormalIndex, UniqueIndex, FulltextIndex, SpatialIndex} {
+		assert.Equal(t, kind, ParseIndexKind(kind.String()))
+	}
+	assert.Equal(t, FulltextIndex, ParseIndexKind("fulltext"))
+	assert.Equal(t, NormalIndex, ParseIndexKind("BITMAP"))
+}
+
+func TestGenerateOptions_PlainIndex(t *testing.T) {
+	var warnings []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}}
+
+	index := Index{Name: "idx_email", Columns: IndexColumns("email"), IsUnique: true, Kind: UniqueIndex}
+	assert.Equal(t, index, options.PlainIndex("users", index))
+
+	index = Index{Name: "ft_bio", Columns: IndexColumns("bio"), Kind: FulltextIndex}
+	assert.Equal(t, Index{Name: "ft_bio", Columns: IndexColumns("bio")}, options.PlainIndex("users", index))
+	assert.Equal(t, []string{"FULLTEXT index ft_bio on users is not supported and was generated as a regular index"}, warnings)
+}
@@ -0,0 +1,322 @@
+package sqlmapper
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// introspectionQueries read the catalog of a dialect. Every query returns its rows
+// ordered, with the columns listed on its field.
+type introspectionQueries struct {
+	// schema, table
+	tables string
+	// schema, table, column, type, length, precision, scale, is_nullable (YES/NO),
+	// default, auto_increment (1/0)
+	columns string
+	// schema, table, column, in key order
+	primaryKeys string
+	// schema, table, index, unique (1/0), kind (FULLTEXT, SPATIAL or empty), column,
+	// in key order
+	indexes string
+	// schema, table, constraint key, constraint name, column, referenced schema,
+	// referenced table, referenced column, delete rule, update rule, in key order.
+	// The referenced schema is empty for the database the tables are read from.
+	foreignKeys string
+}
+
+// introspection holds the catalog queries of the supported dialects
+var introspection = map[DatabaseType]introspectionQueries{
+	SQLite: {
+		tables: `SELECT '', name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`,
+		columns: `SELECT '', m.name, p.name, p.type, NULL, NULL, NULL,
+			CASE WHEN p."notnull" = 1 OR p.pk > 0 THEN 'NO' ELSE 'YES' END, p.dflt_value,
+			CASE WHEN p.pk = 1 AND m.sql LIKE '%AUTOINCREMENT%' THEN 1 ELSE 0 END
+			FROM sqlite_master m JOIN pragma_table_info(m.name) p
+			WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%' ORDER BY m.name, p.cid`,
+		primaryKeys: `SELECT '', m.name, p.name FROM sqlite_master m JOIN pragma_table_info(m.name) p
+			WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%' AND p.pk > 0 ORDER BY m.name, p.pk`,
+		indexes: `SELECT '', m.name, il.name, il."unique", '', ii.name
+			FROM sqlite_master m JOIN pragma_index_list(m.name) il JOIN pragma_index_info(il.name) ii
+			WHERE m.type = 'table' AND il.origin = 'c' ORDER BY m.name, il.name, ii.seqno`,
+		foreignKeys: `SELECT '', m.name, fk.id, '', fk."from", '', fk."table", fk."to", fk.on_delete, fk.on_update
+			FROM sqlite_master m JOIN pragma_foreign_key_list(m.name) fk
+			WHERE m.type = 'table' ORDER BY m.name, fk.id, fk.seq`,
+	},
+	MySQL: {
+		tables: `SELECT '', table_name FROM information_schema.tables
+			WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE' ORDER BY table_name`,
+		columns: `SELECT '', table_name, column_name, column_type, NULL, NULL, NULL, is_nullable, column_default,
+			CASE WHEN extra LIKE '%auto_increment%' THEN 1 ELSE 0 END
+			FROM information_schema.columns WHERE table_schema = DATABASE() ORDER BY table_name, ordinal_position`,
+		primaryKeys: `SELECT '', table_name, column_name FROM information_schema.key_column_usage
+			WHERE table_schema = DATABASE() AND constraint_name = 'PRIMARY' ORDER BY table_name, ordinal_position`,
+		indexes: `SELECT '', table_name, index_name, CASE WHEN non_unique = 0 THEN 1 ELSE 0 END,
+			CASE WHEN index_type IN ('FULLTEXT', 'SPATIAL') THEN index_type ELSE '' END, column_name
+			FROM information_schema.statistics WHERE table_schema = DATABASE() AND index_name <> 'PRIMARY'
+			ORDER BY table_name, index_name, seq_in_index`,
+		foreignKeys: `SELECT '', k.table_name, k.constraint_name, k.constraint_name, k.column_name,
+			CASE WHEN k.referenced_table_schema = k.table_schema THEN '' ELSE k.referenced_table_schema END, k.referenced_table_name, k.referenced_column_name, r.delete_rule, r.update_rule
+			FROM information_schema.key_column_usage k JOIN information_schema.referential_constraints r
+			ON r.constraint_schema = k.constraint_schema AND r.constraint_name = k.constraint_name AND r.table_name = k.table_name
+			WHERE k.table_schema = DATABASE() AND k.referenced_table_name IS NOT NULL
+			ORDER BY k.table_name, k.constraint_name, k.ordinal_position`,
+	},
+	PostgreSQL: {
+		tables: `SELECT table_schema, table_name FROM information_schema.tables
+			WHERE table_type = 'BASE TABLE' AND table_schema NOT IN ('pg_catalog', 'information_schema')
+			ORDER BY table_schema, table_name`,
+		columns: `SELECT table_schema, table_name, column_name, data_type, character_maximum_length,
+			CASE WHEN data_type = 'numeric' THEN numeric_precision END,
+			CASE WHEN data_type = 'numeric' THEN numeric_scale END, is_nullable, column_default,
+			CASE WHEN is_identity = 'YES' OR column_default LIKE 'nextval(%' THEN 1 ELSE 0 END
+			FROM information_schema.columns WHERE table_schema NOT IN ('pg_catalog', 'information_schema')
+			ORDER BY table_schema, table_name, ordinal_position`,
+		primaryKeys: `SELECT k.table_schema, k.table_name, k.column_name
+			FROM information_schema.table_constraints c JOIN information_schema.key_column_usage k
+			ON k.constraint_schema = c.constraint_schema AND k.constraint_name = c.constraint_name
+			WHERE c.constraint_type = 'PRIMARY KEY' ORDER BY k.table_schema, k.table_name, k.ordinal_position`,
+		indexes: `SELECT n.nspname, t.relname, i.relname, CASE WHEN ix.indisunique THEN 1 ELSE 0 END, '', a.attname
+			FROM pg_index ix JOIN pg_class t ON t.oid = ix.indrelid JOIN pg_class i ON i.oid = ix.indexrelid
+			JOIN pg_namespace n ON n.oid = t.relnamespace
+			JOIN LATERAL unnest(ix.indkey) WITH ORDINALITY AS k(attnum, ord) ON true
+			JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum
+			WHERE NOT ix.indisprimary AND n.nspname NOT IN ('pg_catalog', 'information_schema', 'pg_toast')
+			ORDER BY n.nspname, t.relname, i.relname, k.ord`,
+		foreignKeys: `SELECT k.table_schema, k.table_name, k.constraint_name, k.constraint_name, k.column_name,
+			u.table_schema, u.table_name, u.column_name, r.delete_rule, r.update_rule
+			FROM information_schema.referential_constraints r
+			JOIN information_schema.key_column_usage k ON k.constraint_schema = r.constraint_schema AND k.constraint_name = r.constraint_name
+			JOIN information_schema.key_column_usage u ON u.constraint_schema = r.unique_constraint_schema
+			AND u.constraint_name = r.unique_constraint_name AND u.ordinal_position = k.position_in_unique_constraint
+			ORDER BY k.table_schema, k.table_name, k.constraint_name, k.ordinal_position`,
+	},
+	SQLServer: {
+		tables: `SELECT table_schema, table_name FROM information_schema.tables
+			WHERE table_type = 'BASE TABLE' ORDER BY table_schema, table_name`,
+		columns: `SELECT table_schema, table_name, column_name, data_type, character_maximum_length,
+			CASE WHEN data_type IN ('decimal', 'numeric') THEN numeric_precision END,
+			CASE WHEN data_type IN ('decimal', 'numeric') THEN numeric_scale END, is_nullable, column_default,
+			CASE WHEN COLUMNPROPERTY(OBJECT_ID(table_schema + '.' + table_name), column_name, 'IsIdentity') = 1 THEN 1 ELSE 0 END
+			FROM information_schema.columns ORDER BY table_schema, table_name, ordinal_position`,
+		primaryKeys: `SELECT k.table_schema, k.table_name, k.column_name
+			FROM information_schema.table_constraints c JOIN information_schema.key_column_usage k
+			ON k.constraint_schema = c.constraint_schema AND k.constraint_name = c.constraint_name
+			WHERE c.constraint_type = 'PRIMARY KEY' ORDER BY k.table_schema, k.table_name, k.ordinal_position`,
+		indexes: `SELECT s.name, t.name, i.name, CASE WHEN i.is_unique = 1 THEN 1 ELSE 0 END,
+			CASE WHEN i.type = 4 THEN 'SPATIAL' ELSE '' END, c.name
+			FROM sys.indexes i JOIN sys.tables t ON t.object_id = i.object_id JOIN sys.schemas s ON s.schema_id = t.schema_id
+			JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+			JOIN sys.columns c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+			WHERE i.is_primary_key = 0 AND i.name IS NOT NULL AND ic.is_included_column = 0
+			ORDER BY s.name, t.name, i.name, ic.key_ordinal`,
+		foreignKeys: `SELECT k.table_schema, k.table_name, k.constraint_name, k.constraint_name, k.column_name,
+			u.table_schema, u.table_name, u.column_name, r.delete_rule, r.update_rule
+			FROM information_schema.referential_constraints r
+			JOIN information_schema.key_column_usage k ON k.constraint_schema = r.constraint_schema AND k.constraint_name = r.constraint_name
+			JOIN information_schema.key_column_usage u ON u.constraint_schema = r.unique_constraint_schema
+			AND u.constraint_name = r.unique_constraint_name AND u.ordinal_position = k.ordinal_position
+			ORDER BY k.table_schema, k.table_name, k.constraint_name, k.ordinal_position`,
+	},
+	Oracle: {
+		tables: `SELECT NULL, table_name FROM user_tables ORDER BY table_name`,
+		columns: `SELECT NULL, table_name, column_name, data_type,
+			CASE WHEN data_type IN ('VARCHAR2', 'NVARCHAR2', 'CHAR', 'NCHAR', 'RAW') THEN char_length END,
+			data_precision, data_scale, CASE nullable WHEN 'Y' THEN 'YES' ELSE 'NO' END, data_default,
+			CASE identity_column WHEN 'YES' THEN 1 ELSE 0 END
+			FROM user_tab_columns ORDER BY table_name, column_id`,
+		primaryKeys: `SELECT NULL, c.table_name, cc.column_name
+			FROM user_constraints c JOIN user_cons_columns cc ON cc.constraint_name = c.constraint_name
+			WHERE c.constraint_type = 'P' ORDER BY c.table_name, cc.position`,
+		indexes: `SELECT NULL, i.table_name, i.index_name, CASE i.uniqueness WHEN 'UNIQUE' THEN 1 ELSE 0 END, NULL, ic.column_name
+			FROM user_indexes i JOIN user_ind_columns ic ON ic.index_name = i.index_name
+			WHERE NOT EXISTS (SELECT 1 FROM user_constraints c WHERE c.index_name = i.index_name AND c.constraint_type = 'P')
+			ORDER BY i.table_name, i.index_name, ic.column_position`,
+		foreignKeys: `SELECT NULL, c.table_name, c.constraint_name, c.constraint_name, cc.column_name,
+			CASE WHEN c.r_owner <> USER THEN c.r_owner END, rc.table_name, rcc.column_name, c.delete_rule, NULL
+			FROM user_constraints c JOIN user_cons_columns cc ON cc.constraint_name = c.constraint_name
+			JOIN all_constraints rc ON rc.owner = c.r_owner AND rc.constraint_name = c.r_constraint_name
+			JOIN all_cons_columns rcc ON rcc.owner = rc.owner AND rcc.constraint_name = rc.constraint_name AND rcc.position = cc.position
+			WHERE c.constraint_type = 'R' ORDER BY c.table_name, c.constraint_name, cc.position`,
+	},
+}
+
+// IntrospectDatabase reads the tables of a database connected with the dialect from
+// its catalog, with their columns, primary keys, indexes and foreign keys. System
+// tables are skipped. Foreign keys name the tables of other schemas with their schema.
+func IntrospectDatabase(ctx context.Context, db *sql.DB, dialect Dialect) (*Schema, error) {
+	if db == nil || dialect == nil {
+		return nil, &ValidationError{Problems: []string{"database and dialect are required"}}
+	}
+	queries, ok := introspection[dialect.Type()]
+	if !ok {
+		return nil, &UnsupportedFeatureError{Feature: "introspection", Dialect: dialect.Type()}
+	}
+
+	schema := &Schema{}
+	tables := make(map[string]int)
+	table := func(schemaName, name string) *Table {
+		if i, ok := tables[schemaName+"."+name]; ok {
+			return &schema.Tables[i]
+		}
+		return nil
+	}
+
+	err := queryRows(ctx, db, queries.tables, func(values []sql.NullString) {
+		tables[values[0].String+"."+values[1].String] = len(schema.Tables)
+		schema.Tables = append(schema.Tables, Table{Schema: values[0].String, Name: values[1].String})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tables: %w", err)
+	}
+
+	err = queryRows(ctx, db, queries.columns, func(values []sql.NullString) {
+		if t := table(values[0].String, values[1].String); t != nil {
+			column := introspectColumn(values[2:])
+			column.Order = len(t.Columns) + 1
+			t.Columns = append(t.Columns, column)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns: %w", err)
+	}
+
+	err = queryRows(ctx, db, queries.primaryKeys, func(values []sql.NullString) {
+		t := table(values[0].String, values[1].String)
+		if t == nil {
+			return
+		}
+		if len(t.Constraints) == 0 || t.Constraints[0].Type != "PRIMARY KEY" {
+			t.Constraints = append([]Constraint{{Type: "PRIMARY KEY"}}, t.Constraints...)
+		}
+		t.Constraints[0].Columns = append(t.Constraints[0].Columns, values[2].String)
+		for i := range t.Columns {
+			if t.Columns[i].Name == values[2].String {
+				t.Columns[i].IsPrimaryKey = true
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read primary keys: %w", err)
+	}
+
+	err = queryRows(ctx, db, queries.indexes, func(values []sql.NullString) {
+		t := table(values[0].String, values[1].String)
+		if t == nil {
+			return
+		}
+		if n := len(t.Indexes); n == 0 || t.Indexes[n-1].Name != values[2].String {
+			t.Indexes = append(t.Indexes, Index{
+				Name:     values[2].String,
+				IsUnique: values[3].String == "1",
+				Kind:     ParseIndexKind(values[4].String),
+			})
+		}
+		index := &t.Indexes[len(t.Indexes)-1]
+		index.Columns = append(index.Columns, IndexColumn{Name: values[5].String})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read indexes: %w", err)
+	}
+
+	var lastKey string
+	err = queryRows(ctx, db, queries.foreignKeys, func(values []sql.NullString) {
+		t := table(values[0].String, values[1].String)
+		if t == nil {
+			return
+		}
+		key := values[0].String + "." + values[1].String + "." + values[2].String
+		if key != lastKey {
+			refTable := values[6].String
+			if values[5].String != "" {
+				refTable = values[5].String + "." + refTable
+			}
+			t.Constraints = append(t.Constraints, Constraint{
+				Name:       values[3].String,
+				Type:       "FOREIGN KEY",
+				RefTable:   refTable,
+				DeleteRule: referentialRule(values[8].String),
+				UpdateRule: referentialRule(values[9].String),
+			})
+			lastKey = key
+		}
+		constraint := &t.Constraints[len(t.Constraints)-1]
+		constraint.Columns = append(constraint.Columns, values[4].String)
+		constraint.RefColumns = append(constraint.RefColumns, values[7].String)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read foreign keys: %w", err)
+	}
+
+	return schema, nil
+}
+
+// introspectColumn builds a column from the name, type, length, precision, scale,
+// nullability, default and auto increment read from the catalog
+func introspectColumn(values []sql.NullString) Column {
+	column := Column{
+		Name:          values[0].String,
+		IsNullable:    strings.EqualFold(values[5].String, "YES"),
+		DefaultValue:  values[6].String,
+		AutoIncrement: values[7].String == "1",
+	}
+
+	// Types such as "int(10) unsigned" carry their parameters and attributes
+	fields := strings.Fields(values[1].String)
+attributes:
+	for len(fields) > 1 {
+		switch strings.ToUpper(fields[len(fields)-1]) {
+		case "UNSIGNED":
+			column.Unsigned = true
+		case "ZEROFILL":
+			column.Zerofill = true
+		default:
+			break attributes
+		}
+		fields = fields[:len(fields)-1]
+	}
+	column.DataType, column.Length, column.Scale = ParseDataType(strings.Join(fields, " "))
+
+	fmt.Sscan(values[2].String, &column.Length)
+	fmt.Sscan(values[3].String, &column.Precision)
+	fmt.Sscan(values[4].String, &column.Scale)
+	return column
+}
+
+// referentialRule returns the rule of a foreign key action, empty for the default
+// NO ACTION
+func referentialRule(rule string) string {
+	rule = strings.ToUpper(strings.TrimSpace(rule))
+	if rule == "NO ACTION" {
+		return ""
+	}
+	return rule
+}
+
+// queryRows runs a catalog query and passes the values of every row to fn
+func queryRows(ctx context.Context, db *sql.DB, query string, fn func(values []sql.NullString)) error {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	values := make([]sql.NullString, len(columns))
+	targets := make([]interface{}, len(columns))
+	for i := range values {
+		targets[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(targets...); err != nil {
+			return err
+		}
+		fn(values)
+	}
+	return rows.Err()
+}
@@ -0,0 +1,212 @@
+package sqlmapper
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+)
+
+// introspectDialect is a Dialect of a database type, introspection only reads its type
+type introspectDialect struct {
+	Database
+	dbType DatabaseType
+}
+
+func (d introspectDialect) Type() DatabaseType {
+	return d.dbType
+}
+
+// catalogConnector connects to a fake database answering the catalog queries of a
+// dialect with canned rows. Other queries fail.
+type catalogConnector map[string][][]driver.Value
+
+func (c catalogConnector) Connect(context.Context) (driver.Conn, error) { return catalogConn(c), nil }
+func (c catalogConnector) Driver() driver.Driver                        { return nil }
+
+type catalogConn map[string][][]driver.Value
+
+func (c catalogConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c catalogConn) Close() error                        { return nil }
+func (c catalogConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+func (c catalogConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	rows, ok := c[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	return &catalogRows{rows: rows}, nil
+}
+
+type catalogRows struct{ rows [][]driver.Value }
+
+func (r *catalogRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *catalogRows) Close() error { return nil }
+
+func (r *catalogRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestIntrospectDatabase_SQLite(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, email VARCHAR(255) NOT NULL, name TEXT DEFAULT 'anon');
+		CREATE UNIQUE INDEX idx_users_email ON users(email);
+		CREATE TABLE posts (id INTEGER PRIMARY KEY, user_id INTEGER, title TEXT NOT NULL,
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE);
+		CREATE INDEX idx_posts_user_title ON posts(user_id, title);`)
+	assert.NoError(t, err)
+
+	schema, err := IntrospectDatabase(context.Background(), db, introspectDialect{dbType: SQLite})
+	assert.NoError(t, err)
+	assert.Equal(t, []Table{
+		{
+			Name: "posts",
+			Columns: []Column{
+				{Name: "id", DataType: "INTEGER", IsPrimaryKey: true, Order: 1},
+				{Name: "user_id", DataType: "INTEGER", IsNullable: true, Order: 2},
+				{Name: "title", DataType: "TEXT", Order: 3},
+			},
+			Indexes: []Index{{Name: "idx_posts_user_title", Columns: IndexColumns("user_id", "title")}},
+			Constraints: []Constraint{
+				{Type: "PRIMARY KEY", Columns: []string{"id"}},
+				{Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}, DeleteRule: "CASCADE"},
+			},
+		},
+		{
+			Name: "users",
+			Columns: []Column{
+				{Name: "id", DataType: "INTEGER", IsPrimaryKey: true, AutoIncrement: true, Order: 1},
+				{Name: "email", DataType: "VARCHAR", Length: 255, Order: 2},
+				{Name: "name", DataType: "TEXT", IsNullable: true, DefaultValue: "'anon'", Order: 3},
+			},
+			Indexes:     []Index{{Name: "idx_users_email", Columns: IndexColumns("email"), IsUnique: true}},
+			Constraints: []Constraint{{Type: "PRIMARY KEY", Columns: []string{"id"}}},
+		},
+	}, schema.Tables)
+}
+
+func TestIntrospectDatabase_Validation(t *testing.T) {
+	_, err := IntrospectDatabase(context.Background(), nil, introspectDialect{dbType: SQLite})
+	assert.EqualError(t, err, "database and dialect are required")
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	db, err := sql.Open("sqlite", ":memory:")
+	assert.NoError(t, err)
+
+	_, err = IntrospectDatabase(context.Background(), db, nil)
+	assert.ErrorAs(t, err, &validationErr)
+
+	_, err = IntrospectDatabase(context.Background(), db, introspectDialect{dbType: "db2"})
+	assert.EqualError(t, err, "introspection of db2 is not supported")
+	var unsupported *UnsupportedFeatureError
+	if assert.True(t, errors.As(err, &unsupported)) {
+		assert.Equal(t, DatabaseType("db2"), unsupported.Dialect)
+	}
+
+	db.Close()
+	_, err = IntrospectDatabase(context.Background(), db, introspectDialect{dbType: SQLite})
+	assert.EqualError(t, err, "failed to read tables: sql: database is closed")
+}
+
+func TestIntrospectDatabase_ForeignKeySchemas(t *testing.T) {
+	tests := []struct {
+		dbType   DatabaseType
+		schema   string // Schema of the tables, empty for the database connected to
+		other    string // Referenced schema of the foreign key to another schema
+		refTable string // Table referenced by the foreign key to another schema
+	}{
+		{dbType: PostgreSQL, schema: "public", other: "auth", refTable: "auth.accounts"},
+		{dbType: SQLServer, schema: "dbo", other: "auth", refTable: "auth.accounts"},
+		{dbType: MySQL, other: "auth", refTable: "auth.accounts"},
+		{dbType: Oracle, other: "AUTH", refTable: "AUTH.accounts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.dbType), func(t *testing.T) {
+			queries := introspection[tt.dbType]
+			db := sql.OpenDB(catalogConnector{
+				queries.tables: {{tt.schema, "users"}, {tt.schema, "posts"}},
+				queries.columns: {
+					{tt.schema, "users", "id", "int", nil, nil, nil, "NO", nil, "1"},
+					{tt.schema, "posts", "id", "int", nil, nil, nil, "NO", nil, "1"},
+					{tt.schema, "posts", "user_id", "int", nil, nil, nil, "YES", nil, "0"},
+					{tt.schema, "posts", "account_id", "int", nil, nil, nil, "YES", nil, "0"},
+				},
+				queries.primaryKeys: {{tt.schema, "users", "id"}, {tt.schema, "posts", "id"}},
+				queries.indexes:     {{tt.schema, "posts", "idx_posts_user", "0", "", "user_id"}},
+				queries.foreignKeys: {
+					{tt.schema, "posts", "fk_posts_user", "fk_posts_user", "user_id", tt.schema, "users", "id", "CASCADE", "NO ACTION"},
+					{tt.schema, "posts", "fk_posts_account", "fk_posts_account", "account_id", tt.other, "accounts", "id", "NO ACTION", "NO ACTION"},
+				},
+			})
+			defer db.Close()
+
+			schema, err := IntrospectDatabase(context.Background(), db, introspectDialect{dbType: tt.dbType})
+			if !assert.NoError(t, err) || !assert.Len(t, schema.Tables, 2) {
+				return
+			}
+
+			posts := schema.Tables[1]
+			assert.Equal(t, tt.schema, posts.Schema)
+			assert.Equal(t, []Index{{Name: "idx_posts_user", Columns: IndexColumns("user_id")}}, posts.Indexes)
+			refTable := "users"
+			if tt.schema != "" {
+				refTable = tt.schema + ".users"
+			}
+			assert.Equal(t, []Constraint{
+				{Type: "PRIMARY KEY", Columns: []string{"id"}},
+				{Name: "fk_posts_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: refTable, RefColumns: []string{"id"}, DeleteRule: "CASCADE"},
+				{Name: "fk_posts_account", Type: "FOREIGN KEY", Columns: []string{"account_id"}, RefTable: tt.refTable, RefColumns: []string{"id"}},
+			}, posts.Constraints)
+		})
+	}
+}
+
+// selectListRe matches the select list of a catalog query
+var selectListRe = regexp.MustCompile(`(?is)^SELECT\s+(.*?)\s+FROM\s`)
+
+func TestIntrospection_QueryColumns(t *testing.T) {
+	for dbType, queries := range introspection {
+		t.Run(string(dbType), func(t *testing.T) {
+			for _, query := range []struct {
+				name    string
+				sql     string
+				columns int
+			}{
+				{"tables", queries.tables, 2},
+				{"columns", queries.columns, 10},
+				{"primary keys", queries.primaryKeys, 3},
+				{"indexes", queries.indexes, 6},
+				{"foreign keys", queries.foreignKeys, 10},
+			} {
+				match := selectListRe.FindStringSubmatch(query.sql)
+				if assert.NotNil(t, match, query.name) {
+					assert.Len(t, SplitDefinitions(match[1]), query.columns, query.name)
+				}
+			}
+		})
+	}
+}
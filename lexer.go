@@ -0,0 +1,254 @@
+package sqlmapper
+
+import "strings"
+
+// TokenType identifies the kind of a lexical token
+type TokenType int
+
+const (
+	// WordToken is a keyword, an unquoted identifier or a number
+	WordToken TokenType = iota
+	// QuotedIdentifierToken is an identifier quoted with "", `` or []
+	QuotedIdentifierToken
+	// StringToken is a string literal, including PostgreSQL's dollar-quoted strings
+	// when the lexer was created with WithDollarQuotes
+	StringToken
+	// CommentToken is a -- line comment or a /* */ block comment, or a MySQL # line
+	// comment when the lexer was created with WithMySQLComments
+	CommentToken
+	// SymbolToken is any other single character, such as a parenthesis or a semicolon
+	SymbolToken
+)
+
+// Token is a lexical token of a SQL script
+type Token struct {
+	Type   TokenType
+	Text   string
+	Offset int // Byte offset of the token in the input
+	Line   int // Line on which the token starts, counting from 1
+}
+
+// EndLine returns the line on which the token ends
+func (t Token) EndLine() int {
+	return t.Line + strings.Count(t.Text, "\n")
+}
+
+// Lexer splits SQL text into tokens. String literals, quoted identifiers, comments
+// and, for PostgreSQL, dollar-quoted bodies are returned as single tokens, so that the
+// delimiters and keywords they contain are never mistaken for those of the statement.
+type Lexer struct {
+	input  string
+	offset int
+	line   int
+
+	// Whether comments follow the MySQL rules, see WithMySQLComments
+	mysqlComments bool
+	// Whether $$ and $tag$ start a string, see WithDollarQuotes
+	dollarQuotes bool
+	// Whether backslash escapes characters in strings, see WithBackslashEscapes
+	backslashEscapes bool
+}
+
+// NewLexer creates a lexer for the given SQL text
+func NewLexer(input string) *Lexer {
+	return &Lexer{input: input, line: 1}
+}
+
+// WithMySQLComments makes the lexer follow the comment rules of MySQL: # starts a
+// line comment, while -- only does when followed by whitespace or a control
+// character, so that a--b is a subtraction. Other dialects use # in operators and
+// temporary table names, and start a comment at any --.
+func (l *Lexer) WithMySQLComments() *Lexer {
+	l.mysqlComments = true
+	return l
+}
+
+// WithDollarQuotes makes the lexer read the dollar-quoted strings of PostgreSQL, such
+// as $$ ... $$ or $body$ ... $body$, as string literals. Other dialects use $ in names
+// and MySQL dumps use $$ as the DELIMITER of routine bodies, which would otherwise be
+// read as the start of a string running to the next END$$.
+func (l *Lexer) WithDollarQuotes() *Lexer {
+	l.dollarQuotes = true
+	return l
+}
+
+// WithBackslashEscapes makes a backslash escape the character following it in string
+// literals, as in MySQL, so that 'It\'s' is a single literal. Other dialects only
+// escape a quote by doubling it, and 'C:\' is a complete literal; PostgreSQL's E'...'
+// strings honour backslash escapes regardless.
+func (l *Lexer) WithBackslashEscapes() *Lexer {
+	l.backslashEscapes = true
+	return l
+}
+
+// Next returns the next token, skipping whitespace. The second return value is
+// false once the input is exhausted. Unterminated literals and comments extend
+// to the end of the input.
+func (l *Lexer) Next() (Token, bool) {
+	for l.offset < len(l.input) && isSpaceByte(l.input[l.offset]) {
+		if l.input[l.offset] == '\n' {
+			l.line++
+		}
+		l.offset++
+	}
+	if l.offset >= len(l.input) {
+		return Token{}, false
+	}
+
+	start := l.offset
+	rest := l.input[start:]
+	var tokenType TokenType
+
+	switch c := rest[0]; {
+	case c == '\'':
+		tokenType, l.offset = StringToken, start+quotedLength(rest, '\'', l.backslashEscapes)
+	case (c == 'E' || c == 'e') && len(rest) > 1 && rest[1] == '\'':
+		// PostgreSQL's escape string constant
+		tokenType, l.offset = StringToken, start+1+quotedLength(rest[1:], '\'', true)
+	case c == '"' || c == '`':
+		tokenType, l.offset = QuotedIdentifierToken, start+quotedLength(rest, c, false)
+	case c == '[':
+		tokenType, l.offset = QuotedIdentifierToken, start+closedLength(rest, "]", 1)
+	case strings.HasPrefix(rest, "--") && (!l.mysqlComments || len(rest) == 2 || rest[2] <= ' '):
+		tokenType, l.offset = CommentToken, start+lineLength(rest)
+	case strings.HasPrefix(rest, "/*"):
+		tokenType, l.offset = CommentToken, start+closedLength(rest, "*/", 2)
+	case c == '#' && l.mysqlComments:
+		tokenType, l.offset = CommentToken, start+lineLength(rest)
+	case c == '$' && l.dollarQuotes && dollarTag(rest) != "":
+		tag := dollarTag(rest)
+		tokenType, l.offset = StringToken, start+closedLength(rest, tag, len(tag))
+	case isWordByte(c) || c == '$' || c >= 0x80:
+		end := 1
+		for end < len(rest) && (isWordByte(rest[end]) || rest[end] == '$' || rest[end] >= 0x80) {
+			end++
+		}
+		tokenType, l.offset = WordToken, start+end
+	default:
+		tokenType, l.offset = SymbolToken, start+1
+	}
+
+	token := Token{Type: tokenType, Text: l.input[start:l.offset], Offset: start, Line: l.line}
+	l.line = token.EndLine()
+	return token, true
+}
+
+// Tokenize returns all tokens of the given SQL text
+func Tokenize(input string) []Token {
+	var tokens []Token
+	lexer := NewLexer(input)
+	for token, ok := lexer.Next(); ok; token, ok = lexer.Next() {
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// StripComments removes the -- and /* */ comments of SQL text. A block comment is
+// replaced by a space, or by the line breaks it spans, so that the words around it
+// stay apart and line numbers are kept; comment markers inside string literals and
+// quoted identifiers are left alone.
+func StripComments(input string) string {
+	if !strings.Contains(input, "--") && !strings.Contains(input, "/*") {
+		return input
+	}
+	return stripComments(NewLexer(input), input)
+}
+
+// StripMySQLComments removes the comments of MySQL text like StripComments, following
+// the MySQL rules for # and -- comments described at Lexer.WithMySQLComments and the
+// backslash escapes of its string literals.
+func StripMySQLComments(input string) string {
+	if !strings.Contains(input, "--") && !strings.Contains(input, "/*") && !strings.Contains(input, "#") {
+		return input
+	}
+	return stripComments(NewLexer(input).WithMySQLComments().WithBackslashEscapes(), input)
+}
+
+// StripPostgresComments removes the comments of PostgreSQL text like StripComments,
+// leaving the comment markers inside dollar-quoted strings alone
+func StripPostgresComments(input string) string {
+	if !strings.Contains(input, "--") && !strings.Contains(input, "/*") {
+		return input
+	}
+	return stripComments(NewLexer(input).WithDollarQuotes(), input)
+}
+
+// stripComments removes the comment tokens read by lexer from input
+func stripComments(lexer *Lexer, input string) string {
+	var sb strings.Builder
+	sb.Grow(len(input))
+	last := 0
+	for token, ok := lexer.Next(); ok; token, ok = lexer.Next() {
+		if token.Type != CommentToken {
+			continue
+		}
+		sb.WriteString(input[last:token.Offset])
+		if strings.HasPrefix(token.Text, "/*") {
+			if lines := strings.Count(token.Text, "\n"); lines > 0 {
+				sb.WriteString(strings.Repeat("\n", lines))
+			} else {
+				sb.WriteByte(' ')
+			}
+		}
+		last = token.Offset + len(token.Text)
+	}
+	sb.WriteString(input[last:])
+	return sb.String()
+}
+
+// quotedLength returns the length of the literal quoted with quote at the start of s.
+// A doubled quote stands for the quote itself; backslash escapes are honoured if enabled.
+func quotedLength(s string, quote byte, backslash bool) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if backslash {
+				i++
+			}
+		case quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// closedLength returns the length of the token at the start of s that ends with the
+// closing string, searching from the given offset
+func closedLength(s, closing string, from int) int {
+	if end := strings.Index(s[from:], closing); end >= 0 {
+		return from + end + len(closing)
+	}
+	return len(s)
+}
+
+// lineLength returns the length of the line comment at the start of s, excluding the newline
+func lineLength(s string) int {
+	if end := strings.IndexByte(s, '\n'); end >= 0 {
+		return end
+	}
+	return len(s)
+}
+
+// dollarTag returns the opening tag of a dollar-quoted string at the start of s, such
+// as $$ or $body$, or an empty string if s does not start with one
+func dollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '$':
+			return s[:i+1]
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 1 && c >= '0' && c <= '9':
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// isSpaceByte reports whether c is an ASCII whitespace character
+func isSpaceByte(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
@@ -0,0 +1,188 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Token
+	}{
+		{
+			name:  "Strings",
+			input: `'it''s' 'C:\' E'a\'b' 'semi;colon'`,
+			want: []Token{
+				{Type: StringToken, Text: `'it''s'`, Offset: 0, Line: 1},
+				{Type: StringToken, Text: `'C:\'`, Offset: 8, Line: 1},
+				{Type: StringToken, Text: `E'a\'b'`, Offset: 14, Line: 1},
+				{Type: StringToken, Text: `'semi;colon'`, Offset: 22, Line: 1},
+			},
+		},
+		{
+			name:  "Quoted identifiers",
+			input: "\"order\" `key` [user name]",
+			want: []Token{
+				{Type: QuotedIdentifierToken, Text: `"order"`, Offset: 0, Line: 1},
+				{Type: QuotedIdentifierToken, Text: "`key`", Offset: 8, Line: 1},
+				{Type: QuotedIdentifierToken, Text: "[user name]", Offset: 14, Line: 1},
+			},
+		},
+		{
+			name:  "Comments",
+			input: "a -- line; comment\n/* block\n'comment' */ b",
+			want: []Token{
+				{Type: WordToken, Text: "a", Offset: 0, Line: 1},
+				{Type: CommentToken, Text: "-- line; comment", Offset: 2, Line: 1},
+				{Type: CommentToken, Text: "/* block\n'comment' */", Offset: 19, Line: 2},
+				{Type: WordToken, Text: "b", Offset: 41, Line: 3},
+			},
+		},
+		{
+			name:  "Nested parentheses",
+			input: "f((a), (b,c))",
+			want: []Token{
+				{Type: WordToken, Text: "f", Offset: 0, Line: 1},
+				{Type: SymbolToken, Text: "(", Offset: 1, Line: 1},
+				{Type: SymbolToken, Text: "(", Offset: 2, Line: 1},
+				{Type: WordToken, Text: "a", Offset: 3, Line: 1},
+				{Type: SymbolToken, Text: ")", Offset: 4, Line: 1},
+				{Type: SymbolToken, Text: ",", Offset: 5, Line: 1},
+				{Type: SymbolToken, Text: "(", Offset: 7, Line: 1},
+				{Type: WordToken, Text: "b", Offset: 8, Line: 1},
+				{Type: SymbolToken, Text: ",", Offset: 9, Line: 1},
+				{Type: WordToken, Text: "c", Offset: 10, Line: 1},
+				{Type: SymbolToken, Text: ")", Offset: 11, Line: 1},
+				{Type: SymbolToken, Text: ")", Offset: 12, Line: 1},
+			},
+		},
+		{
+			name:  "Unterminated string",
+			input: "x 'open",
+			want: []Token{
+				{Type: WordToken, Text: "x", Offset: 0, Line: 1},
+				{Type: StringToken, Text: "'open", Offset: 2, Line: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Tokenize(tt.input))
+		})
+	}
+}
+
+func TestLexer_DollarQuotes(t *testing.T) {
+	input := "$$ a; $b$ $$ $body$x$$y$body$ $1"
+	var tokens []Token
+	lexer := NewLexer(input).WithDollarQuotes()
+	for token, ok := lexer.Next(); ok; token, ok = lexer.Next() {
+		tokens = append(tokens, token)
+	}
+	assert.Equal(t, []Token{
+		{Type: StringToken, Text: "$$ a; $b$ $$", Offset: 0, Line: 1},
+		{Type: StringToken, Text: "$body$x$$y$body$", Offset: 13, Line: 1},
+		{Type: WordToken, Text: "$1", Offset: 30, Line: 1},
+	}, tokens)
+
+	// Without dollar quoting, $$ is a word, such as the MySQL delimiter ending END$$
+	assert.Equal(t, []Token{
+		{Type: WordToken, Text: "END$$", Offset: 0, Line: 1},
+		{Type: WordToken, Text: "DELIMITER", Offset: 6, Line: 2},
+		{Type: SymbolToken, Text: ";", Offset: 16, Line: 2},
+	}, Tokenize("END$$\nDELIMITER ;"))
+}
+
+func TestLexer_BackslashEscapes(t *testing.T) {
+	input := `'a\'b' 'C:\\'`
+	var tokens []Token
+	lexer := NewLexer(input).WithBackslashEscapes()
+	for token, ok := lexer.Next(); ok; token, ok = lexer.Next() {
+		tokens = append(tokens, token)
+	}
+	assert.Equal(t, []Token{
+		{Type: StringToken, Text: `'a\'b'`, Offset: 0, Line: 1},
+		{Type: StringToken, Text: `'C:\\'`, Offset: 7, Line: 1},
+	}, tokens)
+
+	// Without backslash escapes, a backslash is an ordinary character
+	assert.Equal(t, []Token{
+		{Type: StringToken, Text: `'a\'`, Offset: 0, Line: 1},
+		{Type: WordToken, Text: "b", Offset: 4, Line: 1},
+		{Type: StringToken, Text: `''`, Offset: 5, Line: 1},
+	}, Tokenize(`'a\'b''`))
+}
+
+func TestStripComments(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "No comments",
+			input: "CREATE TABLE t (id INT);",
+			want:  "CREATE TABLE t (id INT);",
+		},
+		{
+			name:  "Line and block comments",
+			input: "id INT, -- primary key, surrogate\n/* login */email TEXT,\nname TEXT /* display,\nname */\n",
+			want:  "id INT, \n email TEXT,\nname TEXT \n\n",
+		},
+		{
+			name:  "Markers inside literals",
+			input: "note TEXT DEFAULT '-- not /* a comment', \"a--b\" INT",
+			want:  "note TEXT DEFAULT '-- not /* a comment', \"a--b\" INT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, StripComments(tt.input))
+		})
+	}
+}
+
+func TestStripMySQLComments(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "Hash comments",
+			input: "# Dump of table users\nid INT, # surrogate; key\nname TEXT -- display\n",
+			want:  "\nid INT, \nname TEXT \n",
+		},
+		{
+			name:  "Dashes without a following space",
+			input: "total INT AS (price--discount), net INT AS (a---b), --\tnote\nid INT --\n",
+			want:  "total INT AS (price--discount), net INT AS (a---b), \nid INT \n",
+		},
+		{
+			name:  "Hash inside literals",
+			input: "color CHAR(4) DEFAULT '#fff', `#tag` INT",
+			want:  "color CHAR(4) DEFAULT '#fff', `#tag` INT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, StripMySQLComments(tt.input))
+		})
+	}
+
+	// Other dialects use # in operators and temporary table names, and start a
+	// comment at any --
+	assert.Equal(t, "SELECT 1 # 2", StripComments("SELECT 1 # 2"))
+	assert.Equal(t, "SELECT a\n", StripComments("SELECT a--b\n"))
+}
+
+func TestStripPostgresComments(t *testing.T) {
+	input := "CREATE FUNCTION f() AS $$ SELECT '--'; -- kept\n $$ LANGUAGE sql; -- removed\n"
+	assert.Equal(t, "CREATE FUNCTION f() AS $$ SELECT '--'; -- kept\n $$ LANGUAGE sql; \n", StripPostgresComments(input))
+}
@@ -0,0 +1,213 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// LintOptions configures the checks of LintForTarget
+type LintOptions struct {
+	// MaxIdentifierLength overrides the identifier length limit of the target, such
+	// as 30 for Oracle releases before 12.2
+	MaxIdentifierLength int
+}
+
+// identifierLinter collects the names of a schema exceeding a length limit
+type identifierLinter struct {
+	BaseVisitor
+	max    int
+	target DatabaseType
+	issues []string
+}
+
+func (v *identifierLinter) check(kind, name string) {
+	v.checkIn(kind, "", name)
+}
+
+// checkIn checks the name of an object qualified by the table it belongs to
+func (v *identifierLinter) checkIn(kind, table, name string) {
+	if len(name) <= v.max {
+		return
+	}
+	qualified := name
+	if table != "" {
+		qualified = table + "." + name
+	}
+	v.issues = append(v.issues, fmt.Sprintf("%s name %s is %d bytes long, %s allows %d", kind, qualified, len(name), v.target, v.max))
+}
+
+func (v *identifierLinter) VisitTable(table *Table) error {
+	v.check("table", table.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitColumn(table *Table, column *Column) error {
+	v.checkIn("column", table.Name, column.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitIndex(table *Table, index *Index) error {
+	v.check("index", index.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitConstraint(table *Table, constraint *Constraint) error {
+	v.check("constraint", constraint.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitForeignKey(table *Table, constraint *Constraint) error {
+	v.check("constraint", constraint.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitView(view *View) error {
+	v.check("view", view.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitFunction(function *Function) error {
+	v.check("function", function.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitProcedure(procedure *Procedure) error {
+	v.check("procedure", procedure.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitTrigger(trigger *Trigger) error {
+	v.check("trigger", trigger.Name)
+	return nil
+}
+
+func (v *identifierLinter) VisitSequence(sequence *Sequence) error {
+	v.check("sequence", sequence.Name)
+	return nil
+}
+
+// LintForTarget reports the parts of a schema the target dialect would reject, such
+// as names longer than the identifiers of the target. Column names are qualified by
+// their table. An empty result means no problem was found.
+func LintForTarget(schema *Schema, target DatabaseType, options LintOptions) []string {
+	if schema == nil {
+		return nil
+	}
+
+	max := options.MaxIdentifierLength
+	if max == 0 {
+		max = Capabilities(target).MaxIdentifierLength
+	}
+	if max <= 0 {
+		return nil
+	}
+
+	// Names are only read, the walk cannot fail
+	linter := &identifierLinter{max: max, target: target}
+	_ = schema.Walk(linter)
+	return linter.issues
+}
+
+// ValidateForTarget returns a ValidationError listing the problems LintForTarget finds, or nil
+// if the schema can be generated for the target as it is
+func ValidateForTarget(schema *Schema, target DatabaseType, options LintOptions) error {
+	issues := LintForTarget(schema, target, options)
+	if len(issues) == 0 {
+		return nil
+	}
+	return &ValidationError{Target: target, Problems: issues}
+}
+
+// TruncateIdentifier shortens a name to at most max bytes. Longer names keep their
+// beginning followed by an underscore and a hash of the whole name, so distinct
+// names stay distinct and the same name is always shortened the same way.
+func TruncateIdentifier(name string, max int) string {
+	if len(name) <= max {
+		return name
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(name))
+	suffix := fmt.Sprintf("_%08x", hash.Sum32())
+	if max <= len(suffix) {
+		return suffix[len(suffix)-max:]
+	}
+	return name[:max-len(suffix)] + suffix
+}
+
+// ShortenIdentifiers returns a transform that truncates the names of tables,
+// columns, indexes, constraints, views, functions, procedures, triggers and
+// sequences longer than max bytes with TruncateIdentifier, and updates the
+// references to the renamed tables and columns
+func ShortenIdentifiers(max int) Transform {
+	return TransformFunc(func(schema *Schema) error {
+		if max <= 0 {
+			return fmt.Errorf("invalid identifier length: %d", max)
+		}
+		shorten := func(name string) string {
+			return TruncateIdentifier(name, max)
+		}
+
+		// Rename the columns while the tables still have their original names
+		for _, table := range schema.Tables {
+			for _, column := range table.Columns {
+				if short := shorten(column.Name); short != column.Name {
+					if err := RenameColumn(table.Name, column.Name, short).Apply(schema); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if err := schema.Walk(&tableRenamer{rename: shorten}); err != nil {
+			return err
+		}
+		return schema.Walk(&identifierShortener{shorten: shorten})
+	})
+}
+
+// identifierShortener shortens the names of the objects other than tables and columns
+type identifierShortener struct {
+	BaseVisitor
+	shorten func(name string) string
+}
+
+func (v *identifierShortener) VisitIndex(table *Table, index *Index) error {
+	index.Name = v.shorten(index.Name)
+	return nil
+}
+
+func (v *identifierShortener) VisitConstraint(table *Table, constraint *Constraint) error {
+	constraint.Name = v.shorten(constraint.Name)
+	return nil
+}
+
+func (v *identifierShortener) VisitForeignKey(table *Table, constraint *Constraint) error {
+	constraint.Name = v.shorten(constraint.Name)
+	return nil
+}
+
+func (v *identifierShortener) VisitView(view *View) error {
+	view.Name = v.shorten(view.Name)
+	return nil
+}
+
+func (v *identifierShortener) VisitFunction(function *Function) error {
+	function.Name = v.shorten(function.Name)
+	return nil
+}
+
+func (v *identifierShortener) VisitProcedure(procedure *Procedure) error {
+	procedure.Name = v.shorten(procedure.Name)
+	return nil
+}
+
+func (v *identifierShortener) VisitTrigger(trigger *Trigger) error {
+	trigger.Name = v.shorten(trigger.Name)
+	return nil
+}
+
+func (v *identifierShortener) VisitSequence(sequence *Sequence) error {
+	sequence.Name = v.shorten(sequence.Name)
+	return nil
+}
@@ -0,0 +1,72 @@
+package sqlmapper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintForTarget_IdentifierLength(t *testing.T) {
+	longTable := "customer_subscription_payment_history"
+	schema := &Schema{
+		Tables: []Table{
+			{
+				Name:    longTable,
+				Columns: []Column{{Name: "id", DataType: "INT"}, {Name: "payment_provider_transaction_reference", DataType: "VARCHAR"}},
+				Constraints: []Constraint{
+					{Name: "pk_history", Type: "PRIMARY KEY", Columns: []string{"id"}},
+				},
+			},
+			{
+				Name:    "refunds",
+				Columns: []Column{{Name: "history_id", DataType: "INT"}},
+				Constraints: []Constraint{
+					{Name: "fk_refunds_history", Type: "FOREIGN KEY", Columns: []string{"history_id"}, RefTable: longTable, RefColumns: []string{"id"}},
+				},
+			},
+		},
+	}
+
+	// Oracle 12.2 and later allow 128 bytes
+	assert.Empty(t, LintForTarget(schema, Oracle, LintOptions{}))
+	assert.NoError(t, ValidateForTarget(schema, Oracle, LintOptions{}))
+
+	legacy := LintOptions{MaxIdentifierLength: 30}
+	assert.Equal(t, []string{
+		"table name customer_subscription_payment_history is 37 bytes long, oracle allows 30",
+		"column name customer_subscription_payment_history.payment_provider_transaction_reference is 38 bytes long, oracle allows 30",
+	}, LintForTarget(schema, Oracle, legacy))
+	err := ValidateForTarget(schema, Oracle, legacy)
+	assert.ErrorContains(t, err, "schema is not valid for oracle: table name customer_subscription_payment_history")
+	var validationErr *ValidationError
+	if assert.True(t, errors.As(err, &validationErr)) {
+		assert.Equal(t, Oracle, validationErr.Target)
+		assert.Equal(t, LintForTarget(schema, Oracle, legacy), validationErr.Problems)
+	}
+
+	// SQLite has no limit
+	assert.Empty(t, LintForTarget(schema, SQLite, LintOptions{}))
+
+	shortened, err := NewPipeline(ShortenIdentifiers(30)).Run(schema)
+	assert.NoError(t, err)
+	assert.Empty(t, LintForTarget(shortened, Oracle, legacy))
+
+	table := shortened.Tables[0]
+	assert.Len(t, table.Name, 30)
+	assert.True(t, strings.HasPrefix(table.Name, "customer_subscription_"))
+	assert.Equal(t, TruncateIdentifier(longTable, 30), table.Name)
+	assert.Equal(t, "id", table.Columns[0].Name)
+	assert.Equal(t, table.Name, shortened.Tables[1].Constraints[0].RefTable)
+
+	// The original schema is left unchanged
+	assert.Equal(t, longTable, schema.Tables[0].Name)
+}
+
+func TestTruncateIdentifier(t *testing.T) {
+	assert.Equal(t, "users", TruncateIdentifier("users", 30))
+	assert.Equal(t, TruncateIdentifier("a_very_long_identifier_name_one", 20), TruncateIdentifier("a_very_long_identifier_name_one", 20))
+	assert.NotEqual(t, TruncateIdentifier("a_very_long_identifier_name_one", 20), TruncateIdentifier("a_very_long_identifier_name_two", 20))
+	assert.Len(t, TruncateIdentifier("a_very_long_identifier_name_one", 20), 20)
+}
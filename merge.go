@@ -0,0 +1,135 @@
+package sqlmapper
+
+import (
+	"strings"
+)
+
+// MergeOptions controls how conflicting objects are handled when merging schemas
+type MergeOptions struct {
+	// Override lets later definitions replace earlier ones with the same name
+	// instead of reporting them as conflicts
+	Override bool
+}
+
+// MergeSchemas combines multiple schemas into a single schema. Tables, views,
+// functions and procedures defined more than once are reported as conflicts.
+func MergeSchemas(schemas ...*Schema) (*Schema, error) {
+	return MergeSchemasWithOptions(MergeOptions{}, schemas...)
+}
+
+// MergeSchemasWithOptions combines multiple schemas into a single schema using the given options.
+// Objects are matched by their schema-qualified name, case-insensitively. The merged
+// schema is a deep copy that shares nothing with the input schemas.
+func MergeSchemasWithOptions(options MergeOptions, schemas ...*Schema) (*Schema, error) {
+	merged := &Schema{}
+	var conflicts []string
+
+	tables := make(map[string]int)
+	views := make(map[string]int)
+	functions := make(map[string]int)
+	procedures := make(map[string]int)
+
+	for _, schema := range schemas {
+		if schema == nil {
+			continue
+		}
+		schema = schema.Clone()
+
+		if merged.Name == "" {
+			merged.Name = schema.Name
+		}
+
+		for _, table := range schema.Tables {
+			key := mergeKey(table.Schema, table.Name)
+			if i, ok := tables[key]; ok {
+				if !options.Override {
+					conflicts = append(conflicts, "table "+key)
+					continue
+				}
+				merged.Tables[i] = table
+				continue
+			}
+			tables[key] = len(merged.Tables)
+			merged.Tables = append(merged.Tables, table)
+		}
+
+		for _, view := range schema.Views {
+			key := mergeKey(view.Schema, view.Name)
+			if i, ok := views[key]; ok {
+				if !options.Override {
+					conflicts = append(conflicts, "view "+key)
+					continue
+				}
+				merged.Views[i] = view
+				continue
+			}
+			views[key] = len(merged.Views)
+			merged.Views = append(merged.Views, view)
+		}
+
+		for _, function := range schema.Functions {
+			key := mergeKey(function.Schema, function.Name)
+			if i, ok := functions[key]; ok {
+				if !options.Override {
+					conflicts = append(conflicts, "function "+key)
+					continue
+				}
+				merged.Functions[i] = function
+				continue
+			}
+			functions[key] = len(merged.Functions)
+			merged.Functions = append(merged.Functions, function)
+		}
+
+		for _, procedure := range schema.Procedures {
+			key := mergeKey(procedure.Schema, procedure.Name)
+			if i, ok := procedures[key]; ok {
+				if !options.Override {
+					conflicts = append(conflicts, "procedure "+key)
+					continue
+				}
+				merged.Procedures[i] = procedure
+				continue
+			}
+			procedures[key] = len(merged.Procedures)
+			merged.Procedures = append(merged.Procedures, procedure)
+		}
+
+		// Remaining objects are combined as-is
+		merged.Triggers = append(merged.Triggers, schema.Triggers...)
+		merged.Sequences = append(merged.Sequences, schema.Sequences...)
+		merged.Extensions = append(merged.Extensions, schema.Extensions...)
+		merged.Permissions = append(merged.Permissions, schema.Permissions...)
+		merged.UserDefinedTypes = append(merged.UserDefinedTypes, schema.UserDefinedTypes...)
+		merged.DatabaseLinks = append(merged.DatabaseLinks, schema.DatabaseLinks...)
+		merged.Tablespaces = append(merged.Tablespaces, schema.Tablespaces...)
+		merged.Roles = append(merged.Roles, schema.Roles...)
+		merged.Users = append(merged.Users, schema.Users...)
+		merged.Clusters = append(merged.Clusters, schema.Clusters...)
+		merged.MaterializedLogs = append(merged.MaterializedLogs, schema.MaterializedLogs...)
+		merged.Types = append(merged.Types, schema.Types...)
+		merged.Drops = append(merged.Drops, schema.Drops...)
+		merged.Settings = append(merged.Settings, schema.Settings...)
+
+		for table, partitions := range schema.Partitions {
+			if merged.Partitions == nil {
+				merged.Partitions = make(map[string][]Partition)
+			}
+			merged.Partitions[table] = append(merged.Partitions[table], partitions...)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return nil, &ValidationError{Reason: "merge conflicts", Problems: conflicts}
+	}
+
+	return merged, nil
+}
+
+// mergeKey returns the case-insensitive, schema-qualified name used to match objects
+func mergeKey(schema, name string) string {
+	if schema != "" {
+		return strings.ToLower(schema + "." + name)
+	}
+	return strings.ToLower(name)
+}
@@ -0,0 +1,133 @@
+package sqlmapper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSchemas(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  MergeOptions
+		schemas  []*Schema
+		wantErr  string
+		validate func(*testing.T, *Schema)
+	}{
+		{
+			name: "Clean merge",
+			schemas: []*Schema{
+				{Name: "shop", Tables: []Table{{Name: "users"}}},
+				{Tables: []Table{{Name: "orders"}}, Views: []View{{Name: "active_users"}}},
+				nil,
+				{Functions: []Function{{Name: "total"}}, Procedures: []Procedure{{Name: "cleanup"}}},
+			},
+			validate: func(t *testing.T, schema *Schema) {
+				assert.Equal(t, "shop", schema.Name)
+				assert.Len(t, schema.Tables, 2)
+				assert.Equal(t, "users", schema.Tables[0].Name)
+				assert.Equal(t, "orders", schema.Tables[1].Name)
+				assert.Len(t, schema.Views, 1)
+				assert.Len(t, schema.Functions, 1)
+				assert.Len(t, schema.Procedures, 1)
+			},
+		},
+		{
+			name: "Same name in different schemas",
+			schemas: []*Schema{
+				{Tables: []Table{{Schema: "sales", Name: "users"}}},
+				{Tables: []Table{{Schema: "hr", Name: "users"}}},
+			},
+			validate: func(t *testing.T, schema *Schema) {
+				assert.Len(t, schema.Tables, 2)
+			},
+		},
+		{
+			name: "Conflicting names",
+			schemas: []*Schema{
+				{Tables: []Table{{Name: "users"}}, Views: []View{{Name: "v_users"}}},
+				{Tables: []Table{{Name: "USERS"}}, Views: []View{{Name: "v_users"}}},
+			},
+			wantErr: "merge conflicts: table users; view v_users",
+		},
+		{
+			name:    "Later definitions override earlier ones",
+			options: MergeOptions{Override: true},
+			schemas: []*Schema{
+				{Tables: []Table{{Name: "users", Comment: "old"}, {Name: "orders"}}},
+				{Tables: []Table{{Name: "users", Comment: "new"}}},
+			},
+			validate: func(t *testing.T, schema *Schema) {
+				assert.Len(t, schema.Tables, 2)
+				assert.Equal(t, "users", schema.Tables[0].Name)
+				assert.Equal(t, "new", schema.Tables[0].Comment)
+			},
+		},
+		{
+			name: "Drops and session settings",
+			schemas: []*Schema{
+				{
+					Drops:    []Drop{{Type: "TABLE", Name: "users", IfExists: true}},
+					Settings: []SessionSetting{{Name: "NAMES", Value: "utf8mb4"}, {Name: "FOREIGN_KEY_CHECKS", Value: "0"}},
+				},
+				{
+					Drops:    []Drop{{Type: "VIEW", Name: "v_users"}},
+					Settings: []SessionSetting{{Name: "FOREIGN_KEY_CHECKS", Value: "1"}},
+				},
+			},
+			validate: func(t *testing.T, schema *Schema) {
+				assert.Equal(t, []Drop{{Type: "TABLE", Name: "users", IfExists: true}, {Type: "VIEW", Name: "v_users"}}, schema.Drops)
+				assert.Equal(t, []SessionSetting{
+					{Name: "NAMES", Value: "utf8mb4"},
+					{Name: "FOREIGN_KEY_CHECKS", Value: "0"},
+					{Name: "FOREIGN_KEY_CHECKS", Value: "1"},
+				}, schema.Settings)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := MergeSchemasWithOptions(tt.options, tt.schemas...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, schema)
+
+				var validationErr *ValidationError
+				if assert.True(t, errors.As(err, &validationErr)) {
+					assert.Equal(t, "merge conflicts", validationErr.Reason)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.validate(t, schema)
+		})
+	}
+}
+
+func TestMergeSchemas_Copies(t *testing.T) {
+	users := &Schema{
+		Tables: []Table{{
+			Name:        "users",
+			Columns:     []Column{{Name: "id", DataType: "INT"}},
+			Indexes:     []Index{{Name: "idx_id", Columns: IndexColumns("id")}},
+			Constraints: []Constraint{{Type: "PRIMARY KEY", Columns: []string{"id"}}},
+		}},
+		Triggers: []Trigger{{Name: "audit", Events: []string{"INSERT"}}},
+	}
+
+	merged, err := MergeSchemas(users, &Schema{Tables: []Table{{Name: "orders"}}})
+	assert.NoError(t, err)
+
+	merged.Tables[0].Columns[0].Name = "user_id"
+	merged.Tables[0].Indexes[0].Columns[0].Name = "user_id"
+	merged.Tables[0].Constraints[0].Columns[0] = "user_id"
+	merged.Triggers[0].Events[0] = "UPDATE"
+
+	assert.Equal(t, "id", users.Tables[0].Columns[0].Name)
+	assert.Equal(t, "id", users.Tables[0].Indexes[0].Columns[0].Name)
+	assert.Equal(t, []string{"id"}, users.Tables[0].Constraints[0].Columns)
+	assert.Equal(t, []string{"INSERT"}, users.Triggers[0].Events)
+}
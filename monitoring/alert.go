@@ -56,7 +56,7 @@ func (a *AlertManager) CheckThresholds() error {
 			"current_rate": a.metrics.ErrorRate(),
 			"threshold":    a.config.Threshold.ErrorRate,
 		}); err != nil {
-			return fmt.Errorf("failed to send error rate alert: %v", err)
+			return fmt.Errorf("failed to send error rate alert: %w", err)
 		}
 	}
 
@@ -66,7 +66,7 @@ func (a *AlertManager) CheckThresholds() error {
 			"current_time": avgTime,
 			"threshold":    a.config.Threshold.ProcessingTime,
 		}); err != nil {
-			return fmt.Errorf("failed to send processing time alert: %v", err)
+			return fmt.Errorf("failed to send processing time alert: %w", err)
 		}
 	}
 
@@ -77,7 +77,7 @@ func (a *AlertManager) CheckThresholds() error {
 			"current_usage": memUsage,
 			"threshold":     a.config.Threshold.MemoryUsage,
 		}); err != nil {
-			return fmt.Errorf("failed to send memory usage alert: %v", err)
+			return fmt.Errorf("failed to send memory usage alert: %w", err)
 		}
 	}
 
@@ -94,7 +94,7 @@ func (a *AlertManager) sendAlert(message string, data map[string]interface{}) er
 
 	for _, channel := range a.config.Notifications {
 		if err := a.sendNotification(channel, message, data); err != nil {
-			return fmt.Errorf("failed to send notification via %s: %v", channel.Type, err)
+			return fmt.Errorf("failed to send notification via %s: %w", channel.Type, err)
 		}
 	}
 
@@ -51,7 +51,7 @@ type Logger struct {
 func NewLogger(config LogConfig) (*Logger, error) {
 	// Create output directory if it doesn't exist
 	if err := os.MkdirAll(filepath.Dir(config.OutputPath), 0755); err != nil {
-		return nil, fmt.Errorf("failed to create log directory: %v", err)
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
 	}
 
 	// Configure main log output
@@ -3,7 +3,6 @@
 package mysql
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -11,21 +10,139 @@ import (
 	"github.com/mstgnz/sqlmapper"
 )
 
+// Expressions used for every parsed statement are compiled once, since compiling
+// them per statement dominated the allocations of stream parsing
+var (
+	whitespaceRe = regexp.MustCompile(`\s+`)
+	backtickRe   = regexp.MustCompile("`(\\w+)`")
+	// delimiterRe matches a DELIMITER directive on a line of its own, capturing the
+	// delimiter it selects
+	delimiterRe = regexp.MustCompile(`(?i)^\s*DELIMITER\s+(\S+)\s*$`)
+	// versionedSetRe matches a SET statement in a version comment, capturing the statement
+	versionedSetRe = regexp.MustCompile(`(?is)/\*!\d*\s*(SET\s[^*]*?)\s*\*/`)
+	// useRe matches a USE statement of normalized content, capturing the database
+	useRe         = regexp.MustCompile(`(?i)\bUSE\s+(\w+)\s*;`)
+	ctasRe        = regexp.MustCompile(`(?i)CREATE\s+(?:(?:GLOBAL|LOCAL)\s+)?(?:(?:TEMPORARY|TEMP)\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?[^\s(;]+\s*(?:\([^();]*\)\s*)?(?:AS\s+)?\(?\s*(?:SELECT|WITH)\b[^;]*;`)
+	createTableRe = regexp.MustCompile(`CREATE\s+(?:TEMPORARY\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\((.*?)\)((?:[^;()']|'(?:[^']|'')*')*);`)
+	temporaryRe   = regexp.MustCompile(`(?i)^CREATE\s+TEMPORARY\s`)
+	quotedRe      = regexp.MustCompile(`'((?:[^']|'')*)'`)
+	// currentTimestampRe matches a CURRENT_TIMESTAMP default with its optional
+	// fractional-second precision, and onUpdateRe the ON UPDATE clause of a column
+	currentTimestampRe = regexp.MustCompile(`(?i)^CURRENT_TIMESTAMP(?:\s*\(\s*\d*\s*\))?`)
+	onUpdateRe         = regexp.MustCompile(`(?i)\bON\s+UPDATE\s+((?:CURRENT_TIMESTAMP|NOW)(?:\s*\(\s*\d*\s*\))?)`)
+	// tableConstraintRe matches a constraint declared at table level
+	tableConstraintRe = regexp.MustCompile(`(?i)^(?:CONSTRAINT\s|PRIMARY\s+KEY\b|FOREIGN\s+KEY\b|UNIQUE\b|CHECK\b)`)
+	// tableIndexRe matches an index declared at table level, such as FULLTEXT KEY ft_bio (bio)
+	tableIndexRe = regexp.MustCompile(`(?i)^(?:(FULLTEXT|SPATIAL)\s+)?(?:KEY|INDEX)\s+(?:(\w+)\s*)?\((.*)\)(?:\s+(INVISIBLE|VISIBLE))?$`)
+	// uniqueKeyRe matches the name and columns of a unique key, such as UNIQUE KEY uq (email(20))
+	uniqueKeyRe = regexp.MustCompile(`(?i)UNIQUE(?:\s+(?:KEY|INDEX))?(?:\s+(\w+))?\s*\(((?:[^()]|\([^()]*\))*)\)`)
+	// typeArgumentsRe matches the arguments of a data type, such as the 10 of
+	// varchar(10), which tell a column named key or index from an index declaration
+	// once normalizeContent has unquoted its name
+	typeArgumentsRe = regexp.MustCompile(`^\s*(?:\d+|'(?:[^']|'')*')(?:\s*,\s*(?:\d+|'(?:[^']|'')*'))*\s*$`)
+	// plsqlBlockRe matches a PL/SQL block without declarations, the body of an Oracle trigger
+	plsqlBlockRe = regexp.MustCompile(`(?is)^BEGIN\b(.*)\bEND\s*;?$`)
+	// rowAssignmentRe matches a PL/SQL assignment to a column of the row of a trigger
+	rowAssignmentRe = regexp.MustCompile(`(?im)^(\s*)((?:NEW|OLD)\.\w+)\s*:=\s*`)
+	// plsqlRe matches PL/SQL constructs MySQL does not support
+	plsqlRe = regexp.MustCompile(`(?i):=|^\s*DECLARE\b|\bELSIF\b|\bRAISE_APPLICATION_ERROR\b|\bDBMS_\w+`)
+	// columnCharsetRe and columnCollateRe match the character set and the collation of
+	// a column definition
+	columnCharsetRe = regexp.MustCompile(`(?i)\b(?:CHARACTER\s+SET|CHARSET)\s+['"` + "`" + `]?(\w+)`)
+	columnCollateRe = regexp.MustCompile(`(?i)\bCOLLATE\s+['"` + "`" + `]?(\w+)`)
+	// tableCommentRe matches the COMMENT option among the options of a table
+	tableCommentRe = regexp.MustCompile(`(?i)\bCOMMENT\s*=?\s*'((?:[^']|'')*)'`)
+)
+
+// temporalTypes map temporal types of other dialects to MySQL, which stores
+// fractional seconds up to microseconds
+var temporalTypes = map[string]string{
+	"DATETIME2":      "DATETIME",
+	"DATETIMEOFFSET": "DATETIME",
+	"TIMESTAMPTZ":    "TIMESTAMP",
+	"TIMETZ":         "TIME",
+}
+
+// maxFractionalSeconds is the largest fractional-second precision of MySQL
+const maxFractionalSeconds = 6
+
+// checkRules translate the operators and functions of other dialects in CHECK and
+// generated column expressions. The regular expression matches of PostgreSQL become
+// REGEXP, which is case insensitive unless the column has a binary or case-sensitive
+// collation.
+var checkRules = sqlmapper.ExpressionRules{
+	Operators: map[string]string{
+		"~":         "REGEXP",
+		"~*":        "REGEXP",
+		"!~":        "NOT REGEXP",
+		"!~*":       "NOT REGEXP",
+		"ILIKE":     "LIKE",
+		"NOT ILIKE": "NOT LIKE",
+	},
+	Functions: map[string]string{
+		"nvl": "IFNULL",
+		"len": "CHAR_LENGTH",
+	},
+	Unsupported: []string{"SIMILAR", "NOT SIMILAR"},
+}
+
+// castTypes map the types of PostgreSQL casts to the types MySQL can cast to
+var castTypes = map[string]string{
+	"json":                     "JSON",
+	"jsonb":                    "JSON",
+	"text":                     "CHAR",
+	"character varying":        "CHAR",
+	"varchar":                  "CHAR",
+	"smallint":                 "SIGNED",
+	"integer":                  "SIGNED",
+	"int":                      "SIGNED",
+	"int4":                     "SIGNED",
+	"bigint":                   "SIGNED",
+	"int8":                     "SIGNED",
+	"numeric":                  "DECIMAL",
+	"timestamp":                "DATETIME",
+	"timestamptz":              "DATETIME",
+	"timestamp with time zone": "DATETIME",
+	"double precision":         "DOUBLE",
+}
+
+// capabilities are the features of MySQL the generator falls back on
+var capabilities = sqlmapper.Capabilities(sqlmapper.MySQL)
+
+// sessionStatements wrap the generated output
+var sessionStatements = sqlmapper.SessionStatements{
+	Begin:                   "START TRANSACTION",
+	Commit:                  "COMMIT",
+	DisableForeignKeyChecks: "SET FOREIGN_KEY_CHECKS=0",
+	EnableForeignKeyChecks:  "SET FOREIGN_KEY_CHECKS=1",
+}
+
 // MySQL represents a MySQL parser implementation that handles parsing and generating
 // MySQL database schemas. It maintains an internal schema representation and provides
 // methods for converting between MySQL SQL and the common schema format.
 type MySQL struct {
-	schema *sqlmapper.Schema
+	schema  *sqlmapper.Schema
+	options sqlmapper.GenerateOptions
 }
 
 // NewMySQL creates and initializes a new MySQL parser instance.
 // It returns a parser that can handle MySQL specific SQL syntax and schema structures.
-func NewMySQL() sqlmapper.Database {
+func NewMySQL() sqlmapper.Dialect {
 	return &MySQL{
 		schema: &sqlmapper.Schema{},
 	}
 }
 
+// SetGenerateOptions configures optional output of the generator
+func (m *MySQL) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	m.options = options
+}
+
+// Type returns the database type of the dialect, sqlmapper.MySQL
+func (m *MySQL) Type() sqlmapper.DatabaseType {
+	return sqlmapper.MySQL
+}
+
 // Parse takes a MySQL SQL dump content and parses it into a common schema structure.
 // It processes various MySQL objects including:
 // - Databases and schemas
@@ -44,7 +161,7 @@ func NewMySQL() sqlmapper.Database {
 //   - error: An error if parsing fails
 func (m *MySQL) Parse(content string) (*sqlmapper.Schema, error) {
 	if content == "" {
-		return nil, errors.New("empty content")
+		return nil, &sqlmapper.ValidationError{Problems: []string{"empty content"}}
 	}
 
 	// Normalize content
@@ -52,34 +169,99 @@ func (m *MySQL) Parse(content string) (*sqlmapper.Schema, error) {
 
 	// Parse schema objects
 	if err := m.parseSchemas(content); err != nil {
-		return nil, fmt.Errorf("error parsing schemas: %v", err)
+		return nil, fmt.Errorf("error parsing schemas: %w", err)
+	}
+
+	// Objects following a USE statement belong to the database it selects
+	start, database := 0, ""
+	for _, loc := range useRe.FindAllStringSubmatchIndex(content, -1) {
+		if before := strings.TrimSpace(content[:loc[0]]); before != "" && !strings.HasSuffix(before, ";") {
+			continue
+		}
+		if err := m.parseDatabase(content[start:loc[0]], database); err != nil {
+			return nil, err
+		}
+		start, database = loc[1], content[loc[2]:loc[3]]
+	}
+	if err := m.parseDatabase(content[start:], database); err != nil {
+		return nil, err
 	}
 
+	return m.schema, nil
+}
+
+// parseDatabase parses the objects of a part of a dump selecting a database with USE.
+// Objects not naming their schema are placed in the database, which is empty for
+// the objects preceding the first USE statement.
+func (m *MySQL) parseDatabase(content, database string) error {
+	if database == "" {
+		return m.parseObjects(content)
+	}
+
+	// Parse separately, so that ALTER TABLE statements only find the tables of the database
+	scoped := &MySQL{schema: &sqlmapper.Schema{}, options: m.options}
+	if err := scoped.parseObjects(content); err != nil {
+		return err
+	}
+	scoped.schema.SetDefaultSchema(database)
+
+	m.schema.Tables = append(m.schema.Tables, scoped.schema.Tables...)
+	m.schema.Views = append(m.schema.Views, scoped.schema.Views...)
+	m.schema.Functions = append(m.schema.Functions, scoped.schema.Functions...)
+	m.schema.Triggers = append(m.schema.Triggers, scoped.schema.Triggers...)
+	m.schema.Permissions = append(m.schema.Permissions, scoped.schema.Permissions...)
+	m.schema.Drops = append(m.schema.Drops, scoped.schema.Drops...)
+	m.schema.Settings = append(m.schema.Settings, scoped.schema.Settings...)
+	return nil
+}
+
+// parseObjects parses the tables, views, routines, triggers, permissions, drops and
+// session settings of normalized content
+func (m *MySQL) parseObjects(content string) error {
 	if err := m.parseTables(content); err != nil {
-		return nil, fmt.Errorf("error parsing tables: %v", err)
+		return fmt.Errorf("error parsing tables: %w", err)
+	}
+
+	if err := m.parseAlterColumns(content); err != nil {
+		return fmt.Errorf("error parsing altered columns: %w", err)
 	}
 
 	if err := m.parseIndexes(content); err != nil {
-		return nil, fmt.Errorf("error parsing indexes: %v", err)
+		return fmt.Errorf("error parsing indexes: %w", err)
 	}
 
+	// Remove the constraints and indexes dropped by ALTER TABLE
+	m.schema.ApplyAlterDrops(content)
+
 	if err := m.parseViews(content); err != nil {
-		return nil, fmt.Errorf("error parsing views: %v", err)
+		return fmt.Errorf("error parsing views: %w", err)
 	}
 
 	if err := m.parseFunctions(content); err != nil {
-		return nil, fmt.Errorf("error parsing functions: %v", err)
+		return fmt.Errorf("error parsing functions: %w", err)
 	}
 
 	if err := m.parseTriggers(content); err != nil {
-		return nil, fmt.Errorf("error parsing triggers: %v", err)
+		return fmt.Errorf("error parsing triggers: %w", err)
 	}
 
 	if err := m.parsePermissions(content); err != nil {
-		return nil, fmt.Errorf("error parsing permissions: %v", err)
+		return fmt.Errorf("error parsing permissions: %w", err)
 	}
 
-	return m.schema, nil
+	for _, statement := range sqlmapper.SplitMySQLStatements(content) {
+		if drop, ok := sqlmapper.ParseDrop(statement.Text); ok {
+			m.schema.Drops = append(m.schema.Drops, *drop)
+		}
+		if statement.Kind != sqlmapper.SetStatement {
+			continue
+		}
+		if settings, ok := sqlmapper.ParseSetStatement(statement.Text); ok {
+			m.schema.Settings = append(m.schema.Settings, settings...)
+		}
+	}
+
+	return nil
 }
 
 // Generate creates a MySQL SQL dump from a schema structure.
@@ -98,15 +280,40 @@ func (m *MySQL) Parse(content string) (*sqlmapper.Schema, error) {
 //   - error: An error if generation fails
 func (m *MySQL) Generate(schema *sqlmapper.Schema) (string, error) {
 	if schema == nil {
-		return "", errors.New("empty schema")
+		return "", &sqlmapper.ValidationError{Problems: []string{"empty schema"}}
 	}
 
 	var result strings.Builder
 
+	// Write session settings, disable foreign key checks and open the transaction
+	if m.options.Transaction && !capabilities.TransactionalDDL {
+		m.options.Warnf("MySQL commits DDL statements implicitly, the transaction does not make the script atomic")
+	}
+	for _, stmt := range m.options.Prologue(sessionStatements) {
+		result.WriteString(stmt + ";\n")
+	}
+
+	// Generate drops
+	for _, drop := range schema.Drops {
+		result.WriteString(m.generateDropSQL(drop) + ";\n")
+	}
+	if len(schema.Drops) > 0 {
+		result.WriteString("\n")
+	}
+
 	// Generate table creation
-	for i, table := range schema.Tables {
+	tables := m.options.WithoutPartitions(schema.Tables)
+	for i, table := range tables {
 		result.WriteString(m.generateTableSQL(table))
-		if i < len(schema.Tables)-1 {
+
+		// Columns with a position hint were added by ALTER TABLE
+		for _, column := range table.Columns {
+			if column.First || column.After != "" {
+				result.WriteString("\n" + m.generateAddColumnSQL(table.Name, column))
+			}
+		}
+
+		if i < len(tables)-1 {
 			result.WriteString("\n\n")
 		}
 
@@ -122,7 +329,30 @@ func (m *MySQL) Generate(schema *sqlmapper.Schema) (string, error) {
 		}
 	}
 
-	return result.String(), nil
+	// Generate triggers, one per event
+	for _, trigger := range sqlmapper.SplitTriggerEvents(schema.Triggers, m.options) {
+		if stmt, ok := m.generateTriggerSQL(trigger); ok {
+			result.WriteString("\n\n" + stmt + ";")
+		}
+	}
+
+	// Generate permissions
+	if m.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := m.generatePermissionSQL(permission); ok {
+				result.WriteString("\n" + stmt + ";")
+			}
+		}
+	}
+
+	m.options.WarnSkipped(schema, sqlmapper.TriggerObjects)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range m.options.Epilogue(sessionStatements) {
+		result.WriteString("\n" + stmt + ";")
+	}
+
+	return m.options.Format.Apply(result.String()), nil
 }
 
 // normalizeContent preprocesses the SQL content by removing comments and normalizing whitespace.
@@ -134,20 +364,48 @@ func (m *MySQL) Generate(schema *sqlmapper.Schema) (string, error) {
 // Returns:
 //   - string: The normalized SQL content
 func (m *MySQL) normalizeContent(content string) string {
-	// Remove comments
-	re := regexp.MustCompile(`--.*$|#.*$`)
-	content = re.ReplaceAllString(content, "")
+	// Keep the SET statements mysqldump wraps in version comments, e.g.
+	// /*!40101 SET NAMES utf8mb4 */
+	content = versionedSetRe.ReplaceAllString(content, "$1")
+
+	// Remove comments, including those between column definitions
+	content = sqlmapper.StripMySQLComments(content)
+
+	// Remove DELIMITER statements, ending the statements they delimit with semicolons
+	content = replaceDelimiters(content)
 
-	// Remove DELIMITER statements
-	content = regexp.MustCompile(`DELIMITER\s+[^\s]+`).ReplaceAllString(content, "")
+	// Unquote identifiers, as mysqldump quotes every name
+	content = backtickRe.ReplaceAllString(content, "$1")
 
 	// Normalize whitespace
 	content = strings.TrimSpace(content)
-	content = regexp.MustCompile(`\s+`).ReplaceAllString(content, " ")
+	content = whitespaceRe.ReplaceAllString(content, " ")
 
 	return content
 }
 
+// replaceDelimiters removes the DELIMITER directives of a dump and replaces the
+// delimiter they select at the end of a line, such as the $$ of END$$ or the // of
+// END //, with a semicolon
+func replaceDelimiters(content string) string {
+	if !strings.Contains(content, "DELIMITER") && !strings.Contains(content, "delimiter") {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+	delimiter := ";"
+	for i, line := range lines {
+		if match := delimiterRe.FindStringSubmatch(line); match != nil {
+			delimiter, lines[i] = match[1], ""
+			continue
+		}
+		if trimmed := strings.TrimRight(line, " \t\r"); delimiter != ";" && strings.HasSuffix(trimmed, delimiter) {
+			lines[i] = strings.TrimSuffix(trimmed, delimiter) + ";"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // parseSchemas extracts database definitions from the SQL content.
 // It handles CREATE DATABASE and USE statements.
 //
@@ -176,15 +434,24 @@ func (m *MySQL) parseSchemas(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (m *MySQL) parseTables(content string) error {
-	re := regexp.MustCompile(`CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\((.*?)\)(?:\s+ENGINE\s*=\s*\w+)?(?:\s+DEFAULT\s+CHARSET\s*=\s*\w+)?(?:\s+COLLATE\s*=\s*\w+)?;`)
-	matches := re.FindAllStringSubmatch(content, -1)
+	// Parse CREATE TABLE ... AS SELECT statements
+	for _, statement := range ctasRe.FindAllString(content, -1) {
+		if table, ok := sqlmapper.ParseCreateTableAs(statement); ok {
+			m.schema.Tables = append(m.schema.Tables, *table)
+		}
+	}
+
+	matches := createTableRe.FindAllStringSubmatch(content, -1)
 
 	for _, match := range matches {
 		if len(match) > 2 {
 			tableName := match[1]
 			columnDefs := match[2]
 
-			table := sqlmapper.Table{}
+			table := sqlmapper.Table{
+				Temporary:   temporaryRe.MatchString(match[0]),
+				IfNotExists: sqlmapper.HasIfNotExists(match[0]),
+			}
 
 			// Parse schema if exists
 			parts := strings.Split(tableName, ".")
@@ -195,28 +462,39 @@ func (m *MySQL) parseTables(content string) error {
 				table.Name = tableName
 			}
 
+			// Table options such as ENGINE or ROW_FORMAT are kept as written, the
+			// comment is kept separately so it can be set by ALTER TABLE as well
+			options := match[3]
+			if commentMatch := tableCommentRe.FindStringSubmatch(options); commentMatch != nil {
+				table.Comment = strings.ReplaceAll(commentMatch[1], "''", "'")
+				options = strings.Replace(options, commentMatch[0], "", 1)
+			}
+			table.Options = strings.Join(strings.Fields(options), " ")
+
 			// Parse columns and constraints
 			if err := m.parseColumnsAndConstraints(columnDefs, &table); err != nil {
-				return err
-			}
-
-			// Parse table comment
-			tableCommentRe := regexp.MustCompile(`ALTER\s+TABLE\s+` + regexp.QuoteMeta(tableName) + `\s+COMMENT\s*=\s*'([^']+)';`)
-			if tableCommentMatch := tableCommentRe.FindStringSubmatch(content); len(tableCommentMatch) > 1 {
-				table.Comment = tableCommentMatch[1]
-			}
-
-			// Parse column comments
-			commentRe := regexp.MustCompile(`ALTER\s+TABLE\s+` + regexp.QuoteMeta(tableName) + `\s+MODIFY\s+COLUMN\s+(\w+)[^']+COMMENT\s*'([^']+)';`)
-			commentMatches := commentRe.FindAllStringSubmatch(content, -1)
-			for _, commentMatch := range commentMatches {
-				if len(commentMatch) > 2 {
-					columnName := commentMatch[1]
-					comment := commentMatch[2]
-					for i := range table.Columns {
-						if table.Columns[i].Name == columnName {
-							table.Columns[i].Comment = comment
-							break
+				return sqlmapper.ObjectError("table", tableName, match[0], err)
+			}
+
+			// Parse table and column comments set by ALTER TABLE statements
+			if strings.Contains(content, "COMMENT") {
+				tableCommentRe := regexp.MustCompile(`ALTER\s+TABLE\s+` + regexp.QuoteMeta(tableName) + `\s+COMMENT\s*=\s*'([^']+)';`)
+				if tableCommentMatch := tableCommentRe.FindStringSubmatch(content); len(tableCommentMatch) > 1 {
+					table.Comment = tableCommentMatch[1]
+				}
+
+				// Parse column comments
+				columnCommentRe := regexp.MustCompile(`ALTER\s+TABLE\s+` + regexp.QuoteMeta(tableName) + `\s+MODIFY\s+COLUMN\s+(\w+)[^']+COMMENT\s*'([^']+)';`)
+				commentMatches := columnCommentRe.FindAllStringSubmatch(content, -1)
+				for _, commentMatch := range commentMatches {
+					if len(commentMatch) > 2 {
+						columnName := commentMatch[1]
+						comment := commentMatch[2]
+						for i := range table.Columns {
+							if table.Columns[i].Name == columnName {
+								table.Columns[i].Comment = comment
+								break
+							}
 						}
 					}
 				}
@@ -234,6 +512,175 @@ func (m *MySQL) parseTables(content string) error {
 	return nil
 }
 
+// parseAlterColumns processes the ALTER TABLE ... ADD COLUMN and CHANGE COLUMN
+// statements for tables defined in the SQL content. Added columns are inserted at the
+// position given by a FIRST or AFTER clause, which is kept on the column so it can be
+// regenerated. Changed columns are renamed and redefined in place, or moved to the
+// position given by FIRST or AFTER.
+//
+// Parameters:
+//   - content: The SQL content to parse
+//
+// Returns:
+//   - error: An error if parsing fails
+func (m *MySQL) parseAlterColumns(content string) error {
+	alterRe := regexp.MustCompile(`(?i)ALTER\s+TABLE\s+([.\w]+)\s+([^;]+);`)
+	positionRe := regexp.MustCompile(`(?i)\s+(?:(FIRST)|AFTER\s+` + "`?" + `(\w+)` + "`?" + `)$`)
+	constraintRe := regexp.MustCompile(`(?i)^(?:CONSTRAINT|PRIMARY|FOREIGN|UNIQUE|INDEX|KEY|FULLTEXT|SPATIAL|CHECK)\b`)
+
+	for _, match := range alterRe.FindAllStringSubmatch(content, -1) {
+		tableIndex := -1
+		for i, table := range m.schema.Tables {
+			if table.Name == match[1] || fmt.Sprintf("%s.%s", table.Schema, table.Name) == match[1] {
+				tableIndex = i
+				break
+			}
+		}
+		if tableIndex == -1 {
+			continue
+		}
+		table := &m.schema.Tables[tableIndex]
+
+		// Split the alterations, keeping commas inside parentheses
+		var clauses []string
+		var current strings.Builder
+		parenCount := 0
+		for _, part := range strings.Split(match[2], ",") {
+			parenCount += strings.Count(part, "(") - strings.Count(part, ")")
+			current.WriteString(part)
+			if parenCount > 0 {
+				current.WriteString(",")
+				continue
+			}
+			clauses = append(clauses, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+
+		for _, clause := range clauses {
+			fields := strings.Fields(clause)
+			if len(fields) >= 3 && strings.EqualFold(fields[0], "CHANGE") {
+				if err := m.changeColumn(table, clause, positionRe); err != nil {
+					return sqlmapper.ObjectError("table", match[1], match[0], err)
+				}
+				continue
+			}
+			if len(fields) < 2 || !strings.EqualFold(fields[0], "ADD") {
+				continue
+			}
+
+			def := strings.TrimSpace(clause[len(fields[0]):])
+			if strings.EqualFold(fields[1], "COLUMN") {
+				def = strings.TrimSpace(def[len(fields[1]):])
+			}
+			if constraintRe.MatchString(def) {
+				continue
+			}
+
+			var first bool
+			var after string
+			if position := positionRe.FindStringSubmatch(def); position != nil {
+				first = position[1] != ""
+				after = position[2]
+				def = def[:len(def)-len(position[0])]
+			}
+
+			column, err := m.parseColumn(def)
+			if err != nil {
+				err = sqlmapper.ColumnError(def, err)
+				return sqlmapper.ObjectError("table", match[1], match[0], err)
+			}
+			column.First = first
+			column.After = after
+
+			// Insert the column at the requested position
+			pos := len(table.Columns)
+			if first {
+				pos = 0
+			}
+			for i, existing := range table.Columns {
+				if after != "" && strings.EqualFold(existing.Name, after) {
+					pos = i + 1
+					break
+				}
+			}
+			table.Columns = append(table.Columns[:pos], append([]sqlmapper.Column{column}, table.Columns[pos:]...)...)
+		}
+
+		// Set column order
+		for i := range table.Columns {
+			table.Columns[i].Order = i + 1
+		}
+	}
+
+	return nil
+}
+
+// changeColumn applies a CHANGE [COLUMN] old_name new_name definition clause, which
+// renames and redefines a column at once. The indexes, constraints and foreign keys
+// referring to the column are renamed with it. Clauses changing a column the table does not have
+// are ignored.
+func (m *MySQL) changeColumn(table *sqlmapper.Table, clause string, positionRe *regexp.Regexp) error {
+	def := strings.TrimSpace(clause[len("CHANGE"):])
+	if fields := strings.Fields(def); len(fields) > 2 && strings.EqualFold(fields[0], "COLUMN") {
+		def = strings.TrimSpace(def[len(fields[0]):])
+	}
+	oldName := strings.Trim(strings.Fields(def)[0], "`")
+	def = strings.TrimSpace(def[len(strings.Fields(def)[0]):])
+
+	var first bool
+	var after string
+	if position := positionRe.FindStringSubmatch(def); position != nil {
+		first = position[1] != ""
+		after = position[2]
+		def = def[:len(def)-len(position[0])]
+	}
+
+	column, err := m.parseColumn(def)
+	if err != nil {
+		return sqlmapper.ColumnError(def, err)
+	}
+
+	index := -1
+	for i, existing := range table.Columns {
+		if strings.EqualFold(existing.Name, oldName) {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return nil
+	}
+
+	if !strings.EqualFold(oldName, column.Name) {
+		if err := sqlmapper.RenameColumn(table.Name, oldName, column.Name).Apply(m.schema); err != nil {
+			return err
+		}
+	}
+
+	// A column added by ALTER TABLE keeps the position it was added at
+	column.First = table.Columns[index].First
+	column.After = table.Columns[index].After
+	table.Columns[index] = column
+	if !first && after == "" {
+		return nil
+	}
+
+	// Move the column to the requested position
+	columns := append(table.Columns[:index:index], table.Columns[index+1:]...)
+	pos := len(columns)
+	if first {
+		pos = 0
+	}
+	for i, existing := range columns {
+		if after != "" && strings.EqualFold(existing.Name, after) {
+			pos = i + 1
+			break
+		}
+	}
+	table.Columns = append(columns[:pos:pos], append([]sqlmapper.Column{column}, columns[pos:]...)...)
+	return nil
+}
+
 // parseColumnsAndConstraints processes column and constraint definitions within a table.
 // It handles various column attributes and both inline and table-level constraints.
 //
@@ -244,83 +691,87 @@ func (m *MySQL) parseTables(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (m *MySQL) parseColumnsAndConstraints(columnDefs string, table *sqlmapper.Table) error {
-	// Split column definitions
-	defs := strings.Split(columnDefs, ",")
-	var currentDef strings.Builder
-	var finalDefs []string
-
-	// Handle nested parentheses in CHECK constraints
-	parenCount := 0
-	for _, def := range defs {
-		parenCount += strings.Count(def, "(") - strings.Count(def, ")")
-		if parenCount > 0 {
-			currentDef.WriteString(def + ",")
-		} else {
-			if currentDef.Len() > 0 {
-				currentDef.WriteString(def)
-				finalDefs = append(finalDefs, currentDef.String())
-				currentDef.Reset()
-			} else {
-				finalDefs = append(finalDefs, def)
+	for _, def := range sqlmapper.SplitDefinitions(columnDefs) {
+		// Parse indexes declared at table level
+		if match := tableIndexRe.FindStringSubmatch(def); match != nil && !typeArgumentsRe.MatchString(match[3]) {
+			columns := sqlmapper.SplitIndexColumns(match[3])
+			name := match[2]
+			if name == "" && len(columns) > 0 {
+				// MySQL names an unnamed index after its first column
+				name = columns[0].Name
 			}
-		}
-	}
-
-	for _, def := range finalDefs {
-		def = strings.TrimSpace(def)
-
-		// Skip empty definitions
-		if def == "" {
+			table.Indexes = append(table.Indexes, sqlmapper.Index{
+				Name:      name,
+				Columns:   columns,
+				Kind:      sqlmapper.ParseIndexKind(match[1]),
+				Invisible: strings.EqualFold(match[4], "INVISIBLE"),
+			})
 			continue
 		}
 
-		// Parse constraints
-		if strings.HasPrefix(strings.ToUpper(def), "CONSTRAINT") ||
-			(strings.Contains(strings.ToUpper(def), "PRIMARY KEY") && !strings.Contains(strings.ToUpper(def), "AUTO_INCREMENT")) ||
-			strings.Contains(strings.ToUpper(def), "FOREIGN KEY") ||
-			(strings.Contains(strings.ToUpper(def), "UNIQUE") && !strings.Contains(strings.ToUpper(def), " ")) ||
-			(strings.Contains(strings.ToUpper(def), "CHECK") && !strings.Contains(strings.ToUpper(def), " ")) {
+		// Parse constraints. Table constraints start with their keyword, while inline
+		// constraints such as PRIMARY KEY follow the column name and type.
+		if tableConstraintRe.MatchString(def) {
 			constraint, err := m.parseConstraint(def)
 			if err != nil {
-				return err
+				return sqlmapper.ObjectError("constraint", "", def, err)
+			}
+			if index, ok := prefixUniqueIndex(constraint, def); ok {
+				table.Indexes = append(table.Indexes, index)
+				continue
 			}
 			table.Constraints = append(table.Constraints, constraint)
 			continue
 		}
 
 		// Parse column
-		if strings.Contains(def, " ") {
-			column, err := m.parseColumn(def)
-			if err != nil {
-				return err
-			}
-			table.Columns = append(table.Columns, column)
+		column, err := m.parseColumn(def)
+		if err != nil {
+			return sqlmapper.ColumnError(def, err)
+		}
 
-			// Check for inline constraints
-			if strings.Contains(strings.ToUpper(def), "PRIMARY KEY") {
+		// Check for inline constraints
+		if strings.Contains(strings.ToUpper(def), "PRIMARY KEY") {
+			table.Constraints = append(table.Constraints, sqlmapper.Constraint{
+				Name:    column.PrimaryKeyName,
+				Type:    "PRIMARY KEY",
+				Columns: []string{column.Name},
+			})
+			column.IsPrimaryKey = true
+			column.IsNullable = false
+		}
+		if strings.Contains(strings.ToUpper(def), "UNIQUE") {
+			table.Constraints = append(table.Constraints, sqlmapper.Constraint{
+				Name:    column.UniqueName,
+				Type:    "UNIQUE",
+				Columns: []string{column.Name},
+			})
+			column.IsUnique = true
+		}
+		if strings.Contains(strings.ToUpper(def), "CHECK") {
+			if check, ok := sqlmapper.CheckExpression(def); ok {
 				table.Constraints = append(table.Constraints, sqlmapper.Constraint{
-					Type:    "PRIMARY KEY",
-					Columns: []string{column.Name},
+					Name:            column.CheckName,
+					Type:            "CHECK",
+					Columns:         []string{column.Name},
+					CheckExpression: check,
 				})
-				column.IsPrimaryKey = true
-				column.IsNullable = false
+				column.CheckExpression = check
 			}
-			if strings.Contains(strings.ToUpper(def), "UNIQUE") {
-				table.Constraints = append(table.Constraints, sqlmapper.Constraint{
-					Type:    "UNIQUE",
-					Columns: []string{column.Name},
-				})
-				column.IsUnique = true
-			}
-			if strings.Contains(strings.ToUpper(def), "CHECK") {
-				re := regexp.MustCompile(`CHECK\s*\((.*?)\)`)
-				if matches := re.FindStringSubmatch(def); len(matches) > 1 {
-					table.Constraints = append(table.Constraints, sqlmapper.Constraint{
-						Type:            "CHECK",
-						Columns:         []string{column.Name},
-						CheckExpression: matches[1],
-					})
-					column.CheckExpression = matches[1]
+		}
+		table.Columns = append(table.Columns, column)
+	}
+
+	// Columns of a primary key declared at table level, such as an AUTO_INCREMENT
+	// column, cannot be NULL
+	for _, constraint := range table.Constraints {
+		if constraint.Type != "PRIMARY KEY" {
+			continue
+		}
+		for i := range table.Columns {
+			for _, name := range constraint.Columns {
+				if strings.EqualFold(table.Columns[i].Name, name) {
+					table.Columns[i].IsNullable = false
 				}
 			}
 		}
@@ -340,7 +791,10 @@ func (m *MySQL) parseColumnsAndConstraints(columnDefs string, table *sqlmapper.T
 //   - sqlmapper.Column: The parsed column structure
 //   - error: An error if parsing fails
 func (m *MySQL) parseColumn(def string) (sqlmapper.Column, error) {
-	parts := strings.Fields(def)
+	// The members of a SET type may contain spaces and keywords
+	members, def, _ := sqlmapper.ParseSetType(def)
+
+	parts := strings.Fields(sqlmapper.CompactTypeParameters(def))
 	if len(parts) < 2 {
 		return sqlmapper.Column{}, fmt.Errorf("invalid column definition: %s", def)
 	}
@@ -349,6 +803,7 @@ func (m *MySQL) parseColumn(def string) (sqlmapper.Column, error) {
 		Name:       parts[0],
 		DataType:   parts[1],
 		IsNullable: true,
+		Members:    members,
 	}
 
 	// Handle AUTO_INCREMENT
@@ -356,33 +811,45 @@ func (m *MySQL) parseColumn(def string) (sqlmapper.Column, error) {
 		column.AutoIncrement = true
 	}
 
-	// Parse length/precision
-	if strings.Contains(column.DataType, "(") {
-		re := regexp.MustCompile(`(\w+)\((\d+)(?:,(\d+))?\)`)
-		if matches := re.FindStringSubmatch(column.DataType); len(matches) > 2 {
-			column.DataType = matches[1]
-			if len(matches[2]) > 0 {
-				fmt.Sscanf(matches[2], "%d", &column.Length)
-			}
-			if len(matches) > 3 && len(matches[3]) > 0 {
-				fmt.Sscanf(matches[3], "%d", &column.Scale)
-			}
+	// Handle UNSIGNED and ZEROFILL, which follow the data type, and INVISIBLE
+	for _, attribute := range parts[2:] {
+		switch strings.ToUpper(attribute) {
+		case "UNSIGNED":
+			column.Unsigned = true
+		case "ZEROFILL":
+			column.Zerofill = true
+			column.Unsigned = true
+		case "INVISIBLE":
+			column.Invisible = true
 		}
 	}
 
+	// Parse length/precision
+	column.DataType, column.Length, column.Scale = sqlmapper.ParseDataType(column.DataType)
+
+	// Parse the character set and the collation, either of which may be given alone
+	if match := columnCharsetRe.FindStringSubmatch(def); match != nil {
+		column.CharacterSet = match[1]
+	}
+	if match := columnCollateRe.FindStringSubmatch(def); match != nil {
+		column.Collation = match[1]
+	}
+
 	// Parse default value
 	if idx := strings.Index(strings.ToUpper(def), "DEFAULT"); idx >= 0 {
 		defaultPart := def[idx+7:]
 		defaultPart = strings.TrimSpace(defaultPart)
 
-		// Handle function calls and keywords
-		if strings.Contains(strings.ToUpper(defaultPart), "CURRENT_TIMESTAMP") {
-			column.DefaultValue = "CURRENT_TIMESTAMP"
+		// Handle expression defaults in parentheses, function calls and keywords
+		if strings.HasPrefix(defaultPart, "(") {
+			column.DefaultValue, _ = sqlmapper.DefaultExpression(def)
+		} else if match := currentTimestampRe.FindString(defaultPart); match != "" {
+			// The precision must match that of the column, e.g. DATETIME(3) DEFAULT CURRENT_TIMESTAMP(3)
+			column.DefaultValue = currentTimestamp(match)
 		} else if strings.HasPrefix(defaultPart, "'") {
 			// Handle quoted string values
-			re := regexp.MustCompile(`'([^']*)'`)
-			if matches := re.FindStringSubmatch(defaultPart); len(matches) > 1 {
-				column.DefaultValue = matches[1]
+			if matches := quotedRe.FindStringSubmatch(defaultPart); len(matches) > 1 {
+				column.DefaultValue = strings.ReplaceAll(matches[1], "''", "'")
 			}
 		} else {
 			// Handle other values
@@ -397,7 +864,12 @@ func (m *MySQL) parseColumn(def string) (sqlmapper.Column, error) {
 		}
 	}
 
-	// Parse column constraints
+	if match := onUpdateRe.FindStringSubmatch(def); match != nil {
+		column.OnUpdate = currentTimestamp(match[1])
+	}
+
+	// Parse column constraints and the names given to them
+	column.ParseConstraintNames(def)
 	if strings.Contains(strings.ToUpper(def), "PRIMARY KEY") {
 		column.IsPrimaryKey = true
 		column.IsNullable = false
@@ -406,9 +878,8 @@ func (m *MySQL) parseColumn(def string) (sqlmapper.Column, error) {
 		column.IsUnique = true
 	}
 	if strings.Contains(strings.ToUpper(def), "CHECK") {
-		re := regexp.MustCompile(`CHECK\s*\((.*?)\)`)
-		if matches := re.FindStringSubmatch(def); len(matches) > 1 {
-			column.CheckExpression = matches[1]
+		if check, ok := sqlmapper.CheckExpression(def); ok {
+			column.CheckExpression = check
 		}
 	}
 
@@ -479,24 +950,50 @@ func (m *MySQL) parseConstraint(def string) (sqlmapper.Constraint, error) {
 		}
 	} else if strings.Contains(strings.ToUpper(def), "UNIQUE") {
 		constraint.Type = "UNIQUE"
-		re := regexp.MustCompile(`UNIQUE\s*\((.*?)\)`)
-		if matches := re.FindStringSubmatch(def); len(matches) > 1 {
-			constraint.Columns = strings.Split(matches[1], ",")
-			for i := range constraint.Columns {
-				constraint.Columns[i] = strings.TrimSpace(constraint.Columns[i])
+		if matches := uniqueKeyRe.FindStringSubmatch(def); len(matches) > 2 {
+			if constraint.Name == "" {
+				constraint.Name = matches[1]
 			}
+			// Constraints have no prefix lengths, such as the 10 of name(10), which
+			// parseColumnsAndConstraints keeps by parsing such keys as unique indexes
+			constraint.Columns = sqlmapper.IndexColumnNames(sqlmapper.SplitIndexColumns(matches[2]))
 		}
 	} else if strings.Contains(strings.ToUpper(def), "CHECK") {
 		constraint.Type = "CHECK"
-		re := regexp.MustCompile(`CHECK\s*\((.*?)\)`)
-		if matches := re.FindStringSubmatch(def); len(matches) > 1 {
-			constraint.CheckExpression = matches[1]
+		if check, ok := sqlmapper.CheckExpression(def); ok {
+			constraint.CheckExpression = check
 		}
 	}
 
 	return constraint, nil
 }
 
+// prefixUniqueIndex returns a unique key on prefixes of its columns, such as
+// UNIQUE KEY uq (email(20)), as a unique index, since constraints have no prefix
+// lengths. It reports false for the other constraints.
+func prefixUniqueIndex(constraint sqlmapper.Constraint, def string) (sqlmapper.Index, bool) {
+	if constraint.Type != "UNIQUE" {
+		return sqlmapper.Index{}, false
+	}
+	matches := uniqueKeyRe.FindStringSubmatch(def)
+	if matches == nil {
+		return sqlmapper.Index{}, false
+	}
+	columns := sqlmapper.SplitIndexColumns(matches[2])
+	for _, column := range columns {
+		if column.Length == 0 {
+			continue
+		}
+		name := constraint.Name
+		if name == "" {
+			// MySQL names an unnamed index after its first column
+			name = columns[0].Name
+		}
+		return sqlmapper.Index{Name: name, Columns: columns, IsUnique: true}, true
+	}
+	return sqlmapper.Index{}, false
+}
+
 // parseIndexes extracts index definitions from the SQL content.
 // It handles various index types including PRIMARY KEY, UNIQUE,
 // FULLTEXT, and regular indexes.
@@ -507,27 +1004,25 @@ func (m *MySQL) parseConstraint(def string) (sqlmapper.Constraint, error) {
 // Returns:
 //   - error: An error if parsing fails
 func (m *MySQL) parseIndexes(content string) error {
-	re := regexp.MustCompile(`CREATE\s+(?:UNIQUE\s+)?(?:FULLTEXT\s+)?INDEX\s+(\w+)\s+ON\s+([.\w]+)\s*\((.*?)\)`)
+	re := regexp.MustCompile(`CREATE\s+(?:(UNIQUE|FULLTEXT|SPATIAL)\s+)?INDEX\s+(?:IF\s+NOT\s+EXISTS\s+)?(\w+)\s+ON\s+([.\w]+)\s*\(((?:[^()]|\([^()]*\))*)\)(\s+INVISIBLE\b)?`)
 	matches := re.FindAllStringSubmatch(content, -1)
 
 	for _, match := range matches {
-		if len(match) > 3 {
-			indexName := match[1]
-			tableName := match[2]
-			columns := strings.Split(match[3], ",")
+		if len(match) > 4 {
+			indexName := match[2]
+			tableName := match[3]
+			columns := sqlmapper.SplitIndexColumns(match[4])
 
 			// Find the table
 			for i, table := range m.schema.Tables {
 				if table.Name == tableName || fmt.Sprintf("%s.%s", table.Schema, table.Name) == tableName {
 					index := sqlmapper.Index{
-						Name:     indexName,
-						Columns:  make([]string, len(columns)),
-						IsUnique: strings.Contains(match[0], "UNIQUE"),
-					}
-
-					// Clean column names
-					for j, col := range columns {
-						index.Columns[j] = strings.TrimSpace(col)
+						Name:        indexName,
+						Columns:     columns,
+						IsUnique:    match[1] == "UNIQUE",
+						Kind:        sqlmapper.ParseIndexKind(match[1]),
+						IfNotExists: sqlmapper.HasIfNotExists(match[0]),
+						Invisible:   match[5] != "",
 					}
 
 					m.schema.Tables[i].Indexes = append(m.schema.Tables[i].Indexes, index)
@@ -549,14 +1044,15 @@ func (m *MySQL) parseIndexes(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (m *MySQL) parseViews(content string) error {
-	viewRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+VIEW\s+([.\w]+)\s+AS\s+(.*?);`)
+	viewRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?(?:\s+ALGORITHM\s*=\s*\w+)?(?:\s+DEFINER\s*=\s*\S+)?(?:\s+SQL\s+SECURITY\s+\w+)?\s+VIEW\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s+AS\s+(.*?);`)
 	viewMatches := viewRe.FindAllStringSubmatch(content, -1)
 
 	for _, match := range viewMatches {
 		if len(match) > 2 {
 			viewName := match[1]
 			view := sqlmapper.View{
-				Definition: match[2],
+				Definition:  match[2],
+				IfNotExists: sqlmapper.HasIfNotExists(match[0]),
 			}
 
 			// Parse schema if exists
@@ -586,8 +1082,8 @@ func (m *MySQL) parseViews(content string) error {
 //   - error: An error if parsing fails
 func (m *MySQL) parseFunctions(content string) error {
 	// Parse functions
-	funcRe := regexp.MustCompile(`CREATE\s+FUNCTION\s+([.\w]+)\s*\((.*?)\)\s+RETURNS\s+(\w+)\s+BEGIN\s+(.*?)\s+END`)
-	funcMatches := funcRe.FindAllStringSubmatch(content, -1)
+	funcRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?(?:\s+DEFINER\s*=\s*\S+)?\s+FUNCTION\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\((.*?)\)\s+RETURNS\s+(\w+)\s+BEGIN\b`)
+	funcMatches := sqlmapper.FindBlocks(funcRe, content)
 
 	for _, match := range funcMatches {
 		if len(match) > 4 {
@@ -626,8 +1122,8 @@ func (m *MySQL) parseFunctions(content string) error {
 	}
 
 	// Parse procedures
-	procRe := regexp.MustCompile(`CREATE\s+PROCEDURE\s+([.\w]+)\s*\((.*?)\)\s+BEGIN\s+(.*?)\s+END`)
-	procMatches := procRe.FindAllStringSubmatch(content, -1)
+	procRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?(?:\s+DEFINER\s*=\s*\S+)?\s+PROCEDURE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\((.*?)\)\s+BEGIN\b`)
+	procMatches := sqlmapper.FindBlocks(procRe, content)
 
 	for _, match := range procMatches {
 		if len(match) > 3 {
@@ -678,15 +1174,15 @@ func (m *MySQL) parseFunctions(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (m *MySQL) parseTriggers(content string) error {
-	triggerRe := regexp.MustCompile(`CREATE\s+TRIGGER\s+(\w+)\s+(BEFORE|AFTER)\s+(INSERT|UPDATE|DELETE)\s+ON\s+([.\w]+)\s+FOR\s+EACH\s+ROW\s+BEGIN\s+(.*?)\s+END`)
-	triggerMatches := triggerRe.FindAllStringSubmatch(content, -1)
+	triggerRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?(?:\s+DEFINER\s*=\s*\S+)?\s+TRIGGER\s+(?:IF\s+NOT\s+EXISTS\s+)?(\w+)\s+(BEFORE|AFTER)\s+(INSERT|UPDATE|DELETE)\s+ON\s+([.\w]+)\s+FOR\s+EACH\s+ROW\s+BEGIN\b`)
+	triggerMatches := sqlmapper.FindBlocks(triggerRe, content)
 
 	for _, match := range triggerMatches {
 		if len(match) > 5 {
 			trigger := sqlmapper.Trigger{
 				Name:       match[1],
 				Timing:     match[2],
-				Event:      match[3],
+				Events:     []string{match[3]},
 				Table:      match[4],
 				Body:       match[5],
 				ForEachRow: true,
@@ -716,79 +1212,9 @@ func (m *MySQL) parseTriggers(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (m *MySQL) parsePermissions(content string) error {
-	// Parse GRANT statements for tables
-	grantRe := regexp.MustCompile(`GRANT\s+(.*?)\s+ON\s+([.\w*]+)\s+TO\s+'([^']+)'@'([^']+)'(?:\s+WITH\s+GRANT\s+OPTION)?;`)
-	grantMatches := grantRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range grantMatches {
-		if len(match) > 4 {
-			// Split privileges and handle multiple privileges in one statement
-			privilegeStr := strings.TrimSpace(match[1])
-			var privileges []string
-			if strings.ToUpper(privilegeStr) == "ALL PRIVILEGES" {
-				privileges = []string{"ALL PRIVILEGES"}
-			} else {
-				// Handle comma-separated privileges and potential whitespace
-				for _, priv := range strings.Split(privilegeStr, ",") {
-					privs := strings.Fields(strings.TrimSpace(priv))
-					privileges = append(privileges, privs...)
-				}
-			}
-
-			perm := sqlmapper.Permission{
-				Type:       "GRANT",
-				Privileges: privileges,
-				Object:     match[2],
-				Grantee:    fmt.Sprintf("%s@%s", match[3], match[4]),
-				WithGrant:  strings.Contains(match[0], "WITH GRANT OPTION"),
-			}
-			m.schema.Permissions = append(m.schema.Permissions, perm)
-		}
-	}
-
-	// Parse GRANT statements for procedures and functions
-	grantProcRe := regexp.MustCompile(`GRANT\s+EXECUTE\s+ON\s+(?:PROCEDURE|FUNCTION)\s+(\w+)\s+TO\s+'([^']+)'@'([^']+)'(?:\s+WITH\s+GRANT\s+OPTION)?;`)
-	grantProcMatches := grantProcRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range grantProcMatches {
-		if len(match) > 3 {
-			perm := sqlmapper.Permission{
-				Type:       "GRANT",
-				Privileges: []string{"EXECUTE"},
-				Object:     match[1],
-				Grantee:    fmt.Sprintf("%s@%s", match[2], match[3]),
-				WithGrant:  strings.Contains(match[0], "WITH GRANT OPTION"),
-			}
-			m.schema.Permissions = append(m.schema.Permissions, perm)
-		}
-	}
-
-	// Parse REVOKE statements
-	revokeRe := regexp.MustCompile(`REVOKE\s+(.*?)\s+ON\s+([.\w*]+)\s+FROM\s+'([^']+)'@'([^']+)';`)
-	revokeMatches := revokeRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range revokeMatches {
-		if len(match) > 4 {
-			// Split privileges and handle multiple privileges in one statement
-			privilegeStr := strings.TrimSpace(match[1])
-			var privileges []string
-			if strings.ToUpper(privilegeStr) == "ALL PRIVILEGES" {
-				privileges = []string{"ALL PRIVILEGES"}
-			} else {
-				// Handle comma-separated privileges and potential whitespace
-				for _, priv := range strings.Split(privilegeStr, ",") {
-					privs := strings.Fields(strings.TrimSpace(priv))
-					privileges = append(privileges, privs...)
-				}
-			}
-
-			perm := sqlmapper.Permission{
-				Type:       "REVOKE",
-				Privileges: privileges,
-				Object:     match[2],
-				Grantee:    fmt.Sprintf("%s@%s", match[3], match[4]),
-			}
-			m.schema.Permissions = append(m.schema.Permissions, perm)
+	for _, statement := range sqlmapper.SplitMySQLStatements(content) {
+		if permission, ok := sqlmapper.ParsePermission(statement.Text); ok {
+			m.schema.Permissions = append(m.schema.Permissions, *permission)
 		}
 	}
 
@@ -804,23 +1230,134 @@ func (m *MySQL) parsePermissions(content string) error {
 // Returns:
 //   - string: The generated CREATE TABLE statement
 func (m *MySQL) generateTableSQL(table sqlmapper.Table) string {
+	ifNotExists := m.options.IfNotExists(table.IfNotExists)
+	if table.SourceQuery != "" {
+		return sqlmapper.CreateTableAsSQL(table, "TEMPORARY", ifNotExists, false) + ";"
+	}
+
 	var result strings.Builder
+	result.Grow(sqlmapper.TableSizeHint(table))
 
-	result.WriteString(fmt.Sprintf("CREATE TABLE %s (\n", table.Name))
+	result.WriteString("CREATE ")
+	if table.Temporary {
+		result.WriteString("TEMPORARY ")
+	}
+	result.WriteString("TABLE ")
+	result.WriteString(ifNotExists)
+	result.WriteString(table.Name)
+	result.WriteString(" (\n")
+
+	m.options.WarnInherits(table)
+
+	// Columns added by ALTER TABLE are generated separately
+	columns := make([]sqlmapper.Column, 0, len(table.Columns))
+	for _, column := range table.Columns {
+		if !column.First && column.After == "" {
+			columns = append(columns, column)
+		}
+	}
 
 	// Columns
-	for i, column := range table.Columns {
-		result.WriteString("    " + m.generateColumnSQL(column))
-		if i < len(table.Columns)-1 {
+	definitions := make([]string, 0, len(columns)+1)
+	for _, column := range columns {
+		converted := m.convertColumn(table.Name, column)
+		m.options.MapColumn(table.Name, column, columnType(converted))
+		definitions = append(definitions, m.generateColumnSQL(converted))
+	}
+
+	// A primary key that is not declared inline, such as a composite key or the key
+	// of an AUTO_INCREMENT column declared at table level
+	if primaryKey := tablePrimaryKey(table); len(primaryKey) > 0 {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", sqlmapper.QuoteIdentifiers(primaryKey, "`")))
+	}
+
+	for i, definition := range definitions {
+		result.WriteString("    ")
+		result.WriteString(definition)
+		if i < len(definitions)-1 {
 			result.WriteString(",")
 		}
 		result.WriteString("\n")
 	}
 
-	result.WriteString(");")
+	result.WriteString(")")
+	if table.Options != "" {
+		result.WriteString(" " + table.Options)
+	}
+	if start := autoIncrementStart(table); start != 0 {
+		result.WriteString(fmt.Sprintf(" AUTO_INCREMENT=%d", start))
+	}
+	if table.Comment != "" {
+		// Comments set by COMMENT ON in other dialects are inlined
+		result.WriteString(" COMMENT=" + quoteLiteral(table.Comment))
+	}
+	result.WriteString(";")
 	return result.String()
 }
 
+// autoIncrementStart returns the first value of the identity column of a table that
+// does not start at 1, or 0 if there is none or the table options already set
+// AUTO_INCREMENT
+func autoIncrementStart(table sqlmapper.Table) int64 {
+	if _, ok := table.TableOptions()["AUTO_INCREMENT"]; ok {
+		return 0
+	}
+	for _, column := range table.Columns {
+		if column.Identity != "" && column.IdentityStart > 1 {
+			return column.IdentityStart
+		}
+	}
+	return 0
+}
+
+// tablePrimaryKey returns the columns of the primary key of a table unless the key
+// is declared inline on its only column
+func tablePrimaryKey(table sqlmapper.Table) []string {
+	for _, constraint := range table.Constraints {
+		if constraint.Type != "PRIMARY KEY" || len(constraint.Columns) == 0 {
+			continue
+		}
+		if len(constraint.Columns) == 1 {
+			for _, column := range table.Columns {
+				if column.IsPrimaryKey && strings.EqualFold(column.Name, constraint.Columns[0]) {
+					return nil
+				}
+			}
+		}
+		return constraint.Columns
+	}
+	return nil
+}
+
+// convertColumn converts a column of another dialect, renaming its temporal type and
+// translating its CHECK and generated expressions. Identity columns become
+// AUTO_INCREMENT columns, whose first value is set by the table, see
+// autoIncrementStart. Computed columns of SQL Server are given a data type.
+func (m *MySQL) convertColumn(table string, column sqlmapper.Column) sqlmapper.Column {
+	column = m.convertTemporal(table, column)
+	column = m.options.TranslateGenerated(table, m.options.GeneratedType(table, column, "TEXT"), checkRules)
+	if column.Identity == "ALWAYS" {
+		m.options.Warnf("identity column %s.%s was generated ALWAYS, AUTO_INCREMENT accepts explicit values", table, column.Name)
+	}
+	if column.IdentityIncrement != 0 && column.IdentityIncrement != 1 {
+		m.options.Warnf("increment %d of identity column %s.%s was dropped, AUTO_INCREMENT columns are incremented by auto_increment_increment", column.IdentityIncrement, table, column.Name)
+	}
+	if column.CheckExpression != "" {
+		column.CheckExpression = m.options.TranslateCheck(table, column.CheckExpression, checkRules)
+	}
+	return column
+}
+
+// convertTemporal renames temporal types MySQL lacks, such as DATETIME2, keeping
+// their fractional-second precision
+func (m *MySQL) convertTemporal(table string, column sqlmapper.Column) sqlmapper.Column {
+	column, reduced := sqlmapper.ConvertTemporalType(column, temporalTypes, maxFractionalSeconds)
+	if reduced {
+		m.options.Warnf("fractional seconds of column %s.%s were reduced to %d digits", table, column.Name, maxFractionalSeconds)
+	}
+	return column
+}
+
 // generateColumnSQL creates the SQL definition for a single column.
 // It handles various column attributes including data type, length/precision,
 // nullability, defaults, auto increment, and constraints.
@@ -831,18 +1368,23 @@ func (m *MySQL) generateTableSQL(table sqlmapper.Table) string {
 // Returns:
 //   - string: The generated column definition
 func (m *MySQL) generateColumnSQL(column sqlmapper.Column) string {
-	var parts []string
-	parts = append(parts, column.Name)
+	parts := make([]string, 0, 16)
+	parts = append(parts, sqlmapper.QuoteIdentifier(column.Name, "`"))
 
 	// Data type with length/precision
-	if column.Length > 0 {
-		if column.Scale > 0 {
-			parts = append(parts, fmt.Sprintf("%s(%d,%d)", column.DataType, column.Length, column.Scale))
-		} else {
-			parts = append(parts, fmt.Sprintf("%s(%d)", column.DataType, column.Length))
-		}
-	} else {
-		parts = append(parts, column.DataType)
+	parts = append(parts, columnType(column))
+	if column.Zerofill {
+		parts = append(parts, "ZEROFILL")
+	}
+	if column.CharacterSet != "" {
+		parts = append(parts, "CHARACTER SET", column.CharacterSet)
+	}
+	if column.Collation != "" {
+		parts = append(parts, "COLLATE", column.Collation)
+	}
+
+	if generated := sqlmapper.GeneratedClause(column); generated != "" {
+		parts = append(parts, generated)
 	}
 
 	// Handle AUTO_INCREMENT and PRIMARY KEY
@@ -851,26 +1393,183 @@ func (m *MySQL) generateColumnSQL(column sqlmapper.Column) string {
 	}
 	if column.IsPrimaryKey {
 		parts = append(parts, "PRIMARY KEY")
-	} else if !column.IsNullable && column.DefaultValue == "" {
-		parts = append(parts, "NOT NULL")
+	}
+	if nullability := m.options.Nullability(column, column.IsPrimaryKey || column.DefaultValue != ""); nullability != "" {
+		parts = append(parts, strings.TrimSpace(nullability))
 	}
 
-	if column.DefaultValue != "" {
-		if strings.Contains(column.DefaultValue, " ") ||
-			strings.ToUpper(column.DefaultValue) == "CURRENT_TIMESTAMP" {
+	if defaultValue := sqlmapper.RewriteCasts(column.DefaultValue, castTypes); defaultValue != column.DefaultValue {
+		// Expression defaults, such as the casts of PostgreSQL, are written in parentheses
+		parts = append(parts, "DEFAULT", "("+defaultValue+")")
+	} else if column.DefaultValue != "" {
+		if strings.Contains(column.DefaultValue, " ") || strings.HasPrefix(column.DefaultValue, "(") ||
+			sqlmapper.IsCurrentTime(column.DefaultValue) {
 			parts = append(parts, "DEFAULT", column.DefaultValue)
 		} else {
-			parts = append(parts, "DEFAULT", fmt.Sprintf("'%s'", column.DefaultValue))
+			parts = append(parts, "DEFAULT", quoteLiteral(column.DefaultValue))
 		}
 	}
+	if column.OnUpdate != "" {
+		parts = append(parts, "ON UPDATE", column.OnUpdate)
+	}
+
+	if column.Invisible {
+		parts = append(parts, "INVISIBLE")
+	}
 
 	if column.IsUnique && !column.IsPrimaryKey {
 		parts = append(parts, "UNIQUE")
 	}
 
+	if column.CheckExpression != "" {
+		parts = append(parts, sqlmapper.ConstraintPrefix(column.CheckName)+"CHECK ("+sqlmapper.RewriteCasts(column.CheckExpression, castTypes)+")")
+	}
+
+	if column.Comment != "" {
+		parts = append(parts, "COMMENT", quoteLiteral(column.Comment))
+	}
+
 	return strings.Join(parts, " ")
 }
 
+// currentTimestamp returns CURRENT_TIMESTAMP with the fractional-second precision of
+// a parsed CURRENT_TIMESTAMP or NOW call, such as CURRENT_TIMESTAMP(3)
+func currentTimestamp(value string) string {
+	start, end := strings.IndexByte(value, '('), strings.IndexByte(value, ')')
+	if start < 0 || end < start {
+		return "CURRENT_TIMESTAMP"
+	}
+	if precision := strings.TrimSpace(value[start+1 : end]); precision != "" {
+		return "CURRENT_TIMESTAMP(" + precision + ")"
+	}
+	return "CURRENT_TIMESTAMP"
+}
+
+// literalEscaper escapes the characters of a MySQL string literal that would end it
+// or be read as the start of an escape sequence
+var literalEscaper = strings.NewReplacer(`\`, `\\`, "'", "''", "\x00", `\0`, "\n", `\n`, "\r", `\r`, "\x1a", `\Z`)
+
+// quoteLiteral quotes a value as a MySQL string literal, such as a default or a
+// comment, escaping its quotes, backslashes and line breaks
+func quoteLiteral(value string) string {
+	return "'" + literalEscaper.Replace(value) + "'"
+}
+
+// columnType returns the data type of a column with its parameters and signedness
+func columnType(column sqlmapper.Column) string {
+	if column.Unsigned {
+		return sqlmapper.FormatDataType(column) + " UNSIGNED"
+	}
+	return sqlmapper.FormatDataType(column)
+}
+
+// generateAddColumnSQL creates an ALTER TABLE ... ADD COLUMN statement for the given column.
+// The column's FIRST or AFTER position hint is preserved.
+//
+// Parameters:
+//   - tableName: The name of the table the column is added to
+//   - column: The column structure to generate SQL for
+//
+// Returns:
+//   - string: The generated ALTER TABLE statement
+func (m *MySQL) generateAddColumnSQL(tableName string, column sqlmapper.Column) string {
+	converted := m.convertColumn(tableName, column)
+	m.options.MapColumn(tableName, column, columnType(converted))
+	sql := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", tableName, m.generateColumnSQL(converted))
+	if column.First {
+		sql += " FIRST"
+	} else if column.After != "" {
+		sql += " AFTER " + sqlmapper.QuoteIdentifier(column.After, "`")
+	}
+	return sql + ";"
+}
+
+// GenerateTable generates the CREATE TABLE statement of a single table, without
+// its indexes and without a terminating semicolon
+func (m *MySQL) GenerateTable(table sqlmapper.Table) (string, error) {
+	return m.options.Format.Apply(strings.TrimSuffix(m.generateTableSQL(table), ";")), nil
+}
+
+// GenerateIndex generates the CREATE INDEX statement of a single index of a table,
+// without a terminating semicolon
+func (m *MySQL) GenerateIndex(tableName string, index sqlmapper.Index) (string, error) {
+	return m.options.Format.Apply(strings.TrimSuffix(m.generateIndexSQL(tableName, index), ";")), nil
+}
+
+// GenerateView generates the CREATE VIEW statement of a single view, without a
+// terminating semicolon
+func (m *MySQL) GenerateView(view sqlmapper.View) (string, error) {
+	return m.options.Format.Apply(m.generateViewSQL(view)), nil
+}
+
+// generateViewSQL generates SQL for a view
+func (m *MySQL) generateViewSQL(view sqlmapper.View) string {
+	return fmt.Sprintf("CREATE VIEW %s%s AS %s", m.options.IfNotExists(view.IfNotExists), view.Name, view.Definition)
+}
+
+// generateTriggerSQL creates the SQL definition of a trigger. Bodies of Oracle
+// triggers are translated where feasible; compound triggers have no MySQL equivalent
+// and are skipped with a warning.
+func (m *MySQL) generateTriggerSQL(trigger sqlmapper.Trigger) (string, bool) {
+	if trigger.Compound {
+		m.options.Warnf("trigger %s is an Oracle compound trigger and was skipped", trigger.Name)
+		return "", false
+	}
+
+	body, translated := translateTriggerBody(trigger.Body)
+	if translated && plsqlRe.MatchString(body) {
+		m.options.Warnf("trigger %s contains PL/SQL that MySQL does not support and needs to be reviewed", trigger.Name)
+	}
+
+	// MySQL triggers are always row-level and have no WHEN clause, so a
+	// condition is moved into the body
+	if trigger.Condition != "" {
+		body = fmt.Sprintf("IF %s THEN\n%s\nEND IF;", sqlmapper.TrimParens(trigger.Condition), body)
+	}
+	return fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s\nFOR EACH ROW\nBEGIN\n%s\nEND",
+		trigger.Name, trigger.Timing, trigger.EventClause(""), trigger.Table, body), true
+}
+
+// generateRoutineSQL creates the CREATE FUNCTION or CREATE PROCEDURE statement of a
+// stored routine. Like the body of a trigger, the body is enclosed in BEGIN ... END
+// unless it is a block already.
+func generateRoutineSQL(kind, name string, parameters []sqlmapper.Parameter, returns, body string) string {
+	params := make([]string, len(parameters))
+	for i, param := range parameters {
+		params[i] = strings.TrimSpace(param.Direction + " " + param.Name + " " + param.DataType)
+	}
+
+	sql := fmt.Sprintf("CREATE %s %s(%s)", kind, name, strings.Join(params, ", "))
+	if returns != "" {
+		sql += " RETURNS " + returns
+	}
+	body = strings.TrimSpace(body)
+	if !plsqlBlockRe.MatchString(body) {
+		body = "BEGIN\n" + body + "\nEND"
+	}
+	return sql + "\n" + body
+}
+
+// translateTriggerBody translates the body of an Oracle trigger, which refers to the
+// row through :NEW and :OLD. The enclosing BEGIN ... END block is removed and
+// assignments to the row become SET statements. Other bodies are returned unchanged
+// and translated is false.
+func translateTriggerBody(body string) (string, bool) {
+	body, translated := sqlmapper.ReplaceBindReferences(body)
+	if !translated {
+		return body, false
+	}
+
+	body = strings.TrimSpace(body)
+	if match := plsqlBlockRe.FindStringSubmatch(body); match != nil {
+		body = strings.TrimSpace(match[1])
+	}
+	if !strings.HasSuffix(body, ";") {
+		body += ";"
+	}
+	return rowAssignmentRe.ReplaceAllString(body, "${1}SET ${2} = "), true
+}
+
 // generateIndexSQL creates a CREATE INDEX statement for the given index.
 // It handles various index types including UNIQUE and regular indexes.
 //
@@ -883,16 +1582,60 @@ func (m *MySQL) generateColumnSQL(column sqlmapper.Column) string {
 func (m *MySQL) generateIndexSQL(tableName string, index sqlmapper.Index) string {
 	var result strings.Builder
 
-	if index.IsUnique {
+	switch {
+	case index.Kind == sqlmapper.FulltextIndex || index.Kind == sqlmapper.SpatialIndex:
+		result.WriteString("CREATE " + index.Kind.String() + " INDEX ")
+	case index.IsUnique:
 		result.WriteString("CREATE UNIQUE INDEX ")
-	} else {
+	default:
 		result.WriteString("CREATE INDEX ")
 	}
 
-	result.WriteString(fmt.Sprintf("%s ON %s(%s);",
+	result.WriteString(fmt.Sprintf("%s%s ON %s(%s)",
+		m.options.IfNotExists(index.IfNotExists),
 		index.Name,
 		tableName,
-		strings.Join(index.Columns, ", ")))
+		index.ColumnList("`", false)))
+	if index.Invisible {
+		result.WriteString(" INVISIBLE")
+	}
+	result.WriteString(";")
 
 	return result.String()
 }
+
+// generateDropSQL creates a DROP statement for the given object.
+// Index drops name the table the index belongs to, as MySQL requires.
+//
+// Parameters:
+//   - drop: The dropped object to generate SQL for
+//
+// Returns:
+//   - string: The generated DROP statement
+func (m *MySQL) generateDropSQL(drop sqlmapper.Drop) string {
+	sql := fmt.Sprintf("DROP %s %s%s", drop.Type, m.options.IfExists(drop.IfExists), drop.QualifiedName())
+	if drop.Type == "INDEX" && drop.Table != "" {
+		sql += " ON " + drop.Table
+	}
+	return sql
+}
+
+// generatePermissionSQL creates a GRANT or REVOKE statement for the given permission.
+// Grantees without a host are written as a plain quoted user or role name.
+func (m *MySQL) generatePermissionSQL(permission sqlmapper.Permission) (string, bool) {
+	object := permission.Object
+	switch permission.ObjectType {
+	case "FUNCTION", "PROCEDURE":
+		object = permission.ObjectType + " " + object
+	case "SCHEMA", "ALL TABLES IN SCHEMA":
+		object += ".*"
+	}
+
+	user, host := permission.Account()
+	grantee := quoteLiteral(user)
+	if host != "" {
+		grantee += "@" + quoteLiteral(host)
+	}
+
+	return sqlmapper.PermissionStatement(permission, object, grantee), true
+}
@@ -12,7 +12,8 @@ import (
 
 // MySQLStreamParser implements the StreamParser interface for MySQL
 type MySQLStreamParser struct {
-	mysql *MySQL
+	mysql   *MySQL
+	options stream.ParseOptions
 }
 
 // NewMySQLStreamParser creates a new MySQL stream parser
@@ -22,119 +23,103 @@ func NewMySQLStreamParser() *MySQLStreamParser {
 	}
 }
 
+// SetOptions configures optional parsing behaviour such as comment capture
+func (p *MySQLStreamParser) SetOptions(options stream.ParseOptions) {
+	p.options = options
+}
+
+// SetGenerateOptions configures optional output of GenerateStream
+func (p *MySQLStreamParser) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	p.mysql.SetGenerateOptions(options)
+}
+
 // ParseStream implements the StreamParser interface
 func (p *MySQLStreamParser) ParseStream(reader io.Reader, callback func(stream.SchemaObject) error) error {
-	streamReader := stream.NewStreamReader(reader, ";")
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithCommentCapture(p.options.CaptureComments).
+		WithMySQLComments().WithBackslashEscapes().WithDelimiterDirectives().WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
+	database := "" // Database selected by the last USE statement
 
 	for {
 		statement, err := streamReader.ReadStatement()
+		if err := budget.Check(); err != nil {
+			return err
+		}
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("error reading statement: %v", err)
+			return fmt.Errorf("error reading statement: %w", err)
 		}
 
 		statement = strings.TrimSpace(statement)
-		if statement == "" {
+		if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 			continue
 		}
-
-		// Parse CREATE TABLE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TABLE") {
-			table, err := p.parseTableStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TableObject,
-				Data: table,
-			})
-			if err != nil {
-				return err
-			}
+		if p.selectDatabase(statement, &database) {
 			continue
 		}
 
-		// Parse CREATE VIEW statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE VIEW") {
-			view, err := p.parseViewStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ViewObject,
-				Data: view,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		obj, err := p.parseStatement(statement)
+		if err == nil && obj == nil {
+			pos := streamReader.Position()
+			err = p.options.Unhandled(stream.Statement{Text: statement, Line: pos.Line, Offset: pos.Offset})
 		}
-
-		// Parse CREATE FUNCTION statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE FUNCTION") {
-			function, err := p.parseFunctionStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.FunctionObject,
-				Data: function,
-			})
-			if err != nil {
+		if err != nil {
+			if err := errs.Handle(stream.Statement{Text: statement, Line: streamReader.Line()}, err); err != nil {
 				return err
 			}
 			continue
 		}
-
-		// Parse CREATE PROCEDURE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE PROCEDURE") {
-			procedure, err := p.parseProcedureStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ProcedureObject,
-				Data: procedure,
-			})
-			if err != nil {
-				return err
-			}
+		if obj == nil || !p.options.Accept(obj) {
 			continue
 		}
+		obj.SetCreateFlags(statement)
+		obj.SetDefaultSchema(database)
 
-		// Parse CREATE TRIGGER statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TRIGGER") {
-			trigger, err := p.parseTriggerStatement(statement)
-			if err != nil {
-				return err
-			}
+		if p.options.CaptureComments {
+			obj.SetSourceComment(streamReader.LeadingComment())
+		}
 
-			err = callback(stream.SchemaObject{
-				Type: stream.TriggerObject,
-				Data: trigger,
-			})
-			if err != nil {
-				return err
+		if err := budget.Object(); err != nil {
+			return err
+		}
+		if err := callback(*obj); err != nil {
+			if stream.IsStopIteration(err) {
+				break
 			}
-			continue
+			return err
 		}
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // ParseStreamParallel implements parallel processing for MySQL stream parsing
 func (p *MySQLStreamParser) ParseStreamParallel(reader io.Reader, callback func(stream.SchemaObject) error, workers int) error {
-	streamReader := stream.NewStreamReader(reader, ";")
-	statements := make(chan string, workers)
-	results := make(chan stream.SchemaObject, workers)
-	errors := make(chan error, workers)
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithCommentCapture(p.options.CaptureComments).
+		WithMySQLComments().WithBackslashEscapes().WithDelimiterDirectives().WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
+	workers, buffer := p.options.Parallelism(workers)
+	statements := make(chan stream.Statement, buffer)
+	results := make(chan stream.SchemaObject, buffer)
+	// Room for an error of each worker and one of the reader
+	errors := make(chan error, workers+1)
+	// Closed when the results are no longer read or a worker failed, so that the
+	// reader and the other workers do not block on their channels
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	halt := func() { stopOnce.Do(func() { close(stop) }) }
 	var wg sync.WaitGroup
 
 	// Start worker goroutines
@@ -143,13 +128,29 @@ func (p *MySQLStreamParser) ParseStreamParallel(reader io.Reader, callback func(
 		go func() {
 			defer wg.Done()
 			for statement := range statements {
-				obj, err := p.parseStatement(statement)
+				obj, err := p.parseStatement(statement.Text)
+				if err == nil && obj == nil {
+					err = p.options.Unhandled(statement)
+				}
 				if err != nil {
-					errors <- err
-					return
+					if err := errs.Handle(statement, err); err != nil {
+						errors <- err
+						halt()
+						return
+					}
+					continue
 				}
-				if obj != nil {
-					results <- *obj
+				if obj != nil && p.options.Accept(obj) {
+					obj.SetCreateFlags(statement.Text)
+					obj.SetDefaultSchema(statement.Database)
+					if p.options.CaptureComments {
+						obj.SetSourceComment(statement.LeadingComment)
+					}
+					select {
+					case results <- *obj:
+					case <-stop:
+						return
+					}
 				}
 			}
 		}()
@@ -163,28 +164,57 @@ func (p *MySQLStreamParser) ParseStreamParallel(reader io.Reader, callback func(
 
 	// Start a goroutine to read statements and send them to workers
 	go func() {
+		database := ""
 		for {
 			statement, err := streamReader.ReadStatement()
+			if err := budget.Check(); err != nil {
+				errors <- err
+				break
+			}
 			if err == io.EOF {
 				break
 			}
 			if err != nil {
-				errors <- fmt.Errorf("error reading statement: %v", err)
+				errors <- fmt.Errorf("error reading statement: %w", err)
 				break
 			}
 
 			statement = strings.TrimSpace(statement)
-			if statement == "" {
+			if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 				continue
 			}
-			statements <- statement
+			// The database is tracked here as workers parse statements out of order
+			if p.selectDatabase(statement, &database) {
+				continue
+			}
+			next := stream.Statement{
+				Text:           statement,
+				LeadingComment: streamReader.LeadingComment(),
+				Line:           streamReader.Line(),
+				Offset:         streamReader.Position().Offset,
+				Database:       database,
+			}
+			select {
+			case statements <- next:
+			case <-stop:
+				close(statements)
+				return
+			}
 		}
 		close(statements)
 	}()
 
 	// Process results and handle errors
 	for obj := range results {
+		if err := budget.Object(); err != nil {
+			halt()
+			return err
+		}
 		if err := callback(obj); err != nil {
+			halt()
+			if stream.IsStopIteration(err) {
+				return errs.Err()
+			}
 			return err
 		}
 	}
@@ -194,16 +224,26 @@ func (p *MySQLStreamParser) ParseStreamParallel(reader io.Reader, callback func(
 	case err := <-errors:
 		return err
 	default:
-		return nil
+		return errs.Err()
+	}
+}
+
+// selectDatabase handles the USE and CREATE DATABASE statements of multi-database
+// dumps. A USE statement makes its database the default schema of the objects that
+// follow it. It reports whether the statement was one of them.
+func (p *MySQLStreamParser) selectDatabase(statement string, database *string) bool {
+	if name, ok := sqlmapper.ParseUseStatement(statement); ok {
+		*database = name
+		return true
 	}
+	_, ok := sqlmapper.ParseCreateDatabase(statement)
+	return ok
 }
 
 // parseStatement parses a single SQL statement and returns a SchemaObject
 func (p *MySQLStreamParser) parseStatement(statement string) (*stream.SchemaObject, error) {
-	upperStatement := strings.ToUpper(statement)
-
-	switch {
-	case strings.HasPrefix(upperStatement, "CREATE TABLE"):
+	switch sqlmapper.ClassifyStatement(statement) {
+	case sqlmapper.CreateTableStatement:
 		table, err := p.parseTableStatement(statement)
 		if err != nil {
 			return nil, err
@@ -213,7 +253,7 @@ func (p *MySQLStreamParser) parseStatement(statement string) (*stream.SchemaObje
 			Data: table,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE VIEW"):
+	case sqlmapper.CreateViewStatement:
 		view, err := p.parseViewStatement(statement)
 		if err != nil {
 			return nil, err
@@ -223,7 +263,7 @@ func (p *MySQLStreamParser) parseStatement(statement string) (*stream.SchemaObje
 			Data: view,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE FUNCTION"):
+	case sqlmapper.CreateFunctionStatement:
 		function, err := p.parseFunctionStatement(statement)
 		if err != nil {
 			return nil, err
@@ -233,7 +273,7 @@ func (p *MySQLStreamParser) parseStatement(statement string) (*stream.SchemaObje
 			Data: function,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE PROCEDURE"):
+	case sqlmapper.CreateProcedureStatement:
 		procedure, err := p.parseProcedureStatement(statement)
 		if err != nil {
 			return nil, err
@@ -242,28 +282,95 @@ func (p *MySQLStreamParser) parseStatement(statement string) (*stream.SchemaObje
 			Type: stream.ProcedureObject,
 			Data: procedure,
 		}, nil
+
+	case sqlmapper.CreateTriggerStatement:
+		trigger, err := p.parseTriggerStatement(statement)
+		if err != nil {
+			return nil, err
+		}
+		return &stream.SchemaObject{
+			Type: stream.TriggerObject,
+			Data: trigger,
+		}, nil
+	}
+
+	if permission, ok := sqlmapper.ParsePermission(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.PermissionObject,
+			Data: permission,
+		}, nil
+	}
+
+	if drop, ok := sqlmapper.ParseDrop(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.DropObject,
+			Data: drop,
+		}, nil
+	}
+
+	if settings, ok := sqlmapper.ParseSetStatement(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.SettingObject,
+			Data: settings,
+		}, nil
 	}
 
 	return nil, nil
 }
 
+// TransformStream parses a dump, applies transform to every object and writes the
+// result right away, see stream.TransformStream
+func (p *MySQLStreamParser) TransformStream(reader io.Reader, writer io.Writer, transform stream.TransformFunc) error {
+	return stream.TransformStream(p, p.mysql, reader, writer, transform)
+}
+
 // GenerateStream implements the StreamParser interface
 func (p *MySQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.Writer) error {
 	if schema == nil {
-		return fmt.Errorf("schema cannot be nil")
+		return &sqlmapper.ValidationError{Problems: []string{"schema cannot be nil"}}
+	}
+	schema = p.mysql.options.Incremental(schema)
+	writer = p.mysql.options.Format.Writer(writer)
+
+	// Write session settings, disable foreign key checks and open the transaction
+	if p.mysql.options.Transaction && !capabilities.TransactionalDDL {
+		p.mysql.options.Warnf("MySQL commits DDL statements implicitly, the transaction does not make the script atomic")
+	}
+	for _, stmt := range p.mysql.options.Prologue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write drops
+	for _, drop := range schema.Drops {
+		stmt := p.mysql.generateDropSQL(drop)
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
 	}
 
-	// Write tables
-	for _, table := range schema.Tables {
+	// Write tables, whose statements end with a semicolon already
+	for _, table := range p.mysql.options.WithoutPartitions(schema.Tables) {
 		stmt := p.mysql.generateTableSQL(table)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, "\n\n"); err != nil {
 			return err
 		}
 
+		// Columns with a position hint were added by ALTER TABLE
+		for _, column := range table.Columns {
+			if column.First || column.After != "" {
+				stmt := p.mysql.generateAddColumnSQL(table.Name, column)
+				if err := sqlmapper.WriteStatement(writer, stmt, "\n"); err != nil {
+					return err
+				}
+			}
+		}
+
 		// Generate indexes for this table
 		for _, index := range table.Indexes {
 			stmt := p.mysql.generateIndexSQL(table.Name, index)
-			if _, err := writer.Write([]byte(stmt + ";\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, "\n"); err != nil {
 				return err
 			}
 		}
@@ -271,8 +378,7 @@ func (p *MySQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.W
 
 	// Write views
 	for _, view := range schema.Views {
-		stmt := fmt.Sprintf("CREATE VIEW %s AS %s", view.Name, view.Definition)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, p.mysql.generateViewSQL(view), ";\n\n"); err != nil {
 			return err
 		}
 	}
@@ -280,42 +386,56 @@ func (p *MySQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.W
 	// Write functions
 	for _, function := range schema.Functions {
 		if !function.IsProc {
-			stmt := fmt.Sprintf("CREATE FUNCTION %s(", function.Name)
-			for i, param := range function.Parameters {
-				if i > 0 {
-					stmt += ", "
-				}
-				stmt += fmt.Sprintf("%s %s", param.Name, param.DataType)
-			}
-			stmt += fmt.Sprintf(") RETURNS %s\n%s", function.Returns, function.Body)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+			stmt := generateRoutineSQL("FUNCTION", function.Name, function.Parameters, function.Returns, function.Body)
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 				return err
 			}
 		}
 	}
 
-	// Write procedures
+	// Write procedures, parsed as such or as functions by the MySQL parser
 	for _, function := range schema.Functions {
 		if function.IsProc {
-			stmt := fmt.Sprintf("CREATE PROCEDURE %s(", function.Name)
-			for i, param := range function.Parameters {
-				if i > 0 {
-					stmt += ", "
-				}
-				stmt += fmt.Sprintf("%s %s", param.Name, param.DataType)
-			}
-			stmt += fmt.Sprintf(")\n%s", function.Body)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+			stmt := generateRoutineSQL("PROCEDURE", function.Name, function.Parameters, "", function.Body)
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 				return err
 			}
 		}
 	}
+	for _, procedure := range schema.Procedures {
+		stmt := generateRoutineSQL("PROCEDURE", procedure.Name, procedure.Parameters, "", procedure.Body)
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
+	}
 
 	// Write triggers
-	for _, trigger := range schema.Triggers {
-		stmt := fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s\n%s",
-			trigger.Name, trigger.Timing, trigger.Event, trigger.Table, trigger.Body)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+	for _, trigger := range sqlmapper.SplitTriggerEvents(schema.Triggers, p.mysql.options) {
+		stmt, ok := p.mysql.generateTriggerSQL(trigger)
+		if !ok {
+			continue
+		}
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write permissions
+	if p.mysql.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := p.mysql.generatePermissionSQL(permission); ok {
+				if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	p.mysql.options.WarnSkipped(schema, sqlmapper.ViewObjects|sqlmapper.FunctionObjects|sqlmapper.ProcedureObjects|sqlmapper.TriggerObjects)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range p.mysql.options.Epilogue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 	}
@@ -325,12 +445,9 @@ func (p *MySQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.W
 
 // parseTableStatement parses a CREATE TABLE statement
 func (p *MySQLStreamParser) parseTableStatement(statement string) (*sqlmapper.Table, error) {
-	// Create a temporary schema for parsing
-	tempSchema := &sqlmapper.Schema{}
-	p.mysql.schema = tempSchema
-
 	// Parse the table using the existing MySQL parser
-	if err := p.mysql.parseTables(statement); err != nil {
+	tempSchema, err := p.parseInto((*MySQL).parseTables, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -345,12 +462,9 @@ func (p *MySQLStreamParser) parseTableStatement(statement string) (*sqlmapper.Ta
 
 // parseViewStatement parses a CREATE VIEW statement
 func (p *MySQLStreamParser) parseViewStatement(statement string) (*sqlmapper.View, error) {
-	// Create a temporary schema for parsing
-	tempSchema := &sqlmapper.Schema{}
-	p.mysql.schema = tempSchema
-
 	// Parse the view using the existing MySQL parser
-	if err := p.mysql.parseViews(statement); err != nil {
+	tempSchema, err := p.parseInto((*MySQL).parseViews, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -365,12 +479,9 @@ func (p *MySQLStreamParser) parseViewStatement(statement string) (*sqlmapper.Vie
 
 // parseFunctionStatement parses a CREATE FUNCTION statement
 func (p *MySQLStreamParser) parseFunctionStatement(statement string) (*sqlmapper.Function, error) {
-	// Create a temporary schema for parsing
-	tempSchema := &sqlmapper.Schema{}
-	p.mysql.schema = tempSchema
-
 	// Parse the function using the existing MySQL parser
-	if err := p.mysql.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*MySQL).parseFunctions, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -385,12 +496,9 @@ func (p *MySQLStreamParser) parseFunctionStatement(statement string) (*sqlmapper
 
 // parseProcedureStatement parses a CREATE PROCEDURE statement
 func (p *MySQLStreamParser) parseProcedureStatement(statement string) (*sqlmapper.Procedure, error) {
-	// Create a temporary schema for parsing
-	tempSchema := &sqlmapper.Schema{}
-	p.mysql.schema = tempSchema
-
 	// Parse the procedure using the existing MySQL parser
-	if err := p.mysql.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*MySQL).parseFunctions, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -417,12 +525,9 @@ func (p *MySQLStreamParser) parseProcedureStatement(statement string) (*sqlmappe
 
 // parseTriggerStatement parses a CREATE TRIGGER statement
 func (p *MySQLStreamParser) parseTriggerStatement(statement string) (*sqlmapper.Trigger, error) {
-	// Create a temporary schema for parsing
-	tempSchema := &sqlmapper.Schema{}
-	p.mysql.schema = tempSchema
-
 	// Parse the trigger using the existing MySQL parser
-	if err := p.mysql.parseTriggers(statement); err != nil {
+	tempSchema, err := p.parseInto((*MySQL).parseTriggers, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -434,3 +539,21 @@ func (p *MySQLStreamParser) parseTriggerStatement(statement string) (*sqlmapper.
 	// Return the first trigger
 	return &tempSchema.Triggers[0], nil
 }
+
+// parseInto parses a single statement with the given MySQL parser method into a
+// schema of its own. The shared parser is never modified, so statements can be
+// parsed concurrently by the workers of ParseStreamParallel.
+func (p *MySQLStreamParser) parseInto(parse func(*MySQL, string) error, statement string) (*sqlmapper.Schema, error) {
+	parser := &MySQL{schema: &sqlmapper.Schema{}, options: p.mysql.options}
+	if err := parse(parser, p.prepareStatement(statement)); err != nil {
+		return nil, err
+	}
+	return parser.schema, nil
+}
+
+// prepareStatement normalizes a single streamed statement and restores the
+// terminating semicolon stripped by the stream reader, since the underlying
+// parser expects complete statements.
+func (p *MySQLStreamParser) prepareStatement(statement string) string {
+	return p.mysql.normalizeContent(statement) + ";"
+}
@@ -1,3 +1,779 @@
 package mysql
 
-// TODO implement tests
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLStreamParser_ParseStream_SourceComment(t *testing.T) {
+	input := `
+-- Stores customer orders
+CREATE TABLE orders (
+	id INT NOT NULL,
+	customer VARCHAR(100)
+);
+
+-- Detached comment
+
+CREATE TABLE customers (id INT);
+`
+
+	tests := []struct {
+		name            string
+		captureComments bool
+		want            []string
+	}{
+		{
+			name:            "Comments captured when enabled",
+			captureComments: true,
+			want:            []string{"Stores customer orders", ""},
+		},
+		{
+			name:            "Comments ignored by default",
+			captureComments: false,
+			want:            []string{"", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := NewMySQLStreamParser()
+			parser.SetOptions(stream.ParseOptions{CaptureComments: tt.captureComments})
+
+			var got []string
+			err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+				table, ok := obj.Data.(*sqlmapper.Table)
+				assert.True(t, ok)
+				got = append(got, table.SourceComment)
+				return nil
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMySQLStreamParser_ParseStream_Filter(t *testing.T) {
+	input := `
+CREATE TABLE app_users (id INT);
+CREATE TABLE app_orders (id INT);
+CREATE TABLE audit_log (id INT);
+CREATE VIEW app_active_users AS SELECT * FROM app_users;
+CREATE VIEW broken_view;
+`
+
+	tests := []struct {
+		name   string
+		filter stream.FilterFunc
+		want   []string
+	}{
+		{
+			name:   "Only tables",
+			filter: stream.TypeFilter(stream.TableObject),
+			want:   []string{"app_users", "app_orders", "audit_log"},
+		},
+		{
+			name:   "Name regex",
+			filter: stream.NameFilter(regexp.MustCompile(`^app_`)),
+			want:   []string{"app_users", "app_orders", "app_active_users"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := NewMySQLStreamParser()
+			parser.SetOptions(stream.ParseOptions{Filter: tt.filter})
+
+			var got []string
+			err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+				got = append(got, obj.Name())
+				return nil
+			})
+
+			// The malformed view is rejected by the filter before it is parsed
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMySQLStreamParser_ParseStream_MaxStatementSize(t *testing.T) {
+	input := "CREATE TABLE users (id INT);\nCREATE TABLE broken (id INT, name VARCHAR(255)"
+
+	parser := NewMySQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{MaxStatementSize: 32})
+
+	var got []string
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		got = append(got, obj.Name())
+		return nil
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds maximum size of 32 bytes")
+	assert.Equal(t, []string{"users"}, got)
+}
+
+func TestMySQLStreamParser_ParseStream_TriggerBlock(t *testing.T) {
+	input := `CREATE TRIGGER audit_users AFTER UPDATE ON users FOR EACH ROW
+BEGIN
+	INSERT INTO audit_log (user_id) VALUES (NEW.id);
+	UPDATE stats SET updates = updates + 1;
+	DELETE FROM cache WHERE user_id = NEW.id;
+END;
+CREATE TABLE posts (id INT);`
+
+	parser := NewMySQLStreamParser()
+	var objects []stream.SchemaObject
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		objects = append(objects, obj)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, objects, 2)
+
+	trigger, ok := objects[0].Data.(*sqlmapper.Trigger)
+	assert.True(t, ok)
+	assert.Equal(t, "audit_users", trigger.Name)
+	assert.Equal(t, "INSERT INTO audit_log (user_id) VALUES (NEW.id); "+
+		"UPDATE stats SET updates = updates + 1; "+
+		"DELETE FROM cache WHERE user_id = NEW.id;", trigger.Body)
+	assert.Equal(t, stream.TableObject, objects[1].Type)
+}
+
+func TestMySQLStreamParser_ParseStream_DelimiterDirectives(t *testing.T) {
+	for _, delimiter := range []string{"//", "$$"} {
+		t.Run(delimiter, func(t *testing.T) {
+			input := "DELIMITER " + delimiter + `
+CREATE TRIGGER audit_users AFTER UPDATE ON users FOR EACH ROW
+BEGIN
+	INSERT INTO audit_log (user_id) VALUES (NEW.id);
+	UPDATE stats SET updates = updates + 1;
+	DELETE FROM cache WHERE user_id = NEW.id;
+END` + delimiter + `
+DELIMITER ;
+CREATE TABLE posts (id INT);`
+
+			parser := NewMySQLStreamParser()
+			parser.SetOptions(stream.ParseOptions{Strict: true})
+			var objects []stream.SchemaObject
+			err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+				objects = append(objects, obj)
+				return nil
+			})
+
+			assert.NoError(t, err)
+			if assert.Len(t, objects, 2) {
+				trigger, ok := objects[0].Data.(*sqlmapper.Trigger)
+				if assert.True(t, ok) {
+					assert.Equal(t, "audit_users", trigger.Name)
+					assert.Equal(t, "INSERT INTO audit_log (user_id) VALUES (NEW.id); "+
+						"UPDATE stats SET updates = updates + 1; "+
+						"DELETE FROM cache WHERE user_id = NEW.id;", trigger.Body)
+				}
+				assert.Equal(t, stream.TableObject, objects[1].Type)
+			}
+		})
+	}
+}
+
+func TestMySQLStreamParser_ParseStream_TemporaryTable(t *testing.T) {
+	input := "CREATE TEMPORARY TABLE import_buffer (id INT NOT NULL, payload VARCHAR(255));"
+
+	parser := NewMySQLStreamParser()
+	var tables []*sqlmapper.Table
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		tables = append(tables, obj.Data.(*sqlmapper.Table))
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, tables, 1)
+	assert.Equal(t, "import_buffer", tables[0].Name)
+	assert.True(t, tables[0].Temporary)
+}
+
+func TestMySQLStreamParser_ParseStream_ContinueOnError(t *testing.T) {
+	input := `CREATE TABLE users (id INT);
+CREATE TABLE broken;
+CREATE TABLE posts (id INT);
+
+CREATE VIEW recent;
+CREATE TABLE comments (id INT);`
+
+	tests := []struct {
+		name     string
+		parallel bool
+	}{
+		{name: "Serial"},
+		{name: "Parallel", parallel: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reported []*stream.StatementError
+			parser := NewMySQLStreamParser()
+			parser.SetOptions(stream.ParseOptions{
+				ContinueOnError: true,
+				OnError: func(err *stream.StatementError) {
+					reported = append(reported, err)
+				},
+			})
+
+			var got []string
+			callback := func(obj stream.SchemaObject) error {
+				got = append(got, obj.Name())
+				return nil
+			}
+
+			var err error
+			if tt.parallel {
+				err = parser.ParseStreamParallel(strings.NewReader(input), callback, 2)
+			} else {
+				err = parser.ParseStream(strings.NewReader(input), callback)
+			}
+
+			var parseErrors stream.ParseErrors
+			assert.ErrorAs(t, err, &parseErrors)
+			assert.Len(t, parseErrors, 2)
+			assert.Equal(t, 2, parseErrors[0].Line)
+			assert.Equal(t, "CREATE TABLE broken", parseErrors[0].Statement)
+			assert.Equal(t, 5, parseErrors[1].Line)
+			assert.Len(t, reported, 2)
+			assert.ElementsMatch(t, []string{"users", "posts", "comments"}, got)
+		})
+	}
+
+	t.Run("Abort by default", func(t *testing.T) {
+		parser := NewMySQLStreamParser()
+		var got []string
+		err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+			got = append(got, obj.Name())
+			return nil
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, []string{"users"}, got)
+	})
+}
+
+func TestMySQLStreamParser_ParseStream_Strict(t *testing.T) {
+	input := `CREATE TABLE users (id INT);
+
+INSERT INTO users VALUES (1);
+CREATE TABLE posts (id INT);`
+
+	tests := []struct {
+		name     string
+		parallel bool
+	}{
+		{name: "Serial"},
+		{name: "Parallel", parallel: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parse := func(options stream.ParseOptions) ([]string, error) {
+				parser := NewMySQLStreamParser()
+				parser.SetOptions(options)
+
+				var got []string
+				callback := func(obj stream.SchemaObject) error {
+					got = append(got, obj.Name())
+					return nil
+				}
+				if tt.parallel {
+					return got, parser.ParseStreamParallel(strings.NewReader(input), callback, 2)
+				}
+				return got, parser.ParseStream(strings.NewReader(input), callback)
+			}
+
+			// Unhandled statements are skipped by default
+			got, err := parse(stream.ParseOptions{})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, []string{"users", "posts"}, got)
+
+			_, err = parse(stream.ParseOptions{Strict: true})
+			var statementErr *stream.StatementError
+			assert.ErrorAs(t, err, &statementErr)
+			assert.ErrorIs(t, err, stream.ErrUnhandledStatement)
+			assert.Equal(t, 3, statementErr.Line)
+			assert.EqualError(t, err, "line 3: unhandled statement: INSERT")
+		})
+	}
+}
+
+func TestMySQLStreamParser_CommentOnlyStatements(t *testing.T) {
+	input := `/* only a comment */;
+-- a line comment terminated by a semicolon
+;
+   ;
+CREATE TABLE users (id INT);
+/* first */ /* second */;
+CREATE TABLE posts (id INT);
+-- trailing comment
+/* trailing block comment */`
+
+	parser := NewMySQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true, CaptureComments: true})
+
+	for _, parallel := range []bool{false, true} {
+		var names []string
+		callback := func(obj stream.SchemaObject) error {
+			names = append(names, obj.Name())
+			return nil
+		}
+
+		var err error
+		if parallel {
+			err = parser.ParseStreamParallel(strings.NewReader(input), callback, 2)
+		} else {
+			err = parser.ParseStream(strings.NewReader(input), callback)
+		}
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"users", "posts"}, names)
+	}
+}
+
+func TestMySQLStreamParser_HashComments(t *testing.T) {
+	parser := NewMySQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true, CaptureComments: true})
+
+	for _, parallel := range []bool{false, true} {
+		tables := map[string]*sqlmapper.Table{}
+		callback := func(obj stream.SchemaObject) error {
+			table := obj.Data.(*sqlmapper.Table)
+			tables[table.Name] = table
+			return nil
+		}
+
+		var err error
+		if parallel {
+			err = parser.ParseStreamParallel(strings.NewReader(hashCommentDump), callback, 2)
+		} else {
+			err = parser.ParseStream(strings.NewReader(hashCommentDump), callback)
+		}
+		assert.NoError(t, err)
+		assert.Len(t, tables, 2)
+		if assert.Contains(t, tables, "users") {
+			assert.Len(t, tables["users"].Columns, 2)
+			assert.Equal(t, "#fff", tables["users"].Columns[1].DefaultValue)
+		}
+		if assert.Contains(t, tables, "posts") {
+			assert.Equal(t, "Dump of table posts", tables["posts"].SourceComment)
+		}
+	}
+}
+
+func TestMySQLStreamParser_ParseStream_DumpPreamble(t *testing.T) {
+	// The version comments of the preamble are dropped by the stream reader, the
+	// plain SET statements are recognized and not reported in Strict mode
+	parser := NewMySQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true})
+
+	var names []string
+	err := parser.ParseStream(strings.NewReader(dumpPreamble), func(obj stream.SchemaObject) error {
+		names = append(names, obj.Name())
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"users"}, names)
+
+	parser.SetOptions(stream.ParseOptions{Strict: true, CaptureSettings: true})
+	var settings []sqlmapper.SessionSetting
+	err = parser.ParseStream(strings.NewReader(dumpPreamble), func(obj stream.SchemaObject) error {
+		if obj.Type == stream.SettingObject {
+			settings = append(settings, obj.Data.([]sqlmapper.SessionSetting)...)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []sqlmapper.SessionSetting{
+		{Name: "FOREIGN_KEY_CHECKS", Value: "0"},
+		{Name: "@OLD_SQL_MODE", Value: "@@SQL_MODE"},
+		{Name: "SQL_MODE", Value: "NO_AUTO_VALUE_ON_ZERO"},
+	}, settings)
+}
+
+func TestMySQLStreamParser_ParseStreamParallel_Concurrent(t *testing.T) {
+	var input strings.Builder
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&input, "CREATE TABLE t%[1]d (c%[1]d INT NOT NULL, name VARCHAR(64));\n", i)
+	}
+
+	parser := NewMySQLStreamParser()
+	seen := make(map[string]int)
+	err := parser.ParseStreamParallel(strings.NewReader(input.String()), func(obj stream.SchemaObject) error {
+		table := obj.Data.(*sqlmapper.Table)
+		seen[table.Name]++
+
+		// Every table must keep its own columns when workers parse concurrently
+		assert.Len(t, table.Columns, 2)
+		assert.Equal(t, "c"+strings.TrimPrefix(table.Name, "t"), table.Columns[0].Name)
+		return nil
+	}, 8)
+
+	assert.NoError(t, err)
+	assert.Len(t, seen, 200)
+	for name, count := range seen {
+		assert.Equal(t, 1, count, name)
+	}
+}
+
+func TestMySQLStreamParser_ParseStreamParallel_Workers(t *testing.T) {
+	var input strings.Builder
+	for i := 0; i < 50; i++ {
+		fmt.Fprintf(&input, "CREATE TABLE t%d (id INT);\n", i)
+	}
+
+	tests := []struct {
+		name       string
+		workers    int
+		bufferSize int
+	}{
+		{name: "No workers", workers: 0},
+		{name: "Negative workers", workers: -1},
+		{name: "Larger buffer", workers: 2, bufferSize: 64},
+		{name: "Unbuffered", workers: 4, bufferSize: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := NewMySQLStreamParser()
+			parser.SetOptions(stream.ParseOptions{BufferSize: tt.bufferSize})
+
+			count := 0
+			err := parser.ParseStreamParallel(strings.NewReader(input.String()), func(obj stream.SchemaObject) error {
+				count++
+				return nil
+			}, tt.workers)
+			assert.NoError(t, err)
+			assert.Equal(t, 50, count)
+		})
+	}
+}
+
+func TestMySQLStreamParser_ParseStreamParallel_WorkerErrors(t *testing.T) {
+	// Every statement fails, so all workers stop while statements are still read
+	input := strings.Repeat("CREATE TABLE broken;\n", 100)
+	before := runtime.NumGoroutine()
+
+	parser := NewMySQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{BufferSize: 1})
+	err := parser.ParseStreamParallel(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		return nil
+	}, 2)
+	assert.Error(t, err)
+
+	// The reader stops as well
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestMySQLStreamParser_GenerateStream_PreserveGuards(t *testing.T) {
+	input := `DROP TABLE IF EXISTS users;
+CREATE TABLE IF NOT EXISTS users (id INT, email VARCHAR(255));
+DROP VIEW active_users;
+CREATE VIEW active_users AS SELECT id FROM users;`
+
+	collect := func(input string) *sqlmapper.Schema {
+		schema := &sqlmapper.Schema{}
+		err := NewMySQLStreamParser().ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+			switch data := obj.Data.(type) {
+			case *sqlmapper.Drop:
+				schema.Drops = append(schema.Drops, *data)
+			case *sqlmapper.Table:
+				schema.Tables = append(schema.Tables, *data)
+			case *sqlmapper.View:
+				schema.Views = append(schema.Views, *data)
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		return schema
+	}
+
+	schema := collect(input)
+	assert.Equal(t, []sqlmapper.Drop{
+		{Type: "TABLE", Name: "users", IfExists: true},
+		{Type: "VIEW", Name: "active_users"},
+	}, schema.Drops)
+	if assert.Len(t, schema.Tables, 1) {
+		assert.True(t, schema.Tables[0].IfNotExists)
+	}
+
+	parser := NewMySQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{PreserveGuards: true})
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "DROP TABLE IF EXISTS users;")
+	assert.Contains(t, output.String(), "DROP VIEW active_users;")
+	assert.Contains(t, output.String(), "CREATE TABLE IF NOT EXISTS users (")
+
+	// The guards survive a second round trip
+	reparsed := collect(output.String())
+	assert.Equal(t, schema.Drops, reparsed.Drops)
+	if assert.Len(t, reparsed.Tables, 1) {
+		assert.True(t, reparsed.Tables[0].IfNotExists)
+	}
+}
+
+func TestMySQLStreamParser_ParseFile_Gzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dump.sql.gz")
+	file, err := os.Create(path)
+	assert.NoError(t, err)
+
+	writer := gzip.NewWriter(file)
+	_, err = writer.Write([]byte(`CREATE TABLE users (id INT, email VARCHAR(255));
+CREATE VIEW active_users AS SELECT id FROM users;
+`))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	assert.NoError(t, file.Close())
+
+	var names []string
+	err = stream.ParseFile(NewMySQLStreamParser(), path, func(obj stream.SchemaObject) error {
+		names = append(names, obj.Name())
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"users", "active_users"}, names)
+}
+
+func TestMySQLStreamParser_GenerateStream_TriggerCondition(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Triggers: []sqlmapper.Trigger{{
+			Name:       "check_price",
+			Timing:     "BEFORE",
+			Events:     []string{"UPDATE"},
+			Table:      "products",
+			Body:       "SET NEW.updated_at = NOW();",
+			Condition:  "(NEW.price > 0)",
+			ForEachRow: true,
+		}},
+	}
+
+	var output strings.Builder
+	err := NewMySQLStreamParser().GenerateStream(schema, &output)
+
+	assert.NoError(t, err)
+	assert.Contains(t, output.String(), "CREATE TRIGGER check_price BEFORE UPDATE ON products\nFOR EACH ROW\nBEGIN\n"+
+		"IF NEW.price > 0 THEN\nSET NEW.updated_at = NOW();\nEND IF;\nEND;")
+}
+
+func TestMySQLStreamParser_GenerateStream_MultiEventTrigger(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Triggers: []sqlmapper.Trigger{{
+			Name:       "audit_users",
+			Timing:     "AFTER",
+			Events:     []string{"INSERT", "UPDATE"},
+			Table:      "users",
+			Body:       "INSERT INTO audit_log (user_id) VALUES (NEW.id);",
+			ForEachRow: true,
+		}},
+	}
+
+	var warnings []string
+	parser := NewMySQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+
+	var output strings.Builder
+	err := parser.GenerateStream(schema, &output)
+
+	assert.NoError(t, err)
+	assert.Contains(t, output.String(), "CREATE TRIGGER audit_users_insert AFTER INSERT ON users\nFOR EACH ROW\n")
+	assert.Contains(t, output.String(), "CREATE TRIGGER audit_users_update AFTER UPDATE ON users\nFOR EACH ROW\n")
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "audit_users")
+}
+
+func TestMySQLStreamParser_GenerateStream_Procedures(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Functions: []sqlmapper.Function{{
+			Name:       "total",
+			Parameters: []sqlmapper.Parameter{{Name: "a", DataType: "INT"}},
+			Returns:    "INT",
+			Body:       "RETURN a * 2;",
+		}},
+		Procedures: []sqlmapper.Procedure{{
+			Name:       "cleanup",
+			Parameters: []sqlmapper.Parameter{{Name: "days", DataType: "INT", Direction: "IN"}},
+			Body:       "DELETE FROM logs WHERE age > days;",
+		}},
+	}
+
+	var warnings []string
+	parser := NewMySQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE FUNCTION total(a INT) RETURNS INT\nBEGIN\nRETURN a * 2;\nEND;\n\n")
+	assert.Contains(t, output.String(), "CREATE PROCEDURE cleanup(IN days INT)\nBEGIN\nDELETE FROM logs WHERE age > days;\nEND;\n\n")
+	assert.Empty(t, warnings)
+}
+
+func TestMySQLStreamParser_TransformStream(t *testing.T) {
+	dump := "CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `email` varchar(255),\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `idx_users_email` (`email`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"INSERT INTO users VALUES (1, 'a@example.com');\n" +
+		"DELIMITER //\n" +
+		"CREATE PROCEDURE cleanup(IN days INT)\n" +
+		"BEGIN\n" +
+		"  DELETE FROM users WHERE id < days;\n" +
+		"END //\n" +
+		"DELIMITER ;\n" +
+		"CREATE VIEW active_users AS SELECT * FROM users;\n"
+
+	// Table names are uppercased wherever a table is named
+	upper := func(obj stream.SchemaObject) (stream.SchemaObject, error) {
+		if table, ok := obj.Data.(*sqlmapper.Table); ok {
+			table.Name = strings.ToUpper(table.Name)
+		}
+		return obj, nil
+	}
+
+	var output strings.Builder
+	parser := NewMySQLStreamParser()
+	assert.NoError(t, parser.TransformStream(strings.NewReader(dump), &output, upper))
+	assert.Equal(t, `CREATE TABLE USERS (
+    id int NOT NULL,
+    email varchar(255),
+    PRIMARY KEY (id)
+) ENGINE=InnoDB;
+
+CREATE INDEX idx_users_email ON USERS(email);
+CREATE PROCEDURE cleanup(IN days INT)
+BEGIN
+DELETE FROM users WHERE id < days;
+END;
+
+CREATE VIEW active_users AS SELECT * FROM users;
+
+`, output.String())
+
+	// The output is read back with every object
+	var types []stream.SchemaObjectType
+	err := parser.ParseStream(strings.NewReader(output.String()), func(obj stream.SchemaObject) error {
+		types = append(types, obj.Type)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []stream.SchemaObjectType{stream.TableObject, stream.ProcedureObject, stream.ViewObject}, types)
+}
+
+func TestMySQLStreamParser_GenerateStream_Transaction(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INT"}}}},
+	}
+
+	parser := NewMySQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{Transaction: true, SessionSettings: []string{"SET NAMES utf8mb4"}})
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.True(t, strings.HasPrefix(output.String(), "SET NAMES utf8mb4;\n\nSTART TRANSACTION;\n\n"))
+	assert.True(t, strings.HasSuffix(output.String(), "COMMIT;\n\n"))
+}
+
+func TestMySQLStreamParser_GenerateStream_OracleTrigger(t *testing.T) {
+	// Triggers as parsed from Oracle, whose bodies refer to the row through :NEW and :OLD
+	schema := &sqlmapper.Schema{
+		Triggers: []sqlmapper.Trigger{
+			{
+				Name:       "orders_audit",
+				Timing:     "BEFORE",
+				Events:     []string{"UPDATE"},
+				Table:      "orders",
+				Body:       "BEGIN\n  :NEW.updated_at := NOW();\n  IF :OLD.status IS NULL THEN\n    :NEW.status := 'NEW';\n  END IF;\nEND",
+				ForEachRow: true,
+			},
+			{
+				Name:       "orders_check",
+				Timing:     "BEFORE",
+				Events:     []string{"INSERT"},
+				Table:      "orders",
+				Body:       "DECLARE\n  v_now DATE := SYSDATE;\nBEGIN\n  :NEW.created_at := v_now;\nEND",
+				ForEachRow: true,
+			},
+			{
+				Name:     "orders_count",
+				Events:   []string{"INSERT"},
+				Table:    "orders",
+				Body:     "AFTER STATEMENT IS\nBEGIN\n  NULL;\nEND AFTER STATEMENT;\nEND orders_count",
+				Compound: true,
+			},
+		},
+	}
+
+	var warnings []string
+	parser := NewMySQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE TRIGGER orders_audit BEFORE UPDATE ON orders\nFOR EACH ROW\nBEGIN\n"+
+		"SET NEW.updated_at = NOW();\n  IF OLD.status IS NULL THEN\n    SET NEW.status = 'NEW';\n  END IF;\nEND;")
+	assert.NotContains(t, output.String(), ":NEW")
+	assert.NotContains(t, output.String(), "orders_count")
+	assert.Equal(t, []string{
+		"trigger orders_check contains PL/SQL that MySQL does not support and needs to be reviewed",
+		"trigger orders_count is an Oracle compound trigger and was skipped",
+	}, warnings)
+}
+
+func TestMySQLStreamParser_ParseStream_MultipleDatabases(t *testing.T) {
+	for _, parallel := range []bool{false, true} {
+		// The stream parser does not apply ALTER TABLE, which is skipped
+		parser := NewMySQLStreamParser()
+
+		var names []string
+		callback := func(obj stream.SchemaObject) error {
+			var name string
+			switch data := obj.Data.(type) {
+			case *sqlmapper.Table:
+				name = data.Schema + "." + data.Name
+			case *sqlmapper.View:
+				name = data.Schema + "." + data.Name
+			}
+			names = append(names, name)
+			return nil
+		}
+
+		var err error
+		if parallel {
+			err = parser.ParseStreamParallel(strings.NewReader(multiDatabaseDump), callback, 2)
+		} else {
+			err = parser.ParseStream(strings.NewReader(multiDatabaseDump), callback)
+		}
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"shop.users", "shop.orders", "blog.users", "blog.active_users"}, names)
+	}
+}
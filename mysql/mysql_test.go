@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -43,6 +44,28 @@ func TestMySQL_Parse(t *testing.T) {
 				assert.Equal(t, "testdb", schema.Name)
 			},
 		},
+		{
+			name: "CREATE TEMPORARY TABLE",
+			content: `
+				CREATE TEMPORARY TABLE import_buffer (
+					id INT NOT NULL,
+					payload VARCHAR(255)
+				);
+				CREATE TABLE imports (id INT NOT NULL);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 2)
+				assert.Equal(t, "import_buffer", schema.Tables[0].Name)
+				assert.True(t, schema.Tables[0].Temporary)
+				assert.Len(t, schema.Tables[0].Columns, 2)
+				assert.False(t, schema.Tables[1].Temporary)
+
+				generated, err := NewMySQL().Generate(schema)
+				assert.NoError(t, err)
+				assert.Contains(t, generated, "CREATE TEMPORARY TABLE import_buffer (")
+				assert.Contains(t, generated, "CREATE TABLE imports (")
+			},
+		},
 		{
 			name: "CREATE TABLE with All Features",
 			content: `
@@ -104,6 +127,22 @@ func TestMySQL_Parse(t *testing.T) {
 				// Index'ler tabloya bağlı olduğu için önce tablo oluşturulmalı
 			},
 		},
+		{
+			name: "CREATE INDEX with sort order",
+			content: `
+				CREATE TABLE events (id INT NOT NULL, user_id INT, created_at DATETIME);
+				CREATE INDEX idx_events_recent ON events(user_id, created_at DESC);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables[0].Indexes, 1)
+				index := schema.Tables[0].Indexes[0]
+				assert.Equal(t, []sqlmapper.IndexColumn{{Name: "user_id"}, {Name: "created_at", Descending: true}}, index.Columns)
+
+				result, err := NewMySQL().Generate(schema)
+				assert.NoError(t, err)
+				assert.Contains(t, result, "(user_id, created_at DESC)")
+			},
+		},
 		{
 			name: "CREATE VIEW",
 			content: `
@@ -172,6 +211,28 @@ func TestMySQL_Parse(t *testing.T) {
 				assert.Equal(t, "after_salary_change", schema.Triggers[1].Name)
 			},
 		},
+		{
+			name: "CREATE TRIGGER with $$ delimiter",
+			content: `
+				CREATE TABLE employees (id INT, name VARCHAR(100));
+
+				DELIMITER $$
+				CREATE TRIGGER before_employee_insert
+				BEFORE INSERT ON employees
+				FOR EACH ROW
+				BEGIN
+					SET NEW.name = UPPER(NEW.name); -- names are stored in upper case
+				END$$
+				DELIMITER ;`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				if assert.Len(t, schema.Triggers, 1) {
+					assert.Equal(t, "before_employee_insert", schema.Triggers[0].Name)
+					assert.Equal(t, "SET NEW.name = UPPER(NEW.name);", schema.Triggers[0].Body)
+				}
+			},
+		},
 		{
 			name: "GRANT and REVOKE",
 			content: `
@@ -207,6 +268,54 @@ func TestMySQL_Parse(t *testing.T) {
 				assert.Len(t, schema.Tables[1].Constraints, 2) // PK ve FK
 			},
 		},
+		{
+			name: "ALTER TABLE DROP FOREIGN KEY and INDEX",
+			content: `
+				CREATE TABLE departments (id INT PRIMARY KEY);
+				CREATE TABLE employees (
+					id INT PRIMARY KEY,
+					department_id INT,
+					email VARCHAR(255),
+					CONSTRAINT fk_department FOREIGN KEY (department_id) REFERENCES departments(id),
+					CONSTRAINT uq_email UNIQUE (email)
+				);
+				CREATE INDEX idx_department ON employees(department_id);
+				ALTER TABLE employees DROP FOREIGN KEY fk_department;
+				ALTER TABLE employees DROP INDEX uq_email, DROP INDEX idx_department;`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				employees := schema.Tables[1]
+				for _, constraint := range employees.Constraints {
+					assert.NotEqual(t, "fk_department", constraint.Name)
+					assert.NotEqual(t, "uq_email", constraint.Name)
+				}
+				assert.Empty(t, employees.Indexes)
+			},
+		},
+		{
+			name: "ADD COLUMN with position",
+			content: `
+				CREATE TABLE users (id INT, email VARCHAR(255));
+				ALTER TABLE users ADD COLUMN tenant_id INT FIRST;
+				ALTER TABLE users ADD COLUMN name VARCHAR(100) AFTER id, ADD COLUMN price DECIMAL(10,2);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				columns := schema.Tables[0].Columns
+				if assert.Len(t, columns, 5) {
+					assert.Equal(t, "tenant_id", columns[0].Name)
+					assert.True(t, columns[0].First)
+					assert.Equal(t, "id", columns[1].Name)
+					assert.Equal(t, "name", columns[2].Name)
+					assert.Equal(t, "id", columns[2].After)
+					assert.Equal(t, 100, columns[2].Length)
+					assert.Equal(t, "email", columns[3].Name)
+					assert.Equal(t, "price", columns[4].Name)
+					assert.Equal(t, 2, columns[4].Scale)
+					assert.Equal(t, 3, columns[2].Order)
+				}
+			},
+		},
 		{
 			name: "INSERT INTO",
 			content: `
@@ -285,8 +394,8 @@ CREATE TABLE users (
 							{Name: "price", DataType: "DECIMAL", Length: 10, Scale: 2, IsNullable: true},
 						},
 						Indexes: []sqlmapper.Index{
-							{Name: "idx_name", Columns: []string{"name"}},
-							{Name: "idx_price", Columns: []string{"price"}, IsUnique: true},
+							{Name: "idx_name", Columns: sqlmapper.IndexColumns("name")},
+							{Name: "idx_price", Columns: sqlmapper.IndexColumns("price"), IsUnique: true},
 						},
 					},
 				},
@@ -301,6 +410,49 @@ CREATE INDEX idx_name ON products(name);
 CREATE UNIQUE INDEX idx_price ON products(price);`),
 			wantErr: false,
 		},
+		{
+			name: "Comments are inlined",
+			schema: &sqlmapper.Schema{
+				Tables: []sqlmapper.Table{
+					{
+						Name:    "accounts",
+						Comment: "Customer accounts",
+						Columns: []sqlmapper.Column{
+							{Name: "id", DataType: "INT", IsPrimaryKey: true},
+							{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true, Comment: "Can't be shared"},
+						},
+					},
+				},
+			},
+			want: strings.TrimSpace(`
+CREATE TABLE accounts (
+    id INT PRIMARY KEY,
+    email VARCHAR(255) COMMENT 'Can''t be shared'
+) COMMENT='Customer accounts';`),
+			wantErr: false,
+		},
+		{
+			name: "Columns added with position hints",
+			schema: &sqlmapper.Schema{
+				Tables: []sqlmapper.Table{
+					{
+						Name: "users",
+						Columns: []sqlmapper.Column{
+							{Name: "tenant_id", DataType: "INT", IsNullable: true, First: true},
+							{Name: "id", DataType: "INT", IsNullable: true},
+							{Name: "name", DataType: "VARCHAR", Length: 100, IsNullable: true, After: "id"},
+						},
+					},
+				},
+			},
+			want: strings.TrimSpace(`
+CREATE TABLE users (
+    id INT
+);
+ALTER TABLE users ADD COLUMN tenant_id INT FIRST;
+ALTER TABLE users ADD COLUMN name VARCHAR(100) AFTER id;`),
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -317,3 +469,803 @@ CREATE UNIQUE INDEX idx_price ON products(price);`),
 		})
 	}
 }
+
+func TestMySQL_Generate_Permissions(t *testing.T) {
+	content := `
+CREATE TABLE orders (
+    id INT NOT NULL,
+    total DECIMAL(10,2)
+);
+GRANT SELECT, INSERT ON orders TO 'app'@'localhost' WITH GRANT OPTION;
+REVOKE INSERT ON orders FROM 'app'@'localhost';`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if assert.Len(t, schema.Permissions, 2) {
+		assert.Equal(t, sqlmapper.Permission{
+			Type:       "GRANT",
+			Privileges: []string{"SELECT", "INSERT"},
+			Object:     "orders",
+			Grantee:    "app@localhost",
+			WithGrant:  true,
+		}, schema.Permissions[0])
+	}
+
+	// Permissions are omitted unless requested
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.NotContains(t, result, "GRANT")
+
+	generator := NewMySQL().(*MySQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{IncludePermissions: true})
+	result, err = generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "GRANT SELECT, INSERT ON orders TO 'app'@'localhost' WITH GRANT OPTION;")
+	assert.Contains(t, result, "REVOKE INSERT ON orders FROM 'app'@'localhost';")
+	assert.Less(t, strings.Index(result, "CREATE TABLE orders"), strings.Index(result, "GRANT"))
+
+	reparsed, err := NewMySQL().Parse(result)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Permissions, reparsed.Permissions)
+}
+
+func TestMySQL_Generate_Transaction(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INT"}}}},
+	}
+
+	var warnings []string
+	generator := NewMySQL().(*MySQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{
+		Transaction:     true,
+		SessionSettings: []string{"SET NAMES utf8mb4", "SET FOREIGN_KEY_CHECKS=0;"},
+		OnWarning:       func(message string) { warnings = append(warnings, message) },
+	})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "SET NAMES utf8mb4;\nSET FOREIGN_KEY_CHECKS=0;\nSTART TRANSACTION;\n"))
+	assert.True(t, strings.HasSuffix(result, "\nCOMMIT;"))
+	assert.Less(t, strings.Index(result, "START TRANSACTION"), strings.Index(result, "CREATE TABLE users"))
+	assert.Len(t, warnings, 1)
+}
+
+func TestMySQL_Generate_DisableForeignKeyChecks(t *testing.T) {
+	content := `
+CREATE TABLE orders (
+    id INT NOT NULL,
+    customer_id INT,
+    FOREIGN KEY (customer_id) REFERENCES customers(id)
+);
+CREATE TABLE customers (
+    id INT NOT NULL
+);`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.NotContains(t, result, "FOREIGN_KEY_CHECKS")
+
+	generator := NewMySQL().(*MySQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{DisableForeignKeyChecks: true, Transaction: true})
+	result, err = generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "SET FOREIGN_KEY_CHECKS=0;\nSTART TRANSACTION;\n"))
+	assert.True(t, strings.HasSuffix(result, "\nCOMMIT;\nSET FOREIGN_KEY_CHECKS=1;"))
+}
+
+func TestMySQL_Generate_TableOptions(t *testing.T) {
+	content := `
+CREATE TABLE archive (
+    id INT NOT NULL,
+    payload TEXT
+) ENGINE=InnoDB ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8 COMMENT='Archived (old) rows';`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) {
+		return
+	}
+	table := schema.Tables[0]
+	assert.Equal(t, "ENGINE=InnoDB ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8", table.Options)
+	assert.Equal(t, "COMPRESSED", table.TableOptions()["ROW_FORMAT"])
+	assert.Equal(t, "8", table.TableOptions()["KEY_BLOCK_SIZE"])
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, ") ENGINE=InnoDB ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8 COMMENT='Archived (old) rows';")
+
+	reparsed, err := NewMySQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 1) {
+		assert.Equal(t, table.Options, reparsed.Tables[0].Options)
+		assert.Equal(t, "Archived (old) rows", reparsed.Tables[0].Comment)
+	}
+}
+
+func TestMySQL_AutoIncrementWithTableLevelPrimaryKey(t *testing.T) {
+	content := `
+CREATE TABLE orders (
+    id INT AUTO_INCREMENT,
+    code VARCHAR(10) NOT NULL,
+    PRIMARY KEY (id)
+) ENGINE=InnoDB;
+CREATE TABLE order_lines (
+    order_id INT NOT NULL,
+    line INT NOT NULL AUTO_INCREMENT,
+    PRIMARY KEY (line, order_id)
+);
+CREATE TABLE customers (
+    id INT AUTO_INCREMENT PRIMARY KEY
+);`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 3) {
+		return
+	}
+
+	orders := schema.Tables[0]
+	if assert.Len(t, orders.Columns, 2) {
+		assert.True(t, orders.Columns[0].AutoIncrement)
+		assert.False(t, orders.Columns[0].IsPrimaryKey)
+		assert.False(t, orders.Columns[0].IsNullable)
+		assert.False(t, orders.Columns[1].AutoIncrement)
+	}
+	assert.Equal(t, []sqlmapper.Constraint{{Type: "PRIMARY KEY", Columns: []string{"id"}}}, orders.Constraints)
+
+	customers := schema.Tables[2]
+	if assert.Len(t, customers.Columns, 1) {
+		assert.True(t, customers.Columns[0].AutoIncrement)
+		assert.True(t, customers.Columns[0].IsPrimaryKey)
+	}
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "    id INT AUTO_INCREMENT NOT NULL,\n    code VARCHAR(10) NOT NULL,\n    PRIMARY KEY (id)\n)")
+	assert.Contains(t, result, "    line INT AUTO_INCREMENT NOT NULL,\n    PRIMARY KEY (line, order_id)\n)")
+	assert.Contains(t, result, "    id INT AUTO_INCREMENT PRIMARY KEY\n)")
+
+	reparsed, err := NewMySQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 3) {
+		assert.Equal(t, schema.Tables[0].Columns, reparsed.Tables[0].Columns)
+		assert.Equal(t, schema.Tables[1].Constraints, reparsed.Tables[1].Constraints)
+	}
+}
+
+func TestMySQL_UnsignedZerofill(t *testing.T) {
+	content := `
+CREATE TABLE counters (
+    id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+    code INT(5) ZEROFILL,
+    hits BIGINT unsigned,
+    delta INT,
+    PRIMARY KEY (id)
+);`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) || !assert.Len(t, schema.Tables[0].Columns, 4) {
+		return
+	}
+	columns := schema.Tables[0].Columns
+	assert.True(t, columns[0].Unsigned)
+	assert.False(t, columns[0].Zerofill)
+	assert.True(t, columns[1].Unsigned)
+	assert.True(t, columns[1].Zerofill)
+	assert.Equal(t, 5, columns[1].Length)
+	assert.True(t, columns[2].Unsigned)
+	assert.False(t, columns[3].Unsigned)
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "id INT UNSIGNED AUTO_INCREMENT NOT NULL")
+	assert.Contains(t, result, "code INT(5) UNSIGNED ZEROFILL")
+	assert.Contains(t, result, "hits BIGINT UNSIGNED")
+	assert.Contains(t, result, "delta INT,")
+
+	reparsed, err := NewMySQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 1) {
+		assert.Equal(t, columns, reparsed.Tables[0].Columns)
+	}
+}
+
+func TestMySQL_TypeParameters(t *testing.T) {
+	content := `
+CREATE TABLE prices (
+    amount DECIMAL(10,2) NOT NULL,
+    ratio NUMERIC(5, 3),
+    name VARCHAR(255),
+    flag CHAR(1),
+    created TIMESTAMP(6)
+);`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) || !assert.Len(t, schema.Tables[0].Columns, 5) {
+		return
+	}
+	columns := schema.Tables[0].Columns
+	assert.Equal(t, "DECIMAL", columns[0].DataType)
+	assert.Equal(t, 10, columns[0].Length)
+	assert.Equal(t, 2, columns[0].Scale)
+	assert.Equal(t, "NUMERIC", columns[1].DataType)
+	assert.Equal(t, 5, columns[1].Length)
+	assert.Equal(t, 3, columns[1].Scale)
+	assert.Equal(t, 255, columns[2].Length)
+	assert.Equal(t, 1, columns[3].Length)
+	assert.Equal(t, "TIMESTAMP", columns[4].DataType)
+	assert.Equal(t, 6, columns[4].Length)
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "amount DECIMAL(10,2) NOT NULL")
+	assert.Contains(t, result, "ratio NUMERIC(5,3)")
+	assert.Contains(t, result, "name VARCHAR(255)")
+	assert.Contains(t, result, "flag CHAR(1)")
+	assert.Contains(t, result, "created TIMESTAMP(6)")
+
+	reparsed, err := NewMySQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 1) {
+		assert.Equal(t, columns, reparsed.Tables[0].Columns)
+	}
+}
+
+func TestMySQL_FractionalSeconds(t *testing.T) {
+	content := `
+CREATE TABLE events (
+    created DATETIME(3) NOT NULL,
+    updated TIMESTAMP(6),
+    duration TIME(6)
+);`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) || !assert.Len(t, schema.Tables[0].Columns, 3) {
+		return
+	}
+	columns := schema.Tables[0].Columns
+	assert.Equal(t, 3, sqlmapper.FractionalSeconds(columns[0]))
+	assert.Equal(t, 6, sqlmapper.FractionalSeconds(columns[1]))
+	assert.Equal(t, 6, sqlmapper.FractionalSeconds(columns[2]))
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "created DATETIME(3) NOT NULL")
+	assert.Contains(t, result, "updated TIMESTAMP(6)")
+	assert.Contains(t, result, "duration TIME(6)")
+
+	// SQL Server stores fractional seconds up to 100 nanoseconds
+	var warnings []string
+	generator := NewMySQL().(*MySQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+	result, err = generator.Generate(&sqlmapper.Schema{Tables: []sqlmapper.Table{{
+		Name:    "events",
+		Columns: []sqlmapper.Column{{Name: "created", DataType: "DATETIME2", Length: 7}},
+	}}})
+	assert.NoError(t, err)
+	assert.Contains(t, result, "created DATETIME(6)")
+	assert.Equal(t, []string{"fractional seconds of column events.created were reduced to 6 digits"}, warnings)
+}
+
+func TestMySQL_FractionalSecondsDefaults(t *testing.T) {
+	content := "CREATE TABLE `events` (\n" +
+		"  `created` datetime(3) DEFAULT CURRENT_TIMESTAMP(3),\n" +
+		"  `updated` timestamp(6) NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),\n" +
+		"  `seen` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP\n" +
+		") ENGINE=InnoDB;"
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) || !assert.Len(t, schema.Tables[0].Columns, 3) {
+		return
+	}
+	columns := schema.Tables[0].Columns
+	assert.Equal(t, "CURRENT_TIMESTAMP(3)", columns[0].DefaultValue)
+	assert.Empty(t, columns[0].OnUpdate)
+	assert.Equal(t, "CURRENT_TIMESTAMP(6)", columns[1].DefaultValue)
+	assert.Equal(t, "CURRENT_TIMESTAMP(6)", columns[1].OnUpdate)
+	assert.Equal(t, "CURRENT_TIMESTAMP", columns[2].OnUpdate)
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "created datetime(3) DEFAULT CURRENT_TIMESTAMP(3)")
+	assert.Contains(t, result, "updated timestamp(6) DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)")
+	assert.Contains(t, result, "seen timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP")
+
+	reparsed, err := NewMySQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 1) {
+		assert.Equal(t, columns, reparsed.Tables[0].Columns)
+	}
+}
+
+func TestMySQL_Generate_Inherits(t *testing.T) {
+	var warnings []string
+	generator := NewMySQL().(*MySQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+
+	// A PostgreSQL table inheriting the columns of its parent
+	result, err := generator.Generate(&sqlmapper.Schema{Tables: []sqlmapper.Table{{
+		Name:     "capitals",
+		Columns:  []sqlmapper.Column{{Name: "state", DataType: "CHAR", Length: 2}},
+		Inherits: []string{"cities"},
+	}}})
+	assert.NoError(t, err)
+	assert.Contains(t, result, "CREATE TABLE capitals (")
+	assert.NotContains(t, result, "INHERITS")
+	assert.Equal(t, []string{"table capitals inherits from cities, inheritance is not supported and was dropped"}, warnings)
+}
+
+func TestMySQL_FulltextAndSpatialIndexes(t *testing.T) {
+	content := `
+CREATE TABLE places (
+    id INT NOT NULL,
+    name VARCHAR(100),
+    description TEXT,
+    location POINT NOT NULL,
+    PRIMARY KEY (id),
+    FULLTEXT KEY ft_places_text (name, description),
+    SPATIAL INDEX (location)
+);
+CREATE FULLTEXT INDEX ft_places_name ON places(name);
+CREATE SPATIAL INDEX sp_places_location ON places(location);`
+
+	schema, err := NewMySQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) {
+		return
+	}
+	assert.Len(t, schema.Tables[0].Columns, 4)
+	indexes := schema.Tables[0].Indexes
+	if !assert.Len(t, indexes, 4) {
+		return
+	}
+	assert.Equal(t, sqlmapper.Index{Name: "ft_places_text", Columns: sqlmapper.IndexColumns("name", "description"), Kind: sqlmapper.FulltextIndex}, indexes[0])
+	assert.Equal(t, sqlmapper.Index{Name: "location", Columns: sqlmapper.IndexColumns("location"), Kind: sqlmapper.SpatialIndex}, indexes[1])
+	assert.Equal(t, sqlmapper.FulltextIndex, indexes[2].Kind)
+	assert.Equal(t, sqlmapper.SpatialIndex, indexes[3].Kind)
+	assert.False(t, indexes[2].IsUnique)
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "CREATE FULLTEXT INDEX ft_places_text ON places(name, description);")
+	assert.Contains(t, result, "CREATE SPATIAL INDEX location ON places(location);")
+	assert.Contains(t, result, "CREATE FULLTEXT INDEX ft_places_name ON places(name);")
+	assert.Contains(t, result, "CREATE SPATIAL INDEX sp_places_location ON places(location);")
+
+	reparsed, err := NewMySQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 1) {
+		assert.Equal(t, indexes, reparsed.Tables[0].Indexes)
+	}
+}
+
+func TestMySQL_GenerateObjects(t *testing.T) {
+	db := NewMySQL()
+	table := sqlmapper.Table{
+		Name: "users",
+		Columns: []sqlmapper.Column{
+			{Name: "id", DataType: "INT"},
+			{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true},
+		},
+	}
+
+	sql, err := sqlmapper.GenerateTable(db, table)
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE TABLE users (")
+	assert.Contains(t, sql, "email VARCHAR(255)")
+	assert.False(t, strings.HasSuffix(sql, ";"))
+
+	sql, err = sqlmapper.GenerateIndex(db, "users", sqlmapper.Index{Name: "idx_users_email", Columns: sqlmapper.IndexColumns("email"), IsUnique: true})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE UNIQUE INDEX idx_users_email ON users")
+	assert.Contains(t, sql, "(email)")
+
+	sql, err = sqlmapper.GenerateView(db, sqlmapper.View{Name: "active_users", Definition: "SELECT * FROM users"})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE VIEW active_users AS")
+	assert.Contains(t, sql, "SELECT * FROM users")
+}
+
+// dumpPreamble is the preamble mysqldump writes before the first table
+const dumpPreamble = `-- MySQL dump 10.13  Distrib 8.0.36, for Linux (x86_64)
+--
+-- Host: localhost    Database: shop
+-- ------------------------------------------------------
+-- Server version	8.0.36
+
+/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
+/*!40101 SET @OLD_CHARACTER_SET_RESULTS=@@CHARACTER_SET_RESULTS */;
+/*!50503 SET NAMES utf8mb4 */;
+/*!40103 SET @OLD_TIME_ZONE=@@TIME_ZONE */;
+/*!40103 SET TIME_ZONE='+00:00' */;
+/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */;
+SET FOREIGN_KEY_CHECKS=0;
+SET @OLD_SQL_MODE=@@SQL_MODE,
+    SQL_MODE='NO_AUTO_VALUE_ON_ZERO';
+
+CREATE TABLE users (
+    id INT NOT NULL AUTO_INCREMENT,
+    name VARCHAR(100) NOT NULL,
+    PRIMARY KEY (id)
+);
+`
+
+func TestMySQL_Parse_DumpPreamble(t *testing.T) {
+	schema, err := NewMySQL().Parse(dumpPreamble)
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+	assert.Equal(t, "users", schema.Tables[0].Name)
+
+	charset, ok := schema.Setting("NAMES")
+	assert.True(t, ok)
+	assert.Equal(t, "utf8mb4", charset)
+
+	timeZone, _ := schema.Setting("TIME_ZONE")
+	assert.Equal(t, "+00:00", timeZone)
+	sqlMode, _ := schema.Setting("SQL_MODE")
+	assert.Equal(t, "NO_AUTO_VALUE_ON_ZERO", sqlMode)
+	assert.Len(t, schema.Settings, 10)
+}
+
+func TestMySQL_InvisibleColumnsAndIndexes(t *testing.T) {
+	input := `CREATE TABLE users (
+    id INT NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    legacy_code VARCHAR(20) INVISIBLE,
+    PRIMARY KEY (id),
+    KEY idx_legacy (legacy_code) INVISIBLE,
+    KEY idx_email (email) VISIBLE
+);
+CREATE INDEX idx_email_code ON users (email, legacy_code) INVISIBLE;`
+
+	schema, err := NewMySQL().Parse(input)
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	table := schema.Tables[0]
+	assert.Len(t, table.Columns, 3)
+	assert.False(t, table.Columns[1].Invisible)
+	assert.True(t, table.Columns[2].Invisible)
+
+	assert.Len(t, table.Indexes, 3)
+	assert.True(t, table.Indexes[0].Invisible)
+	assert.False(t, table.Indexes[1].Invisible)
+	assert.Equal(t, "idx_email_code", table.Indexes[2].Name)
+	assert.True(t, table.Indexes[2].Invisible)
+
+	result, err := NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "legacy_code VARCHAR(20) INVISIBLE")
+	assert.Contains(t, result, "CREATE INDEX idx_legacy ON users(legacy_code) INVISIBLE;")
+	assert.Contains(t, result, "CREATE INDEX idx_email ON users(email);")
+	assert.Contains(t, result, "CREATE INDEX idx_email_code ON users(email, legacy_code) INVISIBLE;")
+}
+
+// multiDatabaseDump is a mysqldump of two databases having a table of the same name
+const multiDatabaseDump = `CREATE DATABASE /*!32312 IF NOT EXISTS*/ ` + "`shop`" + ` /*!40100 DEFAULT CHARACTER SET utf8mb4 */;
+
+USE ` + "`shop`" + `;
+
+CREATE TABLE ` + "`users`" + ` (
+    ` + "`id`" + ` INT NOT NULL,
+    PRIMARY KEY (` + "`id`" + `)
+);
+
+CREATE TABLE orders (
+    id INT NOT NULL,
+    user_id INT NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE DATABASE /*!32312 IF NOT EXISTS*/ ` + "`blog`" + `;
+
+USE ` + "`blog`" + `;
+
+CREATE TABLE users (
+    id INT NOT NULL,
+    nickname VARCHAR(50),
+    PRIMARY KEY (id)
+);
+
+ALTER TABLE users ADD COLUMN bio TEXT;
+
+CREATE VIEW active_users AS SELECT * FROM users;
+`
+
+func TestMySQL_Parse_MultipleDatabases(t *testing.T) {
+	schema, err := NewMySQL().Parse(multiDatabaseDump)
+	assert.NoError(t, err)
+	assert.Equal(t, "shop", schema.Name)
+
+	var tables []string
+	for _, table := range schema.Tables {
+		tables = append(tables, table.Schema+"."+table.Name)
+	}
+	assert.Equal(t, []string{"shop.users", "shop.orders", "blog.users"}, tables)
+
+	// ALTER TABLE only changes the table of the database it follows
+	assert.Len(t, schema.Tables[0].Columns, 1)
+	assert.Len(t, schema.Tables[2].Columns, 3)
+
+	assert.Len(t, schema.Views, 1)
+	assert.Equal(t, "blog", schema.Views[0].Schema)
+}
+
+func TestMySQL_Parse_ChangeColumn(t *testing.T) {
+	m := NewMySQL()
+	schema, err := m.Parse(`CREATE TABLE users (
+    id INT NOT NULL AUTO_INCREMENT,
+    mail VARCHAR(100),
+    name VARCHAR(50),
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_users_mail (mail)
+);
+ALTER TABLE users CHANGE COLUMN mail email VARCHAR(255) NOT NULL, CHANGE name full_name TEXT FIRST;`)
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	table := schema.Tables[0]
+	var names []string
+	for _, column := range table.Columns {
+		names = append(names, column.Name)
+	}
+	assert.Equal(t, []string{"full_name", "id", "email"}, names)
+
+	email := table.Columns[2]
+	assert.Equal(t, "VARCHAR", email.DataType)
+	assert.Equal(t, 255, email.Length)
+	assert.False(t, email.IsNullable)
+	assert.Equal(t, "TEXT", table.Columns[0].DataType)
+	assert.Equal(t, 1, table.Columns[0].Order)
+
+	// The unique key follows the renamed column
+	var unique []string
+	for _, constraint := range table.Constraints {
+		if constraint.Type == "UNIQUE" {
+			unique = append(unique, constraint.Columns...)
+		}
+	}
+	for _, index := range table.Indexes {
+		unique = append(unique, index.ColumnNames()...)
+	}
+	assert.Contains(t, unique, "email")
+	assert.NotContains(t, unique, "mail")
+}
+
+func TestMySQL_QuoteLiteral(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"plain", "'plain'"},
+		{"it's", "'it''s'"},
+		{`C:\temp`, `'C:\\temp'`},
+		{"two\nlines", `'two\nlines'`},
+		{`\'; DROP TABLE users; --`, `'\\''; DROP TABLE users; --'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, quoteLiteral(tt.value))
+		})
+	}
+
+	m := NewMySQL().(*MySQL)
+	column := sqlmapper.Column{Name: "path", DataType: "VARCHAR", Length: 50, IsNullable: true, DefaultValue: `C:\it's`, Comment: "first\nsecond"}
+	assert.Equal(t, `path VARCHAR(50) DEFAULT 'C:\\it''s' COMMENT 'first\nsecond'`, m.generateColumnSQL(column))
+}
+
+func TestMySQL_ColumnCharacterSet(t *testing.T) {
+	m := NewMySQL()
+	schema, err := m.Parse("CREATE TABLE `names` (\n" +
+		"  `code` varchar(50) CHARACTER SET latin1 COLLATE latin1_bin NOT NULL,\n" +
+		"  `title` varchar(100) CHARSET utf8mb4 DEFAULT NULL,\n" +
+		"  `slug` varchar(100) COLLATE utf8mb4_unicode_ci,\n" +
+		"  `id` int NOT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;")
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	columns := schema.Tables[0].Columns
+	assert.Equal(t, "latin1", columns[0].CharacterSet)
+	assert.Equal(t, "latin1_bin", columns[0].Collation)
+	assert.Equal(t, "utf8mb4", columns[1].CharacterSet)
+	assert.Equal(t, "", columns[1].Collation)
+	assert.Equal(t, "", columns[2].CharacterSet)
+	assert.Equal(t, "utf8mb4_unicode_ci", columns[2].Collation)
+	assert.Equal(t, "", columns[3].CharacterSet)
+	assert.Equal(t, "", columns[3].Collation)
+
+	result, err := m.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "code varchar(50) CHARACTER SET latin1 COLLATE latin1_bin NOT NULL")
+	assert.Contains(t, result, "title varchar(100) CHARACTER SET utf8mb4")
+	assert.Contains(t, result, "slug varchar(100) COLLATE utf8mb4_unicode_ci")
+	assert.Contains(t, result, "id int NOT NULL")
+}
+
+func TestMySQL_ExpressionDefaults(t *testing.T) {
+	m := NewMySQL()
+	schema, err := m.Parse("CREATE TABLE `tasks` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `due` date DEFAULT (CURRENT_DATE + INTERVAL 1 DAY) NOT NULL,\n" +
+		"  `code` char(36) DEFAULT (uuid()),\n" +
+		"  `label` varchar(20) DEFAULT (concat('a', lower(upper('B'))))\n" +
+		") ENGINE=InnoDB;")
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	columns := schema.Tables[0].Columns
+	assert.Equal(t, "(CURRENT_DATE + INTERVAL 1 DAY)", columns[1].DefaultValue)
+	assert.Equal(t, "(uuid())", columns[2].DefaultValue)
+	assert.Equal(t, "(concat('a', lower(upper('B'))))", columns[3].DefaultValue)
+
+	result, err := m.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "DEFAULT (CURRENT_DATE + INTERVAL 1 DAY)")
+	assert.Contains(t, result, "DEFAULT (uuid())")
+	assert.Contains(t, result, "DEFAULT (concat('a', lower(upper('B'))))")
+}
+
+func TestMySQL_SetType(t *testing.T) {
+	m := NewMySQL()
+	schema, err := m.Parse("CREATE TABLE `posts` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `tags` set('news', 'sport', 'default') NOT NULL DEFAULT 'news,sport',\n" +
+		"  `flags` SET('it''s','b')\n" +
+		") ENGINE=InnoDB;")
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	tags := schema.Tables[0].Columns[1]
+	assert.Equal(t, "SET", tags.DataType)
+	assert.Equal(t, []string{"news", "sport", "default"}, tags.Members)
+	assert.Equal(t, "news,sport", tags.DefaultValue)
+	assert.False(t, tags.IsNullable)
+
+	flags := schema.Tables[0].Columns[2]
+	assert.Equal(t, []string{"it's", "b"}, flags.Members)
+	assert.True(t, flags.IsNullable)
+
+	result, err := m.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "tags SET('news','sport','default') DEFAULT 'news,sport',")
+	assert.Contains(t, result, "flags SET('it''s','b')\n")
+}
+
+func TestMySQL_ParseErrorContext(t *testing.T) {
+	m := NewMySQL()
+	_, err := m.Parse("CREATE TABLE `users` (\n  `id` int NOT NULL,\n  `price`,\n  `name` varchar(100)\n) ENGINE=InnoDB;")
+	assert.EqualError(t, err, "error parsing tables: table users near \"CREATE TABLE users ( id int NOT NULL, pr...\": "+
+		"column price near \"price\": invalid column definition: price")
+
+	var parseErr *sqlmapper.ParseError
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Equal(t, "table", parseErr.Kind)
+		assert.Equal(t, "users", parseErr.Name)
+		// The error of the column is wrapped by the error of its table
+		var columnErr *sqlmapper.ParseError
+		assert.True(t, errors.As(parseErr.Err, &columnErr))
+		assert.Equal(t, "column", columnErr.Kind)
+		assert.Equal(t, "price", columnErr.Name)
+	}
+
+	m = NewMySQL()
+	_, err = m.Parse("CREATE TABLE users (id int);\nALTER TABLE users ADD COLUMN email;")
+	assert.ErrorContains(t, err, "table users near \"ALTER TABLE users ADD COLUMN email;\": column email near \"email\"")
+}
+
+func TestMySQL_ReservedWordIndexColumns(t *testing.T) {
+	m := NewMySQL()
+	schema, err := m.Parse("CREATE TABLE `orders` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `order` int NOT NULL,\n" +
+		"  `key` varchar(10),\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `idx_order` (`order` DESC, `orders`.`key`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"CREATE INDEX idx_key ON orders (orders.`key`);")
+	assert.NoError(t, err)
+
+	table := schema.Tables[0]
+	assert.Len(t, table.Columns, 3)
+	assert.Equal(t, "key", table.Columns[2].Name)
+	assert.Equal(t, "VARCHAR", strings.ToUpper(table.Columns[2].DataType))
+	assert.Equal(t, 10, table.Columns[2].Length)
+
+	assert.Len(t, table.Indexes, 2)
+	assert.Equal(t, "idx_order", table.Indexes[0].Name)
+	assert.Equal(t, []sqlmapper.IndexColumn{{Name: "order", Descending: true}, {Name: "key"}}, table.Indexes[0].Columns)
+	assert.Equal(t, "idx_key", table.Indexes[1].Name)
+	assert.Equal(t, []string{"key"}, table.Indexes[1].ColumnNames())
+
+	result, err := m.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "    `order` int NOT NULL,\n    `key` varchar(10)")
+	assert.Contains(t, result, "CREATE INDEX idx_order ON orders(`order` DESC, `key`);")
+	assert.Contains(t, result, "CREATE INDEX idx_key ON orders(`key`);")
+}
+
+// hashCommentDump is a dump using the # comments written by mysqldump and many GUI tools
+const hashCommentDump = "# Dump of table users\n" +
+	"# ------------------------------------------------------------\n" +
+	"\n" +
+	"CREATE TABLE `users` (\n" +
+	"  `id` int NOT NULL, # surrogate key; never reused\n" +
+	"  `color` char(4) DEFAULT '#fff',\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	") ENGINE=InnoDB;\n" +
+	"\n" +
+	"# Dump of table posts\n" +
+	"CREATE TABLE `posts` (`id` int NOT NULL, `user_id` int); # end of posts\n"
+
+func TestMySQL_HashComments(t *testing.T) {
+	m := NewMySQL()
+	schema, err := m.Parse(hashCommentDump)
+	assert.NoError(t, err)
+
+	assert.Len(t, schema.Tables, 2)
+	users := schema.Tables[0]
+	assert.Equal(t, "users", users.Name)
+	assert.Len(t, users.Columns, 2)
+	assert.Equal(t, "color", users.Columns[1].Name)
+	assert.Equal(t, "#fff", users.Columns[1].DefaultValue)
+	assert.Equal(t, "posts", schema.Tables[1].Name)
+	assert.Len(t, schema.Tables[1].Columns, 2)
+}
+
+func TestMySQL_DashComments(t *testing.T) {
+	// -- only starts a comment when followed by whitespace or a control character
+	m := NewMySQL()
+	schema, err := m.Parse("CREATE TABLE orders (\n" +
+		"  id int, -- surrogate key\n" +
+		"  discount int,--\tapplied once\n" +
+		"  total int CHECK (total--discount > 0),\n" +
+		"  note varchar(10) DEFAULT '-- none'\n" +
+		");")
+	assert.NoError(t, err)
+
+	columns := schema.Tables[0].Columns
+	assert.Len(t, columns, 4)
+	assert.Equal(t, "total--discount > 0", columns[2].CheckExpression)
+	assert.Equal(t, "note", columns[3].Name)
+	assert.Equal(t, "-- none", columns[3].DefaultValue)
+}
+
+func TestMySQL_IndexPrefixLengths(t *testing.T) {
+	m := NewMySQL()
+	schema, err := m.Parse("CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `name` varchar(255) NOT NULL,\n" +
+		"  `bio` text,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  UNIQUE KEY `uq_name` (`name`(20)),\n" +
+		"  KEY `idx_name` (`name`(10) DESC, `id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"CREATE INDEX idx_bio ON users (bio(100));")
+	assert.NoError(t, err)
+
+	table := schema.Tables[0]
+	assert.Len(t, table.Indexes, 3)
+	// A unique key on a prefix is kept as a unique index, constraints have no prefix lengths
+	assert.Equal(t, sqlmapper.Index{Name: "uq_name", Columns: []sqlmapper.IndexColumn{{Name: "name", Length: 20}}, IsUnique: true}, table.Indexes[0])
+	assert.Equal(t, []sqlmapper.IndexColumn{{Name: "name", Descending: true, Length: 10}, {Name: "id"}}, table.Indexes[1].Columns)
+	assert.Equal(t, []sqlmapper.IndexColumn{{Name: "bio", Length: 100}}, table.Indexes[2].Columns)
+	assert.Len(t, table.Constraints, 1)
+
+	result, err := m.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "CREATE UNIQUE INDEX uq_name ON users(name(20));")
+	assert.Contains(t, result, "CREATE INDEX idx_name ON users(name(10) DESC, id);")
+	assert.Contains(t, result, "CREATE INDEX idx_bio ON users(bio(100));")
+}
@@ -0,0 +1,250 @@
+package sqlmapper
+
+import (
+	"sort"
+	"strings"
+)
+
+// normalizeKeywords are the keywords upper-cased in normalized expressions and bodies.
+// Other unquoted words are identifiers and keep their case.
+var normalizeKeywords = func() map[string]bool {
+	keywords := make(map[string]bool)
+	for _, keyword := range strings.Fields(`
+		ALL AND ANY AS ASC BEGIN BETWEEN BY CASE CROSS CURRENT_DATE
+		CURRENT_TIME CURRENT_TIMESTAMP DECLARE DEFAULT DELETE DESC DISTINCT EACH ELSE
+		ELSIF END EXCEPT EXISTS FALSE FOR FROM FULL GROUP HAVING IF ILIKE IN INNER
+		INSERT INTERSECT INTO IS JOIN LEFT LIKE LIMIT LOOP NEW NOT NULL OFFSET OLD
+		ON OR ORDER OUTER RAISE RETURN RETURNS RIGHT ROW SELECT SET THEN TRUE UNION
+		UPDATE USING VALUES WHEN WHERE WHILE WITH`) {
+		keywords[keyword] = true
+	}
+	return keywords
+}()
+
+// Normalize rewrites the schema in place into a canonical form, so that schemas
+// differing only in formatting compare equal with reflect.DeepEqual:
+//   - whitespace and comments in bodies and expressions are canonicalized and
+//     keywords are upper-cased; string literals and quoted identifiers are kept
+//   - data types, constraint types, trigger timings and events are upper-cased
+//   - objects of the schema, indexes, constraints and privileges are sorted, while
+//     columns and the key columns of indexes and constraints keep their order
+//   - values equal to the default, such as a NULL default of a nullable column or a
+//     NO ACTION rule of a foreign key, are cleared
+//   - comments preceding definitions in the source dump are cleared
+func (s *Schema) Normalize() {
+	for i := range s.Tables {
+		normalizeTable(&s.Tables[i])
+	}
+	sort.SliceStable(s.Tables, func(i, j int) bool {
+		return objectKey(s.Tables[i].Schema, s.Tables[i].Name) < objectKey(s.Tables[j].Schema, s.Tables[j].Name)
+	})
+
+	for i := range s.Views {
+		view := &s.Views[i]
+		view.Definition = normalizeSQL(view.Definition)
+		view.SourceComment = ""
+	}
+	sort.SliceStable(s.Views, func(i, j int) bool {
+		return objectKey(s.Views[i].Schema, s.Views[i].Name) < objectKey(s.Views[j].Schema, s.Views[j].Name)
+	})
+
+	for i := range s.Functions {
+		function := &s.Functions[i]
+		function.Body = normalizeSQL(function.Body)
+		function.Returns = normalizeType(function.Returns)
+		function.Language = strings.ToUpper(function.Language)
+		normalizeParameters(function.Parameters)
+		function.SourceComment = ""
+	}
+	sort.SliceStable(s.Functions, func(i, j int) bool {
+		return objectKey(s.Functions[i].Schema, s.Functions[i].Name) < objectKey(s.Functions[j].Schema, s.Functions[j].Name)
+	})
+
+	for i := range s.Procedures {
+		procedure := &s.Procedures[i]
+		procedure.Body = normalizeSQL(procedure.Body)
+		procedure.Language = strings.ToUpper(procedure.Language)
+		normalizeParameters(procedure.Parameters)
+		procedure.SourceComment = ""
+	}
+	sort.SliceStable(s.Procedures, func(i, j int) bool {
+		return objectKey(s.Procedures[i].Schema, s.Procedures[i].Name) < objectKey(s.Procedures[j].Schema, s.Procedures[j].Name)
+	})
+
+	for i := range s.Triggers {
+		trigger := &s.Triggers[i]
+		trigger.Timing = strings.ToUpper(spacesRe.ReplaceAllString(strings.TrimSpace(trigger.Timing), " "))
+		for j, event := range trigger.Events {
+			trigger.Events[j] = normalizeSQL(strings.ToUpper(event))
+		}
+		sort.Strings(trigger.Events)
+		trigger.Body = normalizeSQL(trigger.Body)
+		trigger.Condition = normalizeSQL(TrimParens(trigger.Condition))
+		trigger.SourceComment = ""
+	}
+	sort.SliceStable(s.Triggers, func(i, j int) bool {
+		return objectKey(s.Triggers[i].Schema, s.Triggers[i].Name) < objectKey(s.Triggers[j].Schema, s.Triggers[j].Name)
+	})
+
+	sort.SliceStable(s.Sequences, func(i, j int) bool {
+		return objectKey(s.Sequences[i].Schema, s.Sequences[i].Name) < objectKey(s.Sequences[j].Schema, s.Sequences[j].Name)
+	})
+	sort.SliceStable(s.Extensions, func(i, j int) bool {
+		return objectKey(s.Extensions[i].Schema, s.Extensions[i].Name) < objectKey(s.Extensions[j].Schema, s.Extensions[j].Name)
+	})
+
+	for i := range s.Types {
+		s.Types[i].Kind = strings.ToUpper(s.Types[i].Kind)
+		s.Types[i].Definition = normalizeSQL(s.Types[i].Definition)
+	}
+	sort.SliceStable(s.Types, func(i, j int) bool {
+		return objectKey(s.Types[i].Schema, s.Types[i].Name) < objectKey(s.Types[j].Schema, s.Types[j].Name)
+	})
+
+	for i := range s.Permissions {
+		permission := &s.Permissions[i]
+		for j, privilege := range permission.Privileges {
+			permission.Privileges[j] = normalizeSQL(strings.ToUpper(privilege))
+		}
+		sort.Strings(permission.Privileges)
+	}
+	sort.SliceStable(s.Permissions, func(i, j int) bool {
+		a, b := s.Permissions[i], s.Permissions[j]
+		if a.Object != b.Object {
+			return a.Object < b.Object
+		}
+		if a.Grantee != b.Grantee {
+			return a.Grantee < b.Grantee
+		}
+		return a.Type < b.Type
+	})
+}
+
+// normalizeTable canonicalizes a table, see Schema.Normalize
+func normalizeTable(table *Table) {
+	table.SourceQuery = normalizeSQL(table.SourceQuery)
+	table.Options = strings.Join(strings.Fields(table.Options), " ")
+	table.SourceComment = ""
+
+	for i := range table.Columns {
+		column := &table.Columns[i]
+		column.DataType = normalizeType(column.DataType)
+		column.DefaultValue = normalizeSQL(column.DefaultValue)
+		if column.IsNullable && strings.EqualFold(column.DefaultValue, "NULL") {
+			column.DefaultValue = ""
+		}
+		column.CheckExpression = normalizeSQL(TrimParens(column.CheckExpression))
+		column.Order = i + 1
+	}
+
+	for i := range table.Indexes {
+		index := &table.Indexes[i]
+		index.Type = strings.ToUpper(index.Type)
+		if index.Type == "BTREE" {
+			index.Type = ""
+		}
+		index.Condition = normalizeSQL(index.Condition)
+		sort.Strings(index.IncludeColumns)
+	}
+	sort.SliceStable(table.Indexes, func(i, j int) bool {
+		return strings.ToLower(table.Indexes[i].Name) < strings.ToLower(table.Indexes[j].Name)
+	})
+
+	for i := range table.Constraints {
+		constraint := &table.Constraints[i]
+		constraint.Type = strings.ToUpper(spacesRe.ReplaceAllString(strings.TrimSpace(constraint.Type), " "))
+		constraint.CheckExpression = normalizeSQL(TrimParens(constraint.CheckExpression))
+		constraint.UpdateRule = normalizeRule(constraint.UpdateRule)
+		constraint.DeleteRule = normalizeRule(constraint.DeleteRule)
+		constraint.Initially = strings.ToUpper(constraint.Initially)
+		if constraint.Initially == "IMMEDIATE" {
+			constraint.Initially = ""
+		}
+	}
+	sort.SliceStable(table.Constraints, func(i, j int) bool {
+		a, b := table.Constraints[i], table.Constraints[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		}
+		return strings.Join(a.Columns, ",") < strings.Join(b.Columns, ",")
+	})
+}
+
+// normalizeParameters canonicalizes the parameters of a routine
+func normalizeParameters(parameters []Parameter) {
+	for i := range parameters {
+		parameter := &parameters[i]
+		parameter.DataType = normalizeType(parameter.DataType)
+		parameter.Default = normalizeSQL(parameter.Default)
+		parameter.Direction = strings.ToUpper(parameter.Direction)
+		if parameter.Direction == "IN" {
+			parameter.Direction = ""
+		}
+	}
+}
+
+// normalizeRule returns the referential action of a foreign key in upper case, or an
+// empty string for the default NO ACTION
+func normalizeRule(rule string) string {
+	rule = strings.ToUpper(spacesRe.ReplaceAllString(strings.TrimSpace(rule), " "))
+	if rule == "NO ACTION" {
+		return ""
+	}
+	return rule
+}
+
+// normalizeType returns a data type in upper case with canonical whitespace
+func normalizeType(dataType string) string {
+	return strings.ToUpper(normalizeSQL(dataType))
+}
+
+// normalizeSQL returns an expression or body with comments removed, a single space
+// between tokens where one is needed and keywords in upper case. A terminating
+// semicolon is removed.
+func normalizeSQL(sql string) string {
+	var result strings.Builder
+	var previous Token
+	for _, token := range Tokenize(sql) {
+		if token.Type == CommentToken {
+			continue
+		}
+		if token.Type == WordToken {
+			if upper := strings.ToUpper(token.Text); normalizeKeywords[upper] {
+				token.Text = upper
+			}
+		}
+		if result.Len() > 0 && needsSpace(previous, token) {
+			result.WriteByte(' ')
+		}
+		result.WriteString(token.Text)
+		previous = token
+	}
+	return strings.TrimSuffix(result.String(), ";")
+}
+
+// needsSpace reports whether normalized SQL separates two adjacent tokens by a space
+func needsSpace(previous, token Token) bool {
+	switch {
+	case previous.Text == "(" || previous.Text == "." || previous.Text == ":":
+		return false
+	case token.Text == ")" || token.Text == "," || token.Text == "." || token.Text == ";" || token.Text == ":":
+		return false
+	case token.Text == "(":
+		// Function calls and column lists follow their name, parenthesized
+		// expressions follow keywords such as IN or AS
+		return previous.Type == WordToken && normalizeKeywords[previous.Text] ||
+			previous.Type != WordToken && previous.Type != QuotedIdentifierToken
+	case previous.Type == SymbolToken && token.Type == SymbolToken:
+		// Operators made of several symbols, such as >= or ::
+		return previous.Text == ")" || previous.Text == "," || previous.Text == ";"
+	}
+	return true
+}
+
+// objectKey returns the key by which objects are sorted
+func objectKey(schema, name string) string {
+	return strings.ToLower(schema + "." + name)
+}
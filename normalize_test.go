@@ -0,0 +1,132 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "Whitespace and keyword case",
+			sql:  "select id,\n\tname  from users\nwhere active = true;",
+			want: "SELECT id, name FROM users WHERE active = TRUE",
+		},
+		{
+			name: "Parentheses and operators",
+			sql:  "count( * ) >=1 and price::numeric > 0",
+			want: "count(*) >= 1 AND price::numeric > 0",
+		},
+		{
+			name: "Literals and comments",
+			sql:  "status IN ( 'new  one',  \"Select\" ) -- pending\n/* or done */",
+			want: "status IN ('new  one', \"Select\")",
+		},
+		{
+			name: "Qualified names",
+			sql:  "app . users.id",
+			want: "app.users.id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeSQL(tt.sql))
+		})
+	}
+}
+
+func TestSchema_Normalize(t *testing.T) {
+	first := &Schema{
+		Tables: []Table{
+			{
+				Name: "users",
+				Columns: []Column{
+					{Name: "id", DataType: "int", IsPrimaryKey: true},
+					{Name: "email", DataType: "varchar", Length: 255, IsNullable: true, DefaultValue: "null"},
+					{Name: "age", DataType: "int", IsNullable: true, CheckExpression: "(age>=0)"},
+				},
+				Indexes: []Index{
+					{Name: "idx_users_email", Columns: IndexColumns("email"), Type: "btree"},
+					{Name: "idx_users_age", Columns: IndexColumns("age"), Condition: "age is not null"},
+				},
+				Constraints: []Constraint{
+					{Name: "uq_users_email", Type: "unique", Columns: []string{"email"}},
+					{Name: "pk_users", Type: "PRIMARY KEY", Columns: []string{"id"}},
+				},
+				SourceComment: "Users of the application",
+			},
+			{
+				Name:    "orders",
+				Columns: []Column{{Name: "user_id", DataType: "INT", Order: 7}},
+				Constraints: []Constraint{
+					{Name: "fk_orders_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}, DeleteRule: "no action"},
+				},
+			},
+		},
+		Views: []View{
+			{Name: "adults", Definition: "select id\nfrom users\nwhere age >= 18;"},
+		},
+		Triggers: []Trigger{
+			{Name: "trg_users", Table: "users", Timing: "before", Events: []string{"update", "insert"}, Body: "begin\n  set new.email = lower(new.email);\nend"},
+		},
+		Permissions: []Permission{
+			{Type: "GRANT", Privileges: []string{"select", "insert"}, Object: "users", Grantee: "app"},
+		},
+	}
+
+	second := &Schema{
+		Tables: []Table{
+			{
+				Name:    "orders",
+				Columns: []Column{{Name: "user_id", DataType: "INT"}},
+				Constraints: []Constraint{
+					{Name: "fk_orders_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+				},
+			},
+			{
+				Name: "users",
+				Columns: []Column{
+					{Name: "id", DataType: "INT", IsPrimaryKey: true},
+					{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true},
+					{Name: "age", DataType: "INT", IsNullable: true, CheckExpression: "age >= 0"},
+				},
+				Indexes: []Index{
+					{Name: "idx_users_age", Columns: IndexColumns("age"), Condition: "age IS NOT NULL"},
+					{Name: "idx_users_email", Columns: IndexColumns("email")},
+				},
+				Constraints: []Constraint{
+					{Name: "pk_users", Type: "PRIMARY KEY", Columns: []string{"id"}},
+					{Name: "uq_users_email", Type: "UNIQUE", Columns: []string{"email"}},
+				},
+			},
+		},
+		Views: []View{
+			{Name: "adults", Definition: "SELECT id FROM users WHERE age >= 18"},
+		},
+		Triggers: []Trigger{
+			{Name: "trg_users", Table: "users", Timing: "BEFORE", Events: []string{"INSERT", "UPDATE"}, Body: "BEGIN SET NEW.email = lower(NEW.email); END"},
+		},
+		Permissions: []Permission{
+			{Type: "GRANT", Privileges: []string{"INSERT", "SELECT"}, Object: "users", Grantee: "app"},
+		},
+	}
+
+	assert.NotEqual(t, first, second)
+	first.Normalize()
+	second.Normalize()
+	assert.Equal(t, first, second)
+
+	// Normalizing twice changes nothing
+	normalized := first.Clone()
+	normalized.Normalize()
+	assert.Equal(t, first, normalized)
+
+	// Column order is significant
+	assert.Equal(t, []string{"id", "email", "age"}, []string{first.Tables[1].Columns[0].Name, first.Tables[1].Columns[1].Name, first.Tables[1].Columns[2].Name})
+}
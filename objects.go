@@ -0,0 +1,61 @@
+package sqlmapper
+
+import "fmt"
+
+// ObjectGenerator is implemented by the dialects generating the DDL of single objects,
+// for callers assembling their own output. Statements are returned without a
+// terminating semicolon.
+type ObjectGenerator interface {
+	GenerateTable(table Table) (string, error)
+	GenerateIndex(tableName string, index Index) (string, error)
+	GenerateView(view View) (string, error)
+}
+
+// GenerateTable generates the CREATE TABLE statement of a table in the dialect of db
+func GenerateTable(db Database, table Table) (string, error) {
+	generator, err := objectGenerator(db)
+	if err != nil {
+		return "", err
+	}
+	if table.Name == "" {
+		return "", fmt.Errorf("table name is required")
+	}
+	return generator.GenerateTable(table)
+}
+
+// GenerateIndex generates the CREATE INDEX statement of an index of the given table in
+// the dialect of db
+func GenerateIndex(db Database, tableName string, index Index) (string, error) {
+	generator, err := objectGenerator(db)
+	if err != nil {
+		return "", err
+	}
+	if tableName == "" || index.Name == "" {
+		return "", fmt.Errorf("index name and table name are required")
+	}
+	if len(index.Columns) == 0 {
+		return "", fmt.Errorf("index %s has no columns", index.Name)
+	}
+	return generator.GenerateIndex(tableName, index)
+}
+
+// GenerateView generates the CREATE VIEW statement of a view in the dialect of db
+func GenerateView(db Database, view View) (string, error) {
+	generator, err := objectGenerator(db)
+	if err != nil {
+		return "", err
+	}
+	if view.Name == "" || view.Definition == "" {
+		return "", fmt.Errorf("view name and definition are required")
+	}
+	return generator.GenerateView(view)
+}
+
+// objectGenerator returns db as an ObjectGenerator
+func objectGenerator(db Database) (ObjectGenerator, error) {
+	generator, ok := db.(ObjectGenerator)
+	if !ok {
+		return nil, &UnsupportedFeatureError{Feature: fmt.Sprintf("generation of single objects by %T", db)}
+	}
+	return generator, nil
+}
@@ -0,0 +1,57 @@
+package sqlmapper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// objectDatabase renders objects by name, to test the validation of the package functions
+type objectDatabase struct{}
+
+func (objectDatabase) Parse(content string) (*Schema, error)   { return &Schema{}, nil }
+func (objectDatabase) Generate(schema *Schema) (string, error) { return "", nil }
+func (objectDatabase) GenerateTable(table Table) (string, error) {
+	return "CREATE TABLE " + table.Name, nil
+}
+func (objectDatabase) GenerateIndex(tableName string, index Index) (string, error) {
+	return "CREATE INDEX " + index.Name + " ON " + tableName, nil
+}
+func (objectDatabase) GenerateView(view View) (string, error) {
+	return "CREATE VIEW " + view.Name, nil
+}
+
+// plainDatabase only generates whole schemas
+type plainDatabase struct{}
+
+func (plainDatabase) Parse(content string) (*Schema, error)   { return &Schema{}, nil }
+func (plainDatabase) Generate(schema *Schema) (string, error) { return "", nil }
+
+func TestGenerateObjects(t *testing.T) {
+	db := objectDatabase{}
+
+	sql, err := GenerateTable(db, Table{Name: "users"})
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE users", sql)
+	_, err = GenerateTable(db, Table{})
+	assert.Error(t, err)
+
+	sql, err = GenerateIndex(db, "users", Index{Name: "idx_users_email", Columns: IndexColumns("email")})
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE INDEX idx_users_email ON users", sql)
+	_, err = GenerateIndex(db, "", Index{Name: "idx_users_email", Columns: IndexColumns("email")})
+	assert.Error(t, err)
+	_, err = GenerateIndex(db, "users", Index{Name: "idx_users_email"})
+	assert.EqualError(t, err, "index idx_users_email has no columns")
+
+	sql, err = GenerateView(db, View{Name: "active_users", Definition: "SELECT * FROM users"})
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE VIEW active_users", sql)
+	_, err = GenerateView(db, View{Name: "active_users"})
+	assert.Error(t, err)
+
+	_, err = GenerateTable(plainDatabase{}, Table{Name: "users"})
+	assert.EqualError(t, err, "generation of single objects by sqlmapper.plainDatabase is not supported")
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+}
@@ -3,7 +3,6 @@
 package oracle
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -11,21 +10,81 @@ import (
 	"github.com/mstgnz/sqlmapper"
 )
 
+// temporalTypes map temporal types of other dialects to Oracle, where TIMESTAMP
+// stores fractional seconds up to nanoseconds
+var temporalTypes = map[string]string{
+	"DATETIME":  "TIMESTAMP",
+	"DATETIME2": "TIMESTAMP",
+}
+
+// maxFractionalSeconds is the largest fractional-second precision of Oracle
+const maxFractionalSeconds = 9
+
+// viewRe matches a CREATE VIEW statement, capturing OR REPLACE, the FORCE option, the
+// editioning keywords, the name and the query of the view
+var viewRe = regexp.MustCompile(`(?is)CREATE(\s+OR\s+REPLACE)?(?:\s+(NO\s+FORCE|FORCE))?((?:\s+(?:EDITIONABLE|NONEDITIONABLE|EDITIONING))*)\s+VIEW\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w"]+)\s+AS\s+(.*?)(?:WITH\s+READ\s+ONLY)?$`)
+
+// checkRules translate the functions of other dialects in CHECK expressions. Regular
+// expressions are matched by the REGEXP_LIKE function in Oracle, which the operators
+// of other dialects are not rewritten to.
+var checkRules = sqlmapper.ExpressionRules{
+	Functions: map[string]string{
+		"ifnull":      "NVL",
+		"len":         "LENGTH",
+		"char_length": "LENGTH",
+		"lcase":       "LOWER",
+		"ucase":       "UPPER",
+	},
+	Unsupported: []string{"REGEXP", "NOT REGEXP", "RLIKE", "NOT RLIKE", "~", "~*", "!~", "!~*", "ILIKE", "NOT ILIKE", "SIMILAR", "NOT SIMILAR", "<=>"},
+}
+
+// castTypes map the types of PostgreSQL casts to Oracle
+var castTypes = map[string]string{
+	"json":                     "CLOB",
+	"jsonb":                    "CLOB",
+	"text":                     "CLOB",
+	"character varying":        "VARCHAR2(4000)",
+	"varchar":                  "VARCHAR2(4000)",
+	"integer":                  "NUMBER(10)",
+	"int4":                     "NUMBER(10)",
+	"bigint":                   "NUMBER(19)",
+	"int8":                     "NUMBER(19)",
+	"boolean":                  "NUMBER(1)",
+	"numeric":                  "NUMBER",
+	"timestamptz":              "TIMESTAMP WITH TIME ZONE",
+	"double precision":         "BINARY_DOUBLE",
+	"timestamp with time zone": "TIMESTAMP WITH TIME ZONE",
+}
+
+// capabilities are the features of Oracle the generator falls back on
+var capabilities = sqlmapper.Capabilities(sqlmapper.Oracle)
+
 // Oracle represents an Oracle parser implementation that handles parsing and generating
 // Oracle database schemas. It maintains an internal schema representation and provides
 // methods for converting between Oracle SQL and the common schema format.
 type Oracle struct {
-	schema *sqlmapper.Schema
+	schema  *sqlmapper.Schema
+	options sqlmapper.GenerateOptions
 }
 
 // NewOracle creates and initializes a new Oracle parser instance.
 // It returns a parser that can handle Oracle specific SQL syntax and schema structures.
-func NewOracle() sqlmapper.Database {
+func NewOracle() sqlmapper.Dialect {
 	return &Oracle{
 		schema: &sqlmapper.Schema{},
 	}
 }
 
+// SetGenerateOptions configures optional output of the generator
+func (o *Oracle) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	o.options = options
+}
+
+// Type returns the database type of the dialect, sqlmapper.Oracle
+func (o *Oracle) Type() sqlmapper.DatabaseType {
+	return sqlmapper.Oracle
+}
+
 // Parse takes an Oracle SQL dump content and parses it into a common schema structure.
 // It processes various Oracle objects including:
 // - Tables with columns and constraints
@@ -42,89 +101,75 @@ func NewOracle() sqlmapper.Database {
 //   - error: An error if parsing fails or if the content is empty
 func (o *Oracle) Parse(content string) (*sqlmapper.Schema, error) {
 	if content == "" {
-		return nil, errors.New("empty content")
+		return nil, &sqlmapper.ValidationError{Problems: []string{"empty content"}}
 	}
 
-	// SQL ifadelerini ayır
-	var statements []string
-	var currentStmt strings.Builder
-	lines := strings.Split(content, "\n")
+	// COMMENT ON statements are applied once the tables they refer to are parsed
+	var comments []sqlmapper.Comment
 
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-
-		if line == "/" {
-			if currentStmt.Len() > 0 {
-				statements = append(statements, currentStmt.String())
-				currentStmt.Reset()
-			}
-			continue
-		}
+	// SQL ifadelerini ayır
+	for _, statement := range sqlmapper.SplitStatements(content, "/") {
+		stmt := statement.Text
 
-		if strings.HasSuffix(line, ";") {
-			currentStmt.WriteString(line[:len(line)-1])
-			if currentStmt.Len() > 0 {
-				statements = append(statements, currentStmt.String())
-				currentStmt.Reset()
+		switch statement.Kind {
+		case sqlmapper.CommentStatement:
+			if comment, ok := sqlmapper.ParseCommentOn(stmt); ok {
+				comments = append(comments, *comment)
 			}
-		} else {
-			if currentStmt.Len() > 0 {
-				currentStmt.WriteString(" ")
-			}
-			currentStmt.WriteString(line)
-		}
-	}
-
-	if currentStmt.Len() > 0 {
-		statements = append(statements, currentStmt.String())
-	}
 
-	for _, stmt := range statements {
-		stmt = strings.TrimSpace(stmt)
-		if stmt == "" {
-			continue
-		}
-
-		// CREATE TABLE
-		if strings.HasPrefix(strings.ToUpper(stmt), "CREATE TABLE") {
+		case sqlmapper.CreateTableStatement:
 			table, err := o.parseCreateTable(stmt)
 			if err != nil {
-				return nil, err
+				return nil, statement.WrapError(err)
 			}
 			o.schema.Tables = append(o.schema.Tables, table)
-		}
 
-		// CREATE SEQUENCE
-		if strings.HasPrefix(strings.ToUpper(stmt), "CREATE SEQUENCE") {
+		case sqlmapper.CreateSequenceStatement:
 			seq, err := o.parseCreateSequence(stmt)
 			if err != nil {
-				return nil, err
+				return nil, statement.WrapError(err)
 			}
 			o.schema.Sequences = append(o.schema.Sequences, seq)
-		}
 
-		// CREATE VIEW
-		if strings.HasPrefix(strings.ToUpper(stmt), "CREATE") && strings.Contains(strings.ToUpper(stmt), "VIEW") {
+		case sqlmapper.CreateViewStatement:
 			view, err := o.parseCreateView(stmt)
 			if err != nil {
-				return nil, err
+				return nil, statement.WrapError(err)
 			}
 			o.schema.Views = append(o.schema.Views, view)
-		}
 
-		// CREATE TRIGGER
-		if strings.HasPrefix(strings.ToUpper(stmt), "CREATE") && strings.Contains(strings.ToUpper(stmt), "TRIGGER") {
+		case sqlmapper.CreateTriggerStatement:
 			trigger, err := o.parseCreateTrigger(stmt)
 			if err != nil {
-				return nil, err
+				return nil, statement.WrapError(err)
 			}
 			o.schema.Triggers = append(o.schema.Triggers, trigger)
+
+		case sqlmapper.AlterTableStatement:
+			// ALTER TABLE ... DROP CONSTRAINT
+			o.schema.ApplyAlterDrops(stmt)
+
+		case sqlmapper.GrantStatement, sqlmapper.RevokeStatement:
+			if permission, ok := sqlmapper.ParsePermission(stmt); ok {
+				o.schema.Permissions = append(o.schema.Permissions, *permission)
+			}
+
+		case sqlmapper.DropStatement:
+			if drop, ok := sqlmapper.ParseDrop(stmt); ok {
+				o.schema.Drops = append(o.schema.Drops, *drop)
+			}
+
+		case sqlmapper.SetStatement:
+			if settings, ok := sqlmapper.ParseSetStatement(stmt); ok {
+				o.schema.Settings = append(o.schema.Settings, settings...)
+			}
 		}
 	}
 
+	for _, comment := range comments {
+		o.schema.ApplyComment(comment)
+	}
+
 	return o.schema, nil
 }
 
@@ -142,22 +187,35 @@ func (o *Oracle) Parse(content string) (*sqlmapper.Schema, error) {
 //   - sqlmapper.Table: The parsed table structure
 //   - error: An error if parsing fails
 func (o *Oracle) parseCreateTable(stmt string) (sqlmapper.Table, error) {
+	stmt = sqlmapper.StripComments(stmt)
+	if ctas, ok := sqlmapper.ParseCreateTableAs(stmt); ok {
+		return *ctas, nil
+	}
+
 	table := sqlmapper.Table{}
 
 	// Tablo adını al
-	tableNameRegex := regexp.MustCompile(`CREATE\s+TABLE\s+(\w+)`)
+	tableNameRegex := regexp.MustCompile(`CREATE\s+(GLOBAL\s+TEMPORARY\s+)?TABLE\s+(IF\s+NOT\s+EXISTS\s+)?(\w+)`)
 	matches := tableNameRegex.FindStringSubmatch(stmt)
-	if len(matches) > 1 {
-		table.Name = matches[1]
+	if len(matches) > 3 {
+		table.Temporary = matches[1] != ""
+		table.IfNotExists = matches[2] != ""
+		table.Name = matches[3]
+	}
+
+	// Geçici tablo ON COMMIT davranışı
+	if table.Temporary {
+		table.OnCommit = o.parseOnCommit(stmt)
 	}
 
 	// Kolonları parse et
-	columnsStr := stmt[strings.Index(stmt, "(")+1 : strings.LastIndex(stmt, ")")]
-	columnDefs := strings.Split(columnsStr, ",")
+	columnsStr, _, ok := sqlmapper.Parenthesized(stmt)
+	if !ok {
+		return table, fmt.Errorf("no columns found in CREATE TABLE statement")
+	}
 
-	for _, colDef := range columnDefs {
-		colDef = strings.TrimSpace(colDef)
-		if strings.HasPrefix(colDef, "CONSTRAINT") {
+	for _, colDef := range sqlmapper.SplitDefinitions(columnsStr) {
+		if strings.HasPrefix(colDef, "CONSTRAINT") || strings.HasPrefix(colDef, "CHECK") {
 			constraint := sqlmapper.Constraint{}
 
 			// Constraint adını al
@@ -223,25 +281,21 @@ func (o *Oracle) parseCreateTable(stmt string) (sqlmapper.Table, error) {
 			} else if strings.Contains(colDef, "CHECK") {
 				constraint.Type = "CHECK"
 				// Check ifadesini al
-				checkRegex := regexp.MustCompile(`CHECK\s*\(([^)]+)\)`)
-				matches = checkRegex.FindStringSubmatch(colDef)
-				if len(matches) > 1 {
-					constraint.CheckExpression = strings.TrimSpace(matches[1])
-				}
+				constraint.CheckExpression, _ = sqlmapper.CheckExpression(colDef)
 			}
 			table.Constraints = append(table.Constraints, constraint)
 			continue
 		}
 
-		parts := strings.Fields(colDef)
+		parts := strings.Fields(sqlmapper.CompactTypeParameters(colDef))
 		if len(parts) < 2 {
 			continue
 		}
 
 		col := sqlmapper.Column{
-			Name:     parts[0],
-			DataType: parts[1],
+			Name: parts[0],
 		}
+		col.DataType, col.Length, col.Scale = sqlmapper.ParseDataType(parts[1])
 
 		if strings.Contains(colDef, "NOT NULL") {
 			col.IsNullable = false
@@ -257,9 +311,11 @@ func (o *Oracle) parseCreateTable(stmt string) (sqlmapper.Table, error) {
 			col.DefaultValue = strings.TrimSpace(restStr[:defaultEnd])
 		}
 
+		col.ParseConstraintNames(colDef)
 		if strings.Contains(colDef, "PRIMARY KEY") {
 			col.IsPrimaryKey = true
 			constraint := sqlmapper.Constraint{
+				Name:    col.PrimaryKeyName,
 				Type:    "PRIMARY KEY",
 				Columns: []string{col.Name},
 			}
@@ -269,6 +325,7 @@ func (o *Oracle) parseCreateTable(stmt string) (sqlmapper.Table, error) {
 		if strings.Contains(colDef, "UNIQUE") {
 			col.IsUnique = true
 			constraint := sqlmapper.Constraint{
+				Name:    col.UniqueName,
 				Type:    "UNIQUE",
 				Columns: []string{col.Name},
 			}
@@ -276,12 +333,11 @@ func (o *Oracle) parseCreateTable(stmt string) (sqlmapper.Table, error) {
 		}
 
 		if strings.Contains(colDef, "CHECK") {
-			checkRegex := regexp.MustCompile(`CHECK\s*\(([^)]+)\)`)
-			matches := checkRegex.FindStringSubmatch(colDef)
-			if len(matches) > 1 {
+			if check, ok := sqlmapper.CheckExpression(colDef); ok {
 				constraint := sqlmapper.Constraint{
+					Name:            col.CheckName,
 					Type:            "CHECK",
-					CheckExpression: strings.TrimSpace(matches[1]),
+					CheckExpression: check,
 				}
 				table.Constraints = append(table.Constraints, constraint)
 			}
@@ -348,11 +404,9 @@ func (o *Oracle) parseCreateSequence(stmt string) (sqlmapper.Sequence, error) {
 func (o *Oracle) parseCreateView(stmt string) (sqlmapper.View, error) {
 	view := sqlmapper.View{}
 
-	// View adını al
-	viewNameRegex := regexp.MustCompile(`CREATE\s+(?:OR\s+REPLACE\s+)?VIEW\s+(\w+)`)
-	matches := viewNameRegex.FindStringSubmatch(stmt)
-	if len(matches) > 1 {
-		view.Name = matches[1]
+	// View adını ve seçeneklerini al
+	if matches := viewRe.FindStringSubmatch(stmt); matches != nil {
+		view = viewFromMatch(stmt, matches)
 	}
 
 	// View tanımını al
@@ -364,13 +418,31 @@ func (o *Oracle) parseCreateView(stmt string) (sqlmapper.View, error) {
 	return view, nil
 }
 
+var (
+	// triggerRe matches a CREATE TRIGGER statement up to the clauses following its
+	// table: the name, the timing and events, or FOR and the events of a compound
+	// trigger, and the table
+	triggerRe = regexp.MustCompile(`(?is)\bCREATE\s+(OR\s+REPLACE\s+)?(?:(?:NON)?EDITIONABLE\s+)?TRIGGER\s+([.\w"$#]+)\s+` +
+		`(BEFORE|AFTER|INSTEAD\s+OF|FOR)\s+(.+?)\s+ON\s+([.\w"$#]+)`)
+	// triggerReferencingRe matches the REFERENCING clause renaming :NEW and :OLD
+	triggerReferencingRe = regexp.MustCompile(`(?is)^\s*REFERENCING(?:\s+(?:NEW|OLD|PARENT)\s+(?:AS\s+)?\w+)+`)
+	// triggerForEachRowRe matches the FOR EACH ROW clause of a row trigger
+	triggerForEachRowRe = regexp.MustCompile(`(?is)^\s*FOR\s+EACH\s+ROW\b`)
+	// triggerWhenRe matches the WHEN condition preceding the body of a row trigger
+	triggerWhenRe = regexp.MustCompile(`(?is)^\s*WHEN\s*\((.*?)\)\s*(?:DECLARE|BEGIN|CALL)\b`)
+	// triggerCompoundRe matches the start of the body of a compound trigger
+	triggerCompoundRe = regexp.MustCompile(`(?is)^\s*COMPOUND\s+TRIGGER\b`)
+	// plsqlBlockRe matches a body that is a PL/SQL block or a call
+	plsqlBlockRe = regexp.MustCompile(`(?is)^(?:DECLARE|BEGIN|CALL)\b`)
+)
+
 // parseCreateTrigger processes a CREATE TRIGGER statement.
 // It extracts trigger properties including:
 // - Trigger name and schema
-// - Triggering event (INSERT, UPDATE, DELETE)
-// - Trigger timing (BEFORE, AFTER, INSTEAD OF)
+// - Triggering events (INSERT, UPDATE, DELETE)
+// - Trigger timing (BEFORE, AFTER, INSTEAD OF) or the sections of a compound trigger
 // - Table name
-// - Trigger body
+// - Trigger body, kept verbatim including :NEW and :OLD references
 //
 // Parameters:
 //   - stmt: The CREATE TRIGGER statement to parse
@@ -379,49 +451,68 @@ func (o *Oracle) parseCreateView(stmt string) (sqlmapper.View, error) {
 //   - sqlmapper.Trigger: The parsed trigger structure
 //   - error: An error if parsing fails
 func (o *Oracle) parseCreateTrigger(stmt string) (sqlmapper.Trigger, error) {
-	trigger := sqlmapper.Trigger{}
-
-	// Trigger adını al
-	triggerNameRegex := regexp.MustCompile(`CREATE\s+(?:OR\s+REPLACE\s+)?TRIGGER\s+(\w+)`)
-	matches := triggerNameRegex.FindStringSubmatch(stmt)
-	if len(matches) > 1 {
-		trigger.Name = matches[1]
+	trigger, ok := parseTrigger(stmt)
+	if !ok {
+		return trigger, fmt.Errorf("invalid CREATE TRIGGER statement")
 	}
+	return trigger, nil
+}
 
-	// Trigger zamanlamasını al
-	if strings.Contains(strings.ToUpper(stmt), "BEFORE") {
-		trigger.Timing = "BEFORE"
-	} else if strings.Contains(strings.ToUpper(stmt), "AFTER") {
-		trigger.Timing = "AFTER"
+// parseTrigger parses a CREATE TRIGGER statement. The body is the PL/SQL block of the
+// trigger, or the declarations and timing sections of a compound trigger, without the
+// terminating semicolon.
+func parseTrigger(stmt string) (sqlmapper.Trigger, bool) {
+	loc := triggerRe.FindStringSubmatchIndex(stmt)
+	if loc == nil {
+		return sqlmapper.Trigger{}, false
 	}
-
-	// Trigger olayını al
-	if strings.Contains(strings.ToUpper(stmt), "INSERT") {
-		trigger.Event = "INSERT"
-	} else if strings.Contains(strings.ToUpper(stmt), "UPDATE") {
-		trigger.Event = "UPDATE"
-	} else if strings.Contains(strings.ToUpper(stmt), "DELETE") {
-		trigger.Event = "DELETE"
+	group := func(i int) string {
+		if loc[2*i] < 0 {
+			return ""
+		}
+		return stmt[loc[2*i]:loc[2*i+1]]
 	}
 
-	// Tablo adını al
-	tableRegex := regexp.MustCompile(`ON\s+(\w+)`)
-	matches = tableRegex.FindStringSubmatch(stmt)
-	if len(matches) > 1 {
-		trigger.Table = matches[1]
+	trigger := sqlmapper.Trigger{
+		OrReplace: group(1) != "",
+		Timing:    strings.ToUpper(strings.Join(strings.Fields(group(3)), " ")),
+		Events:    sqlmapper.ParseTriggerEvents(group(4)),
+		Table:     group(5),
 	}
-
-	// FOR EACH ROW kontrolü
-	trigger.ForEachRow = strings.Contains(strings.ToUpper(stmt), "FOR EACH ROW")
-
-	// Trigger gövdesini al
-	beginIndex := strings.Index(strings.ToUpper(stmt), "BEGIN")
-	endIndex := strings.LastIndex(strings.ToUpper(stmt), "END")
-	if beginIndex != -1 && endIndex != -1 {
-		trigger.Body = strings.TrimSpace(stmt[beginIndex : endIndex+3])
+	parts := strings.Split(group(2), ".")
+	if len(parts) > 1 {
+		trigger.Schema = parts[0]
+	}
+	trigger.Name = parts[len(parts)-1]
+
+	rest := stmt[loc[1]:]
+	if trigger.Timing == "FOR" {
+		// FOR <events> ON <table> COMPOUND TRIGGER
+		match := triggerCompoundRe.FindStringIndex(rest)
+		if match == nil {
+			return sqlmapper.Trigger{}, false
+		}
+		trigger.Timing = ""
+		trigger.Compound = true
+		rest = rest[match[1]:]
+	} else {
+		if match := triggerReferencingRe.FindStringIndex(rest); match != nil {
+			rest = rest[match[1]:]
+		}
+		if match := triggerForEachRowRe.FindStringIndex(rest); match != nil {
+			trigger.ForEachRow = true
+			rest = rest[match[1]:]
+		}
+		if match := triggerWhenRe.FindStringSubmatchIndex(rest); match != nil {
+			trigger.Condition = sqlmapper.TrimParens(rest[match[2]:match[3]])
+			rest = rest[match[3]+1:]
+		}
 	}
 
-	return trigger, nil
+	body := strings.TrimSpace(rest)
+	body = strings.TrimSpace(strings.TrimSuffix(body, "/"))
+	trigger.Body = strings.TrimSpace(strings.TrimSuffix(body, ";"))
+	return trigger, true
 }
 
 // Generate creates an Oracle SQL dump from a schema structure.
@@ -440,11 +531,30 @@ func (o *Oracle) parseCreateTrigger(stmt string) (sqlmapper.Trigger, error) {
 //   - error: An error if generation fails or if the schema is nil
 func (o *Oracle) Generate(schema *sqlmapper.Schema) (string, error) {
 	if schema == nil {
-		return "", errors.New("empty schema")
+		return "", &sqlmapper.ValidationError{Problems: []string{"empty schema"}}
 	}
 
 	var result strings.Builder
 
+	// Write session settings, Oracle has neither transactional DDL nor a switch for foreign key checks
+	if o.options.Transaction && !capabilities.TransactionalDDL {
+		o.options.Warnf("Oracle commits DDL statements implicitly, the output is not wrapped in a transaction")
+	}
+	if o.options.DisableForeignKeyChecks {
+		o.options.Warnf("Oracle cannot disable foreign key checks for a session, constraints are created enabled")
+	}
+	for _, stmt := range o.options.Prologue(sqlmapper.SessionStatements{}) {
+		result.WriteString(stmt + ";\n")
+	}
+
+	// Drop objects
+	for _, drop := range schema.Drops {
+		result.WriteString(o.generateDropSQL(drop) + ";\n")
+	}
+	if len(schema.Drops) > 0 {
+		result.WriteString("\n")
+	}
+
 	// Create sequences
 	for _, seq := range schema.Sequences {
 		result.WriteString(fmt.Sprintf("CREATE SEQUENCE %s START WITH %d INCREMENT BY %d;\n\n",
@@ -452,75 +562,101 @@ func (o *Oracle) Generate(schema *sqlmapper.Schema) (string, error) {
 	}
 
 	// Create tables
-	for _, table := range schema.Tables {
-		result.WriteString(fmt.Sprintf("CREATE TABLE %s (\n", table.Name))
-
-		// Add columns
-		for i, col := range table.Columns {
-			result.WriteString(fmt.Sprintf("    %s %s", col.Name, col.DataType))
-			if col.Length > 0 {
-				if col.Scale > 0 {
-					result.WriteString(fmt.Sprintf("(%d,%d)", col.Length, col.Scale))
-				} else {
-					result.WriteString(fmt.Sprintf("(%d)", col.Length))
-				}
-			}
-			if col.IsPrimaryKey {
-				result.WriteString(" PRIMARY KEY")
-			} else if !col.IsNullable {
-				result.WriteString(" NOT NULL")
+	for _, table := range o.options.WithoutPartitions(schema.Tables) {
+		ifNotExists := o.options.IfNotExists(table.IfNotExists)
+		if table.SourceQuery != "" {
+			result.WriteString(o.generateTableSQL(table) + ";\n")
+		} else {
+			o.options.WarnInherits(table)
+			if table.Temporary {
+				result.WriteString(fmt.Sprintf("CREATE GLOBAL TEMPORARY TABLE %s%s (\n", ifNotExists, table.Name))
+			} else {
+				result.WriteString(fmt.Sprintf("CREATE TABLE %s%s (\n", ifNotExists, table.Name))
 			}
-			if col.DefaultValue != "" {
-				// Add quotes for default values of type String
-				if strings.HasPrefix(col.DataType, "VARCHAR") || strings.HasPrefix(col.DataType, "CHAR") {
-					result.WriteString(fmt.Sprintf(" DEFAULT '%s'", col.DefaultValue))
-				} else {
-					result.WriteString(fmt.Sprintf(" DEFAULT %s", col.DefaultValue))
+
+			// Named constraints are written after the columns, except those declared on
+			// a column, which keep their name in the column definition
+			var constraints []string
+			for _, constraint := range table.Constraints {
+				if constraint.Name == "" || inlineConstraint(table, constraint) {
+					continue // Skip unnamed constraints as they are handled with column definitions
 				}
+				definition := fmt.Sprintf("CONSTRAINT %s %s", constraint.Name, constraint.Type)
+				if constraint.Type == "CHECK" {
+					definition += fmt.Sprintf(" (%s)", o.options.TranslateCheck(table.Name, sqlmapper.RewriteCasts(constraint.CheckExpression, castTypes), checkRules))
+				} else if len(constraint.Columns) > 0 {
+					definition += fmt.Sprintf(" (%s)", sqlmapper.QuoteIdentifiers(constraint.Columns, `"`))
+				}
+				if constraint.Type == "FOREIGN KEY" && constraint.RefTable != "" {
+					definition += fmt.Sprintf(" REFERENCES %s", constraint.RefTable)
+					if len(constraint.RefColumns) > 0 {
+						definition += fmt.Sprintf("(%s)", sqlmapper.QuoteIdentifiers(constraint.RefColumns, `"`))
+					}
+					if constraint.DeleteRule != "" {
+						definition += fmt.Sprintf(" ON DELETE %s", constraint.DeleteRule)
+					}
+				}
+				constraints = append(constraints, definition)
 			}
-			if col.IsUnique && !col.IsPrimaryKey {
-				result.WriteString(" UNIQUE")
-			}
-			if i < len(table.Columns)-1 || len(table.Constraints) > 0 {
-				result.WriteString(",")
-			}
-			result.WriteString("\n")
-		}
 
-		// Add Constraint
-		for i, constraint := range table.Constraints {
-			if constraint.Name == "" {
-				continue // Skip unnamed constraints as they are handled with column definitions
-			}
-			result.WriteString(fmt.Sprintf("    CONSTRAINT %s %s", constraint.Name, constraint.Type))
-			if len(constraint.Columns) > 0 {
-				result.WriteString(fmt.Sprintf(" (%s)", strings.Join(constraint.Columns, ", ")))
-			}
-			if constraint.Type == "FOREIGN KEY" && constraint.RefTable != "" {
-				result.WriteString(fmt.Sprintf(" REFERENCES %s", constraint.RefTable))
-				if len(constraint.RefColumns) > 0 {
-					result.WriteString(fmt.Sprintf("(%s)", strings.Join(constraint.RefColumns, ", ")))
+			// Add columns
+			for i, col := range table.Columns {
+				col = o.options.ConvertSetType(table.Name, o.convertTemporal(table.Name, col), "VARCHAR2")
+				o.options.MapColumn(table.Name, table.Columns[i], sqlmapper.FormatDataType(col))
+				result.WriteString(fmt.Sprintf("    %s %s", sqlmapper.QuoteIdentifier(col.Name, `"`), sqlmapper.FormatDataType(col)))
+				if col.IsPrimaryKey {
+					result.WriteString(" " + sqlmapper.ConstraintPrefix(col.PrimaryKeyName) + "PRIMARY KEY")
 				}
-				if constraint.DeleteRule != "" {
-					result.WriteString(fmt.Sprintf(" ON DELETE %s", constraint.DeleteRule))
+				result.WriteString(o.options.Nullability(col, col.IsPrimaryKey))
+				if col.DefaultValue != "" {
+					defaultValue := sqlmapper.RewriteCasts(col.DefaultValue, castTypes)
+					// Add quotes for default values of type String
+					if defaultValue == col.DefaultValue && (strings.HasPrefix(col.DataType, "VARCHAR") || strings.HasPrefix(col.DataType, "CHAR")) {
+						result.WriteString(" DEFAULT " + quoteLiteral(col.DefaultValue))
+					} else {
+						result.WriteString(fmt.Sprintf(" DEFAULT %s", defaultValue))
+					}
+				}
+				if col.IsUnique && !col.IsPrimaryKey {
+					result.WriteString(" " + sqlmapper.ConstraintPrefix(col.UniqueName) + "UNIQUE")
+				}
+				if i < len(table.Columns)-1 || len(constraints) > 0 {
+					result.WriteString(",")
+				}
+				result.WriteString("\n")
+			}
+
+			// Add Constraint
+			for i, constraint := range constraints {
+				result.WriteString("    " + constraint)
+				if i < len(constraints)-1 {
+					result.WriteString(",")
 				}
+				result.WriteString("\n")
 			}
-			if i < len(table.Constraints)-1 {
-				result.WriteString(",")
+
+			result.WriteString(")")
+			if table.Temporary && table.OnCommit != "" {
+				result.WriteString(" ON COMMIT " + table.OnCommit)
 			}
-			result.WriteString("\n")
+			result.WriteString(";\n")
 		}
 
-		result.WriteString(");\n")
+		// Tablo ve kolon yorumlarını oluştur
+		for _, stmt := range sqlmapper.QuotedCommentStatements(table, quoteLiteral) {
+			result.WriteString(stmt + ";\n")
+		}
 
 		// Index'leri oluştur
 		for _, index := range table.Indexes {
+			index = o.options.WholeColumnIndex(table.Name, o.options.PlainIndex(table.Name, index))
+			ifNotExists := o.options.IfNotExists(index.IfNotExists)
 			if index.IsUnique {
-				result.WriteString(fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s(%s);\n",
-					index.Name, table.Name, strings.Join(index.Columns, ", ")))
+				result.WriteString(fmt.Sprintf("CREATE UNIQUE INDEX %s%s ON %s(%s);\n",
+					ifNotExists, index.Name, table.Name, index.ColumnList(`"`, false)))
 			} else {
-				result.WriteString(fmt.Sprintf("CREATE INDEX %s ON %s(%s);\n",
-					index.Name, table.Name, strings.Join(index.Columns, ", ")))
+				result.WriteString(fmt.Sprintf("CREATE INDEX %s%s ON %s(%s);\n",
+					ifNotExists, index.Name, table.Name, index.ColumnList(`"`, false)))
 			}
 		}
 
@@ -535,37 +671,44 @@ func (o *Oracle) Generate(schema *sqlmapper.Schema) (string, error) {
 
 	// Create triggers
 	for _, trigger := range schema.Triggers {
-		result.WriteString(fmt.Sprintf("CREATE OR REPLACE TRIGGER %s\n", trigger.Name))
-		if trigger.Timing != "" {
-			result.WriteString(trigger.Timing + " ")
-		}
-		if trigger.Event != "" {
-			result.WriteString(trigger.Event + " ")
-		}
-		if trigger.Table != "" {
-			result.WriteString("ON " + trigger.Table + " ")
-		}
-		if trigger.ForEachRow {
-			result.WriteString("FOR EACH ROW\n")
-		}
-		if trigger.Body != "" {
-			result.WriteString(trigger.Body)
+		result.WriteString(o.generateTriggerSQL(trigger) + ";\n/\n\n")
+	}
+
+	// Generate permissions
+	if o.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := o.generatePermissionSQL(permission); ok {
+				result.WriteString(stmt + ";\n")
+			}
 		}
-		result.WriteString("\n/\n\n")
 	}
 
-	return result.String(), nil
+	o.options.WarnSkipped(schema, sqlmapper.ViewObjects|sqlmapper.TriggerObjects|sqlmapper.SequenceObjects)
+
+	return o.options.Format.Apply(result.String()), nil
 }
 
 func (o *Oracle) parseTables(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+TABLE\s+([.\w]+)\s*\((.*?)\)(?:\s+TABLESPACE\s+(\w+))?`)
+	statement = sqlmapper.StripComments(statement)
+	if table, ok := sqlmapper.ParseCreateTableAs(statement); ok {
+		o.schema.Tables = append(o.schema.Tables, *table)
+		return nil
+	}
+
+	re := regexp.MustCompile(`CREATE\s+(?:GLOBAL\s+TEMPORARY\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\(`)
 	matches := re.FindStringSubmatch(statement)
+	columnDefs, end, ok := sqlmapper.Parenthesized(statement)
 
-	if len(matches) > 2 {
+	if len(matches) > 1 && ok {
 		tableName := matches[1]
-		columnDefs := matches[2]
 
-		table := sqlmapper.Table{}
+		table := sqlmapper.Table{
+			Temporary:   regexp.MustCompile(`(?i)^\s*CREATE\s+GLOBAL\s+TEMPORARY\s`).MatchString(statement),
+			IfNotExists: sqlmapper.HasIfNotExists(statement),
+		}
+		if table.Temporary {
+			table.OnCommit = o.parseOnCommit(statement)
+		}
 
 		// Parse schema if exists
 		parts := strings.Split(tableName, ".")
@@ -577,19 +720,17 @@ func (o *Oracle) parseTables(statement string) error {
 		}
 
 		// Parse tablespace if exists
-		if len(matches) > 3 && matches[3] != "" {
-			table.TableSpace = matches[3]
+		if tablespace := regexp.MustCompile(`^\s+TABLESPACE\s+(\w+)`).FindStringSubmatch(statement[end:]); tablespace != nil {
+			table.TableSpace = tablespace[1]
 		}
 
 		// Parse columns and constraints
-		columns := strings.Split(columnDefs, ",")
-		for _, col := range columns {
-			col = strings.TrimSpace(col)
-			if strings.HasPrefix(strings.ToUpper(col), "CONSTRAINT") {
+		for _, col := range sqlmapper.SplitDefinitions(columnDefs) {
+			if strings.HasPrefix(strings.ToUpper(col), "CONSTRAINT") || strings.HasPrefix(strings.ToUpper(col), "CHECK") {
 				continue // Skip constraints for now
 			}
 
-			parts := strings.Fields(col)
+			parts := strings.Fields(sqlmapper.CompactTypeParameters(col))
 			if len(parts) < 2 {
 				continue
 			}
@@ -601,16 +742,7 @@ func (o *Oracle) parseTables(statement string) error {
 			}
 
 			// Parse length/precision
-			if strings.Contains(column.DataType, "(") {
-				re := regexp.MustCompile(`(\w+)\((\d+)(?:,(\d+))?\)`)
-				if matches := re.FindStringSubmatch(column.DataType); len(matches) > 2 {
-					column.DataType = matches[1]
-					fmt.Sscanf(matches[2], "%d", &column.Length)
-					if len(matches) > 3 && matches[3] != "" {
-						fmt.Sscanf(matches[3], "%d", &column.Scale)
-					}
-				}
-			}
+			column.DataType, column.Length, column.Scale = sqlmapper.ParseDataType(column.DataType)
 
 			// Parse constraints
 			if strings.Contains(strings.ToUpper(col), "NOT NULL") {
@@ -622,8 +754,10 @@ func (o *Oracle) parseTables(statement string) error {
 			if strings.Contains(strings.ToUpper(col), "UNIQUE") {
 				column.IsUnique = true
 			}
+			column.CheckExpression, _ = sqlmapper.CheckExpression(col)
+			column.ParseConstraintNames(col)
 			if strings.Contains(strings.ToUpper(col), "DEFAULT") {
-				re := regexp.MustCompile(`DEFAULT\s+([^,\s]+)`)
+				re := regexp.MustCompile(`DEFAULT\s+('(?:[^']|'')*'|[^,\s]+)`)
 				if matches := re.FindStringSubmatch(col); len(matches) > 1 {
 					column.DefaultValue = matches[1]
 				}
@@ -639,32 +773,38 @@ func (o *Oracle) parseTables(statement string) error {
 }
 
 func (o *Oracle) parseViews(statement string) error {
-	re := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+VIEW\s+([.\w]+)\s+AS\s+(.*?)(?:WITH\s+READ\s+ONLY)?$`)
-	matches := re.FindStringSubmatch(statement)
-
-	if len(matches) > 2 {
-		viewName := matches[1]
-		view := sqlmapper.View{
-			Definition: matches[2],
-		}
-
-		// Parse schema if exists
-		parts := strings.Split(viewName, ".")
-		if len(parts) > 1 {
-			view.Schema = parts[0]
-			view.Name = parts[1]
-		} else {
-			view.Name = viewName
-		}
-
+	if matches := viewRe.FindStringSubmatch(statement); matches != nil {
+		view := viewFromMatch(statement, matches)
+		view.Definition = matches[5]
 		o.schema.Views = append(o.schema.Views, view)
 	}
 
 	return nil
 }
 
+// viewFromMatch returns the view named by a match of viewRe, with the options of
+// its CREATE statement
+func viewFromMatch(statement string, matches []string) sqlmapper.View {
+	view := sqlmapper.View{
+		OrReplace:   matches[1] != "",
+		IfNotExists: sqlmapper.HasIfNotExists(statement),
+		Force:       strings.EqualFold(matches[2], "FORCE"),
+		Edition:     strings.ToUpper(strings.Join(strings.Fields(matches[3]), " ")),
+	}
+
+	// Parse schema if exists
+	parts := strings.Split(matches[4], ".")
+	if len(parts) > 1 {
+		view.Schema = parts[0]
+		view.Name = parts[1]
+	} else {
+		view.Name = matches[4]
+	}
+	return view
+}
+
 func (o *Oracle) parseFunctions(statement string) error {
-	re := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+(FUNCTION|PROCEDURE)\s+([.\w]+)\s*\((.*?)\)(?:\s+RETURN\s+(\w+))?\s+IS|AS\s+(.*?)(?:END\s+\w+)?$`)
+	re := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+(FUNCTION|PROCEDURE)\s+([.\w]+)\s*\((.*?)\)(?:\s+RETURN\s+(\w+))?\s+(?:IS|AS)\s+(.*?)(?:END\s+\w+)?$`)
 	matches := re.FindStringSubmatch(statement)
 
 	if len(matches) > 4 {
@@ -709,29 +849,10 @@ func (o *Oracle) parseFunctions(statement string) error {
 	return nil
 }
 
+// parseTriggerCondition returns the WHEN condition of a row-level trigger
+// without its enclosing parentheses
 func (o *Oracle) parseTriggers(statement string) error {
-	re := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+TRIGGER\s+([.\w]+)\s+(BEFORE|AFTER|INSTEAD\s+OF)\s+(INSERT|UPDATE|DELETE)\s+ON\s+([.\w]+)(?:\s+FOR\s+EACH\s+ROW)?\s+(.*?)(?:END\s+\w+)?$`)
-	matches := re.FindStringSubmatch(statement)
-
-	if len(matches) > 5 {
-		triggerName := matches[1]
-		trigger := sqlmapper.Trigger{
-			Timing:     matches[2],
-			Event:      matches[3],
-			Table:      matches[4],
-			Body:       matches[5],
-			ForEachRow: strings.Contains(statement, "FOR EACH ROW"),
-		}
-
-		// Parse schema if exists
-		parts := strings.Split(triggerName, ".")
-		if len(parts) > 1 {
-			trigger.Schema = parts[0]
-			trigger.Name = parts[1]
-		} else {
-			trigger.Name = triggerName
-		}
-
+	if trigger, ok := parseTrigger(statement); ok {
 		o.schema.Triggers = append(o.schema.Triggers, trigger)
 	}
 
@@ -805,27 +926,23 @@ func (o *Oracle) parseTypes(statement string) error {
 }
 
 func (o *Oracle) parseIndexes(statement string) error {
-	re := regexp.MustCompile(`CREATE(?:\s+UNIQUE|\s+BITMAP)?\s+INDEX\s+([.\w]+)\s+ON\s+([.\w]+)\s*\((.*?)\)(?:\s+TABLESPACE\s+(\w+))?`)
+	re := regexp.MustCompile(`CREATE(?:\s+UNIQUE|\s+BITMAP)?\s+INDEX\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s+ON\s+([.\w]+)\s*\((.*?)\)(?:\s+TABLESPACE\s+(\w+))?`)
 	matches := re.FindStringSubmatch(statement)
 
 	if len(matches) > 3 {
 		indexName := matches[1]
 		tableName := matches[2]
-		columns := strings.Split(matches[3], ",")
+		columns := sqlmapper.SplitIndexColumns(matches[3])
 
 		// Find the table
 		for i, table := range o.schema.Tables {
 			if table.Name == tableName || fmt.Sprintf("%s.%s", table.Schema, table.Name) == tableName {
 				index := sqlmapper.Index{
-					Name:     indexName,
-					Columns:  make([]string, len(columns)),
-					IsUnique: strings.Contains(statement, "UNIQUE"),
-					IsBitmap: strings.Contains(statement, "BITMAP"),
-				}
-
-				// Clean column names
-				for j, col := range columns {
-					index.Columns[j] = strings.TrimSpace(col)
+					Name:        indexName,
+					Columns:     columns,
+					IsUnique:    strings.Contains(statement, "UNIQUE"),
+					IsBitmap:    strings.Contains(statement, "BITMAP"),
+					IfNotExists: sqlmapper.HasIfNotExists(statement),
 				}
 
 				// Parse tablespace if exists
@@ -871,48 +988,147 @@ func (o *Oracle) generateTypeSQL(typ sqlmapper.Type) string {
 	return fmt.Sprintf("CREATE TYPE %s AS %s", typ.Name, typ.Definition)
 }
 
+// convertTemporal renames temporal types Oracle lacks, such as DATETIME, keeping
+// their fractional-second precision
+func (o *Oracle) convertTemporal(table string, col sqlmapper.Column) sqlmapper.Column {
+	col, reduced := sqlmapper.ConvertTemporalType(col, temporalTypes, maxFractionalSeconds)
+	if reduced {
+		o.options.Warnf("fractional seconds of column %s.%s were reduced to %d digits", table, col.Name, maxFractionalSeconds)
+	}
+	return col
+}
+
+// generateTriggerSQL generates SQL for a trigger. Bodies of other dialects that are
+// not a PL/SQL block are wrapped in BEGIN ... END.
+func (o *Oracle) generateTriggerSQL(trigger sqlmapper.Trigger) string {
+	sql := "CREATE OR REPLACE TRIGGER " + trigger.Name + "\n"
+	body := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(trigger.Body), ";"))
+
+	if trigger.Compound {
+		sql += "FOR " + trigger.EventClause(" OR ") + " ON " + trigger.Table + "\n"
+		return sql + "COMPOUND TRIGGER\n" + body
+	}
+
+	sql += trigger.Timing + " " + trigger.EventClause(" OR ") + " ON " + trigger.Table + "\n"
+	if trigger.ForEachRow {
+		sql += "FOR EACH ROW\n"
+		if trigger.Condition != "" {
+			sql += "WHEN (" + sqlmapper.TrimParens(trigger.Condition) + ")\n"
+		}
+	}
+	if !plsqlBlockRe.MatchString(body) {
+		body = "BEGIN\n" + body + ";\nEND"
+	}
+	return sql + body
+}
+
 // generateTableSQL generates SQL for a table
 func (o *Oracle) generateTableSQL(table sqlmapper.Table) string {
-	sql := "CREATE TABLE " + table.Name + " (\n"
+	ifNotExists := o.options.IfNotExists(table.IfNotExists)
+	if table.SourceQuery != "" {
+		return sqlmapper.CreateTableAsSQL(table, "GLOBAL TEMPORARY", ifNotExists, true)
+	}
+
+	o.options.WarnInherits(table)
+
+	var sql strings.Builder
+	sql.Grow(sqlmapper.TableSizeHint(table))
+	sql.WriteString("CREATE ")
+	if table.Temporary {
+		sql.WriteString("GLOBAL TEMPORARY ")
+	}
+	sql.WriteString("TABLE ")
+	sql.WriteString(ifNotExists)
+	sql.WriteString(table.Name)
+	sql.WriteString(" (\n")
 
 	// Generate columns
 	for i, col := range table.Columns {
-		sql += "    " + col.Name + " " + col.DataType
-		if col.Length > 0 {
-			sql += fmt.Sprintf("(%d", col.Length)
-			if col.Scale > 0 {
-				sql += fmt.Sprintf(",%d", col.Scale)
-			}
-			sql += ")"
-		}
-
-		if !col.IsNullable {
-			sql += " NOT NULL"
-		}
+		col = o.options.ConvertSetType(table.Name, o.convertTemporal(table.Name, col), "VARCHAR2")
+		o.options.MapColumn(table.Name, table.Columns[i], sqlmapper.FormatDataType(col))
+		sql.WriteString("    ")
+		sql.WriteString(sqlmapper.QuoteIdentifier(col.Name, `"`))
+		sql.WriteString(" ")
+		sql.WriteString(sqlmapper.FormatDataType(col))
+
+		sql.WriteString(o.options.Nullability(col, false))
 		if col.IsUnique {
-			sql += " UNIQUE"
+			sql.WriteString(" ")
+			sql.WriteString(sqlmapper.ConstraintPrefix(col.UniqueName))
+			sql.WriteString("UNIQUE")
 		}
 		if col.DefaultValue != "" {
-			sql += " DEFAULT " + col.DefaultValue
+			sql.WriteString(" DEFAULT ")
+			sql.WriteString(sqlmapper.RewriteCasts(col.DefaultValue, castTypes))
 		}
 
 		if i < len(table.Columns)-1 {
-			sql += ",\n"
+			sql.WriteString(",\n")
 		}
 	}
 
-	sql += "\n)"
+	sql.WriteString("\n)")
 
 	// Add table options
+	if table.Temporary && table.OnCommit != "" {
+		sql.WriteString(" ON COMMIT ")
+		sql.WriteString(table.OnCommit)
+	}
 	if table.TableSpace != "" {
-		sql += " TABLESPACE " + table.TableSpace
+		sql.WriteString(" TABLESPACE ")
+		sql.WriteString(table.TableSpace)
 	}
 
-	return sql
+	return sql.String()
+}
+
+// parseOnCommit returns the ON COMMIT behavior of a global temporary table.
+// Oracle deletes rows on commit unless PRESERVE ROWS is specified.
+func (o *Oracle) parseOnCommit(statement string) string {
+	re := regexp.MustCompile(`(?i)ON\s+COMMIT\s+(DELETE|PRESERVE)\s+ROWS`)
+	if matches := re.FindStringSubmatch(statement); len(matches) > 1 {
+		return strings.ToUpper(matches[1]) + " ROWS"
+	}
+	return "DELETE ROWS"
+}
+
+// GenerateTable generates the CREATE TABLE statement of a single table, without
+// its indexes and without a terminating semicolon
+func (o *Oracle) GenerateTable(table sqlmapper.Table) (string, error) {
+	return o.options.Format.Apply(o.generateTableSQL(table)), nil
+}
+
+// GenerateIndex generates the CREATE INDEX statement of a single index of a table,
+// without a terminating semicolon
+func (o *Oracle) GenerateIndex(tableName string, index sqlmapper.Index) (string, error) {
+	return o.options.Format.Apply(o.generateIndexSQL(tableName, index)), nil
+}
+
+// GenerateView generates the CREATE VIEW statement of a single view, without a
+// terminating semicolon
+func (o *Oracle) GenerateView(view sqlmapper.View) (string, error) {
+	return o.options.Format.Apply(o.generateViewSQL(view)), nil
+}
+
+// generateViewSQL generates SQL for a view
+func (o *Oracle) generateViewSQL(view sqlmapper.View) string {
+	sql := "CREATE "
+	if view.OrReplace {
+		sql += "OR REPLACE "
+	}
+	if view.Force {
+		sql += "FORCE "
+	}
+	if view.Edition != "" {
+		sql += view.Edition + " "
+	}
+	return fmt.Sprintf("%sVIEW %s%s AS %s", sql, o.options.IfNotExists(view.IfNotExists), view.Name, view.Definition)
 }
 
 // generateIndexSQL generates SQL for an index
 func (o *Oracle) generateIndexSQL(tableName string, index sqlmapper.Index) string {
+	index = o.options.WholeColumnIndex(tableName, o.options.PlainIndex(tableName, index))
+
 	var sql string
 	if index.IsBitmap {
 		sql = "CREATE BITMAP INDEX "
@@ -922,7 +1138,7 @@ func (o *Oracle) generateIndexSQL(tableName string, index sqlmapper.Index) strin
 		sql = "CREATE INDEX "
 	}
 
-	sql += index.Name + " ON " + tableName + " (" + strings.Join(index.Columns, ", ") + ")"
+	sql += o.options.IfNotExists(index.IfNotExists) + index.Name + " ON " + tableName + " (" + index.ColumnList(`"`, false) + ")"
 
 	// Add index options
 	if index.TableSpace != "" {
@@ -931,3 +1147,46 @@ func (o *Oracle) generateIndexSQL(tableName string, index sqlmapper.Index) strin
 
 	return sql
 }
+
+// generateDropSQL generates SQL for a dropped object
+func (o *Oracle) generateDropSQL(drop sqlmapper.Drop) string {
+	return "DROP " + drop.Type + " " + o.options.IfExists(drop.IfExists) + drop.QualifiedName()
+}
+
+// generatePermissionSQL creates a GRANT or REVOKE statement for the given permission.
+// Oracle grants object privileges one object at a time, so schema-wide grants are skipped.
+func (o *Oracle) generatePermissionSQL(permission sqlmapper.Permission) (string, bool) {
+	if strings.Contains(permission.Object, "*") || permission.ObjectType == "SCHEMA" || permission.ObjectType == "ALL TABLES IN SCHEMA" {
+		o.options.Warnf("privileges on every object of %s granted to %s are not supported", permission.Object, permission.Grantee)
+		return "", false
+	}
+
+	user, _ := permission.Account()
+	return sqlmapper.PermissionStatement(permission, permission.Object, user), true
+}
+
+// inlineConstraint reports whether a named PRIMARY KEY or UNIQUE constraint is
+// declared on a column, and so written with the column definition
+func inlineConstraint(table sqlmapper.Table, constraint sqlmapper.Constraint) bool {
+	if len(constraint.Columns) != 1 {
+		return false
+	}
+	for _, col := range table.Columns {
+		if !strings.EqualFold(col.Name, constraint.Columns[0]) {
+			continue
+		}
+		switch constraint.Type {
+		case "PRIMARY KEY":
+			return col.IsPrimaryKey && strings.EqualFold(col.PrimaryKeyName, constraint.Name)
+		case "UNIQUE":
+			return col.IsUnique && !col.IsPrimaryKey && strings.EqualFold(col.UniqueName, constraint.Name)
+		}
+	}
+	return false
+}
+
+// quoteLiteral quotes a value as an Oracle string literal. Oracle has no escape
+// sequences, so only the single quotes are doubled.
+func quoteLiteral(value string) string {
+	return sqlmapper.QuoteLiteral(value)
+}
@@ -3,6 +3,7 @@ package oracle
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -12,7 +13,8 @@ import (
 
 // OracleStreamParser implements the StreamParser interface for Oracle
 type OracleStreamParser struct {
-	oracle *Oracle
+	oracle  *Oracle
+	options stream.ParseOptions
 }
 
 // NewOracleStreamParser creates a new Oracle stream parser
@@ -22,173 +24,98 @@ func NewOracleStreamParser() *OracleStreamParser {
 	}
 }
 
+// SetOptions configures optional parsing behaviour such as comment capture
+func (p *OracleStreamParser) SetOptions(options stream.ParseOptions) {
+	p.options = options
+}
+
+// SetGenerateOptions configures optional output of GenerateStream
+func (p *OracleStreamParser) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	p.oracle.SetGenerateOptions(options)
+}
+
 // ParseStream implements the StreamParser interface
 func (p *OracleStreamParser) ParseStream(reader io.Reader, callback func(stream.SchemaObject) error) error {
-	streamReader := stream.NewStreamReader(reader, "/")
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithBatchSeparator("/").WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
 
 	for {
 		statement, err := streamReader.ReadStatement()
+		if err := budget.Check(); err != nil {
+			return err
+		}
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("error reading statement: %v", err)
+			return fmt.Errorf("error reading statement: %w", err)
 		}
 
 		statement = strings.TrimSpace(statement)
-		if statement == "" {
-			continue
-		}
-
-		// Parse CREATE TABLE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TABLE") {
-			table, err := p.parseTableStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TableObject,
-				Data: table,
-			})
-			if err != nil {
-				return err
-			}
+		if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 			continue
 		}
 
-		// Parse CREATE VIEW statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE VIEW") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE MATERIALIZED VIEW") {
-			view, err := p.parseViewStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ViewObject,
-				Data: view,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		obj, err := p.parseStatement(statement)
+		if err == nil && obj == nil {
+			pos := streamReader.Position()
+			err = p.options.Unhandled(stream.Statement{Text: statement, Line: pos.Line, Offset: pos.Offset})
 		}
-
-		// Parse CREATE FUNCTION statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE FUNCTION") {
-			function, err := p.parseFunctionStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.FunctionObject,
-				Data: function,
-			})
-			if err != nil {
-				return err
-			}
-			continue
-		}
-
-		// Parse CREATE PROCEDURE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE PROCEDURE") {
-			procedure, err := p.parseProcedureStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ProcedureObject,
-				Data: procedure,
-			})
-			if err != nil {
+		if err != nil {
+			if err := errs.Handle(stream.Statement{Text: statement, Line: streamReader.Line()}, err); err != nil {
 				return err
 			}
 			continue
 		}
-
-		// Parse CREATE TRIGGER statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TRIGGER") {
-			trigger, err := p.parseTriggerStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TriggerObject,
-				Data: trigger,
-			})
-			if err != nil {
-				return err
-			}
+		if obj == nil || !p.options.Accept(obj) {
 			continue
 		}
+		obj.SetCreateFlags(statement)
 
-		// Parse CREATE SEQUENCE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE SEQUENCE") {
-			sequence, err := p.parseSequenceStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.SequenceObject,
-				Data: sequence,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		if p.options.CaptureComments {
+			obj.SetSourceComment(streamReader.LeadingComment())
 		}
 
-		// Parse CREATE TYPE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TYPE") {
-			typ, err := p.parseTypeStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TypeObject,
-				Data: typ,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		if err := budget.Object(); err != nil {
+			return err
 		}
-
-		// Parse CREATE INDEX statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE INDEX") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE UNIQUE INDEX") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE BITMAP INDEX") {
-			index, err := p.parseIndexStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.IndexObject,
-				Data: index,
-			})
-			if err != nil {
-				return err
+		if err := callback(*obj); err != nil {
+			if stream.IsStopIteration(err) {
+				break
 			}
-			continue
+			return err
 		}
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // ParseStreamParallel implements parallel processing for Oracle stream parsing
 func (p *OracleStreamParser) ParseStreamParallel(reader io.Reader, callback func(stream.SchemaObject) error, workers int) error {
-	streamReader := stream.NewStreamReader(reader, "/")
-	statements := make(chan string, workers)
-	results := make(chan stream.SchemaObject, workers)
-	errors := make(chan error, workers)
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithBatchSeparator("/").WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
+	workers, buffer := p.options.Parallelism(workers)
+	statements := make(chan stream.Statement, buffer)
+	results := make(chan stream.SchemaObject, buffer)
+	// Room for an error of each worker and one of the reader
+	errors := make(chan error, workers+1)
+	// Closed when the results are no longer read or a worker failed, so that the
+	// reader and the other workers do not block on their channels
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	halt := func() { stopOnce.Do(func() { close(stop) }) }
 	var wg sync.WaitGroup
 
 	// Start worker goroutines
@@ -197,13 +124,28 @@ func (p *OracleStreamParser) ParseStreamParallel(reader io.Reader, callback func
 		go func() {
 			defer wg.Done()
 			for statement := range statements {
-				obj, err := p.parseStatement(statement)
+				obj, err := p.parseStatement(statement.Text)
+				if err == nil && obj == nil {
+					err = p.options.Unhandled(statement)
+				}
 				if err != nil {
-					errors <- err
-					return
+					if err := errs.Handle(statement, err); err != nil {
+						errors <- err
+						halt()
+						return
+					}
+					continue
 				}
-				if obj != nil {
-					results <- *obj
+				if obj != nil && p.options.Accept(obj) {
+					obj.SetCreateFlags(statement.Text)
+					if p.options.CaptureComments {
+						obj.SetSourceComment(statement.LeadingComment)
+					}
+					select {
+					case results <- *obj:
+					case <-stop:
+						return
+					}
 				}
 			}
 		}()
@@ -219,26 +161,49 @@ func (p *OracleStreamParser) ParseStreamParallel(reader io.Reader, callback func
 	go func() {
 		for {
 			statement, err := streamReader.ReadStatement()
+			if err := budget.Check(); err != nil {
+				errors <- err
+				break
+			}
 			if err == io.EOF {
 				break
 			}
 			if err != nil {
-				errors <- fmt.Errorf("error reading statement: %v", err)
+				errors <- fmt.Errorf("error reading statement: %w", err)
 				break
 			}
 
 			statement = strings.TrimSpace(statement)
-			if statement == "" {
+			if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 				continue
 			}
-			statements <- statement
+			next := stream.Statement{
+				Text:           statement,
+				LeadingComment: streamReader.LeadingComment(),
+				Line:           streamReader.Line(),
+				Offset:         streamReader.Position().Offset,
+			}
+			select {
+			case statements <- next:
+			case <-stop:
+				close(statements)
+				return
+			}
 		}
 		close(statements)
 	}()
 
 	// Process results and handle errors
 	for obj := range results {
+		if err := budget.Object(); err != nil {
+			halt()
+			return err
+		}
 		if err := callback(obj); err != nil {
+			halt()
+			if stream.IsStopIteration(err) {
+				return errs.Err()
+			}
 			return err
 		}
 	}
@@ -248,16 +213,14 @@ func (p *OracleStreamParser) ParseStreamParallel(reader io.Reader, callback func
 	case err := <-errors:
 		return err
 	default:
-		return nil
+		return errs.Err()
 	}
 }
 
 // parseStatement parses a single SQL statement and returns a SchemaObject
 func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObject, error) {
-	upperStatement := strings.ToUpper(statement)
-
-	switch {
-	case strings.HasPrefix(upperStatement, "CREATE TABLE"):
+	switch sqlmapper.ClassifyStatement(statement) {
+	case sqlmapper.CreateTableStatement:
 		table, err := p.parseTableStatement(statement)
 		if err != nil {
 			return nil, err
@@ -267,8 +230,7 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: table,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE VIEW"),
-		strings.HasPrefix(upperStatement, "CREATE MATERIALIZED VIEW"):
+	case sqlmapper.CreateViewStatement:
 		view, err := p.parseViewStatement(statement)
 		if err != nil {
 			return nil, err
@@ -278,7 +240,7 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: view,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE FUNCTION"):
+	case sqlmapper.CreateFunctionStatement:
 		function, err := p.parseFunctionStatement(statement)
 		if err != nil {
 			return nil, err
@@ -288,7 +250,7 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: function,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE PROCEDURE"):
+	case sqlmapper.CreateProcedureStatement:
 		procedure, err := p.parseProcedureStatement(statement)
 		if err != nil {
 			return nil, err
@@ -298,7 +260,7 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: procedure,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE TRIGGER"):
+	case sqlmapper.CreateTriggerStatement:
 		trigger, err := p.parseTriggerStatement(statement)
 		if err != nil {
 			return nil, err
@@ -308,7 +270,7 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: trigger,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE SEQUENCE"):
+	case sqlmapper.CreateSequenceStatement:
 		sequence, err := p.parseSequenceStatement(statement)
 		if err != nil {
 			return nil, err
@@ -318,7 +280,7 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: sequence,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE TYPE"):
+	case sqlmapper.CreateTypeStatement:
 		typ, err := p.parseTypeStatement(statement)
 		if err != nil {
 			return nil, err
@@ -328,9 +290,7 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: typ,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE INDEX"),
-		strings.HasPrefix(upperStatement, "CREATE UNIQUE INDEX"),
-		strings.HasPrefix(upperStatement, "CREATE BITMAP INDEX"):
+	case sqlmapper.CreateIndexStatement:
 		index, err := p.parseIndexStatement(statement)
 		if err != nil {
 			return nil, err
@@ -341,15 +301,54 @@ func (p *OracleStreamParser) parseStatement(statement string) (*stream.SchemaObj
 		}, nil
 	}
 
+	if permission, ok := sqlmapper.ParsePermission(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.PermissionObject,
+			Data: permission,
+		}, nil
+	}
+
+	if drop, ok := sqlmapper.ParseDrop(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.DropObject,
+			Data: drop,
+		}, nil
+	}
+
+	// COMMENT ON statements are passed on so they can be applied to the
+	// table once it is known
+	if comment, ok := sqlmapper.ParseCommentOn(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.CommentObject,
+			Data: comment,
+		}, nil
+	}
+
+	if settings, ok := sqlmapper.ParseSetStatement(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.SettingObject,
+			Data: settings,
+		}, nil
+	}
+
 	return nil, nil
 }
 
+// parseInto parses a single statement with the given Oracle parser method into a
+// schema of its own. The shared parser is never modified, so statements can be
+// parsed concurrently by the workers of ParseStreamParallel.
+func (p *OracleStreamParser) parseInto(parse func(*Oracle, string) error, statement string) (*sqlmapper.Schema, error) {
+	parser := &Oracle{schema: &sqlmapper.Schema{}, options: p.oracle.options}
+	if err := parse(parser, statement); err != nil {
+		return nil, err
+	}
+	return parser.schema, nil
+}
+
 // parseTableStatement parses a CREATE TABLE statement
 func (p *OracleStreamParser) parseTableStatement(statement string) (*sqlmapper.Table, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
-
-	if err := p.oracle.parseTables(statement); err != nil {
+	tempSchema, err := p.parseInto((*Oracle).parseTables, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -362,10 +361,8 @@ func (p *OracleStreamParser) parseTableStatement(statement string) (*sqlmapper.T
 
 // parseViewStatement parses a CREATE VIEW statement
 func (p *OracleStreamParser) parseViewStatement(statement string) (*sqlmapper.View, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
-
-	if err := p.oracle.parseViews(statement); err != nil {
+	tempSchema, err := p.parseInto((*Oracle).parseViews, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -378,10 +375,8 @@ func (p *OracleStreamParser) parseViewStatement(statement string) (*sqlmapper.Vi
 
 // parseFunctionStatement parses a CREATE FUNCTION statement
 func (p *OracleStreamParser) parseFunctionStatement(statement string) (*sqlmapper.Function, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
-
-	if err := p.oracle.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*Oracle).parseFunctions, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -400,10 +395,8 @@ func (p *OracleStreamParser) parseFunctionStatement(statement string) (*sqlmappe
 
 // parseProcedureStatement parses a CREATE PROCEDURE statement
 func (p *OracleStreamParser) parseProcedureStatement(statement string) (*sqlmapper.Procedure, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
-
-	if err := p.oracle.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*Oracle).parseFunctions, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -428,10 +421,8 @@ func (p *OracleStreamParser) parseProcedureStatement(statement string) (*sqlmapp
 
 // parseTriggerStatement parses a CREATE TRIGGER statement
 func (p *OracleStreamParser) parseTriggerStatement(statement string) (*sqlmapper.Trigger, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
-
-	if err := p.oracle.parseTriggers(statement); err != nil {
+	tempSchema, err := p.parseInto((*Oracle).parseTriggers, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -444,10 +435,8 @@ func (p *OracleStreamParser) parseTriggerStatement(statement string) (*sqlmapper
 
 // parseSequenceStatement parses a CREATE SEQUENCE statement
 func (p *OracleStreamParser) parseSequenceStatement(statement string) (*sqlmapper.Sequence, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
-
-	if err := p.oracle.parseSequences(statement); err != nil {
+	tempSchema, err := p.parseInto((*Oracle).parseSequences, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -460,10 +449,8 @@ func (p *OracleStreamParser) parseSequenceStatement(statement string) (*sqlmappe
 
 // parseTypeStatement parses a CREATE TYPE statement
 func (p *OracleStreamParser) parseTypeStatement(statement string) (*sqlmapper.Type, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
-
-	if err := p.oracle.parseTypes(statement); err != nil {
+	tempSchema, err := p.parseInto((*Oracle).parseTypes, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -476,10 +463,14 @@ func (p *OracleStreamParser) parseTypeStatement(statement string) (*sqlmapper.Ty
 
 // parseIndexStatement parses a CREATE INDEX statement
 func (p *OracleStreamParser) parseIndexStatement(statement string) (*sqlmapper.Index, error) {
+	// Seed the indexed table so the parser can attach the index to it
 	tempSchema := &sqlmapper.Schema{}
-	p.oracle.schema = tempSchema
+	if matches := regexp.MustCompile(`(?i)\sON\s+([.\w]+)`).FindStringSubmatch(statement); len(matches) > 1 {
+		tempSchema.Tables = []sqlmapper.Table{{Name: matches[1]}}
+	}
+	parser := &Oracle{schema: tempSchema, options: p.oracle.options}
 
-	if err := p.oracle.parseIndexes(statement); err != nil {
+	if err := parser.parseIndexes(statement); err != nil {
 		return nil, err
 	}
 
@@ -487,19 +478,50 @@ func (p *OracleStreamParser) parseIndexStatement(statement string) (*sqlmapper.I
 		return nil, fmt.Errorf("no index found in statement")
 	}
 
-	return &tempSchema.Tables[0].Indexes[0], nil
+	index := tempSchema.Tables[0].Indexes[0]
+	index.Table = tempSchema.Tables[0].Name
+	return &index, nil
+}
+
+// TransformStream parses a dump, applies transform to every object and writes the
+// result right away, see stream.TransformStream
+func (p *OracleStreamParser) TransformStream(reader io.Reader, writer io.Writer, transform stream.TransformFunc) error {
+	return stream.TransformStream(p, p.oracle, reader, writer, transform)
 }
 
 // GenerateStream implements the StreamParser interface
 func (p *OracleStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.Writer) error {
 	if schema == nil {
-		return fmt.Errorf("schema cannot be nil")
+		return &sqlmapper.ValidationError{Problems: []string{"schema cannot be nil"}}
+	}
+	schema = p.oracle.options.Incremental(schema)
+	writer = p.oracle.options.Format.Writer(writer)
+
+	// Write session settings, Oracle has neither transactional DDL nor a switch for foreign key checks
+	if p.oracle.options.Transaction && !capabilities.TransactionalDDL {
+		p.oracle.options.Warnf("Oracle commits DDL statements implicitly, the output is not wrapped in a transaction")
+	}
+	if p.oracle.options.DisableForeignKeyChecks {
+		p.oracle.options.Warnf("Oracle cannot disable foreign key checks for a session, constraints are created enabled")
+	}
+	for _, stmt := range p.oracle.options.Prologue(sqlmapper.SessionStatements{}) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write drops
+	for _, drop := range schema.Drops {
+		stmt := p.oracle.generateDropSQL(drop)
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
 	}
 
 	// Write sequences
 	for _, sequence := range schema.Sequences {
 		stmt := p.oracle.generateSequenceSQL(sequence)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 	}
@@ -507,22 +529,29 @@ func (p *OracleStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.
 	// Write types
 	for _, typ := range schema.Types {
 		stmt := p.oracle.generateTypeSQL(typ)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 	}
 
 	// Write tables
-	for _, table := range schema.Tables {
+	for _, table := range p.oracle.options.WithoutPartitions(schema.Tables) {
 		stmt := p.oracle.generateTableSQL(table)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 
+		// Write table and column comments
+		for _, stmt := range sqlmapper.QuotedCommentStatements(table, quoteLiteral) {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
+				return err
+			}
+		}
+
 		// Generate indexes for this table
 		for _, index := range table.Indexes {
 			stmt := p.oracle.generateIndexSQL(table.Name, index)
-			if _, err := writer.Write([]byte(stmt + ";\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
 				return err
 			}
 		}
@@ -530,8 +559,7 @@ func (p *OracleStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.
 
 	// Write views
 	for _, view := range schema.Views {
-		stmt := fmt.Sprintf("CREATE VIEW %s AS %s", view.Name, view.Definition)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, p.oracle.generateViewSQL(view), ";\n\n"); err != nil {
 			return err
 		}
 	}
@@ -547,7 +575,7 @@ func (p *OracleStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.
 				stmt += fmt.Sprintf("%s %s", param.Name, param.DataType)
 			}
 			stmt += fmt.Sprintf(") RETURN %s\n%s", function.Returns, function.Body)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 				return err
 			}
 		}
@@ -564,7 +592,7 @@ func (p *OracleStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.
 				stmt += fmt.Sprintf("%s %s", param.Name, param.DataType)
 			}
 			stmt += fmt.Sprintf(")\n%s", function.Body)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 				return err
 			}
 		}
@@ -572,12 +600,24 @@ func (p *OracleStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.
 
 	// Write triggers
 	for _, trigger := range schema.Triggers {
-		stmt := fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s\n%s",
-			trigger.Name, trigger.Timing, trigger.Event, trigger.Table, trigger.Body)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		// Triggers are PL/SQL blocks, executed by the following slash
+		if err := sqlmapper.WriteStatement(writer, p.oracle.generateTriggerSQL(trigger), ";\n/\n\n"); err != nil {
 			return err
 		}
 	}
 
+	// Write permissions
+	if p.oracle.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := p.oracle.generatePermissionSQL(permission); ok {
+				if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	p.oracle.options.WarnSkipped(schema, sqlmapper.ViewObjects|sqlmapper.FunctionObjects|sqlmapper.ProcedureObjects|sqlmapper.TriggerObjects|sqlmapper.SequenceObjects|sqlmapper.TypeObjects)
+
 	return nil
 }
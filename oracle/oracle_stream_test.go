@@ -1,3 +1,152 @@
 package oracle
 
-// TODO implement tests
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOracleStreamParser_ParseStreamParallel_Concurrent(t *testing.T) {
+	var input strings.Builder
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&input, "CREATE TABLE t%[1]d (c%[1]d NUMBER NOT NULL, name VARCHAR2(64))\n/\n", i)
+	}
+
+	parser := NewOracleStreamParser()
+	seen := make(map[string]int)
+	err := parser.ParseStreamParallel(strings.NewReader(input.String()), func(obj stream.SchemaObject) error {
+		table := obj.Data.(*sqlmapper.Table)
+		seen[table.Name]++
+
+		// Every table must keep its own columns when workers parse concurrently
+		assert.Len(t, table.Columns, 2)
+		assert.Equal(t, "c"+strings.TrimPrefix(table.Name, "t"), table.Columns[0].Name)
+		return nil
+	}, 8)
+
+	assert.NoError(t, err)
+	assert.Len(t, seen, 200)
+	for name, count := range seen {
+		assert.Equal(t, 1, count, name)
+	}
+}
+
+func TestOracleStreamParser_CommentOnlyStatements(t *testing.T) {
+	input := `/* generated by exp */
+/
+-- users
+CREATE TABLE users (id NUMBER(10));
+/
+/* nothing here */;
+/
+   ;
+/
+CREATE TABLE orders (id NUMBER(10));
+/
+-- end of dump
+`
+
+	parser := NewOracleStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true})
+
+	for _, parallel := range []bool{false, true} {
+		var names []string
+		callback := func(obj stream.SchemaObject) error {
+			names = append(names, obj.Name())
+			return nil
+		}
+
+		var err error
+		if parallel {
+			err = parser.ParseStreamParallel(strings.NewReader(input), callback, 2)
+		} else {
+			err = parser.ParseStream(strings.NewReader(input), callback)
+		}
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"users", "orders"}, names)
+	}
+}
+
+func TestOracleStreamParser_ForceView(t *testing.T) {
+	input := `CREATE OR REPLACE FORCE EDITIONABLE VIEW hr.active_employees AS
+SELECT id, name FROM hr.employees WHERE active = 1
+/
+CREATE OR REPLACE NO FORCE VIEW plain_employees AS SELECT id FROM employees
+/
+`
+
+	parser := NewOracleStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true})
+
+	var views []*sqlmapper.View
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		views = append(views, obj.Data.(*sqlmapper.View))
+		return nil
+	})
+	assert.NoError(t, err)
+	if !assert.Len(t, views, 2) {
+		return
+	}
+
+	assert.Equal(t, "hr", views[0].Schema)
+	assert.Equal(t, "active_employees", views[0].Name)
+	assert.True(t, views[0].OrReplace)
+	assert.True(t, views[0].Force)
+	assert.Equal(t, "EDITIONABLE", views[0].Edition)
+	assert.Contains(t, views[0].Definition, "FROM hr.employees WHERE active = 1")
+
+	assert.Equal(t, "plain_employees", views[1].Name)
+	assert.True(t, views[1].OrReplace)
+	assert.False(t, views[1].Force)
+	assert.Equal(t, "", views[1].Edition)
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(&sqlmapper.Schema{Views: []sqlmapper.View{*views[0]}}, &output))
+	assert.Contains(t, output.String(), "CREATE OR REPLACE FORCE EDITIONABLE VIEW active_employees AS SELECT id, name")
+}
+
+func TestOracleStreamParser_Delimiters(t *testing.T) {
+	input := `CREATE TABLE ratios (a NUMBER, b NUMBER, q NUMBER GENERATED ALWAYS AS (a/b) VIRTUAL);
+CREATE VIEW ratio_view AS SELECT a/b AS q FROM ratios;
+CREATE TABLE notes (id NUMBER)
+/
+CREATE VIEW note_view AS SELECT id FROM notes
+/
+`
+
+	parser := NewOracleStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true})
+
+	for _, parallel := range []bool{false, true} {
+		var names []string
+		callback := func(obj stream.SchemaObject) error {
+			names = append(names, obj.Name())
+			return nil
+		}
+
+		var err error
+		if parallel {
+			err = parser.ParseStreamParallel(strings.NewReader(input), callback, 2)
+		} else {
+			err = parser.ParseStream(strings.NewReader(input), callback)
+		}
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"ratios", "ratio_view", "notes", "note_view"}, names)
+	}
+
+	var views []*sqlmapper.View
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		if view, ok := obj.Data.(*sqlmapper.View); ok {
+			views = append(views, view)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, views, 2) {
+		assert.Contains(t, views[0].Definition, "SELECT a/b AS q FROM ratios")
+	}
+}
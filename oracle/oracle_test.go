@@ -72,6 +72,22 @@ func TestOracle_Parse(t *testing.T) {
 				assert.True(t, fkFound, "Foreign key constraint not found")
 			},
 		},
+		{
+			name: "COMMENT ON before the table",
+			content: `
+COMMENT ON TABLE employees IS 'All employees';
+COMMENT ON COLUMN employees.salary IS 'Monthly gross salary';
+CREATE TABLE employees (
+    id NUMBER PRIMARY KEY,
+    salary NUMBER(10,2)
+);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				assert.Equal(t, "All employees", schema.Tables[0].Comment)
+				assert.Equal(t, "Monthly gross salary", schema.Tables[0].Columns[1].Comment)
+			},
+		},
 		{
 			name: "CREATE SEQUENCE",
 			content: `
@@ -84,6 +100,36 @@ func TestOracle_Parse(t *testing.T) {
 				assert.Equal(t, "posts_seq", schema.Sequences[1].Name)
 			},
 		},
+		{
+			name: "CREATE GLOBAL TEMPORARY TABLE",
+			content: `
+				CREATE GLOBAL TEMPORARY TABLE session_cart (
+					item_id NUMBER(10),
+					quantity NUMBER(5)
+				) ON COMMIT PRESERVE ROWS;
+				CREATE GLOBAL TEMPORARY TABLE tx_scratch (
+					id NUMBER(10)
+				);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 2)
+
+				cart := schema.Tables[0]
+				assert.Equal(t, "session_cart", cart.Name)
+				assert.True(t, cart.Temporary)
+				assert.Equal(t, "PRESERVE ROWS", cart.OnCommit)
+				assert.Len(t, cart.Columns, 2)
+
+				scratch := schema.Tables[1]
+				assert.True(t, scratch.Temporary)
+				assert.Equal(t, "DELETE ROWS", scratch.OnCommit)
+
+				generated, err := NewOracle().Generate(&sqlmapper.Schema{Tables: []sqlmapper.Table{cart}})
+				assert.NoError(t, err)
+				assert.Contains(t, generated, "CREATE GLOBAL TEMPORARY TABLE session_cart (")
+				assert.Contains(t, generated, ") ON COMMIT PRESERVE ROWS;")
+			},
+		},
 		{
 			name: "CREATE VIEW",
 			content: `
@@ -129,7 +175,7 @@ func TestOracle_Parse(t *testing.T) {
 				assert.Equal(t, "users_update_timestamp", usersTrigger.Name)
 				assert.Equal(t, "users", usersTrigger.Table)
 				assert.Equal(t, "BEFORE", usersTrigger.Timing)
-				assert.Equal(t, "UPDATE", usersTrigger.Event)
+				assert.Equal(t, []string{"UPDATE"}, usersTrigger.Events)
 				assert.True(t, usersTrigger.ForEachRow)
 
 				// Posts trigger kontrolü
@@ -137,7 +183,7 @@ func TestOracle_Parse(t *testing.T) {
 				assert.Equal(t, "posts_update_timestamp", postsTrigger.Name)
 				assert.Equal(t, "posts", postsTrigger.Table)
 				assert.Equal(t, "BEFORE", postsTrigger.Timing)
-				assert.Equal(t, "UPDATE", postsTrigger.Event)
+				assert.Equal(t, []string{"UPDATE"}, postsTrigger.Events)
 				assert.True(t, postsTrigger.ForEachRow)
 			},
 		},
@@ -205,8 +251,8 @@ CREATE TABLE users (
 							{Name: "price", DataType: "NUMBER", Length: 10, Scale: 2, IsNullable: true},
 						},
 						Indexes: []sqlmapper.Index{
-							{Name: "idx_name", Columns: []string{"name"}},
-							{Name: "idx_price", Columns: []string{"price"}, IsUnique: true},
+							{Name: "idx_name", Columns: sqlmapper.IndexColumns("name")},
+							{Name: "idx_price", Columns: sqlmapper.IndexColumns("price"), IsUnique: true},
 						},
 					},
 				},
@@ -269,3 +315,163 @@ SELECT u.*, COUNT(p.id) as post_count FROM users u LEFT JOIN posts p ON u.id = p
 		})
 	}
 }
+
+func TestOracle_Generate_Transaction(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "NUMBER"}}}},
+	}
+
+	// DDL is committed implicitly, so only the session settings are written
+	var warnings []string
+	generator := NewOracle().(*Oracle)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{
+		Transaction:     true,
+		SessionSettings: []string{"ALTER SESSION SET CURRENT_SCHEMA = app"},
+		OnWarning:       func(message string) { warnings = append(warnings, message) },
+	})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "ALTER SESSION SET CURRENT_SCHEMA = app;\n"))
+	assert.NotContains(t, result, "COMMIT")
+	assert.Len(t, warnings, 1)
+}
+
+func TestOracle_Generate_TypeParameters(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "prices",
+			Columns: []sqlmapper.Column{
+				{Name: "amount", DataType: "DECIMAL", Length: 10, Scale: 2},
+				{Name: "total", DataType: "DECIMAL", Precision: 12, Scale: 4},
+				{Name: "name", DataType: "VARCHAR", Length: 255},
+				{Name: "flag", DataType: "CHAR", Length: 1},
+				{Name: "created", DataType: "TIMESTAMP", Length: 6},
+			},
+		}},
+	}
+
+	result, err := NewOracle().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "amount DECIMAL(10,2)")
+	assert.Contains(t, result, "total DECIMAL(12,4)")
+	assert.Contains(t, result, "name VARCHAR(255)")
+	assert.Contains(t, result, "flag CHAR(1)")
+	assert.Contains(t, result, "created TIMESTAMP(6)")
+}
+
+func TestOracle_Generate_FractionalSeconds(t *testing.T) {
+	// Columns as parsed from MySQL DATETIME(3) and SQL Server DATETIME2(7)
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "events",
+			Columns: []sqlmapper.Column{
+				{Name: "created", DataType: "DATETIME", Length: 3},
+				{Name: "stamp", DataType: "DATETIME2", Length: 7},
+				{Name: "updated", DataType: "TIMESTAMP", Length: 9},
+			},
+		}},
+	}
+
+	result, err := NewOracle().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "created TIMESTAMP(3)")
+	assert.Contains(t, result, "stamp TIMESTAMP(7)")
+	assert.Contains(t, result, "updated TIMESTAMP(9)")
+}
+
+func TestOracle_GenerateObjects(t *testing.T) {
+	db := NewOracle()
+	table := sqlmapper.Table{
+		Name: "users",
+		Columns: []sqlmapper.Column{
+			{Name: "id", DataType: "INT"},
+			{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true},
+		},
+	}
+
+	sql, err := sqlmapper.GenerateTable(db, table)
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE TABLE users (")
+	assert.Contains(t, sql, "email VARCHAR(255)")
+	assert.False(t, strings.HasSuffix(sql, ";"))
+
+	sql, err = sqlmapper.GenerateIndex(db, "users", sqlmapper.Index{Name: "idx_users_email", Columns: sqlmapper.IndexColumns("email"), IsUnique: true})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE UNIQUE INDEX idx_users_email ON users")
+	assert.Contains(t, sql, "(email)")
+
+	sql, err = sqlmapper.GenerateView(db, sqlmapper.View{Name: "active_users", Definition: "SELECT * FROM users"})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE VIEW active_users AS")
+	assert.Contains(t, sql, "SELECT * FROM users")
+}
+
+func TestOracle_RowTrigger(t *testing.T) {
+	content := `
+CREATE OR REPLACE TRIGGER orders_audit
+BEFORE INSERT OR UPDATE OF status ON orders
+REFERENCING NEW AS NEW OLD AS OLD
+FOR EACH ROW
+WHEN (NEW.total > 0)
+DECLARE
+  v_now DATE := SYSDATE;
+BEGIN
+  :NEW.updated_at := v_now;
+  IF :OLD.status IS NULL THEN
+    :NEW.status := 'NEW';
+  END IF;
+END;
+/
+
+CREATE OR REPLACE TRIGGER orders_count
+FOR INSERT ON orders
+COMPOUND TRIGGER
+  g_count PLS_INTEGER := 0;
+  BEFORE EACH ROW IS
+  BEGIN
+    g_count := g_count + 1;
+  END BEFORE EACH ROW;
+  AFTER STATEMENT IS
+  BEGIN
+    DBMS_OUTPUT.PUT_LINE(g_count);
+  END AFTER STATEMENT;
+END orders_count;
+/`
+
+	schema, err := NewOracle().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Triggers, 2) {
+		return
+	}
+
+	row := schema.Triggers[0]
+	assert.Equal(t, "orders_audit", row.Name)
+	assert.Equal(t, "orders", row.Table)
+	assert.Equal(t, "BEFORE", row.Timing)
+	assert.Equal(t, []string{"INSERT", "UPDATE OF status"}, row.Events)
+	assert.True(t, row.ForEachRow)
+	assert.Equal(t, "NEW.total > 0", row.Condition)
+	assert.Equal(t, "DECLARE\n  v_now DATE := SYSDATE;\nBEGIN\n  :NEW.updated_at := v_now;\n"+
+		"  IF :OLD.status IS NULL THEN\n    :NEW.status := 'NEW';\n  END IF;\nEND", row.Body)
+
+	compound := schema.Triggers[1]
+	assert.True(t, compound.Compound)
+	assert.Empty(t, compound.Timing)
+	assert.Equal(t, []string{"INSERT"}, compound.Events)
+	assert.True(t, strings.HasPrefix(compound.Body, "g_count PLS_INTEGER := 0;"))
+	assert.True(t, strings.HasSuffix(compound.Body, "END orders_count"))
+
+	result, err := NewOracle().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "BEFORE INSERT OR UPDATE OF status ON orders\nFOR EACH ROW\nWHEN (NEW.total > 0)\nDECLARE\n")
+	assert.Contains(t, result, "  END IF;\nEND;\n/\n")
+	assert.Contains(t, result, "FOR INSERT ON orders\nCOMPOUND TRIGGER\n")
+	assert.Contains(t, result, "END orders_count;\n/\n")
+
+	reparsed, err := NewOracle().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Triggers, 2) {
+		assert.Equal(t, row, reparsed.Triggers[0])
+		assert.Equal(t, compound, reparsed.Triggers[1])
+	}
+}
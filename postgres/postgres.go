@@ -3,29 +3,88 @@
 package postgres
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/mstgnz/sqlmapper"
 )
 
+// sessionStatements wrap the generated output. Replica mode skips the triggers
+// enforcing foreign keys and requires superuser privileges.
+var sessionStatements = sqlmapper.SessionStatements{
+	Begin:                   "BEGIN",
+	Commit:                  "COMMIT",
+	DisableForeignKeyChecks: "SET session_replication_role = replica",
+	EnableForeignKeyChecks:  "SET session_replication_role = DEFAULT",
+}
+
+// temporalTypes map temporal types of other dialects to PostgreSQL, which stores
+// fractional seconds up to microseconds
+var temporalTypes = map[string]string{
+	"DATETIME":       "TIMESTAMP",
+	"DATETIME2":      "TIMESTAMP",
+	"DATETIMEOFFSET": "TIMESTAMPTZ",
+}
+
+// maxFractionalSeconds is the largest fractional-second precision of PostgreSQL
+const maxFractionalSeconds = 6
+
+// checkRules translate the operators and functions of other dialects in CHECK
+// expressions. The regular expressions of MySQL become POSIX regular expression
+// matches, which are case sensitive.
+var checkRules = sqlmapper.ExpressionRules{
+	Operators: map[string]string{
+		"REGEXP":     "~",
+		"RLIKE":      "~",
+		"NOT REGEXP": "!~",
+		"NOT RLIKE":  "!~",
+		"<=>":        "IS NOT DISTINCT FROM",
+	},
+	Functions: map[string]string{
+		"ifnull": "COALESCE",
+		"nvl":    "COALESCE",
+		"lcase":  "LOWER",
+		"ucase":  "UPPER",
+		"len":    "LENGTH",
+	},
+}
+
+var (
+	// extensionRe matches a CREATE EXTENSION statement, capturing the quoted or plain
+	// name, the schema and the quoted or plain version
+	extensionRe = regexp.MustCompile(`(?is)CREATE\s+EXTENSION\s+(?:IF\s+NOT\s+EXISTS\s+)?(?:"([^"]+)"|(\w+))(?:\s+WITH)?(?:\s+SCHEMA\s+"?(\w+)"?)?(?:\s+VERSION\s+(?:'([^']*)'|"([^"]*)"|([\w.]+)))?(?:\s+CASCADE)?\s*(?:;|$)`)
+	// plainIdentifierRe matches the names that need no quotes
+	plainIdentifierRe = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+)
+
 // PostgreSQL represents a PostgreSQL parser implementation that handles parsing and generating
 // PostgreSQL database schemas. It maintains an internal schema representation and provides
 // methods for converting between PostgreSQL SQL and the common schema format.
 type PostgreSQL struct {
-	schema *sqlmapper.Schema
+	schema  *sqlmapper.Schema
+	options sqlmapper.GenerateOptions
 }
 
 // NewPostgreSQL creates and initializes a new PostgreSQL parser instance.
 // It returns a parser that can handle PostgreSQL specific SQL syntax and schema structures.
-func NewPostgreSQL() sqlmapper.Database {
+func NewPostgreSQL() sqlmapper.Dialect {
 	return &PostgreSQL{
 		schema: &sqlmapper.Schema{},
 	}
 }
 
+// SetGenerateOptions configures optional output of the generator
+func (p *PostgreSQL) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	p.options = options
+}
+
+// Type returns the database type of the dialect, sqlmapper.PostgreSQL
+func (p *PostgreSQL) Type() sqlmapper.DatabaseType {
+	return sqlmapper.PostgreSQL
+}
+
 // Parse takes a PostgreSQL SQL dump content and parses it into a common schema structure.
 // It processes various PostgreSQL objects including:
 // - Schemas and databases
@@ -47,7 +106,7 @@ func NewPostgreSQL() sqlmapper.Database {
 //   - error: An error if parsing fails
 func (p *PostgreSQL) Parse(content string) (*sqlmapper.Schema, error) {
 	if content == "" {
-		return nil, errors.New("empty content")
+		return nil, &sqlmapper.ValidationError{Problems: []string{"empty content"}}
 	}
 
 	// Normalize content
@@ -55,43 +114,53 @@ func (p *PostgreSQL) Parse(content string) (*sqlmapper.Schema, error) {
 
 	// Parse schema objects
 	if err := p.parseSchemas(content); err != nil {
-		return nil, fmt.Errorf("error parsing schemas: %v", err)
+		return nil, fmt.Errorf("error parsing schemas: %w", err)
 	}
 
 	if err := p.parseTypes(content); err != nil {
-		return nil, fmt.Errorf("error parsing types: %v", err)
+		return nil, fmt.Errorf("error parsing types: %w", err)
 	}
 
 	if err := p.parseExtensions(content); err != nil {
-		return nil, fmt.Errorf("error parsing extensions: %v", err)
+		return nil, fmt.Errorf("error parsing extensions: %w", err)
 	}
 
 	if err := p.parseSequences(content); err != nil {
-		return nil, fmt.Errorf("error parsing sequences: %v", err)
+		return nil, fmt.Errorf("error parsing sequences: %w", err)
 	}
 
 	if err := p.parseTables(content); err != nil {
-		return nil, fmt.Errorf("error parsing tables: %v", err)
+		return nil, fmt.Errorf("error parsing tables: %w", err)
+	}
+
+	// Attach the comments set by COMMENT ON statements
+	for _, comment := range sqlmapper.ParseComments(content) {
+		p.schema.ApplyComment(comment)
 	}
 
 	if err := p.parseIndexes(content); err != nil {
-		return nil, fmt.Errorf("error parsing indexes: %v", err)
+		return nil, fmt.Errorf("error parsing indexes: %w", err)
 	}
 
+	// Remove the constraints and indexes dropped by ALTER TABLE, and apply the
+	// changes of columns
+	p.schema.ApplyAlterDrops(content)
+	p.schema.ApplyAlterColumns(content)
+
 	if err := p.parseViews(content); err != nil {
-		return nil, fmt.Errorf("error parsing views: %v", err)
+		return nil, fmt.Errorf("error parsing views: %w", err)
 	}
 
 	if err := p.parseFunctions(content); err != nil {
-		return nil, fmt.Errorf("error parsing functions: %v", err)
+		return nil, fmt.Errorf("error parsing functions: %w", err)
 	}
 
 	if err := p.parseTriggers(content); err != nil {
-		return nil, fmt.Errorf("error parsing triggers: %v", err)
+		return nil, fmt.Errorf("error parsing triggers: %w", err)
 	}
 
-	if err := p.parsePermissions(content); err != nil {
-		return nil, fmt.Errorf("error parsing permissions: %v", err)
+	if err := p.parseStatements(content); err != nil {
+		return nil, fmt.Errorf("error parsing permissions: %w", err)
 	}
 
 	return p.schema, nil
@@ -99,6 +168,7 @@ func (p *PostgreSQL) Parse(content string) (*sqlmapper.Schema, error) {
 
 // Generate creates a PostgreSQL SQL dump from a schema structure.
 // It generates SQL statements for all database objects in the schema, including:
+// - Extensions, written first
 // - Tables with columns and constraints
 // - Indexes
 // - Views
@@ -114,67 +184,148 @@ func (p *PostgreSQL) Parse(content string) (*sqlmapper.Schema, error) {
 //   - error: An error if generation fails
 func (p *PostgreSQL) Generate(schema *sqlmapper.Schema) (string, error) {
 	if schema == nil {
-		return "", errors.New("empty schema")
+		return "", &sqlmapper.ValidationError{Problems: []string{"empty schema"}}
 	}
 
 	var result strings.Builder
 
-	for _, table := range schema.Tables {
-		result.WriteString("CREATE TABLE ")
-		result.WriteString(table.Name)
-		result.WriteString(" (\n")
+	// Write session settings, disable foreign key checks and open the transaction
+	for _, stmt := range p.options.Prologue(sessionStatements) {
+		result.WriteString(stmt + ";\n")
+	}
 
-		for i, col := range table.Columns {
-			result.WriteString("    ")
-			result.WriteString(col.Name)
-			result.WriteString(" ")
+	// Extensions come first, as types, defaults and functions may depend on them
+	for _, extension := range schema.Extensions {
+		result.WriteString(p.generateExtensionSQL(extension) + ";\n")
+	}
 
-			if col.IsPrimaryKey && col.DataType == "SERIAL" {
-				result.WriteString("SERIAL PRIMARY KEY")
+	for _, drop := range schema.Drops {
+		result.WriteString(p.generateDropSQL(drop) + ";\n")
+	}
+
+	for _, table := range schema.Tables {
+		ifNotExists := p.options.IfNotExists(table.IfNotExists)
+		if table.SourceQuery != "" {
+			result.WriteString(p.generateTableSQL(table) + ";\n")
+		} else if table.PartitionOf != "" {
+			result.WriteString(p.generateTableSQL(table) + ";\n")
+		} else {
+			if table.Temporary {
+				result.WriteString("CREATE TEMPORARY TABLE ")
+			} else if table.Unlogged {
+				result.WriteString("CREATE UNLOGGED TABLE ")
 			} else {
-				result.WriteString(col.DataType)
-				if col.Length > 0 {
-					result.WriteString(fmt.Sprintf("(%d", col.Length))
-					if col.Scale > 0 {
-						result.WriteString(fmt.Sprintf(",%d", col.Scale))
+				result.WriteString("CREATE TABLE ")
+			}
+			result.WriteString(ifNotExists)
+			result.WriteString(table.Name)
+			result.WriteString(" (\n")
+
+			for i, col := range table.Columns {
+				col, check := p.convertColumn(table.Name, col)
+				p.options.MapColumn(table.Name, table.Columns[i], sqlmapper.FormatDataType(col))
+				result.WriteString("    ")
+				result.WriteString(sqlmapper.QuoteIdentifier(col.Name, `"`))
+				result.WriteString(" ")
+
+				if col.IsPrimaryKey && col.DataType == "SERIAL" {
+					result.WriteString("SERIAL PRIMARY KEY")
+					result.WriteString(p.options.Nullability(col, true))
+				} else {
+					result.WriteString(sqlmapper.FormatDataType(col))
+					if identity := sqlmapper.IdentityClause(col); identity != "" {
+						result.WriteString(" " + identity)
+					}
+					result.WriteString(p.options.Nullability(col, false))
+
+					if col.IsUnique {
+						result.WriteString(" " + sqlmapper.ConstraintPrefix(col.UniqueName) + "UNIQUE")
+					}
+
+					if col.DefaultValue != "" {
+						result.WriteString(" DEFAULT " + defaultValueSQL(col.DefaultValue))
 					}
-					result.WriteString(")")
-				}
 
-				if !col.IsNullable {
-					result.WriteString(" NOT NULL")
+					if check != "" {
+						result.WriteString(" CHECK (" + check + ")")
+					}
+					if col.CheckExpression != "" {
+						result.WriteString(" " + sqlmapper.ConstraintPrefix(col.CheckName) + "CHECK (" + p.options.TranslateCheck(table.Name, col.CheckExpression, checkRules) + ")")
+					}
 				}
 
-				if col.IsUnique {
-					result.WriteString(" UNIQUE")
+				if i < len(table.Columns)-1 {
+					result.WriteString(",")
 				}
+				result.WriteString("\n")
 			}
 
-			if i < len(table.Columns)-1 {
-				result.WriteString(",")
+			result.WriteString(")")
+			if len(table.Inherits) > 0 {
+				result.WriteString(" INHERITS (" + strings.Join(table.Inherits, ", ") + ")")
+			}
+			if table.PartitionBy != "" {
+				result.WriteString(" PARTITION BY " + table.PartitionBy)
+			}
+			if table.OnCommit != "" {
+				result.WriteString(" ON COMMIT " + table.OnCommit)
 			}
-			result.WriteString("\n")
+			result.WriteString(";\n")
 		}
 
-		result.WriteString(");\n")
+		// Add table and column comments
+		for _, stmt := range sqlmapper.QuotedCommentStatements(table, quoteLiteral) {
+			result.WriteString(stmt + ";\n")
+		}
 
 		// Add indexes
 		for _, idx := range table.Indexes {
+			idx = p.options.WholeColumnIndex(table.Name, idx)
+			if idx.Kind == sqlmapper.FulltextIndex || idx.Kind == sqlmapper.SpatialIndex {
+				result.WriteString(p.generateIndexSQL(table.Name, idx) + ";\n")
+				continue
+			}
 			if idx.IsUnique {
 				result.WriteString("CREATE UNIQUE INDEX ")
 			} else {
 				result.WriteString("CREATE INDEX ")
 			}
+			result.WriteString(p.options.IfNotExists(idx.IfNotExists))
 			result.WriteString(idx.Name)
 			result.WriteString(" ON ")
 			result.WriteString(table.Name)
 			result.WriteString("(")
-			result.WriteString(strings.Join(idx.Columns, ", "))
-			result.WriteString(");\n")
+			result.WriteString(idx.ColumnList(`"`, true))
+			result.WriteString(")")
+			if len(idx.IncludeColumns) > 0 {
+				result.WriteString(" INCLUDE (" + strings.Join(idx.IncludeColumns, ", ") + ")")
+			}
+			result.WriteString(";\n")
 		}
 	}
 
-	return result.String(), nil
+	// Generate triggers
+	for _, trigger := range schema.Triggers {
+		result.WriteString(p.generateTriggerSQL(trigger) + ";\n")
+	}
+
+	// Generate permissions
+	if p.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := p.generatePermissionSQL(permission); ok {
+				result.WriteString(stmt + ";\n")
+			}
+		}
+	}
+
+	p.options.WarnSkipped(schema, sqlmapper.TriggerObjects|sqlmapper.ExtensionObjects)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range p.options.Epilogue(sessionStatements) {
+		result.WriteString(stmt + ";\n")
+	}
+
+	return p.options.Format.Apply(result.String()), nil
 }
 
 // normalizeContent preprocesses the SQL content by removing comments and normalizing whitespace.
@@ -186,9 +337,8 @@ func (p *PostgreSQL) Generate(schema *sqlmapper.Schema) (string, error) {
 // Returns:
 //   - string: The normalized SQL content
 func (p *PostgreSQL) normalizeContent(content string) string {
-	// Remove comments
-	re := regexp.MustCompile(`--.*$`)
-	content = re.ReplaceAllString(content, "")
+	// Remove comments, including those between column definitions
+	content = sqlmapper.StripPostgresComments(content)
 
 	// Normalize whitespace
 	content = strings.TrimSpace(content)
@@ -286,7 +436,7 @@ func (p *PostgreSQL) parseTypes(content string) error {
 }
 
 // parseExtensions extracts extension definitions from the SQL content.
-// It handles CREATE EXTENSION statements with optional schema qualification.
+// It handles CREATE EXTENSION statements with optional schema and version.
 //
 // Parameters:
 //   - content: The SQL content to parse
@@ -294,27 +444,32 @@ func (p *PostgreSQL) parseTypes(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (p *PostgreSQL) parseExtensions(content string) error {
-	re := regexp.MustCompile(`CREATE\s+EXTENSION(?:\s+IF\s+NOT\s+EXISTS)?\s+(?:"([^"]+)"|(\w+))(?:\s+WITH\s+SCHEMA\s+(\w+))?;`)
-	matches := re.FindAllStringSubmatch(content, -1)
-
-	for _, match := range matches {
-		if len(match) > 1 {
-			extension := sqlmapper.Extension{
-				Name: match[1],
-			}
-			if extension.Name == "" {
-				extension.Name = match[2]
-			}
-			if len(match) > 3 && match[3] != "" {
-				extension.Schema = match[3]
-			}
-			p.schema.Extensions = append(p.schema.Extensions, extension)
-		}
+	for _, match := range extensionRe.FindAllStringSubmatch(content, -1) {
+		p.schema.Extensions = append(p.schema.Extensions, extensionFromMatch(match))
 	}
 
 	return nil
 }
 
+// parseExtensionStatement parses a single CREATE EXTENSION statement
+func parseExtensionStatement(statement string) (*sqlmapper.Extension, bool) {
+	match := extensionRe.FindStringSubmatch(statement)
+	if match == nil || sqlmapper.ClassifyStatement(statement) != sqlmapper.CreateStatement {
+		return nil, false
+	}
+	extension := extensionFromMatch(match)
+	return &extension, true
+}
+
+// extensionFromMatch builds an extension from a match of extensionRe
+func extensionFromMatch(match []string) sqlmapper.Extension {
+	return sqlmapper.Extension{
+		Name:    match[1] + match[2],
+		Schema:  match[3],
+		Version: match[4] + match[5] + match[6],
+	}
+}
+
 // parseSequences processes sequence definitions from the SQL content.
 // It handles all sequence options including INCREMENT, MINVALUE, MAXVALUE,
 // START WITH, CACHE, and CYCLE.
@@ -379,15 +534,29 @@ func (p *PostgreSQL) parseSequences(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (p *PostgreSQL) parseTables(content string) error {
-	re := regexp.MustCompile(`CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\((.*?)\)(?:\s+TABLESPACE\s+(\w+))?;`)
+	// Parse CREATE TABLE ... AS SELECT statements
+	ctasRe := regexp.MustCompile(`(?i)CREATE\s+(?:(?:GLOBAL|LOCAL)\s+)?(?:(?:TEMPORARY|TEMP)\s+)?(?:UNLOGGED\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?[^\s(;]+\s*(?:\([^();]*\)\s*)?(?:ON\s+COMMIT\s+(?:DELETE\s+ROWS|PRESERVE\s+ROWS|DROP)\s+)?(?:AS\s+)?\(?\s*(?:SELECT|WITH)\b[^;]*;`)
+	for _, statement := range ctasRe.FindAllString(content, -1) {
+		if table, ok := sqlmapper.ParseCreateTableAs(statement); ok {
+			p.schema.Tables = append(p.schema.Tables, *table)
+		}
+	}
+
+	re := regexp.MustCompile(`CREATE\s+(?:(?:GLOBAL|LOCAL)\s+)?(?:TEMPORARY\s+|TEMP\s+)?(UNLOGGED\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\((.*?)\)(?:\s+INHERITS\s*\(([^()]*)\))?(?:\s+PARTITION\s+BY\s+(\w+\s*\(.*?\)))?(?:\s+ON\s+COMMIT\s+(PRESERVE\s+ROWS|DELETE\s+ROWS|DROP))?(?:\s+TABLESPACE\s+(\w+))?;`)
 	matches := re.FindAllStringSubmatch(content, -1)
 
 	for _, match := range matches {
 		if len(match) > 2 {
-			tableName := match[1]
-			columnDefs := match[2]
+			tableName := match[2]
+			columnDefs := match[3]
 
-			table := sqlmapper.Table{}
+			table := sqlmapper.Table{
+				Temporary:   regexp.MustCompile(`(?i)^CREATE\s+(?:(?:GLOBAL|LOCAL)\s+)?(?:TEMPORARY|TEMP)\s`).MatchString(match[0]),
+				Unlogged:    match[1] != "",
+				PartitionBy: match[5],
+				OnCommit:    strings.ToUpper(match[6]),
+				IfNotExists: sqlmapper.HasIfNotExists(match[0]),
+			}
 
 			// Parse schema if exists
 			parts := strings.Split(tableName, ".")
@@ -398,36 +567,19 @@ func (p *PostgreSQL) parseTables(content string) error {
 				table.Name = tableName
 			}
 
-			// Parse tablespace if exists
-			if len(match) > 3 && match[3] != "" {
-				table.TableSpace = match[3]
+			// Parse parent tables and tablespace if exists
+			for _, parent := range strings.Split(match[4], ",") {
+				if parent = strings.TrimSpace(parent); parent != "" {
+					table.Inherits = append(table.Inherits, parent)
+				}
+			}
+			if len(match) > 7 && match[7] != "" {
+				table.TableSpace = match[7]
 			}
 
 			// Parse columns and constraints
 			if err := p.parseColumnsAndConstraints(columnDefs, &table); err != nil {
-				return err
-			}
-
-			// Parse table comment
-			tableCommentRe := regexp.MustCompile(`COMMENT\s+ON\s+TABLE\s+` + regexp.QuoteMeta(tableName) + `\s+IS\s+'([^']+)';`)
-			if tableCommentMatch := tableCommentRe.FindStringSubmatch(content); len(tableCommentMatch) > 1 {
-				table.Comment = tableCommentMatch[1]
-			}
-
-			// Parse column comments
-			commentRe := regexp.MustCompile(`COMMENT\s+ON\s+COLUMN\s+` + regexp.QuoteMeta(tableName) + `\.(\w+)\s+IS\s+'([^']+)';`)
-			commentMatches := commentRe.FindAllStringSubmatch(content, -1)
-			for _, commentMatch := range commentMatches {
-				if len(commentMatch) > 2 {
-					columnName := commentMatch[1]
-					comment := commentMatch[2]
-					for i := range table.Columns {
-						if table.Columns[i].Name == columnName {
-							table.Columns[i].Comment = comment
-							break
-						}
-					}
-				}
+				return sqlmapper.ObjectError("table", tableName, match[0], err)
 			}
 
 			// Set column order
@@ -439,6 +591,24 @@ func (p *PostgreSQL) parseTables(content string) error {
 		}
 	}
 
+	// Parse partitions, which take their columns from the parent table
+	partitionRe := regexp.MustCompile(`CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s+PARTITION\s+OF\s+([.\w]+)\s*(?:\(.*?\)\s*)?(FOR\s+VALUES\s+.*?|DEFAULT)(?:\s+PARTITION\s+BY\s+(\w+\s*\(.*?\)))?(?:\s+TABLESPACE\s+(\w+))?;`)
+	for _, match := range partitionRe.FindAllStringSubmatch(content, -1) {
+		table := sqlmapper.Table{
+			Name:           match[1],
+			PartitionOf:    match[2],
+			PartitionBound: match[3],
+			PartitionBy:    match[4],
+			TableSpace:     match[5],
+			IfNotExists:    sqlmapper.HasIfNotExists(match[0]),
+		}
+		if parts := strings.Split(match[1], "."); len(parts) > 1 {
+			table.Schema = parts[0]
+			table.Name = parts[1]
+		}
+		p.schema.Tables = append(p.schema.Tables, table)
+	}
+
 	return nil
 }
 
@@ -452,83 +622,54 @@ func (p *PostgreSQL) parseTables(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (p *PostgreSQL) parseColumnsAndConstraints(columnDefs string, table *sqlmapper.Table) error {
-	// Split column definitions
-	defs := strings.Split(columnDefs, ",")
-	var currentDef strings.Builder
-	var finalDefs []string
-
-	// Handle nested parentheses in CHECK constraints
-	parenCount := 0
-	for _, def := range defs {
-		parenCount += strings.Count(def, "(") - strings.Count(def, ")")
-		if parenCount > 0 {
-			currentDef.WriteString(def + ",")
-		} else {
-			if currentDef.Len() > 0 {
-				currentDef.WriteString(def)
-				finalDefs = append(finalDefs, currentDef.String())
-				currentDef.Reset()
-			} else {
-				finalDefs = append(finalDefs, def)
-			}
-		}
-	}
-
-	for _, def := range finalDefs {
-		def = strings.TrimSpace(def)
-
-		// Skip empty definitions
-		if def == "" {
-			continue
-		}
-
+	for _, def := range sqlmapper.SplitDefinitions(columnDefs) {
 		// Parse constraints
 		if strings.HasPrefix(strings.ToUpper(def), "CONSTRAINT") ||
-			(strings.Contains(strings.ToUpper(def), "PRIMARY KEY") && !strings.Contains(strings.ToUpper(def), "SERIAL")) ||
+			strings.HasPrefix(strings.ToUpper(def), "PRIMARY KEY") ||
 			strings.Contains(strings.ToUpper(def), "FOREIGN KEY") ||
 			(strings.Contains(strings.ToUpper(def), "UNIQUE") && !strings.Contains(strings.ToUpper(def), " ")) ||
-			(strings.Contains(strings.ToUpper(def), "CHECK") && !strings.Contains(strings.ToUpper(def), " ")) {
+			strings.HasPrefix(strings.ToUpper(def), "CHECK") {
 			constraint, err := p.parseConstraint(def)
 			if err != nil {
-				return err
+				return sqlmapper.ObjectError("constraint", "", def, err)
 			}
 			table.Constraints = append(table.Constraints, constraint)
 			continue
 		}
 
 		// Parse column
-		if strings.Contains(def, " ") {
-			column, err := p.parseColumn(def)
-			if err != nil {
-				return err
-			}
-			table.Columns = append(table.Columns, column)
-
-			// Check for inline constraints
-			if strings.Contains(strings.ToUpper(def), "PRIMARY KEY") {
-				table.Constraints = append(table.Constraints, sqlmapper.Constraint{
-					Type:    "PRIMARY KEY",
-					Columns: []string{column.Name},
-				})
-				column.IsPrimaryKey = true
-			}
-			if strings.Contains(strings.ToUpper(def), "UNIQUE") {
+		column, err := p.parseColumn(def)
+		if err != nil {
+			return sqlmapper.ColumnError(def, err)
+		}
+		table.Columns = append(table.Columns, column)
+
+		// Check for inline constraints
+		if strings.Contains(strings.ToUpper(def), "PRIMARY KEY") {
+			table.Constraints = append(table.Constraints, sqlmapper.Constraint{
+				Name:    column.PrimaryKeyName,
+				Type:    "PRIMARY KEY",
+				Columns: []string{column.Name},
+			})
+			column.IsPrimaryKey = true
+		}
+		if strings.Contains(strings.ToUpper(def), "UNIQUE") {
+			table.Constraints = append(table.Constraints, sqlmapper.Constraint{
+				Name:    column.UniqueName,
+				Type:    "UNIQUE",
+				Columns: []string{column.Name},
+			})
+			column.IsUnique = true
+		}
+		if strings.Contains(strings.ToUpper(def), "CHECK") {
+			if check, ok := sqlmapper.CheckExpression(def); ok {
 				table.Constraints = append(table.Constraints, sqlmapper.Constraint{
-					Type:    "UNIQUE",
-					Columns: []string{column.Name},
+					Name:            column.CheckName,
+					Type:            "CHECK",
+					Columns:         []string{column.Name},
+					CheckExpression: check,
 				})
-				column.IsUnique = true
-			}
-			if strings.Contains(strings.ToUpper(def), "CHECK") {
-				re := regexp.MustCompile(`CHECK\s*\((.*?)\)`)
-				if matches := re.FindStringSubmatch(def); len(matches) > 1 {
-					table.Constraints = append(table.Constraints, sqlmapper.Constraint{
-						Type:            "CHECK",
-						Columns:         []string{column.Name},
-						CheckExpression: matches[1],
-					})
-					column.CheckExpression = matches[1]
-				}
+				column.CheckExpression = check
 			}
 		}
 	}
@@ -547,7 +688,7 @@ func (p *PostgreSQL) parseColumnsAndConstraints(columnDefs string, table *sqlmap
 //   - sqlmapper.Column: The parsed column structure
 //   - error: An error if parsing fails
 func (p *PostgreSQL) parseColumn(def string) (sqlmapper.Column, error) {
-	parts := strings.Fields(def)
+	parts := strings.Fields(sqlmapper.CompactTypeParameters(def))
 	if len(parts) < 2 {
 		return sqlmapper.Column{}, fmt.Errorf("invalid column definition: %s", def)
 	}
@@ -558,6 +699,12 @@ func (p *PostgreSQL) parseColumn(def string) (sqlmapper.Column, error) {
 		IsNullable: true,
 	}
 
+	// Identity columns, whose GENERATED BY DEFAULT clause is not a default value
+	if rest, ok := column.ParseIdentity(def); ok {
+		def = rest
+		column.IsNullable = false
+	}
+
 	// Handle SERIAL type
 	if strings.ToUpper(column.DataType) == "SERIAL" {
 		column.AutoIncrement = true
@@ -566,47 +713,15 @@ func (p *PostgreSQL) parseColumn(def string) (sqlmapper.Column, error) {
 	}
 
 	// Parse length/precision
-	if strings.Contains(column.DataType, "(") {
-		re := regexp.MustCompile(`(\w+)\((\d+)(?:,(\d+))?\)`)
-		if matches := re.FindStringSubmatch(column.DataType); len(matches) > 2 {
-			column.DataType = matches[1]
-			if len(matches[2]) > 0 {
-				fmt.Sscanf(matches[2], "%d", &column.Length)
-			}
-			if len(matches) > 3 && len(matches[3]) > 0 {
-				fmt.Sscanf(matches[3], "%d", &column.Scale)
-			}
-		}
-	}
-
-	// Parse default value
-	if idx := strings.Index(strings.ToUpper(def), "DEFAULT"); idx >= 0 {
-		defaultPart := def[idx+7:]
-		defaultPart = strings.TrimSpace(defaultPart)
+	column.DataType, column.Length, column.Scale = sqlmapper.ParseDataType(column.DataType)
 
-		// Handle function calls and keywords
-		if strings.Contains(strings.ToUpper(defaultPart), "CURRENT_TIMESTAMP") {
-			column.DefaultValue = "CURRENT_TIMESTAMP"
-		} else if strings.HasPrefix(defaultPart, "'") {
-			// Handle quoted string values
-			re := regexp.MustCompile(`'([^']*)'`)
-			if matches := re.FindStringSubmatch(defaultPart); len(matches) > 1 {
-				column.DefaultValue = matches[1]
-			}
-		} else {
-			// Handle other values
-			endIdx := strings.Index(defaultPart, " ")
-			if endIdx == -1 {
-				endIdx = len(defaultPart)
-			}
-			defaultValue := strings.TrimSpace(defaultPart[:endIdx])
-			// Remove trailing comma
-			defaultValue = strings.TrimSuffix(defaultValue, ",")
-			column.DefaultValue = defaultValue
-		}
+	// Parse default value, keeping casts, function calls and array literals whole
+	if defaultValue, ok := sqlmapper.DefaultExpression(def); ok {
+		column.DefaultValue = sqlmapper.UnquoteLiteral(defaultValue)
 	}
 
-	// Parse column constraints
+	// Parse column constraints and the names given to them
+	column.ParseConstraintNames(def)
 	if strings.Contains(strings.ToUpper(def), "PRIMARY KEY") {
 		column.IsPrimaryKey = true
 	}
@@ -614,9 +729,8 @@ func (p *PostgreSQL) parseColumn(def string) (sqlmapper.Column, error) {
 		column.IsUnique = true
 	}
 	if strings.Contains(strings.ToUpper(def), "CHECK") {
-		re := regexp.MustCompile(`CHECK\s*\((.*?)\)`)
-		if matches := re.FindStringSubmatch(def); len(matches) > 1 {
-			column.CheckExpression = matches[1]
+		if check, ok := sqlmapper.CheckExpression(def); ok {
+			column.CheckExpression = check
 		}
 	}
 
@@ -686,9 +800,8 @@ func (p *PostgreSQL) parseConstraint(def string) (sqlmapper.Constraint, error) {
 		}
 	} else if strings.Contains(strings.ToUpper(def), "CHECK") {
 		constraint.Type = "CHECK"
-		re := regexp.MustCompile(`CHECK\s*\((.*?)\)`)
-		if matches := re.FindStringSubmatch(def); len(matches) > 1 {
-			constraint.CheckExpression = matches[1]
+		if check, ok := sqlmapper.CheckExpression(def); ok {
+			constraint.CheckExpression = check
 		}
 	}
 
@@ -704,27 +817,30 @@ func (p *PostgreSQL) parseConstraint(def string) (sqlmapper.Constraint, error) {
 // Returns:
 //   - error: An error if parsing fails
 func (p *PostgreSQL) parseIndexes(content string) error {
-	re := regexp.MustCompile(`CREATE\s+(?:UNIQUE\s+)?INDEX\s+(\w+)\s+ON\s+([.\w]+)\s*\((.*?)\)`)
+	re := regexp.MustCompile(`CREATE\s+(?:UNIQUE\s+)?INDEX\s+(?:CONCURRENTLY\s+)?(?:IF\s+NOT\s+EXISTS\s+)?(\w+)\s+ON\s+([.\w]+)\s*\((.*?)\)(?:\s+INCLUDE\s*\((.*?)\))?`)
 	matches := re.FindAllStringSubmatch(content, -1)
 
 	for _, match := range matches {
 		if len(match) > 3 {
 			indexName := match[1]
 			tableName := match[2]
-			columns := strings.Split(match[3], ",")
+			columns := sqlmapper.SplitIndexColumns(match[3])
 
 			// Find the table
 			for i, table := range p.schema.Tables {
 				if table.Name == tableName || fmt.Sprintf("%s.%s", table.Schema, table.Name) == tableName {
 					index := sqlmapper.Index{
-						Name:     indexName,
-						Columns:  make([]string, len(columns)),
-						IsUnique: strings.Contains(match[0], "UNIQUE"),
+						Name:        indexName,
+						Columns:     columns,
+						IsUnique:    strings.Contains(match[0], "UNIQUE"),
+						IfNotExists: sqlmapper.HasIfNotExists(match[0]),
 					}
 
-					// Clean column names
-					for j, col := range columns {
-						index.Columns[j] = strings.TrimSpace(col)
+					// Non-key columns of a covering index
+					if match[4] != "" {
+						for _, col := range strings.Split(match[4], ",") {
+							index.IncludeColumns = append(index.IncludeColumns, strings.TrimSpace(col))
+						}
 					}
 
 					p.schema.Tables[i].Indexes = append(p.schema.Tables[i].Indexes, index)
@@ -771,7 +887,7 @@ func (p *PostgreSQL) parseViews(content string) error {
 	}
 
 	// Parse materialized views
-	matViewRe := regexp.MustCompile(`CREATE\s+MATERIALIZED\s+VIEW\s+([.\w]+)(?:\s+WITH\s*\([^)]*\))?\s+AS\s+(.*?)\s+WITH\s+(?:NO\s+)?DATA;`)
+	matViewRe := regexp.MustCompile(`CREATE\s+MATERIALIZED\s+VIEW\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)(?:\s+WITH\s*\([^)]*\))?\s+AS\s+(.*?)\s+WITH\s+(?:NO\s+)?DATA;`)
 	matViewMatches := matViewRe.FindAllStringSubmatch(content, -1)
 
 	for _, match := range matViewMatches {
@@ -780,6 +896,7 @@ func (p *PostgreSQL) parseViews(content string) error {
 			view := sqlmapper.View{
 				Definition:     match[2],
 				IsMaterialized: true,
+				IfNotExists:    sqlmapper.HasIfNotExists(match[0]),
 			}
 
 			// Parse schema if exists
@@ -809,16 +926,17 @@ func (p *PostgreSQL) parseViews(content string) error {
 //   - error: An error if parsing fails
 func (p *PostgreSQL) parseFunctions(content string) error {
 	// Parse functions
-	funcRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+FUNCTION\s+([.\w]+)\s*\((.*?)\)\s+RETURNS\s+(\w+)\s+AS\s+\$\$(.*?)\$\$\s+LANGUAGE\s+(\w+)`)
+	// The body is quoted with $$ or a tagged dollar quote such as $body$
+	funcRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+FUNCTION\s+([.\w]+)\s*\((.*?)\)\s+RETURNS\s+(\w+)\s+AS\s+\$(\w*)\$(.*?)\$(\w*)\$\s+LANGUAGE\s+(\w+)`)
 	funcMatches := funcRe.FindAllStringSubmatch(content, -1)
 
 	for _, match := range funcMatches {
-		if len(match) > 5 {
+		if len(match) > 7 && match[4] == match[6] {
 			functionName := match[1]
 			function := sqlmapper.Function{
 				Returns:  match[3],
-				Body:     match[4],
-				Language: match[5],
+				Body:     match[5],
+				Language: match[7],
 			}
 
 			// Parse schema if exists
@@ -850,15 +968,15 @@ func (p *PostgreSQL) parseFunctions(content string) error {
 	}
 
 	// Parse procedures
-	procRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+PROCEDURE\s+([.\w]+)\s*\((.*?)\)\s+LANGUAGE\s+(\w+)\s+AS\s+\$\$(.*?)\$\$`)
+	procRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?\s+PROCEDURE\s+([.\w]+)\s*\((.*?)\)\s+LANGUAGE\s+(\w+)\s+AS\s+\$(\w*)\$(.*?)\$(\w*)\$`)
 	procMatches := procRe.FindAllStringSubmatch(content, -1)
 
 	for _, match := range procMatches {
-		if len(match) > 4 {
+		if len(match) > 6 && match[4] == match[6] {
 			procName := match[1]
 			function := sqlmapper.Function{
 				Name:     procName,
-				Body:     match[4],
+				Body:     match[5],
 				Language: match[3],
 				IsProc:   true,
 			}
@@ -893,8 +1011,8 @@ func (p *PostgreSQL) parseFunctions(content string) error {
 }
 
 // parseTriggers processes trigger definitions from the SQL content.
-// It handles both regular and conditional triggers with their timing,
-// events, and conditions.
+// It handles trigger timing, events, FOR EACH ROW/STATEMENT granularity
+// and WHEN conditions.
 //
 // Parameters:
 //   - content: The SQL content to parse
@@ -902,45 +1020,19 @@ func (p *PostgreSQL) parseFunctions(content string) error {
 // Returns:
 //   - error: An error if parsing fails
 func (p *PostgreSQL) parseTriggers(content string) error {
-	// Parse regular triggers
-	triggerRe := regexp.MustCompile(`CREATE\s+TRIGGER\s+(\w+)\s+(BEFORE|AFTER|INSTEAD\s+OF)\s+(INSERT|UPDATE|DELETE)\s+ON\s+([.\w]+)\s+(?:FOR\s+EACH\s+ROW\s+)?EXECUTE\s+(?:FUNCTION|PROCEDURE)\s+([.\w]+)`)
+	triggerRe := regexp.MustCompile(`CREATE(?:\s+OR\s+REPLACE)?(?:\s+CONSTRAINT)?\s+TRIGGER\s+(\w+)\s+(BEFORE|AFTER|INSTEAD\s+OF)\s+((?:INSERT|UPDATE(?:\s+OF\s+[.\w]+(?:\s*,\s*[.\w]+)*)?|DELETE|TRUNCATE)(?:\s+OR\s+(?:INSERT|UPDATE(?:\s+OF\s+[.\w]+(?:\s*,\s*[.\w]+)*)?|DELETE|TRUNCATE))*)\s+ON\s+([.\w]+)\s+(?:FOR\s+(?:EACH\s+)?(ROW|STATEMENT)\s+)?(?:WHEN\s+\((.*?)\)\s+)?EXECUTE\s+(?:FUNCTION|PROCEDURE)\s+([.\w]+)`)
 	triggerMatches := triggerRe.FindAllStringSubmatch(content, -1)
 
 	for _, match := range triggerMatches {
-		if len(match) > 5 {
+		if len(match) > 7 {
 			trigger := sqlmapper.Trigger{
 				Name:       match[1],
 				Timing:     match[2],
-				Event:      match[3],
+				Events:     sqlmapper.ParseTriggerEvents(match[3]),
 				Table:      match[4],
-				Body:       match[5],
-				ForEachRow: strings.Contains(match[0], "FOR EACH ROW"),
-			}
-
-			// Parse schema if exists
-			parts := strings.Split(trigger.Table, ".")
-			if len(parts) > 1 {
-				trigger.Schema = parts[0]
-				trigger.Table = parts[1]
-			}
-
-			p.schema.Triggers = append(p.schema.Triggers, trigger)
-		}
-	}
-
-	// Parse conditional triggers
-	condTriggerRe := regexp.MustCompile(`CREATE\s+TRIGGER\s+(\w+)\s+(BEFORE|AFTER|INSTEAD\s+OF)\s+(?:UPDATE\s+OF\s+[.\w]+\s+)?ON\s+([.\w]+)\s+(?:FOR\s+EACH\s+ROW\s+)?WHEN\s+\((.*?)\)\s+EXECUTE\s+(?:FUNCTION|PROCEDURE)\s+([.\w]+)`)
-	condTriggerMatches := condTriggerRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range condTriggerMatches {
-		if len(match) > 5 {
-			trigger := sqlmapper.Trigger{
-				Name:       match[1],
-				Timing:     match[2],
-				Table:      match[3],
-				Condition:  match[4],
-				Body:       match[5],
-				ForEachRow: strings.Contains(match[0], "FOR EACH ROW"),
+				ForEachRow: match[5] == "ROW",
+				Condition:  sqlmapper.TrimParens(match[6]),
+				Body:       match[7],
 			}
 
 			// Parse schema if exists
@@ -957,89 +1049,28 @@ func (p *PostgreSQL) parseTriggers(content string) error {
 	return nil
 }
 
-// parsePermissions extracts permission definitions from the SQL content.
-// It handles GRANT and REVOKE statements for various privilege types.
+// parseStatements extracts the permissions, drops and session settings of the SQL
+// content. It handles GRANT and REVOKE statements for various privilege types. The
+// statements are split with dollar quoting, so that those inside function bodies
+// are ignored.
 //
 // Parameters:
 //   - content: The SQL content to parse
 //
 // Returns:
 //   - error: An error if parsing fails
-func (p *PostgreSQL) parsePermissions(content string) error {
-	// Parse GRANT statements
-	grantRe := regexp.MustCompile(`GRANT\s+(.*?)\s+ON\s+(?:TABLE\s+)?([.\w]+)\s+TO\s+(\w+)(?:\s+WITH\s+GRANT\s+OPTION)?;`)
-	grantMatches := grantRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range grantMatches {
-		if len(match) > 3 {
-			privileges := strings.Split(strings.TrimSpace(match[1]), ",")
-			for i := range privileges {
-				privileges[i] = strings.TrimSpace(privileges[i])
-			}
-
-			perm := sqlmapper.Permission{
-				Type:       "GRANT",
-				Privileges: privileges,
-				Object:     match[2],
-				Grantee:    match[3],
-				WithGrant:  strings.Contains(match[0], "WITH GRANT OPTION"),
-			}
-			p.schema.Permissions = append(p.schema.Permissions, perm)
+func (p *PostgreSQL) parseStatements(content string) error {
+	for _, statement := range sqlmapper.SplitPostgresStatements(content) {
+		if permission, ok := sqlmapper.ParsePermission(statement.Text); ok {
+			p.schema.Permissions = append(p.schema.Permissions, *permission)
 		}
-	}
-
-	// Parse GRANT ALL statements
-	grantAllRe := regexp.MustCompile(`GRANT\s+ALL\s+PRIVILEGES\s+ON\s+(?:ALL\s+TABLES\s+IN\s+SCHEMA\s+)?([.\w]+)\s+TO\s+(\w+)(?:\s+WITH\s+GRANT\s+OPTION)?;`)
-	grantAllMatches := grantAllRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range grantAllMatches {
-		if len(match) > 2 {
-			perm := sqlmapper.Permission{
-				Type:       "GRANT",
-				Privileges: []string{"ALL PRIVILEGES"},
-				Object:     match[1],
-				Grantee:    match[2],
-				WithGrant:  strings.Contains(match[0], "WITH GRANT OPTION"),
-			}
-			p.schema.Permissions = append(p.schema.Permissions, perm)
+		if drop, ok := sqlmapper.ParseDrop(statement.Text); ok {
+			p.schema.Drops = append(p.schema.Drops, *drop)
 		}
-	}
-
-	// Parse GRANT EXECUTE statements
-	grantExecRe := regexp.MustCompile(`GRANT\s+EXECUTE\s+ON\s+(?:FUNCTION|PROCEDURE)\s+([.\w]+)\s*\((.*?)\)\s+TO\s+(\w+)(?:\s+WITH\s+GRANT\s+OPTION)?;`)
-	grantExecMatches := grantExecRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range grantExecMatches {
-		if len(match) > 3 {
-			perm := sqlmapper.Permission{
-				Type:       "GRANT",
-				Privileges: []string{"EXECUTE"},
-				Object:     match[1],
-				Grantee:    match[3],
-				WithGrant:  strings.Contains(match[0], "WITH GRANT OPTION"),
-			}
-			p.schema.Permissions = append(p.schema.Permissions, perm)
-		}
-	}
-
-	// Parse REVOKE statements
-	revokeRe := regexp.MustCompile(`REVOKE\s+(.*?)\s+ON\s+(?:TABLE\s+)?([.\w]+)\s+FROM\s+(\w+);`)
-	revokeMatches := revokeRe.FindAllStringSubmatch(content, -1)
-
-	for _, match := range revokeMatches {
-		if len(match) > 3 {
-			privileges := strings.Split(strings.TrimSpace(match[1]), ",")
-			for i := range privileges {
-				privileges[i] = strings.TrimSpace(privileges[i])
-			}
-
-			perm := sqlmapper.Permission{
-				Type:       "REVOKE",
-				Privileges: privileges,
-				Object:     match[2],
-				Grantee:    match[3],
+		if statement.Kind == sqlmapper.SetStatement {
+			if settings, ok := sqlmapper.ParseSetStatement(statement.Text); ok {
+				p.schema.Settings = append(p.schema.Settings, settings...)
 			}
-			p.schema.Permissions = append(p.schema.Permissions, perm)
 		}
 	}
 
@@ -1056,52 +1087,229 @@ func (p *PostgreSQL) generateTypeSQL(typ sqlmapper.Type) string {
 
 // generateTableSQL generates SQL for a table
 func (p *PostgreSQL) generateTableSQL(table sqlmapper.Table) string {
-	sql := "CREATE TABLE " + table.Name + " (\n"
+	ifNotExists := p.options.IfNotExists(table.IfNotExists)
+	if table.SourceQuery != "" {
+		sql := sqlmapper.CreateTableAsSQL(table, "TEMPORARY", ifNotExists, true)
+		if table.Unlogged {
+			sql = "CREATE UNLOGGED " + strings.TrimPrefix(sql, "CREATE ")
+		}
+		return sql
+	}
+
+	if table.PartitionOf != "" {
+		sql := "CREATE TABLE " + ifNotExists + table.Name + " PARTITION OF " + table.PartitionOf + " " + table.PartitionBound
+		if table.PartitionBy != "" {
+			sql += " PARTITION BY " + table.PartitionBy
+		}
+		if table.TableSpace != "" {
+			sql += " TABLESPACE " + table.TableSpace
+		}
+		return sql
+	}
+
+	var sql strings.Builder
+	sql.Grow(sqlmapper.TableSizeHint(table))
+	sql.WriteString("CREATE ")
+	if table.Temporary {
+		sql.WriteString("TEMPORARY ")
+	} else if table.Unlogged {
+		sql.WriteString("UNLOGGED ")
+	}
+	sql.WriteString("TABLE ")
+	sql.WriteString(ifNotExists)
+	sql.WriteString(table.Name)
+	sql.WriteString(" (\n")
 
 	// Generate columns
 	for i, col := range table.Columns {
-		sql += "    " + col.Name + " "
+		col, check := p.convertColumn(table.Name, col)
+		p.options.MapColumn(table.Name, table.Columns[i], sqlmapper.FormatDataType(col))
+		sql.WriteString("    ")
+		sql.WriteString(sqlmapper.QuoteIdentifier(col.Name, `"`))
+		sql.WriteString(" ")
 
 		if col.IsPrimaryKey && strings.ToUpper(col.DataType) == "SERIAL" {
-			sql += "SERIAL PRIMARY KEY"
+			sql.WriteString("SERIAL PRIMARY KEY")
+			sql.WriteString(p.options.Nullability(col, true))
 		} else {
-			sql += col.DataType
-			if col.Length > 0 {
-				sql += fmt.Sprintf("(%d", col.Length)
-				if col.Scale > 0 {
-					sql += fmt.Sprintf(",%d", col.Scale)
-				}
-				sql += ")"
-			}
-
-			if !col.IsNullable {
-				sql += " NOT NULL"
+			sql.WriteString(sqlmapper.FormatDataType(col))
+			if identity := sqlmapper.IdentityClause(col); identity != "" {
+				sql.WriteString(" ")
+				sql.WriteString(identity)
 			}
+			sql.WriteString(p.options.Nullability(col, false))
 			if col.IsUnique {
-				sql += " UNIQUE"
+				sql.WriteString(" ")
+				sql.WriteString(sqlmapper.ConstraintPrefix(col.UniqueName))
+				sql.WriteString("UNIQUE")
 			}
 			if col.DefaultValue != "" {
-				sql += " DEFAULT " + col.DefaultValue
+				sql.WriteString(" DEFAULT ")
+				sql.WriteString(defaultValueSQL(col.DefaultValue))
+			}
+			if check != "" {
+				sql.WriteString(" CHECK (")
+				sql.WriteString(check)
+				sql.WriteString(")")
+			}
+			if col.CheckExpression != "" {
+				sql.WriteString(" ")
+				sql.WriteString(sqlmapper.ConstraintPrefix(col.CheckName))
+				sql.WriteString("CHECK (")
+				sql.WriteString(p.options.TranslateCheck(table.Name, col.CheckExpression, checkRules))
+				sql.WriteString(")")
 			}
 		}
 
 		if i < len(table.Columns)-1 {
-			sql += ",\n"
+			sql.WriteString(",\n")
 		}
 	}
 
-	sql += "\n)"
+	sql.WriteString("\n)")
 
 	// Add table options
+	if len(table.Inherits) > 0 {
+		sql.WriteString(" INHERITS (")
+		sql.WriteString(strings.Join(table.Inherits, ", "))
+		sql.WriteString(")")
+	}
+	if table.PartitionBy != "" {
+		sql.WriteString(" PARTITION BY ")
+		sql.WriteString(table.PartitionBy)
+	}
+	if table.OnCommit != "" {
+		sql.WriteString(" ON COMMIT ")
+		sql.WriteString(table.OnCommit)
+	}
 	if table.TableSpace != "" {
-		sql += " TABLESPACE " + table.TableSpace
+		sql.WriteString(" TABLESPACE ")
+		sql.WriteString(table.TableSpace)
 	}
 
-	return sql
+	return sql.String()
+}
+
+// enumRe matches a MySQL ENUM type and its values
+var enumRe = regexp.MustCompile(`(?is)^ENUM\s*\((.*)\)$`)
+
+// convertColumn converts a column of another dialect, returning the CHECK expression
+// of the column, if any. An inline MySQL ENUM becomes TEXT with a CHECK constraint,
+// as PostgreSQL only has named enum types, and a SET becomes VARCHAR.
+func (p *PostgreSQL) convertColumn(table string, col sqlmapper.Column) (sqlmapper.Column, string) {
+	if match := enumRe.FindStringSubmatch(col.DataType); match != nil {
+		p.options.Warnf("ENUM type of column %s.%s was dropped, the column was converted to TEXT with a CHECK constraint", table, col.Name)
+		col.DataType = "TEXT"
+		return col, col.Name + " IN (" + match[1] + ")"
+	}
+	col = p.options.ConvertSetType(table, col, "VARCHAR")
+	return p.convertUnsigned(table, p.convertTemporal(table, p.convertBoolean(col)))
+}
+
+// convertUnsigned widens an unsigned column, since PostgreSQL has no unsigned types,
+// and returns the CHECK expression keeping it non-negative
+func (p *PostgreSQL) convertUnsigned(table string, col sqlmapper.Column) (sqlmapper.Column, string) {
+	if col.Zerofill {
+		p.options.Warnf("ZEROFILL of column %s.%s is not supported and was dropped", table, col.Name)
+	}
+	return sqlmapper.WidenUnsigned(col), sqlmapper.UnsignedCheck(col)
+}
+
+// convertBoolean converts a MySQL TINYINT(1) column to BOOLEAN unless
+// KeepTinyInt is set
+func (p *PostgreSQL) convertBoolean(col sqlmapper.Column) sqlmapper.Column {
+	if p.options.KeepTinyInt {
+		return col
+	}
+	col, _ = sqlmapper.TinyIntBoolean(col)
+	return col
+}
+
+// convertTemporal renames temporal types PostgreSQL lacks, such as DATETIME, keeping
+// their fractional-second precision
+func (p *PostgreSQL) convertTemporal(table string, col sqlmapper.Column) sqlmapper.Column {
+	col, reduced := sqlmapper.ConvertTemporalType(col, temporalTypes, maxFractionalSeconds)
+	if reduced {
+		p.options.Warnf("fractional seconds of column %s.%s were reduced to %d digits", table, col.Name, maxFractionalSeconds)
+	}
+	return col
+}
+
+// convertIndexKind converts a MySQL FULLTEXT index to a GIN index on the text search
+// vector of its columns, and a SPATIAL index to a GiST index
+func (p *PostgreSQL) convertIndexKind(table string, index sqlmapper.Index) sqlmapper.Index {
+	switch index.Kind {
+	case sqlmapper.FulltextIndex:
+		document := make([]string, len(index.Columns))
+		for i, column := range index.Columns {
+			document[i] = sqlmapper.QuoteIdentifier(column.Name, `"`)
+			if len(index.Columns) > 1 {
+				document[i] = "coalesce(" + document[i] + ", '')"
+			}
+		}
+		vector := "to_tsvector('simple', " + strings.Join(document, " || ' ' || ") + ")"
+		p.options.Warnf("FULLTEXT index %s on %s was converted to a GIN index on %s, queries must use the same expression", index.Name, table, vector)
+		index.Columns = sqlmapper.IndexColumns(vector)
+		index.Type = "GIN"
+	case sqlmapper.SpatialIndex:
+		p.options.Warnf("SPATIAL index %s on %s was converted to a GiST index, which needs PostGIS geometry columns", index.Name, table)
+		index.Type = "GIST"
+	default:
+		return index
+	}
+	index.Kind = sqlmapper.NormalIndex
+	return index
+}
+
+// GenerateTable generates the CREATE TABLE statement of a single table, without
+// its indexes and without a terminating semicolon
+func (p *PostgreSQL) GenerateTable(table sqlmapper.Table) (string, error) {
+	return p.options.Format.Apply(p.generateTableSQL(table)), nil
+}
+
+// GenerateIndex generates the CREATE INDEX statement of a single index of a table,
+// without a terminating semicolon
+func (p *PostgreSQL) GenerateIndex(tableName string, index sqlmapper.Index) (string, error) {
+	return p.options.Format.Apply(p.generateIndexSQL(tableName, index)), nil
+}
+
+// GenerateView generates the CREATE VIEW statement of a single view, without a
+// terminating semicolon
+func (p *PostgreSQL) GenerateView(view sqlmapper.View) (string, error) {
+	return p.options.Format.Apply(p.generateViewSQL(view)), nil
+}
+
+// generateViewSQL generates SQL for a view
+func (p *PostgreSQL) generateViewSQL(view sqlmapper.View) string {
+	if view.IsMaterialized {
+		return fmt.Sprintf("CREATE MATERIALIZED VIEW %s%s AS %s", p.options.IfNotExists(view.IfNotExists), view.Name, view.Definition)
+	}
+	return fmt.Sprintf("CREATE VIEW %s AS %s", view.Name, view.Definition)
+}
+
+// generateTriggerSQL generates SQL for a trigger, whose body is the trigger function
+func (p *PostgreSQL) generateTriggerSQL(trigger sqlmapper.Trigger) string {
+	stmt := fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s\n",
+		trigger.Name, trigger.Timing, trigger.EventClause(" OR "), trigger.Table)
+	if trigger.ForEachRow {
+		stmt += "FOR EACH ROW\n"
+	} else {
+		stmt += "FOR EACH STATEMENT\n"
+	}
+	if trigger.Condition != "" {
+		stmt += "WHEN (" + sqlmapper.TrimParens(trigger.Condition) + ")\n"
+	}
+	function := trigger.Body
+	if !strings.Contains(function, "(") {
+		function += "()"
+	}
+	return stmt + fmt.Sprintf("EXECUTE FUNCTION %s", function)
 }
 
 // generateIndexSQL generates SQL for an index
 func (p *PostgreSQL) generateIndexSQL(tableName string, index sqlmapper.Index) string {
+	index = p.convertIndexKind(tableName, p.options.WholeColumnIndex(tableName, index))
+
 	var sql string
 	if index.IsUnique {
 		sql = "CREATE UNIQUE INDEX "
@@ -1109,11 +1317,14 @@ func (p *PostgreSQL) generateIndexSQL(tableName string, index sqlmapper.Index) s
 		sql = "CREATE INDEX "
 	}
 
-	sql += index.Name + " ON " + tableName
+	sql += p.options.IfNotExists(index.IfNotExists) + index.Name + " ON " + tableName
 	if index.Type != "" {
 		sql += " USING " + index.Type
 	}
-	sql += " (" + strings.Join(index.Columns, ", ") + ")"
+	sql += " (" + index.ColumnList(`"`, true) + ")"
+	if len(index.IncludeColumns) > 0 {
+		sql += " INCLUDE (" + strings.Join(index.IncludeColumns, ", ") + ")"
+	}
 
 	// Add index options
 	if index.TableSpace != "" {
@@ -1122,3 +1333,74 @@ func (p *PostgreSQL) generateIndexSQL(tableName string, index sqlmapper.Index) s
 
 	return sql
 }
+
+// generateExtensionSQL generates the CREATE EXTENSION statement of an extension. The
+// statement tolerates an extension that is already installed.
+func (p *PostgreSQL) generateExtensionSQL(extension sqlmapper.Extension) string {
+	var sql strings.Builder
+	sql.WriteString("CREATE EXTENSION IF NOT EXISTS ")
+	if plainIdentifierRe.MatchString(extension.Name) {
+		sql.WriteString(extension.Name)
+	} else {
+		sql.WriteString(`"` + extension.Name + `"`)
+	}
+	if extension.Schema != "" {
+		sql.WriteString(" WITH SCHEMA " + extension.Schema)
+	}
+	if extension.Version != "" {
+		sql.WriteString(" VERSION " + quoteLiteral(extension.Version))
+	}
+	return sql.String()
+}
+
+// generateDropSQL generates SQL for a dropped object
+func (p *PostgreSQL) generateDropSQL(drop sqlmapper.Drop) string {
+	return "DROP " + drop.Type + " " + p.options.IfExists(drop.IfExists) + drop.QualifiedName()
+}
+
+// generatePermissionSQL creates a GRANT or REVOKE statement for the given permission.
+// The host of a MySQL account is dropped, and global MySQL privileges are skipped.
+func (p *PostgreSQL) generatePermissionSQL(permission sqlmapper.Permission) (string, bool) {
+	if strings.Contains(permission.Object, "*") {
+		p.options.Warnf("global privileges on %s granted to %s are not supported", permission.Object, permission.Grantee)
+		return "", false
+	}
+
+	object := permission.Object
+	if permission.ObjectType != "" {
+		object = permission.ObjectType + " " + object
+	}
+
+	user, _ := permission.Account()
+	return sqlmapper.PermissionStatement(permission, object, user), true
+}
+
+// escapeStringEscaper escapes the characters of a PostgreSQL escape string literal
+var escapeStringEscaper = strings.NewReplacer(`\`, `\\`, "'", "''", "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// defaultValueSQL returns the SQL of a column default. The parsers store string
+// defaults without their quotes, so values other than keywords, numbers, function
+// calls, casts and literals are quoted again.
+func defaultValueSQL(value string) string {
+	switch strings.ToUpper(value) {
+	case "NULL", "TRUE", "FALSE", "CURRENT_TIMESTAMP", "CURRENT_DATE", "CURRENT_TIME", "LOCALTIMESTAMP", "LOCALTIME":
+		return value
+	}
+	if strings.HasPrefix(value, "'") || strings.Contains(value, "(") || strings.Contains(value, "::") {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	return quoteLiteral(value)
+}
+
+// quoteLiteral quotes a value as a PostgreSQL string literal. Values with backslashes,
+// tabs or line breaks are written as escape strings, E'...', which read the same whatever
+// the standard_conforming_strings setting of the server.
+func quoteLiteral(value string) string {
+	if !strings.ContainsAny(value, "\\\n\r\t") {
+		return sqlmapper.QuoteLiteral(value)
+	}
+	return "E'" + escapeStringEscaper.Replace(value) + "'"
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -13,6 +14,7 @@ import (
 // PostgreSQLStreamParser implements the StreamParser interface for PostgreSQL
 type PostgreSQLStreamParser struct {
 	postgres *PostgreSQL
+	options  stream.ParseOptions
 }
 
 // NewPostgreSQLStreamParser creates a new PostgreSQL stream parser
@@ -22,173 +24,98 @@ func NewPostgreSQLStreamParser() *PostgreSQLStreamParser {
 	}
 }
 
+// SetOptions configures optional parsing behaviour such as comment capture
+func (p *PostgreSQLStreamParser) SetOptions(options stream.ParseOptions) {
+	p.options = options
+}
+
+// SetGenerateOptions configures optional output of GenerateStream
+func (p *PostgreSQLStreamParser) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	p.postgres.SetGenerateOptions(options)
+}
+
 // ParseStream implements the StreamParser interface
 func (p *PostgreSQLStreamParser) ParseStream(reader io.Reader, callback func(stream.SchemaObject) error) error {
-	streamReader := stream.NewStreamReader(reader, ";")
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithDollarQuotes().WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
 
 	for {
 		statement, err := streamReader.ReadStatement()
+		if err := budget.Check(); err != nil {
+			return err
+		}
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("error reading statement: %v", err)
+			return fmt.Errorf("error reading statement: %w", err)
 		}
 
 		statement = strings.TrimSpace(statement)
-		if statement == "" {
-			continue
-		}
-
-		// Parse CREATE TYPE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TYPE") {
-			typ, err := p.parseTypeStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TypeObject,
-				Data: typ,
-			})
-			if err != nil {
-				return err
-			}
-			continue
-		}
-
-		// Parse CREATE TABLE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TABLE") {
-			table, err := p.parseTableStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TableObject,
-				Data: table,
-			})
-			if err != nil {
-				return err
-			}
+		if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 			continue
 		}
 
-		// Parse CREATE VIEW statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE VIEW") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE MATERIALIZED VIEW") {
-			view, err := p.parseViewStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ViewObject,
-				Data: view,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		obj, err := p.parseStatement(statement)
+		if err == nil && obj == nil {
+			pos := streamReader.Position()
+			err = p.options.Unhandled(stream.Statement{Text: statement, Line: pos.Line, Offset: pos.Offset})
 		}
-
-		// Parse CREATE FUNCTION statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE FUNCTION") {
-			function, err := p.parseFunctionStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.FunctionObject,
-				Data: function,
-			})
-			if err != nil {
+		if err != nil {
+			if err := errs.Handle(stream.Statement{Text: statement, Line: streamReader.Line()}, err); err != nil {
 				return err
 			}
 			continue
 		}
-
-		// Parse CREATE PROCEDURE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE PROCEDURE") {
-			procedure, err := p.parseProcedureStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ProcedureObject,
-				Data: procedure,
-			})
-			if err != nil {
-				return err
-			}
+		if obj == nil || !p.options.Accept(obj) {
 			continue
 		}
+		obj.SetCreateFlags(statement)
 
-		// Parse CREATE TRIGGER statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TRIGGER") {
-			trigger, err := p.parseTriggerStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TriggerObject,
-				Data: trigger,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		if p.options.CaptureComments {
+			obj.SetSourceComment(streamReader.LeadingComment())
 		}
 
-		// Parse CREATE INDEX statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE INDEX") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE UNIQUE INDEX") {
-			index, err := p.parseIndexStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.IndexObject,
-				Data: index,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		if err := budget.Object(); err != nil {
+			return err
 		}
-
-		// Parse GRANT/REVOKE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "GRANT") ||
-			strings.HasPrefix(strings.ToUpper(statement), "REVOKE") {
-			permission, err := p.parsePermissionStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.PermissionObject,
-				Data: permission,
-			})
-			if err != nil {
-				return err
+		if err := callback(*obj); err != nil {
+			if stream.IsStopIteration(err) {
+				break
 			}
-			continue
+			return err
 		}
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // ParseStreamParallel implements parallel processing for PostgreSQL stream parsing
 func (p *PostgreSQLStreamParser) ParseStreamParallel(reader io.Reader, callback func(stream.SchemaObject) error, workers int) error {
-	streamReader := stream.NewStreamReader(reader, ";")
-	statements := make(chan string, workers)
-	results := make(chan stream.SchemaObject, workers)
-	errors := make(chan error, workers)
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithDollarQuotes().WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
+	workers, buffer := p.options.Parallelism(workers)
+	statements := make(chan stream.Statement, buffer)
+	results := make(chan stream.SchemaObject, buffer)
+	// Room for an error of each worker and one of the reader
+	errors := make(chan error, workers+1)
+	// Closed when the results are no longer read or a worker failed, so that the
+	// reader and the other workers do not block on their channels
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	halt := func() { stopOnce.Do(func() { close(stop) }) }
 	var wg sync.WaitGroup
 
 	// Start worker goroutines
@@ -197,13 +124,28 @@ func (p *PostgreSQLStreamParser) ParseStreamParallel(reader io.Reader, callback
 		go func() {
 			defer wg.Done()
 			for statement := range statements {
-				obj, err := p.parseStatement(statement)
+				obj, err := p.parseStatement(statement.Text)
+				if err == nil && obj == nil {
+					err = p.options.Unhandled(statement)
+				}
 				if err != nil {
-					errors <- err
-					return
+					if err := errs.Handle(statement, err); err != nil {
+						errors <- err
+						halt()
+						return
+					}
+					continue
 				}
-				if obj != nil {
-					results <- *obj
+				if obj != nil && p.options.Accept(obj) {
+					obj.SetCreateFlags(statement.Text)
+					if p.options.CaptureComments {
+						obj.SetSourceComment(statement.LeadingComment)
+					}
+					select {
+					case results <- *obj:
+					case <-stop:
+						return
+					}
 				}
 			}
 		}()
@@ -219,26 +161,49 @@ func (p *PostgreSQLStreamParser) ParseStreamParallel(reader io.Reader, callback
 	go func() {
 		for {
 			statement, err := streamReader.ReadStatement()
+			if err := budget.Check(); err != nil {
+				errors <- err
+				break
+			}
 			if err == io.EOF {
 				break
 			}
 			if err != nil {
-				errors <- fmt.Errorf("error reading statement: %v", err)
+				errors <- fmt.Errorf("error reading statement: %w", err)
 				break
 			}
 
 			statement = strings.TrimSpace(statement)
-			if statement == "" {
+			if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 				continue
 			}
-			statements <- statement
+			next := stream.Statement{
+				Text:           statement,
+				LeadingComment: streamReader.LeadingComment(),
+				Line:           streamReader.Line(),
+				Offset:         streamReader.Position().Offset,
+			}
+			select {
+			case statements <- next:
+			case <-stop:
+				close(statements)
+				return
+			}
 		}
 		close(statements)
 	}()
 
 	// Process results and handle errors
 	for obj := range results {
+		if err := budget.Object(); err != nil {
+			halt()
+			return err
+		}
 		if err := callback(obj); err != nil {
+			halt()
+			if stream.IsStopIteration(err) {
+				return errs.Err()
+			}
 			return err
 		}
 	}
@@ -248,16 +213,14 @@ func (p *PostgreSQLStreamParser) ParseStreamParallel(reader io.Reader, callback
 	case err := <-errors:
 		return err
 	default:
-		return nil
+		return errs.Err()
 	}
 }
 
 // parseStatement parses a single SQL statement and returns a SchemaObject
 func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.SchemaObject, error) {
-	upperStatement := strings.ToUpper(statement)
-
-	switch {
-	case strings.HasPrefix(upperStatement, "CREATE TYPE"):
+	switch sqlmapper.ClassifyStatement(statement) {
+	case sqlmapper.CreateTypeStatement:
 		typ, err := p.parseTypeStatement(statement)
 		if err != nil {
 			return nil, err
@@ -267,7 +230,7 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 			Data: typ,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE TABLE"):
+	case sqlmapper.CreateTableStatement:
 		table, err := p.parseTableStatement(statement)
 		if err != nil {
 			return nil, err
@@ -277,8 +240,7 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 			Data: table,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE VIEW"),
-		strings.HasPrefix(upperStatement, "CREATE MATERIALIZED VIEW"):
+	case sqlmapper.CreateViewStatement:
 		view, err := p.parseViewStatement(statement)
 		if err != nil {
 			return nil, err
@@ -288,7 +250,7 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 			Data: view,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE FUNCTION"):
+	case sqlmapper.CreateFunctionStatement:
 		function, err := p.parseFunctionStatement(statement)
 		if err != nil {
 			return nil, err
@@ -298,7 +260,7 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 			Data: function,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE PROCEDURE"):
+	case sqlmapper.CreateProcedureStatement:
 		procedure, err := p.parseProcedureStatement(statement)
 		if err != nil {
 			return nil, err
@@ -308,7 +270,7 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 			Data: procedure,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE TRIGGER"):
+	case sqlmapper.CreateTriggerStatement:
 		trigger, err := p.parseTriggerStatement(statement)
 		if err != nil {
 			return nil, err
@@ -318,8 +280,7 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 			Data: trigger,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE INDEX"),
-		strings.HasPrefix(upperStatement, "CREATE UNIQUE INDEX"):
+	case sqlmapper.CreateIndexStatement:
 		index, err := p.parseIndexStatement(statement)
 		if err != nil {
 			return nil, err
@@ -329,8 +290,7 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 			Data: index,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "GRANT"),
-		strings.HasPrefix(upperStatement, "REVOKE"):
+	case sqlmapper.GrantStatement, sqlmapper.RevokeStatement:
 		permission, err := p.parsePermissionStatement(statement)
 		if err != nil {
 			return nil, err
@@ -341,15 +301,54 @@ func (p *PostgreSQLStreamParser) parseStatement(statement string) (*stream.Schem
 		}, nil
 	}
 
+	if extension, ok := parseExtensionStatement(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.ExtensionObject,
+			Data: extension,
+		}, nil
+	}
+
+	if drop, ok := sqlmapper.ParseDrop(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.DropObject,
+			Data: drop,
+		}, nil
+	}
+
+	// COMMENT ON statements are passed on so they can be applied to the
+	// table once it is known
+	if comment, ok := sqlmapper.ParseCommentOn(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.CommentObject,
+			Data: comment,
+		}, nil
+	}
+
+	if settings, ok := sqlmapper.ParseSetStatement(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.SettingObject,
+			Data: settings,
+		}, nil
+	}
+
 	return nil, nil
 }
 
+// parseInto parses a single statement with the given PostgreSQL parser method into a
+// schema of its own. The shared parser is never modified, so statements can be
+// parsed concurrently by the workers of ParseStreamParallel.
+func (p *PostgreSQLStreamParser) parseInto(parse func(*PostgreSQL, string) error, statement string) (*sqlmapper.Schema, error) {
+	parser := &PostgreSQL{schema: &sqlmapper.Schema{}, options: p.postgres.options}
+	if err := parse(parser, statement); err != nil {
+		return nil, err
+	}
+	return parser.schema, nil
+}
+
 // parseTypeStatement parses a CREATE TYPE statement
 func (p *PostgreSQLStreamParser) parseTypeStatement(statement string) (*sqlmapper.Type, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
-
-	if err := p.postgres.parseTypes(statement); err != nil {
+	tempSchema, err := p.parseInto((*PostgreSQL).parseTypes, p.prepareStatement(statement))
+	if err != nil {
 		return nil, err
 	}
 
@@ -362,10 +361,8 @@ func (p *PostgreSQLStreamParser) parseTypeStatement(statement string) (*sqlmappe
 
 // parseTableStatement parses a CREATE TABLE statement
 func (p *PostgreSQLStreamParser) parseTableStatement(statement string) (*sqlmapper.Table, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
-
-	if err := p.postgres.parseTables(statement); err != nil {
+	tempSchema, err := p.parseInto((*PostgreSQL).parseTables, p.prepareStatement(statement))
+	if err != nil {
 		return nil, err
 	}
 
@@ -378,10 +375,8 @@ func (p *PostgreSQLStreamParser) parseTableStatement(statement string) (*sqlmapp
 
 // parseViewStatement parses a CREATE VIEW statement
 func (p *PostgreSQLStreamParser) parseViewStatement(statement string) (*sqlmapper.View, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
-
-	if err := p.postgres.parseViews(statement); err != nil {
+	tempSchema, err := p.parseInto((*PostgreSQL).parseViews, p.prepareStatement(statement))
+	if err != nil {
 		return nil, err
 	}
 
@@ -394,10 +389,8 @@ func (p *PostgreSQLStreamParser) parseViewStatement(statement string) (*sqlmappe
 
 // parseFunctionStatement parses a CREATE FUNCTION statement
 func (p *PostgreSQLStreamParser) parseFunctionStatement(statement string) (*sqlmapper.Function, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
-
-	if err := p.postgres.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*PostgreSQL).parseFunctions, p.prepareStatement(statement))
+	if err != nil {
 		return nil, err
 	}
 
@@ -416,10 +409,8 @@ func (p *PostgreSQLStreamParser) parseFunctionStatement(statement string) (*sqlm
 
 // parseProcedureStatement parses a CREATE PROCEDURE statement
 func (p *PostgreSQLStreamParser) parseProcedureStatement(statement string) (*sqlmapper.Procedure, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
-
-	if err := p.postgres.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*PostgreSQL).parseFunctions, p.prepareStatement(statement))
+	if err != nil {
 		return nil, err
 	}
 
@@ -444,10 +435,8 @@ func (p *PostgreSQLStreamParser) parseProcedureStatement(statement string) (*sql
 
 // parseTriggerStatement parses a CREATE TRIGGER statement
 func (p *PostgreSQLStreamParser) parseTriggerStatement(statement string) (*sqlmapper.Trigger, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
-
-	if err := p.postgres.parseTriggers(statement); err != nil {
+	tempSchema, err := p.parseInto((*PostgreSQL).parseTriggers, p.prepareStatement(statement))
+	if err != nil {
 		return nil, err
 	}
 
@@ -460,10 +449,14 @@ func (p *PostgreSQLStreamParser) parseTriggerStatement(statement string) (*sqlma
 
 // parseIndexStatement parses a CREATE INDEX statement
 func (p *PostgreSQLStreamParser) parseIndexStatement(statement string) (*sqlmapper.Index, error) {
+	// Seed the indexed table so the parser can attach the index to it
 	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
+	if matches := regexp.MustCompile(`(?i)\sON\s+([.\w]+)`).FindStringSubmatch(statement); len(matches) > 1 {
+		tempSchema.Tables = []sqlmapper.Table{{Name: matches[1]}}
+	}
+	parser := &PostgreSQL{schema: tempSchema, options: p.postgres.options}
 
-	if err := p.postgres.parseIndexes(statement); err != nil {
+	if err := parser.parseIndexes(p.prepareStatement(statement)); err != nil {
 		return nil, err
 	}
 
@@ -471,15 +464,15 @@ func (p *PostgreSQLStreamParser) parseIndexStatement(statement string) (*sqlmapp
 		return nil, fmt.Errorf("no index found in statement")
 	}
 
-	return &tempSchema.Tables[0].Indexes[0], nil
+	index := tempSchema.Tables[0].Indexes[0]
+	index.Table = tempSchema.Tables[0].Name
+	return &index, nil
 }
 
 // parsePermissionStatement parses a GRANT/REVOKE statement
 func (p *PostgreSQLStreamParser) parsePermissionStatement(statement string) (*sqlmapper.Permission, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.postgres.schema = tempSchema
-
-	if err := p.postgres.parsePermissions(statement); err != nil {
+	tempSchema, err := p.parseInto((*PostgreSQL).parseStatements, p.prepareStatement(statement))
+	if err != nil {
 		return nil, err
 	}
 
@@ -490,16 +483,47 @@ func (p *PostgreSQLStreamParser) parsePermissionStatement(statement string) (*sq
 	return &tempSchema.Permissions[0], nil
 }
 
+// TransformStream parses a dump, applies transform to every object and writes the
+// result right away, see stream.TransformStream
+func (p *PostgreSQLStreamParser) TransformStream(reader io.Reader, writer io.Writer, transform stream.TransformFunc) error {
+	return stream.TransformStream(p, p.postgres, reader, writer, transform)
+}
+
 // GenerateStream implements the StreamParser interface
 func (p *PostgreSQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.Writer) error {
 	if schema == nil {
-		return fmt.Errorf("schema cannot be nil")
+		return &sqlmapper.ValidationError{Problems: []string{"schema cannot be nil"}}
+	}
+	schema = p.postgres.options.Incremental(schema)
+	writer = p.postgres.options.Format.Writer(writer)
+
+	// Write session settings, disable foreign key checks and open the transaction
+	for _, stmt := range p.postgres.options.Prologue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write extensions, which types, defaults and functions may depend on
+	for _, extension := range schema.Extensions {
+		stmt := p.postgres.generateExtensionSQL(extension)
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write drops
+	for _, drop := range schema.Drops {
+		stmt := p.postgres.generateDropSQL(drop)
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
 	}
 
 	// Write types
 	for _, typ := range schema.Types {
 		stmt := p.postgres.generateTypeSQL(typ)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 	}
@@ -507,14 +531,21 @@ func (p *PostgreSQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 	// Write tables
 	for _, table := range schema.Tables {
 		stmt := p.postgres.generateTableSQL(table)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 
+		// Write table and column comments
+		for _, stmt := range sqlmapper.QuotedCommentStatements(table, quoteLiteral) {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
+				return err
+			}
+		}
+
 		// Generate indexes for this table
 		for _, index := range table.Indexes {
 			stmt := p.postgres.generateIndexSQL(table.Name, index)
-			if _, err := writer.Write([]byte(stmt + ";\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
 				return err
 			}
 		}
@@ -522,16 +553,8 @@ func (p *PostgreSQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 
 	// Write views
 	for _, view := range schema.Views {
-		if view.IsMaterialized {
-			stmt := fmt.Sprintf("CREATE MATERIALIZED VIEW %s AS %s", view.Name, view.Definition)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
-				return err
-			}
-		} else {
-			stmt := fmt.Sprintf("CREATE VIEW %s AS %s", view.Name, view.Definition)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
-				return err
-			}
+		if err := sqlmapper.WriteStatement(writer, p.postgres.generateViewSQL(view), ";\n\n"); err != nil {
+			return err
 		}
 	}
 
@@ -547,7 +570,7 @@ func (p *PostgreSQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 			}
 			stmt += fmt.Sprintf(") RETURNS %s AS $$\n%s\n$$ LANGUAGE %s",
 				function.Returns, function.Body, function.Language)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 				return err
 			}
 		}
@@ -565,7 +588,7 @@ func (p *PostgreSQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 			}
 			stmt += fmt.Sprintf(") AS $$\n%s\n$$ LANGUAGE %s",
 				function.Body, function.Language)
-			if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 				return err
 			}
 		}
@@ -573,19 +596,37 @@ func (p *PostgreSQLStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 
 	// Write triggers
 	for _, trigger := range schema.Triggers {
-		stmt := fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s\n",
-			trigger.Name, trigger.Timing, trigger.Event, trigger.Table)
-		if trigger.ForEachRow {
-			stmt += "FOR EACH ROW\n"
+		if err := sqlmapper.WriteStatement(writer, p.postgres.generateTriggerSQL(trigger), ";\n\n"); err != nil {
+			return err
 		}
-		if trigger.Condition != "" {
-			stmt += "WHEN " + trigger.Condition + "\n"
+	}
+
+	// Write permissions
+	if p.postgres.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := p.postgres.generatePermissionSQL(permission); ok {
+				if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
+					return err
+				}
+			}
 		}
-		stmt += fmt.Sprintf("EXECUTE FUNCTION %s", trigger.Body)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+	}
+
+	p.postgres.options.WarnSkipped(schema, sqlmapper.ViewObjects|sqlmapper.FunctionObjects|sqlmapper.ProcedureObjects|sqlmapper.TriggerObjects|sqlmapper.TypeObjects|sqlmapper.ExtensionObjects)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range p.postgres.options.Epilogue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// prepareStatement normalizes a single streamed statement and restores the
+// terminating semicolon stripped by the stream reader, since the underlying
+// parser expects complete statements.
+func (p *PostgreSQLStreamParser) prepareStatement(statement string) string {
+	return p.postgres.normalizeContent(statement) + ";"
+}
@@ -1,3 +1,582 @@
 package postgres
 
-// TODO implement tests
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgreSQLStreamParser_ParseStream_CreateVariants(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		wantType  stream.SchemaObjectType
+		validate  func(*testing.T, interface{})
+	}{
+		{
+			name:      "Table IF NOT EXISTS",
+			statement: "CREATE TABLE IF NOT EXISTS users (id INTEGER);",
+			wantType:  stream.TableObject,
+			validate: func(t *testing.T, data interface{}) {
+				table := data.(*sqlmapper.Table)
+				assert.Equal(t, "users", table.Name)
+				assert.True(t, table.IfNotExists)
+			},
+		},
+		{
+			name:      "OR REPLACE view",
+			statement: "CREATE OR REPLACE VIEW active_users AS SELECT * FROM users;",
+			wantType:  stream.ViewObject,
+			validate: func(t *testing.T, data interface{}) {
+				view := data.(*sqlmapper.View)
+				assert.Equal(t, "active_users", view.Name)
+				assert.True(t, view.OrReplace)
+			},
+		},
+		{
+			name:      "Materialized view IF NOT EXISTS",
+			statement: "CREATE MATERIALIZED VIEW IF NOT EXISTS user_stats AS SELECT count(*) FROM users WITH DATA;",
+			wantType:  stream.ViewObject,
+			validate: func(t *testing.T, data interface{}) {
+				assert.Equal(t, "user_stats", data.(*sqlmapper.View).Name)
+			},
+		},
+		{
+			name:      "OR REPLACE function",
+			statement: "CREATE OR REPLACE FUNCTION add_one(a integer) RETURNS integer AS $$ SELECT a + 1 $$ LANGUAGE sql;",
+			wantType:  stream.FunctionObject,
+			validate: func(t *testing.T, data interface{}) {
+				function := data.(*sqlmapper.Function)
+				assert.Equal(t, "add_one", function.Name)
+				assert.True(t, function.OrReplace)
+			},
+		},
+		{
+			name:      "OR REPLACE procedure",
+			statement: "CREATE OR REPLACE PROCEDURE cleanup(days integer) LANGUAGE sql AS $$ DELETE FROM logs $$;",
+			wantType:  stream.ProcedureObject,
+			validate: func(t *testing.T, data interface{}) {
+				procedure := data.(*sqlmapper.Procedure)
+				assert.Equal(t, "cleanup", procedure.Name)
+				assert.True(t, procedure.OrReplace)
+			},
+		},
+		{
+			name:      "OR REPLACE trigger",
+			statement: "CREATE OR REPLACE TRIGGER audit_users AFTER INSERT ON users FOR EACH ROW EXECUTE FUNCTION audit();",
+			wantType:  stream.TriggerObject,
+			validate: func(t *testing.T, data interface{}) {
+				trigger := data.(*sqlmapper.Trigger)
+				assert.Equal(t, "audit_users", trigger.Name)
+				assert.True(t, trigger.OrReplace)
+			},
+		},
+		{
+			name:      "Unique index IF NOT EXISTS",
+			statement: "CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);",
+			wantType:  stream.IndexObject,
+			validate: func(t *testing.T, data interface{}) {
+				index := data.(*sqlmapper.Index)
+				assert.Equal(t, "idx_users_email", index.Name)
+				assert.True(t, index.IsUnique)
+				assert.Equal(t, []string{"email"}, index.ColumnNames())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := NewPostgreSQLStreamParser()
+
+			var objects []stream.SchemaObject
+			err := parser.ParseStream(strings.NewReader(tt.statement), func(obj stream.SchemaObject) error {
+				objects = append(objects, obj)
+				return nil
+			})
+
+			assert.NoError(t, err)
+			if assert.Len(t, objects, 1) {
+				assert.Equal(t, tt.wantType, objects[0].Type)
+				tt.validate(t, objects[0].Data)
+			}
+		})
+	}
+}
+
+func TestPostgreSQLStreamParser_Trigger_ForEachAndWhen(t *testing.T) {
+	input := `CREATE TRIGGER check_price BEFORE UPDATE ON products FOR EACH ROW WHEN (NEW.price > 0) EXECUTE FUNCTION check_price();
+CREATE TRIGGER log_truncate AFTER TRUNCATE ON products FOR EACH STATEMENT EXECUTE FUNCTION log_truncate();`
+
+	parser := NewPostgreSQLStreamParser()
+	schema := &sqlmapper.Schema{}
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		schema.Triggers = append(schema.Triggers, *obj.Data.(*sqlmapper.Trigger))
+		return nil
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, schema.Triggers, 2) {
+		assert.True(t, schema.Triggers[0].ForEachRow)
+		assert.Equal(t, "NEW.price > 0", schema.Triggers[0].Condition)
+		assert.Equal(t, []string{"UPDATE"}, schema.Triggers[0].Events)
+		assert.False(t, schema.Triggers[1].ForEachRow)
+		assert.Empty(t, schema.Triggers[1].Condition)
+		assert.Equal(t, []string{"TRUNCATE"}, schema.Triggers[1].Events)
+	}
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE TRIGGER check_price BEFORE UPDATE ON products\nFOR EACH ROW\nWHEN (NEW.price > 0)\nEXECUTE FUNCTION check_price();")
+	assert.Contains(t, output.String(), "CREATE TRIGGER log_truncate AFTER TRUNCATE ON products\nFOR EACH STATEMENT\nEXECUTE FUNCTION log_truncate();")
+}
+
+func TestPostgreSQLStreamParser_Trigger_MultipleEvents(t *testing.T) {
+	input := "CREATE TRIGGER audit_users AFTER INSERT OR UPDATE OF email OR DELETE ON users FOR EACH ROW EXECUTE FUNCTION audit();"
+
+	parser := NewPostgreSQLStreamParser()
+	schema := &sqlmapper.Schema{}
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		schema.Triggers = append(schema.Triggers, *obj.Data.(*sqlmapper.Trigger))
+		return nil
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, schema.Triggers, 1) {
+		assert.Equal(t, []string{"INSERT", "UPDATE OF email", "DELETE"}, schema.Triggers[0].Events)
+		assert.Equal(t, "users", schema.Triggers[0].Table)
+	}
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE TRIGGER audit_users AFTER INSERT OR UPDATE OF email OR DELETE ON users\n")
+}
+
+func TestPostgreSQLStreamParser_CommentOn(t *testing.T) {
+	input := `COMMENT ON TABLE users IS 'Registered users';
+CREATE TABLE users (
+    id INTEGER NOT NULL,
+    email VARCHAR(255)
+);
+COMMENT ON COLUMN users.email IS 'Login address';`
+
+	// Comments are buffered until the table they refer to is known
+	schema := &sqlmapper.Schema{}
+	var pending []sqlmapper.Comment
+	parser := NewPostgreSQLStreamParser()
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		switch data := obj.Data.(type) {
+		case *sqlmapper.Table:
+			schema.Tables = append(schema.Tables, *data)
+		case *sqlmapper.Comment:
+			assert.Equal(t, stream.CommentObject, obj.Type)
+			assert.Equal(t, "users", obj.Name())
+			pending = append(pending, *data)
+		}
+
+		remaining := pending[:0]
+		for _, comment := range pending {
+			if !schema.ApplyComment(comment) {
+				remaining = append(remaining, comment)
+			}
+		}
+		pending = remaining
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+	assert.Equal(t, "Registered users", schema.Tables[0].Comment)
+	assert.Equal(t, "Login address", schema.Tables[0].Columns[1].Comment)
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "COMMENT ON TABLE users IS 'Registered users';\n")
+	assert.Contains(t, output.String(), "COMMENT ON COLUMN users.email IS 'Login address';\n")
+}
+
+func TestPostgreSQLStreamParser_ParseStreamParallel_Concurrent(t *testing.T) {
+	var input strings.Builder
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&input, "CREATE TABLE t%[1]d (c%[1]d INTEGER NOT NULL, name VARCHAR(64));\n", i)
+	}
+
+	parser := NewPostgreSQLStreamParser()
+	seen := make(map[string]int)
+	err := parser.ParseStreamParallel(strings.NewReader(input.String()), func(obj stream.SchemaObject) error {
+		table := obj.Data.(*sqlmapper.Table)
+		seen[table.Name]++
+
+		// Every table must keep its own columns when workers parse concurrently
+		assert.Len(t, table.Columns, 2)
+		assert.Equal(t, "c"+strings.TrimPrefix(table.Name, "t"), table.Columns[0].Name)
+		return nil
+	}, 8)
+
+	assert.NoError(t, err)
+	assert.Len(t, seen, 200)
+	for name, count := range seen {
+		assert.Equal(t, 1, count, name)
+	}
+}
+
+func TestPostgreSQLStreamParser_StopIteration(t *testing.T) {
+	var input strings.Builder
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&input, "CREATE TABLE t%d (id INTEGER);\n", i)
+	}
+
+	for _, parallel := range []bool{false, true} {
+		parser := NewPostgreSQLStreamParser()
+		var names []string
+		callback := func(obj stream.SchemaObject) error {
+			names = append(names, obj.Name())
+			if len(names) == 3 {
+				return stream.ErrStopIteration
+			}
+			return nil
+		}
+
+		var err error
+		if parallel {
+			err = parser.ParseStreamParallel(strings.NewReader(input.String()), callback, 4)
+		} else {
+			err = parser.ParseStream(strings.NewReader(input.String()), callback)
+		}
+		assert.NoError(t, err)
+		assert.Len(t, names, 3)
+	}
+
+	// Other callback errors still abort parsing
+	parser := NewPostgreSQLStreamParser()
+	failure := errors.New("callback failed")
+	err := parser.ParseStream(strings.NewReader(input.String()), func(obj stream.SchemaObject) error {
+		return failure
+	})
+	assert.Equal(t, failure, err)
+}
+
+func TestPostgreSQLStreamParser_Extensions(t *testing.T) {
+	input := `CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION postgis WITH SCHEMA gis CASCADE;
+CREATE TABLE places (id UUID DEFAULT uuid_generate_v4());`
+
+	parser := NewPostgreSQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true})
+
+	var extensions []sqlmapper.Extension
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		if obj.Type == stream.ExtensionObject {
+			extensions = append(extensions, *obj.Data.(*sqlmapper.Extension))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []sqlmapper.Extension{
+		{Name: "uuid-ossp"},
+		{Name: "postgis", Schema: "gis"},
+	}, extensions)
+}
+
+func TestPostgreSQLStreamParser_Partitioning(t *testing.T) {
+	input := `CREATE TABLE measurements (id INTEGER, logdate DATE) PARTITION BY RANGE (logdate);
+CREATE TABLE measurements_2024 PARTITION OF measurements FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');`
+
+	parser := NewPostgreSQLStreamParser()
+	schema := &sqlmapper.Schema{}
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		schema.Tables = append(schema.Tables, *obj.Data.(*sqlmapper.Table))
+		return nil
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, schema.Tables, 2) {
+		assert.Equal(t, "RANGE (logdate)", schema.Tables[0].PartitionBy)
+		assert.Equal(t, "measurements", schema.Tables[1].PartitionOf)
+	}
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), ") PARTITION BY RANGE (logdate);")
+	assert.Contains(t, output.String(), "CREATE TABLE measurements_2024 PARTITION OF measurements FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');")
+}
+
+func TestPostgreSQLStreamParser_OnSkip(t *testing.T) {
+	input := `CREATE TABLE users (id INTEGER);
+INSERT INTO users VALUES (1);
+CREATE TABLE posts (id INTEGER);`
+
+	type skip struct {
+		statement string
+		pos       stream.Position
+	}
+	var skipped []skip
+
+	parser := NewPostgreSQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{
+		OnSkip: func(statement string, pos stream.Position) {
+			skipped = append(skipped, skip{statement, pos})
+		},
+	})
+
+	var got []string
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		got = append(got, obj.Name())
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"users", "posts"}, got)
+	assert.Equal(t, []skip{{"INSERT INTO users VALUES (1)", stream.Position{Line: 2, Offset: 33}}}, skipped)
+}
+
+func TestPostgreSQLStreamParser_Budget(t *testing.T) {
+	input := `CREATE TABLE users (id INTEGER);
+CREATE TABLE posts (id INTEGER);
+CREATE TABLE comments (id INTEGER);`
+
+	tests := []struct {
+		name    string
+		options stream.ParseOptions
+		delay   time.Duration
+		wantErr string
+	}{
+		{
+			name:    "Max objects",
+			options: stream.ParseOptions{MaxObjects: 2},
+			wantErr: "parse budget exceeded: more than 2 objects parsed",
+		},
+		{
+			name:    "Max total bytes",
+			options: stream.ParseOptions{MaxTotalBytes: 40},
+			wantErr: "parse budget exceeded: more than 40 bytes read",
+		},
+		{
+			name:    "Max duration",
+			options: stream.ParseOptions{MaxDuration: 5 * time.Millisecond},
+			delay:   10 * time.Millisecond,
+			wantErr: "parse budget exceeded: parsing took longer than 5ms",
+		},
+		{
+			name:    "Within budget",
+			options: stream.ParseOptions{MaxObjects: 3, MaxTotalBytes: int64(len(input)), MaxDuration: time.Minute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := NewPostgreSQLStreamParser()
+			parser.SetOptions(tt.options)
+			callback := func(obj stream.SchemaObject) error {
+				time.Sleep(tt.delay)
+				return nil
+			}
+
+			err := parser.ParseStream(strings.NewReader(input), callback)
+			parallelErr := parser.ParseStreamParallel(strings.NewReader(input), callback, 2)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.NoError(t, parallelErr)
+				return
+			}
+			assert.ErrorIs(t, err, stream.ErrBudgetExceeded)
+			assert.EqualError(t, err, tt.wantErr)
+			assert.ErrorIs(t, parallelErr, stream.ErrBudgetExceeded)
+		})
+	}
+}
+
+func TestPostgreSQLStreamParser_ComplexDefaults(t *testing.T) {
+	input := `CREATE TABLE orders (
+    id BIGINT DEFAULT nextval('orders_id_seq'::regclass) NOT NULL,
+    status VARCHAR(20) DEFAULT 'new'::character varying,
+    tags TEXT[] DEFAULT ARRAY[]::text[],
+    scores INTEGER[] DEFAULT ARRAY[1, 2, 3],
+    due TIMESTAMP DEFAULT (now() + interval '1 day'),
+    default_note TEXT
+);`
+
+	want := map[string]string{
+		"id":           "nextval('orders_id_seq'::regclass)",
+		"status":       "'new'::character varying",
+		"tags":         "ARRAY[]::text[]",
+		"scores":       "ARRAY[1, 2, 3]",
+		"due":          "(now() + interval '1 day')",
+		"default_note": "",
+	}
+
+	var tables []sqlmapper.Table
+	err := NewPostgreSQLStreamParser().ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		tables = append(tables, *obj.Data.(*sqlmapper.Table))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	schema, parseErr := NewPostgreSQL().Parse(input)
+	assert.NoError(t, parseErr)
+
+	for _, table := range []sqlmapper.Table{tables[0], schema.Tables[0]} {
+		if assert.Len(t, table.Columns, len(want)) {
+			for _, column := range table.Columns {
+				assert.Equal(t, want[column.Name], column.DefaultValue, column.Name)
+			}
+		}
+	}
+}
+
+func TestPostgreSQLStreamParser_GenerateStream_Baseline(t *testing.T) {
+	baseline, err := NewPostgreSQL().Parse(`CREATE TABLE users (id INTEGER, email VARCHAR(255));`)
+	assert.NoError(t, err)
+
+	schema, err := NewPostgreSQL().Parse(`CREATE TABLE users (id INTEGER, email VARCHAR(255));
+CREATE TABLE orders (id INTEGER, user_id INTEGER);`)
+	assert.NoError(t, err)
+
+	parser := NewPostgreSQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{Baseline: baseline})
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE TABLE orders (")
+	assert.NotContains(t, output.String(), "users")
+}
+
+func TestPostgreSQLStreamParser_TransformStream(t *testing.T) {
+	dump := `CREATE TABLE users (
+    id INTEGER,
+    email VARCHAR(255)
+);
+CREATE INDEX idx_users_email ON users (email);
+COMMENT ON COLUMN users.email IS 'Login address';
+INSERT INTO users VALUES (1, 'a@example.com');
+CREATE VIEW active_users AS SELECT * FROM users;`
+
+	// Table names are uppercased wherever a table is named
+	upper := func(obj stream.SchemaObject) (stream.SchemaObject, error) {
+		switch data := obj.Data.(type) {
+		case *sqlmapper.Table:
+			data.Name = strings.ToUpper(data.Name)
+		case *sqlmapper.Index:
+			data.Table = strings.ToUpper(data.Table)
+		case *sqlmapper.Comment:
+			data.Table = strings.ToUpper(data.Table)
+		}
+		return obj, nil
+	}
+
+	var output bytes.Buffer
+	parser := NewPostgreSQLStreamParser()
+	err := parser.TransformStream(strings.NewReader(dump), &output, upper)
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE TABLE USERS (
+    id INTEGER,
+    email VARCHAR(255)
+);
+
+CREATE INDEX idx_users_email ON USERS (email);
+
+COMMENT ON COLUMN USERS.email IS 'Login address';
+
+CREATE VIEW active_users AS SELECT * FROM users;
+
+`, output.String())
+
+	// An error of the transform stops the stream
+	output.Reset()
+	err = parser.TransformStream(strings.NewReader(dump), &output, func(obj stream.SchemaObject) (stream.SchemaObject, error) {
+		if obj.Type == stream.IndexObject {
+			return obj, errors.New("indexes are not allowed")
+		}
+		return obj, nil
+	})
+	assert.ErrorContains(t, err, "indexes are not allowed")
+	assert.Contains(t, output.String(), "CREATE TABLE users")
+	assert.NotContains(t, output.String(), "INDEX")
+
+	err = parser.TransformStream(strings.NewReader(dump), &output, nil)
+	assert.EqualError(t, err, "transform cannot be nil")
+}
+
+func TestPostgreSQLStreamParser_DollarQuotedBodies(t *testing.T) {
+	input := `CREATE FUNCTION touch() RETURNS trigger AS $$
+BEGIN
+  NEW.updated_at := now();
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE FUNCTION label(id integer) RETURNS text AS $body$
+  SELECT 'item;' || id; -- keeps 'quotes' and ; inside
+$body$ LANGUAGE sql;
+CREATE TABLE items (id INTEGER);`
+
+	parser := NewPostgreSQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true})
+
+	for _, parallel := range []bool{false, true} {
+		var functions []*sqlmapper.Function
+		var tables []string
+		callback := func(obj stream.SchemaObject) error {
+			switch data := obj.Data.(type) {
+			case *sqlmapper.Function:
+				functions = append(functions, data)
+			case *sqlmapper.Table:
+				tables = append(tables, data.Name)
+			}
+			return nil
+		}
+
+		var err error
+		if parallel {
+			err = parser.ParseStreamParallel(strings.NewReader(input), callback, 2)
+		} else {
+			err = parser.ParseStream(strings.NewReader(input), callback)
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"items"}, tables)
+		if !assert.Len(t, functions, 2) {
+			continue
+		}
+
+		bodies := map[string]string{}
+		for _, function := range functions {
+			bodies[function.Name] = function.Body
+		}
+		assert.Contains(t, bodies["touch"], "NEW.updated_at := now();")
+		assert.Contains(t, bodies["touch"], "RETURN NEW;")
+		assert.Contains(t, bodies["label"], "SELECT 'item;' || id;")
+	}
+}
+
+func TestPostgreSQLStreamParser_UnloggedTables(t *testing.T) {
+	input := `CREATE UNLOGGED TABLE u (id INTEGER);
+CREATE UNLOGGED TABLE IF NOT EXISTS u_copy AS SELECT id FROM u;`
+
+	parser := NewPostgreSQLStreamParser()
+	parser.SetOptions(stream.ParseOptions{Strict: true})
+
+	schema := &sqlmapper.Schema{}
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		if table, ok := obj.Data.(*sqlmapper.Table); ok {
+			schema.Tables = append(schema.Tables, *table)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 2) {
+		return
+	}
+	assert.True(t, schema.Tables[0].Unlogged)
+	assert.True(t, schema.Tables[1].Unlogged)
+	assert.True(t, schema.Tables[1].IfNotExists)
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE UNLOGGED TABLE u (")
+	assert.Contains(t, output.String(), "CREATE UNLOGGED TABLE u_copy AS SELECT id FROM u;")
+}
@@ -123,6 +123,78 @@ func TestPostgreSQL_Parse(t *testing.T) {
 				// Index'ler tabloya bağlı olduğu için önce tablo oluşturulmalı
 			},
 		},
+		{
+			name: "CREATE INDEX with INCLUDE",
+			content: `
+				CREATE TABLE orders (id INTEGER PRIMARY KEY, customer_id INTEGER, total NUMERIC(10,2), status VARCHAR(20));
+				CREATE INDEX idx_orders_customer ON orders (customer_id) INCLUDE (total, status);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				assert.Len(t, schema.Tables[0].Indexes, 1)
+				index := schema.Tables[0].Indexes[0]
+				assert.Equal(t, []string{"customer_id"}, index.ColumnNames())
+				assert.Equal(t, []string{"total", "status"}, index.IncludeColumns)
+
+				result, err := NewPostgreSQL().Generate(schema)
+				assert.NoError(t, err)
+				assert.Contains(t, result, "CREATE INDEX idx_orders_customer ON orders(customer_id) INCLUDE (total, status);")
+			},
+		},
+		{
+			name: "CREATE INDEX with sort order",
+			content: `
+				CREATE TABLE scores (id INTEGER PRIMARY KEY, player_id INTEGER, score INTEGER);
+				CREATE INDEX idx_scores_top ON scores (player_id, score DESC NULLS LAST);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables[0].Indexes, 1)
+				index := schema.Tables[0].Indexes[0]
+				assert.Equal(t, []sqlmapper.IndexColumn{{Name: "player_id"}, {Name: "score", Descending: true, Nulls: "LAST"}}, index.Columns)
+
+				result, err := NewPostgreSQL().Generate(schema)
+				assert.NoError(t, err)
+				assert.Contains(t, result, "CREATE INDEX idx_scores_top ON scores(player_id, score DESC NULLS LAST);")
+			},
+		},
+		{
+			name: "ALTER TABLE DROP CONSTRAINT",
+			content: `
+				CREATE TABLE accounts (
+					id INTEGER PRIMARY KEY,
+					email VARCHAR(255),
+					CONSTRAINT uq_accounts_email UNIQUE (email)
+				);
+				ALTER TABLE ONLY public.accounts DROP CONSTRAINT IF EXISTS uq_accounts_email;`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				for _, constraint := range schema.Tables[0].Constraints {
+					assert.NotEqual(t, "uq_accounts_email", constraint.Name)
+				}
+			},
+		},
+		{
+			name: "COMMENT ON TABLE and COLUMN",
+			content: `
+				CREATE TABLE public.accounts (
+					id INTEGER NOT NULL,
+					email VARCHAR(255)
+				);
+				COMMENT ON TABLE public.accounts IS 'Customer accounts';
+				COMMENT ON COLUMN public.accounts.email IS 'Login address, can''t be shared';`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				assert.Equal(t, "Customer accounts", schema.Tables[0].Comment)
+				assert.Equal(t, "Login address, can't be shared", schema.Tables[0].Columns[1].Comment)
+
+				result, err := NewPostgreSQL().Generate(schema)
+				assert.NoError(t, err)
+				assert.Contains(t, result, "COMMENT ON TABLE accounts IS 'Customer accounts';\n")
+				assert.Contains(t, result, "COMMENT ON COLUMN accounts.email IS 'Login address, can''t be shared';\n")
+			},
+		},
 		{
 			name: "CREATE SEQUENCE",
 			content: `
@@ -348,6 +420,44 @@ func TestPostgreSQL_Parse(t *testing.T) {
 				// INSERT komutları şu an için parse edilmiyor
 			},
 		},
+		{
+			name: "CREATE TABLE AS SELECT",
+			content: `
+				CREATE TABLE customer_summary AS
+				SELECT customer_id, COUNT(*) AS order_count
+				FROM orders
+				GROUP BY customer_id;`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				table := schema.Tables[0]
+				assert.Equal(t, "customer_summary", table.Name)
+				assert.Equal(t, "SELECT customer_id, COUNT(*) AS order_count FROM orders GROUP BY customer_id", table.SourceQuery)
+				assert.Len(t, table.Columns, 2)
+				assert.Equal(t, "order_count", table.Columns[1].Name)
+
+				generated, err := NewPostgreSQL().Generate(schema)
+				assert.NoError(t, err)
+				assert.Equal(t, "CREATE TABLE customer_summary AS SELECT customer_id, COUNT(*) AS order_count FROM orders GROUP BY customer_id;\n", generated)
+			},
+		},
+		{
+			name: "Temporary create table as select",
+			content: `
+				CREATE TEMPORARY TABLE staged (id, amount) ON COMMIT DROP AS
+				SELECT o.id, o.total * 2 FROM orders o;`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				table := schema.Tables[0]
+				assert.True(t, table.Temporary)
+				assert.Equal(t, "DROP", table.OnCommit)
+
+				generated, err := NewPostgreSQL().Generate(schema)
+				assert.NoError(t, err)
+				assert.Equal(t, "CREATE TEMPORARY TABLE staged (id, amount) ON COMMIT DROP AS SELECT o.id, o.total * 2 FROM orders o;\n", generated)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -416,8 +526,8 @@ CREATE TABLE users (
 							{Name: "price", DataType: "NUMERIC", Length: 10, Scale: 2, IsNullable: true},
 						},
 						Indexes: []sqlmapper.Index{
-							{Name: "idx_name", Columns: []string{"name"}},
-							{Name: "idx_price", Columns: []string{"price"}, IsUnique: true},
+							{Name: "idx_name", Columns: sqlmapper.IndexColumns("name")},
+							{Name: "idx_price", Columns: sqlmapper.IndexColumns("price"), IsUnique: true},
 						},
 					},
 				},
@@ -447,3 +557,601 @@ CREATE UNIQUE INDEX idx_price ON products(price);`),
 		})
 	}
 }
+
+func TestPostgreSQL_Generate_PreserveGuards(t *testing.T) {
+	content := `
+DROP TABLE IF EXISTS users;
+CREATE TABLE IF NOT EXISTS users (
+    id INTEGER,
+    email VARCHAR(255)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);`
+
+	parser := NewPostgreSQL()
+	schema, err := parser.Parse(content)
+	assert.NoError(t, err)
+	if assert.Len(t, schema.Drops, 1) && assert.Len(t, schema.Tables, 1) && assert.Len(t, schema.Tables[0].Indexes, 1) {
+		assert.True(t, schema.Drops[0].IfExists)
+		assert.True(t, schema.Tables[0].IfNotExists)
+		assert.True(t, schema.Tables[0].Indexes[0].IfNotExists)
+	}
+
+	// Guards are omitted unless requested
+	result, err := NewPostgreSQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.NotContains(t, result, "IF NOT EXISTS")
+	assert.NotContains(t, result, "IF EXISTS")
+
+	generator := NewPostgreSQL().(*PostgreSQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{PreserveGuards: true})
+	result, err = generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "DROP TABLE IF EXISTS users;")
+	assert.Contains(t, result, "CREATE TABLE IF NOT EXISTS users (")
+	assert.Contains(t, result, "CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users(email);")
+
+	// The guards survive a second round trip
+	reparsed, err := NewPostgreSQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Drops, 1) && assert.Len(t, reparsed.Tables, 1) && assert.Len(t, reparsed.Tables[0].Indexes, 1) {
+		assert.True(t, reparsed.Drops[0].IfExists)
+		assert.True(t, reparsed.Tables[0].IfNotExists)
+		assert.True(t, reparsed.Tables[0].Indexes[0].IfNotExists)
+	}
+}
+
+func TestPostgreSQL_Generate_Permissions(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "orders", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER"}}}},
+		Permissions: []sqlmapper.Permission{
+			{Type: "GRANT", Privileges: []string{"SELECT"}, Object: "orders", Grantee: "app@localhost"},
+			{Type: "GRANT", Privileges: []string{"ALL PRIVILEGES"}, ObjectType: "ALL TABLES IN SCHEMA", Object: "shop", Grantee: "admin@%"},
+			{Type: "GRANT", Privileges: []string{"USAGE"}, Object: "*.*", Grantee: "monitor@%"},
+		},
+	}
+
+	var warnings []string
+	generator := NewPostgreSQL().(*PostgreSQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{
+		IncludePermissions: true,
+		OnWarning:          func(message string) { warnings = append(warnings, message) },
+	})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+
+	// MySQL accounts become roles and database grants become schema-wide grants
+	assert.Contains(t, result, "GRANT SELECT ON orders TO app;")
+	assert.Contains(t, result, "GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA shop TO admin;")
+	assert.NotContains(t, result, "monitor")
+	assert.Len(t, warnings, 1)
+
+	reparsed, err := NewPostgreSQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Permissions, 2) {
+		assert.Equal(t, "orders", reparsed.Permissions[0].Object)
+		assert.Equal(t, "ALL TABLES IN SCHEMA", reparsed.Permissions[1].ObjectType)
+	}
+}
+
+func TestPostgreSQL_Generate_Transaction(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER"}}}},
+	}
+
+	generator := NewPostgreSQL().(*PostgreSQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{
+		Transaction:     true,
+		SessionSettings: []string{"SET session_replication_role = replica"},
+	})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "SET session_replication_role = replica;\nBEGIN;\n"))
+	assert.True(t, strings.HasSuffix(result, "COMMIT;\n"))
+
+	// Nothing is added by default
+	result, err = NewPostgreSQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.NotContains(t, result, "BEGIN")
+	assert.NotContains(t, result, "COMMIT")
+}
+
+func TestPostgreSQL_Generate_DisableForeignKeyChecks(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER"}}}},
+	}
+
+	generator := NewPostgreSQL().(*PostgreSQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{DisableForeignKeyChecks: true})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "SET session_replication_role = replica;\nCREATE TABLE users"))
+	assert.True(t, strings.HasSuffix(result, "SET session_replication_role = DEFAULT;\n"))
+
+	var output strings.Builder
+	parser := NewPostgreSQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{DisableForeignKeyChecks: true, Transaction: true})
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.True(t, strings.HasPrefix(output.String(), "SET session_replication_role = replica;\n\nBEGIN;\n\n"))
+	assert.True(t, strings.HasSuffix(output.String(), "COMMIT;\n\nSET session_replication_role = DEFAULT;\n\n"))
+}
+
+func TestPostgreSQL_Extensions_RoundTrip(t *testing.T) {
+	input := `CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
+CREATE EXTENSION pgcrypto VERSION '1.3';
+
+CREATE TABLE users (
+    id UUID DEFAULT uuid_generate_v4(),
+    token UUID DEFAULT gen_random_uuid(),
+    PRIMARY KEY (id)
+);`
+
+	schema, err := NewPostgreSQL().Parse(input)
+	assert.NoError(t, err)
+	assert.Equal(t, []sqlmapper.Extension{
+		{Name: "uuid-ossp", Schema: "public"},
+		{Name: "pgcrypto", Version: "1.3"},
+	}, schema.Extensions)
+
+	result, err := NewPostgreSQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, `CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
+CREATE EXTENSION IF NOT EXISTS pgcrypto VERSION '1.3';
+CREATE TABLE users`))
+
+	reparsed, err := NewPostgreSQL().Parse(result)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Extensions, reparsed.Extensions)
+
+	// Extensions are written before the tables by the stream generator as well
+	var output strings.Builder
+	assert.NoError(t, NewPostgreSQLStreamParser().GenerateStream(schema, &output))
+	assert.True(t, strings.HasPrefix(output.String(), `CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;`))
+}
+
+func TestPostgreSQL_Generate_Unsigned(t *testing.T) {
+	// Columns as parsed from MySQL INT UNSIGNED, INT(5) ZEROFILL and BIGINT UNSIGNED
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "counters",
+			Columns: []sqlmapper.Column{
+				{Name: "id", DataType: "INT", Unsigned: true},
+				{Name: "code", DataType: "INT", Length: 5, Unsigned: true, Zerofill: true, IsNullable: true},
+				{Name: "hits", DataType: "BIGINT", Unsigned: true, IsNullable: true},
+				{Name: "price", DataType: "DECIMAL", Length: 10, Scale: 2, Unsigned: true, IsNullable: true},
+			},
+		}},
+	}
+
+	var warnings []string
+	generator := NewPostgreSQL().(*PostgreSQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "id BIGINT NOT NULL CHECK (id >= 0)")
+	assert.Contains(t, result, "code BIGINT CHECK (code >= 0)")
+	assert.Contains(t, result, "hits NUMERIC(20) CHECK (hits >= 0)")
+	assert.Contains(t, result, "price DECIMAL(10,2) CHECK (price >= 0)")
+	assert.Equal(t, []string{"ZEROFILL of column counters.code is not supported and was dropped"}, warnings)
+
+	var output strings.Builder
+	assert.NoError(t, NewPostgreSQLStreamParser().GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "id BIGINT NOT NULL CHECK (id >= 0)")
+	assert.Contains(t, output.String(), "hits NUMERIC(20) CHECK (hits >= 0)")
+}
+
+func TestPostgreSQL_TypeParameters(t *testing.T) {
+	content := `
+CREATE TABLE prices (
+    amount DECIMAL(10,2),
+    ratio NUMERIC(5, 3),
+    name VARCHAR(255),
+    flag CHAR(1),
+    created TIMESTAMP(6)
+);`
+
+	schema, err := NewPostgreSQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) || !assert.Len(t, schema.Tables[0].Columns, 5) {
+		return
+	}
+	columns := schema.Tables[0].Columns
+	assert.Equal(t, 10, columns[0].Length)
+	assert.Equal(t, 2, columns[0].Scale)
+	assert.Equal(t, 5, columns[1].Length)
+	assert.Equal(t, 3, columns[1].Scale)
+	assert.Equal(t, 255, columns[2].Length)
+	assert.Equal(t, 1, columns[3].Length)
+	assert.Equal(t, 6, columns[4].Length)
+
+	result, err := NewPostgreSQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "amount DECIMAL(10,2)")
+	assert.Contains(t, result, "ratio NUMERIC(5,3)")
+	assert.Contains(t, result, "name VARCHAR(255)")
+	assert.Contains(t, result, "flag CHAR(1)")
+	assert.Contains(t, result, "created TIMESTAMP(6)")
+
+	// A column built by hand may carry the precision instead of the length
+	schema.Tables[0].Columns[0] = sqlmapper.Column{Name: "amount", DataType: "DECIMAL", Precision: 10, Scale: 2, IsNullable: true}
+	var output strings.Builder
+	assert.NoError(t, NewPostgreSQLStreamParser().GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "amount DECIMAL(10,2)")
+	assert.Contains(t, output.String(), "created TIMESTAMP(6)")
+}
+
+func TestPostgreSQL_Generate_FractionalSeconds(t *testing.T) {
+	// Columns as parsed from MySQL DATETIME(3), TIMESTAMP(6) and TIME(6)
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "events",
+			Columns: []sqlmapper.Column{
+				{Name: "created", DataType: "DATETIME", Length: 3},
+				{Name: "updated", DataType: "TIMESTAMP", Length: 6, IsNullable: true},
+				{Name: "duration", DataType: "TIME", Length: 6, IsNullable: true},
+				{Name: "logged", DataType: "DATETIME", IsNullable: true},
+			},
+		}},
+	}
+
+	result, err := NewPostgreSQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "created TIMESTAMP(3) NOT NULL")
+	assert.Contains(t, result, "updated TIMESTAMP(6)")
+	assert.Contains(t, result, "duration TIME(6)")
+	assert.Contains(t, result, "logged TIMESTAMP")
+	assert.NotContains(t, result, "DATETIME")
+
+	var output strings.Builder
+	assert.NoError(t, NewPostgreSQLStreamParser().GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "created TIMESTAMP(3) NOT NULL")
+
+	reparsed, err := NewPostgreSQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 1) {
+		assert.Equal(t, 3, sqlmapper.FractionalSeconds(reparsed.Tables[0].Columns[0]))
+		assert.Equal(t, 6, sqlmapper.FractionalSeconds(reparsed.Tables[0].Columns[2]))
+	}
+}
+
+func TestPostgreSQL_GenerateObjects(t *testing.T) {
+	db := NewPostgreSQL()
+	table := sqlmapper.Table{
+		Name: "users",
+		Columns: []sqlmapper.Column{
+			{Name: "id", DataType: "INT"},
+			{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true},
+		},
+	}
+
+	sql, err := sqlmapper.GenerateTable(db, table)
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE TABLE users (")
+	assert.Contains(t, sql, "email VARCHAR(255)")
+	assert.False(t, strings.HasSuffix(sql, ";"))
+
+	sql, err = sqlmapper.GenerateIndex(db, "users", sqlmapper.Index{Name: "idx_users_email", Columns: sqlmapper.IndexColumns("email"), IsUnique: true})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE UNIQUE INDEX idx_users_email ON users")
+	assert.Contains(t, sql, "(email)")
+
+	sql, err = sqlmapper.GenerateView(db, sqlmapper.View{Name: "active_users", Definition: "SELECT * FROM users"})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE VIEW active_users AS")
+	assert.Contains(t, sql, "SELECT * FROM users")
+}
+
+func TestPostgreSQL_Inherits(t *testing.T) {
+	content := `
+CREATE TABLE cities (
+    name TEXT,
+    population INTEGER
+);
+
+CREATE TABLE capitals (
+    state CHAR(2)
+) INHERITS (cities);
+
+CREATE TABLE archive.capitals_log (
+    logged TIMESTAMP
+) INHERITS (cities, capitals) TABLESPACE archive_space;`
+
+	schema, err := NewPostgreSQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 3) {
+		return
+	}
+	assert.Empty(t, schema.Tables[0].Inherits)
+	assert.Equal(t, []string{"cities"}, schema.Tables[1].Inherits)
+	assert.Len(t, schema.Tables[1].Columns, 1)
+	assert.Equal(t, []string{"cities", "capitals"}, schema.Tables[2].Inherits)
+	assert.Equal(t, "archive_space", schema.Tables[2].TableSpace)
+
+	result, err := NewPostgreSQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, ") INHERITS (cities);")
+	assert.Contains(t, result, ") INHERITS (cities, capitals);")
+
+	var output strings.Builder
+	assert.NoError(t, NewPostgreSQLStreamParser().GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), ") INHERITS (cities, capitals) TABLESPACE archive_space")
+
+	reparsed, err := NewPostgreSQL().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 3) {
+		assert.Equal(t, []string{"cities"}, reparsed.Tables[1].Inherits)
+	}
+}
+
+func TestPostgreSQL_Partitioning(t *testing.T) {
+	content := `
+CREATE TABLE measurements (
+    id INTEGER,
+    logdate DATE NOT NULL
+) PARTITION BY RANGE (logdate);
+
+CREATE TABLE measurements_2024 PARTITION OF measurements FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+CREATE TABLE measurements_default PARTITION OF measurements DEFAULT TABLESPACE archive_space;`
+
+	schema, err := NewPostgreSQL().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 3) {
+		return
+	}
+	parent := schema.Tables[0]
+	assert.Equal(t, "measurements", parent.Name)
+	assert.Equal(t, "RANGE (logdate)", parent.PartitionBy)
+	assert.Len(t, parent.Columns, 2)
+
+	child := schema.Tables[1]
+	assert.Equal(t, "measurements_2024", child.Name)
+	assert.Equal(t, "measurements", child.PartitionOf)
+	assert.Equal(t, "FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')", child.PartitionBound)
+	assert.Empty(t, child.Columns)
+	assert.Equal(t, "DEFAULT", schema.Tables[2].PartitionBound)
+	assert.Equal(t, "archive_space", schema.Tables[2].TableSpace)
+
+	result, err := NewPostgreSQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, ") PARTITION BY RANGE (logdate);")
+	assert.Contains(t, result, "CREATE TABLE measurements_2024 PARTITION OF measurements FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');")
+	assert.Contains(t, result, "CREATE TABLE measurements_default PARTITION OF measurements DEFAULT TABLESPACE archive_space;")
+
+	reparsed, err := NewPostgreSQL().Parse(result)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Tables, reparsed.Tables)
+}
+
+func TestPostgreSQL_Generate_Format(t *testing.T) {
+	schema := &sqlmapper.Schema{Tables: []sqlmapper.Table{{
+		Name: "users",
+		Columns: []sqlmapper.Column{
+			{Name: "id", DataType: "INTEGER"},
+			{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true},
+		},
+	}}}
+	generator := NewPostgreSQL().(*PostgreSQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{Format: sqlmapper.FormatOptions{
+		Keywords:     sqlmapper.LowerCaseKeywords,
+		AlignColumns: true,
+		Commas:       sqlmapper.LeadingCommas,
+	}})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, "create table users (\n      id    integer not null\n    , email varchar(255)\n);\n", result)
+
+	parser := NewPostgreSQLStreamParser()
+	parser.SetGenerateOptions(sqlmapper.GenerateOptions{Format: sqlmapper.FormatOptions{Keywords: sqlmapper.LowerCaseKeywords}})
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "create table users (\n    id integer not null,\n    email varchar(255)\n)")
+}
+
+func TestPostgreSQL_Generate_FulltextAndSpatialIndexes(t *testing.T) {
+	var warnings []string
+	generator := NewPostgreSQL().(*PostgreSQL)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+
+	// Indexes as parsed from MySQL
+	schema := &sqlmapper.Schema{Tables: []sqlmapper.Table{{
+		Name: "places",
+		Columns: []sqlmapper.Column{
+			{Name: "name", DataType: "VARCHAR", Length: 100, IsNullable: true},
+			{Name: "description", DataType: "TEXT", IsNullable: true},
+			{Name: "location", DataType: "GEOMETRY"},
+		},
+		Indexes: []sqlmapper.Index{
+			{Name: "ft_places_text", Columns: sqlmapper.IndexColumns("name", "description"), Kind: sqlmapper.FulltextIndex},
+			{Name: "ft_places_name", Columns: sqlmapper.IndexColumns("name"), Kind: sqlmapper.FulltextIndex},
+			{Name: "sp_places_location", Columns: sqlmapper.IndexColumns("location"), Kind: sqlmapper.SpatialIndex},
+		},
+	}}}
+
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "CREATE INDEX ft_places_text ON places USING GIN (to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(description, '')));")
+	assert.Contains(t, result, "CREATE INDEX ft_places_name ON places USING GIN (to_tsvector('simple', name));")
+	assert.Contains(t, result, "CREATE INDEX sp_places_location ON places USING GIST (location);")
+	assert.NotContains(t, result, "FULLTEXT")
+	assert.Equal(t, []string{
+		"FULLTEXT index ft_places_text on places was converted to a GIN index on to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(description, '')), queries must use the same expression",
+		"FULLTEXT index ft_places_name on places was converted to a GIN index on to_tsvector('simple', name), queries must use the same expression",
+		"SPATIAL index sp_places_location on places was converted to a GiST index, which needs PostGIS geometry columns",
+	}, warnings)
+
+	var output strings.Builder
+	assert.NoError(t, NewPostgreSQLStreamParser().GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE INDEX ft_places_name ON places USING GIN (to_tsvector('simple', name));")
+}
+
+func TestPostgreSQL_NamedInlineConstraints(t *testing.T) {
+	p := NewPostgreSQL()
+	schema, err := p.Parse(`CREATE TABLE order_items (
+    id SERIAL PRIMARY KEY,
+    sku VARCHAR(20) CONSTRAINT uq_order_items_sku UNIQUE,
+    qty INT CONSTRAINT qty_positive CHECK (qty > 0)
+);`)
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	table := schema.Tables[0]
+	assert.Len(t, table.Columns, 3)
+	assert.Equal(t, "uq_order_items_sku", table.Columns[1].UniqueName)
+	assert.Equal(t, "qty_positive", table.Columns[2].CheckName)
+	assert.Equal(t, "qty > 0", table.Columns[2].CheckExpression)
+	assert.Contains(t, table.Constraints, sqlmapper.Constraint{Name: "qty_positive", Type: "CHECK", Columns: []string{"qty"}, CheckExpression: "qty > 0"})
+
+	result, err := p.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "sku VARCHAR(20) CONSTRAINT uq_order_items_sku UNIQUE")
+	assert.Contains(t, result, "qty INT CONSTRAINT qty_positive CHECK (qty > 0)")
+
+	var output strings.Builder
+	assert.NoError(t, NewPostgreSQLStreamParser().GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "qty INT CONSTRAINT qty_positive CHECK (qty > 0)")
+}
+
+func TestPostgreSQL_Parse_AlterColumn(t *testing.T) {
+	p := NewPostgreSQL()
+	schema, err := p.Parse(`CREATE TABLE orders (
+    id INTEGER NOT NULL,
+    status VARCHAR(20) DEFAULT 'pending',
+    price INTEGER,
+    note TEXT DEFAULT 'none'
+);
+ALTER TABLE ONLY orders ALTER COLUMN status SET DEFAULT 'active';
+ALTER TABLE orders ALTER COLUMN note DROP DEFAULT;
+ALTER TABLE orders ALTER COLUMN price SET DATA TYPE NUMERIC(12,2) USING price::numeric / 100, ALTER COLUMN price SET NOT NULL;`)
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	columns := schema.Tables[0].Columns
+	assert.Len(t, columns, 4)
+	assert.Equal(t, "active", columns[1].DefaultValue)
+	assert.Equal(t, "", columns[3].DefaultValue)
+	assert.Equal(t, "NUMERIC", columns[2].DataType)
+	assert.Equal(t, 12, columns[2].Length)
+	assert.Equal(t, 2, columns[2].Scale)
+	assert.Equal(t, "price::numeric / 100", columns[2].Using)
+	assert.False(t, columns[2].IsNullable)
+}
+
+func TestPostgreSQL_QuoteLiteral(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"plain", "'plain'"},
+		{"it's", "'it''s'"},
+		{`C:\temp`, `E'C:\\temp'`},
+		{"two\nlines", `E'two\nlines'`},
+		{"it's\n", `E'it''s\n'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, quoteLiteral(tt.value))
+		})
+	}
+
+	p := NewPostgreSQL()
+	result, err := p.Generate(&sqlmapper.Schema{Tables: []sqlmapper.Table{{
+		Name:    "files",
+		Comment: "Uploaded files",
+		Columns: []sqlmapper.Column{{Name: "path", DataType: "TEXT", IsNullable: true, Comment: `Owner's C:\ path`}},
+	}}})
+	assert.NoError(t, err)
+	assert.Contains(t, result, "COMMENT ON TABLE files IS 'Uploaded files';")
+	assert.Contains(t, result, `COMMENT ON COLUMN files.path IS E'Owner''s C:\\ path';`)
+}
+
+func TestPostgreSQL_IdentityColumns(t *testing.T) {
+	p := NewPostgreSQL()
+	schema, err := p.Parse(`CREATE TABLE orders (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    seq INTEGER GENERATED BY DEFAULT AS IDENTITY (START WITH 100 INCREMENT BY 5),
+    note TEXT DEFAULT 'none'
+);`)
+	assert.NoError(t, err)
+
+	columns := schema.Tables[0].Columns
+	assert.Len(t, columns, 3)
+	assert.Equal(t, "ALWAYS", columns[0].Identity)
+	assert.True(t, columns[0].AutoIncrement)
+	assert.False(t, columns[0].IsNullable)
+	assert.Equal(t, "", columns[0].DefaultValue)
+	assert.Equal(t, "BY DEFAULT", columns[1].Identity)
+	assert.Equal(t, int64(100), columns[1].IdentityStart)
+	assert.Equal(t, int64(5), columns[1].IdentityIncrement)
+	assert.Equal(t, "", columns[1].DefaultValue)
+	assert.Equal(t, "", columns[2].Identity)
+	assert.Equal(t, "none", columns[2].DefaultValue)
+
+	result, err := p.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "id BIGINT GENERATED ALWAYS AS IDENTITY NOT NULL,")
+	assert.Contains(t, result, "seq INTEGER GENERATED BY DEFAULT AS IDENTITY (START WITH 100 INCREMENT BY 5) NOT NULL,")
+}
+
+func TestPostgreSQL_ParseErrorContext(t *testing.T) {
+	p := NewPostgreSQL()
+	_, err := p.Parse("CREATE TABLE public.orders (\n    id SERIAL PRIMARY KEY,\n    total\n);")
+	assert.EqualError(t, err, `error parsing tables: table public.orders near "CREATE TABLE public.orders ( id SERIAL P...": `+
+		`column total near "total": invalid column definition: total`)
+}
+
+func TestPostgreSQL_DollarQuotedBodies(t *testing.T) {
+	p := NewPostgreSQL()
+	schema, err := p.Parse(`CREATE TABLE audit (id INT);
+CREATE FUNCTION reset_audit() RETURNS void AS $$
+BEGIN
+    DROP TABLE IF EXISTS audit_old; -- rotated
+    SET search_path = audit;
+END;
+$$ LANGUAGE plpgsql;
+DROP TABLE IF EXISTS legacy;`)
+	assert.NoError(t, err)
+
+	// The statements of the function body are not those of the dump
+	if assert.Len(t, schema.Drops, 1) {
+		assert.Equal(t, "legacy", schema.Drops[0].Name)
+	}
+	assert.Empty(t, schema.Settings)
+}
+
+func TestPostgreSQL_DefaultValues(t *testing.T) {
+	p := NewPostgreSQL()
+	schema, err := p.Parse(`CREATE TABLE notes (
+    id INT NOT NULL,
+    title TEXT DEFAULT 'it''s',
+    tags TEXT DEFAULT 'a,b',
+    status VARCHAR(10) DEFAULT 'draft'::character varying,
+    score INT DEFAULT -1,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    archived BOOLEAN DEFAULT FALSE
+);`)
+	assert.NoError(t, err)
+	assert.Equal(t, "it's", schema.Tables[0].Columns[1].DefaultValue)
+
+	want := []string{
+		"title TEXT DEFAULT 'it''s'",
+		"tags TEXT DEFAULT 'a,b'",
+		"status VARCHAR(10) DEFAULT 'draft'::character varying",
+		"score INT DEFAULT -1",
+		"created_at TIMESTAMP DEFAULT now()",
+		"updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP",
+		"archived BOOLEAN DEFAULT FALSE",
+	}
+	result, err := p.Generate(schema)
+	assert.NoError(t, err)
+	table, err := sqlmapper.GenerateTable(p, schema.Tables[0])
+	assert.NoError(t, err)
+	for _, definition := range want {
+		assert.Contains(t, result, definition)
+		assert.Contains(t, table, definition)
+	}
+}
@@ -0,0 +1,96 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ColumnRename is a column found under a new name in a later version of its table
+type ColumnRename struct {
+	Table string // Schema-qualified when the table names its schema
+	From  string
+	To    string
+}
+
+// Statement returns the statement renaming the column in the target dialect, without
+// a terminating semicolon
+func (r ColumnRename) Statement(target DatabaseType) string {
+	if target == SQLServer {
+		return fmt.Sprintf("EXEC sp_rename %s, %s, 'COLUMN'", QuoteLiteral(r.Table+"."+r.From), QuoteLiteral(r.To))
+	}
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", r.Table, r.From, r.To)
+}
+
+// DetectColumnRenames compares two versions of a table and returns the columns that
+// appear to be renamed rather than dropped and added: a column missing from the new
+// version is paired with a new column of the same type at the same position relative
+// to the columns kept in both versions. Columns without such a counterpart are left
+// out, so a comparison can still treat them as a drop and an add.
+func DetectColumnRenames(from, to Table) []ColumnRename {
+	names := make(map[string]bool)
+	for _, column := range from.Columns {
+		names[strings.ToLower(column.Name)] = true
+	}
+	kept := make(map[string]bool)
+	for _, column := range to.Columns {
+		if name := strings.ToLower(column.Name); names[name] {
+			kept[name] = true
+		}
+	}
+
+	added := make(map[columnPosition]Column)
+	for _, change := range changedColumns(to, kept) {
+		added[change.position] = change.column
+	}
+
+	table := to.Name
+	if to.Schema != "" {
+		table = to.Schema + "." + to.Name
+	}
+
+	var renames []ColumnRename
+	for _, change := range changedColumns(from, kept) {
+		candidate, ok := added[change.position]
+		if !ok || !sameColumnType(change.column, candidate) {
+			continue
+		}
+		renames = append(renames, ColumnRename{Table: table, From: change.column.Name, To: candidate.Name})
+	}
+	return renames
+}
+
+// columnPosition locates a column relative to the columns kept in both versions of a
+// table: the kept column preceding it, empty at the start of the table, and the
+// number of other changed columns between them
+type columnPosition struct {
+	after  string
+	offset int
+}
+
+// columnChange is a column present in only one version of a table
+type columnChange struct {
+	position columnPosition
+	column   Column
+}
+
+// changedColumns returns the columns of a table that are not kept, in table order
+func changedColumns(table Table, kept map[string]bool) []columnChange {
+	var changes []columnChange
+	var position columnPosition
+	for _, column := range table.Columns {
+		name := strings.ToLower(column.Name)
+		if kept[name] {
+			position = columnPosition{after: name}
+			continue
+		}
+		changes = append(changes, columnChange{position: position, column: column})
+		position.offset++
+	}
+	return changes
+}
+
+// sameColumnType reports whether two columns have the same data type
+func sameColumnType(a, b Column) bool {
+	return strings.EqualFold(a.DataType, b.DataType) && a.Length == b.Length && a.Scale == b.Scale &&
+		a.Unsigned == b.Unsigned
+}
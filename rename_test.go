@@ -0,0 +1,69 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectColumnRenames(t *testing.T) {
+	columns := func(definitions ...string) []Column {
+		var result []Column
+		for i := 0; i < len(definitions); i += 2 {
+			result = append(result, Column{Name: definitions[i], DataType: definitions[i+1]})
+		}
+		return result
+	}
+
+	tests := []struct {
+		name string
+		from []Column
+		to   []Column
+		want []ColumnRename
+	}{
+		{
+			name: "clear rename",
+			from: columns("id", "INT", "mail", "VARCHAR", "created_at", "TIMESTAMP"),
+			to:   columns("id", "INT", "email", "VARCHAR", "created_at", "TIMESTAMP"),
+			want: []ColumnRename{{Table: "users", From: "mail", To: "email"}},
+		},
+		{
+			name: "consecutive renames",
+			from: columns("id", "INT", "first", "VARCHAR", "last", "VARCHAR"),
+			to:   columns("id", "INT", "given_name", "VARCHAR", "family_name", "VARCHAR"),
+			want: []ColumnRename{
+				{Table: "users", From: "first", To: "given_name"},
+				{Table: "users", From: "last", To: "family_name"},
+			},
+		},
+		{
+			name: "drop and add of another type",
+			from: columns("id", "INT", "age", "INT"),
+			to:   columns("id", "INT", "birth_date", "DATE"),
+		},
+		{
+			name: "drop and add at another position",
+			from: columns("id", "INT", "nickname", "VARCHAR", "name", "VARCHAR"),
+			to:   columns("id", "INT", "name", "VARCHAR", "bio", "VARCHAR"),
+		},
+		{
+			name: "unchanged",
+			from: columns("id", "INT", "name", "VARCHAR"),
+			to:   columns("ID", "INT", "name", "VARCHAR"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DetectColumnRenames(Table{Name: "users", Columns: tt.from}, Table{Name: "users", Columns: tt.to})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestColumnRename_Statement(t *testing.T) {
+	rename := ColumnRename{Table: "app.users", From: "mail", To: "email"}
+	assert.Equal(t, "ALTER TABLE app.users RENAME COLUMN mail TO email", rename.Statement(PostgreSQL))
+	assert.Equal(t, "ALTER TABLE app.users RENAME COLUMN mail TO email", rename.Statement(MySQL))
+	assert.Equal(t, "EXEC sp_rename 'app.users.mail', 'email', 'COLUMN'", rename.Statement(SQLServer))
+}
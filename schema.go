@@ -9,6 +9,63 @@ type Database interface {
 	Generate(schema *Schema) (string, error)
 }
 
+// Dialect is a database implementation that reports its database type
+type Dialect interface {
+	Database
+	Type() DatabaseType
+}
+
+// GenerateOptions controls optional output of the dialect generators
+type GenerateOptions struct {
+	// PreserveGuards emits the IF NOT EXISTS and IF EXISTS guards recorded on parsed
+	// objects so regenerated DDL stays idempotent. Guards are omitted by default.
+	PreserveGuards bool
+
+	// OnWarning is called with a description of every construct the generator could
+	// not reproduce faithfully in the target dialect
+	OnWarning func(message string)
+
+	// OnColumn is called for every generated column with the column as parsed from
+	// the source and the data type written for it in the target dialect
+	OnColumn func(table string, column Column, targetType string)
+
+	// IncludePermissions emits the GRANT and REVOKE statements captured from the parsed
+	// dump after the objects they refer to. Grantees usually differ between
+	// environments, so permissions are omitted by default.
+	IncludePermissions bool
+
+	// Transaction wraps the generated output in a transaction block, so that a failing
+	// migration script leaves nothing behind on databases with transactional DDL
+	Transaction bool
+
+	// SessionSettings are written before the generated output, and before the
+	// transaction is opened, such as "SET NAMES utf8mb4" or "SET search_path = app".
+	// A terminating semicolon is optional.
+	SessionSettings []string
+
+	// DisableForeignKeyChecks disables foreign key checks while the generated output is
+	// loaded and enables them again afterwards, so that tables with circular or
+	// out-of-order foreign keys can be created
+	DisableForeignKeyChecks bool
+
+	// Format controls the indentation, keyword case, alignment and comma placement of
+	// the generated DDL
+	Format FormatOptions
+
+	// KeepTinyInt keeps TINYINT(1) columns as integers. By default dialects with a
+	// boolean type, such as PostgreSQL, generate them as BOOLEAN since MySQL uses
+	// TINYINT(1) for booleans.
+	KeepTinyInt bool
+
+	// ExplicitNullability writes NULL or NOT NULL on every column, even where it
+	// matches the default of the dialect or is implied, such as by a primary key
+	ExplicitNullability bool
+
+	// Baseline restricts GenerateStream to the objects absent from it, such as the
+	// schema of a dump generated earlier, so that only new objects are appended
+	Baseline *Schema
+}
+
 const (
 	MySQL      DatabaseType = "mysql"
 	PostgreSQL DatabaseType = "postgresql"
@@ -37,6 +94,8 @@ type Schema struct {
 	Clusters         []Cluster
 	MaterializedLogs []MaterializedViewLog
 	Types            []Type
+	Drops            []Drop
+	Settings         []SessionSetting // Session settings assigned by SET statements, e.g. SET NAMES
 }
 
 // Table represents a database table
@@ -50,8 +109,20 @@ type Table struct {
 	TableSpace  string
 	Storage     *StorageClause
 	Temporary   bool
+	OnCommit    string // ON COMMIT behavior of temporary tables (DELETE ROWS, PRESERVE ROWS, DROP)
+	Unlogged    bool   // PostgreSQL UNLOGGED table, whose data is not written to the write-ahead log
+	IfNotExists bool
 	Comment     string
-	Options     string // Storage engine options (e.g., ENGINE=InnoDB, CHARSET=utf8mb4)
+	Options     string   // Table options as written, e.g. ENGINE=InnoDB ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8
+	SourceQuery string   // Defining query of a CREATE TABLE ... AS SELECT table
+	Inherits    []string // Parent tables of a PostgreSQL INHERITS clause
+
+	// PostgreSQL declarative partitioning
+	PartitionBy    string // Partitioning of a partitioned table, e.g. RANGE (created_at)
+	PartitionOf    string // Parent table of a partition
+	PartitionBound string // Bound of a partition, e.g. FOR VALUES FROM (1) TO (100) or DEFAULT
+
+	SourceComment string // Comment preceding the definition in the source dump
 }
 
 // Column represents a table column
@@ -63,26 +134,53 @@ type Column struct {
 	Precision       int
 	IsNullable      bool `default:"true"`
 	DefaultValue    string
+	OnUpdate        string // Value set by updates of the row (MySQL ON UPDATE), such as CURRENT_TIMESTAMP(3)
 	AutoIncrement   bool
 	IsPrimaryKey    bool
 	IsUnique        bool
 	Comment         string
 	Order           int
 	CheckExpression string
+	PrimaryKeyName  string // Names given by CONSTRAINT prefixes to the inline constraints
+	UniqueName      string
+	CheckName       string
+	Unsigned        bool     // Unsigned numeric type (MySQL UNSIGNED)
+	Zerofill        bool     // Zero-padded display of a numeric type (MySQL ZEROFILL), implies Unsigned
+	First           bool     // Added as the first column (MySQL ADD COLUMN ... FIRST)
+	After           string   // Column this column was added after (MySQL ADD COLUMN ... AFTER)
+	Invisible       bool     // Left out of SELECT * unless named (MySQL INVISIBLE)
+	CharacterSet    string   // Character set of a character column (MySQL CHARACTER SET)
+	Collation       string   // Collation of a character column (COLLATE)
+	Using           string   // Conversion of the values of a column whose type was changed (PostgreSQL ALTER COLUMN ... TYPE ... USING)
+	Members         []string // Members of a MySQL SET type, unquoted
+
+	// Identity columns (GENERATED ... AS IDENTITY), which are also AutoIncrement
+	Identity          string // ALWAYS or BY DEFAULT
+	IdentityStart     int64  // First value, 0 when not given
+	IdentityIncrement int64  // Increment, 0 when not given
+
+	// Generated columns (GENERATED ALWAYS AS, or computed columns of SQL Server)
+	GeneratedExpression string // Expression computing the value
+	GeneratedStored     bool   // Stored when written (STORED, PERSISTED) rather than computed when read
 }
 
 // Index represents a table index
 type Index struct {
-	Name        string
-	Columns     []string
-	IsUnique    bool
-	IsBitmap    bool   // Oracle için bitmap indeks desteği
-	IsClustered bool   // SQL Server için clustered indeks desteği
-	Type        string // BTREE, HASH etc.
-	Condition   string // WHERE clause
-	TableSpace  string
-	Storage     *StorageClause
-	Compression bool
+	Name           string
+	Columns        []IndexColumn // Key columns in order, with their sort order and prefix length
+	IncludeColumns []string      // Non-key columns of a covering index (INCLUDE)
+	IsUnique       bool
+	Kind           IndexKind // Fulltext and spatial indexes of MySQL, uniqueness is read from IsUnique
+	IsBitmap       bool      // Oracle için bitmap indeks desteği
+	IsClustered    bool      // SQL Server için clustered indeks desteği
+	Type           string    // BTREE, HASH etc.
+	Condition      string    // WHERE clause
+	TableSpace     string
+	Storage        *StorageClause
+	Compression    bool
+	IfNotExists    bool
+	Invisible      bool   // Ignored by the optimizer while still maintained (MySQL INVISIBLE)
+	Table          string // Indexed table, set only on an index parsed from a CREATE INDEX statement on its own
 }
 
 // Constraint represents a table constraint
@@ -99,6 +197,15 @@ type Constraint struct {
 	Initially       string // IMMEDIATE, DEFERRED
 }
 
+// Drop represents a DROP statement
+type Drop struct {
+	Type     string // TABLE, VIEW, MATERIALIZED VIEW, INDEX, SEQUENCE, FUNCTION, PROCEDURE, TRIGGER, TYPE
+	Name     string
+	Schema   string
+	Table    string // Table of a dropped index (MySQL, SQL Server)
+	IfExists bool
+}
+
 // Row represents table data
 type Row struct {
 	Values map[string]interface{}
@@ -115,6 +222,8 @@ type Procedure struct {
 	SQLSecurity   string
 	Deterministic bool
 	Comment       string
+	OrReplace     bool
+	SourceComment string // Comment preceding the definition in the source dump
 }
 
 // Function represents a database function
@@ -126,6 +235,9 @@ type Function struct {
 	Body       string
 	Language   string
 	IsProc     bool
+	OrReplace  bool
+
+	SourceComment string // Comment preceding the definition in the source dump
 }
 
 // Parameter represents a procedure or function parameter
@@ -142,10 +254,14 @@ type Trigger struct {
 	Schema     string
 	Table      string
 	Timing     string
-	Event      string
+	Events     []string // INSERT, UPDATE [OF columns], DELETE or TRUNCATE; fires on any of them
 	Body       string
-	Condition  string
-	ForEachRow bool
+	Condition  string // WHEN condition, without the enclosing parentheses
+	ForEachRow bool   // FOR EACH ROW; statement-level (FOR EACH STATEMENT) otherwise
+	OrReplace  bool
+	Compound   bool // Oracle COMPOUND TRIGGER; Body holds its declarations and timing sections
+
+	SourceComment string // Comment preceding the definition in the source dump
 }
 
 // View represents a database view
@@ -154,6 +270,12 @@ type View struct {
 	Schema         string
 	Definition     string
 	IsMaterialized bool
+	OrReplace      bool
+	IfNotExists    bool
+	Force          bool   // Created even if its base objects are missing (Oracle FORCE)
+	Edition        string // EDITIONABLE, NONEDITIONABLE or EDITIONING, as written (Oracle)
+
+	SourceComment string // Comment preceding the definition in the source dump
 }
 
 // Sequence represents a database sequence
@@ -179,8 +301,9 @@ type Extension struct {
 type Permission struct {
 	Type       string // GRANT, REVOKE
 	Privileges []string
+	ObjectType string // FUNCTION, PROCEDURE, SCHEMA or ALL TABLES IN SCHEMA; empty for a table
 	Object     string
-	Grantee    string
+	Grantee    string // user@host for MySQL accounts
 	WithGrant  bool
 }
 
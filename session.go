@@ -0,0 +1,153 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SessionStatements are the statements of a dialect that wrap generated output. Empty
+// statements are not supported by the dialect and are left out.
+type SessionStatements struct {
+	Begin  string // Opens a transaction
+	Commit string // Commits the transaction opened by Begin
+
+	DisableForeignKeyChecks string
+	EnableForeignKeyChecks  string
+}
+
+// Prologue returns the statements to write before the generated objects: the session
+// settings, the statement disabling foreign key checks and the statement opening the
+// transaction, as far as they are enabled. Statements are returned without a
+// terminating semicolon.
+func (o GenerateOptions) Prologue(session SessionStatements) []string {
+	var statements []string
+	for _, setting := range o.SessionSettings {
+		setting = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(setting), ";"))
+		if setting != "" {
+			statements = append(statements, setting)
+		}
+	}
+	if o.DisableForeignKeyChecks && session.DisableForeignKeyChecks != "" {
+		statements = append(statements, session.DisableForeignKeyChecks)
+	}
+	if o.Transaction && session.Begin != "" {
+		statements = append(statements, session.Begin)
+	}
+	return statements
+}
+
+// Epilogue returns the statements to write after the generated objects, in reverse
+// order of the Prologue
+func (o GenerateOptions) Epilogue(session SessionStatements) []string {
+	var statements []string
+	if o.Transaction && session.Commit != "" {
+		statements = append(statements, session.Commit)
+	}
+	if o.DisableForeignKeyChecks && session.EnableForeignKeyChecks != "" {
+		statements = append(statements, session.EnableForeignKeyChecks)
+	}
+	return statements
+}
+
+// SessionSetting is a session setting assigned by a SET statement of a dump, such as
+// the character set of SET NAMES or a saved user variable
+type SessionSetting struct {
+	Name  string // Setting or variable as written, e.g. NAMES, FOREIGN_KEY_CHECKS or @OLD_SQL_MODE
+	Value string // Assigned value, unquoted when it is a single string literal
+}
+
+var (
+	// setRe matches a SET statement and captures its assignments
+	setRe = regexp.MustCompile(`(?is)^SET\s+(.+)$`)
+
+	// setScopeRe matches the scope keyword of a system variable assignment
+	setScopeRe = regexp.MustCompile(`(?i)^(?:SESSION|LOCAL|GLOBAL|PERSIST)\s+`)
+
+	// setNamesRe matches SET NAMES and SET CHARACTER SET, ignoring the collation
+	setNamesRe = regexp.MustCompile(`(?is)^(NAMES|CHARACTER\s+SET|CHARSET)\s+(\S+)`)
+
+	// setAssignRe matches a name = value, name := value or name TO value assignment
+	setAssignRe = regexp.MustCompile(`(?is)^(@{0,2}[\w.$]+)\s*(?::=|=|\s+TO\s+)\s*(.*)$`)
+
+	// setOptionRe matches a name followed by its value, as in SET ANSI_NULLS ON
+	setOptionRe = regexp.MustCompile(`(?is)^(\S+)\s+(.+)$`)
+)
+
+// ParseSetStatement parses a SET statement into the settings it assigns. It
+// recognizes SET NAMES, SET CHARACTER SET, assignments of variables optionally
+// prefixed with their scope, several assignments separated by commas, PostgreSQL's
+// SET name TO value and options followed by their value, as in SET ANSI_NULLS ON.
+// The last return value is false when the statement is not a SET statement.
+func ParseSetStatement(statement string) ([]SessionSetting, bool) {
+	statement = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(StripComments(statement)), ";"))
+	match := setRe.FindStringSubmatch(statement)
+	if match == nil {
+		return nil, false
+	}
+	body := setScopeRe.ReplaceAllString(match[1], "")
+
+	if names := setNamesRe.FindStringSubmatch(body); names != nil {
+		name := strings.ToUpper(strings.Join(strings.Fields(names[1]), " "))
+		if name == "CHARSET" {
+			name = "CHARACTER SET"
+		}
+		return []SessionSetting{{Name: name, Value: UnquoteLiteral(names[2])}}, true
+	}
+
+	var settings []SessionSetting
+	for _, part := range splitTopLevel(body) {
+		part = setScopeRe.ReplaceAllString(strings.TrimSpace(part), "")
+		if part == "" {
+			continue
+		}
+
+		if assign := setAssignRe.FindStringSubmatch(part); assign != nil {
+			settings = append(settings, SessionSetting{Name: assign[1], Value: strings.TrimSpace(assign[2])})
+			continue
+		}
+
+		// A value list, such as the schemas of SET search_path TO a, b
+		if len(settings) > 0 {
+			settings[len(settings)-1].Value += ", " + part
+			continue
+		}
+
+		if option := setOptionRe.FindStringSubmatch(part); option != nil {
+			settings = append(settings, SessionSetting{Name: option[1], Value: strings.TrimSpace(option[2])})
+		} else {
+			settings = append(settings, SessionSetting{Name: part})
+		}
+	}
+
+	for i := range settings {
+		settings[i].Value = UnquoteLiteral(settings[i].Value)
+	}
+
+	return settings, true
+}
+
+// ParseSessionSettings returns the settings assigned by the SET statements of a
+// normalized SQL dump whose statements are terminated by semicolons
+func ParseSessionSettings(content string) []SessionSetting {
+	var settings []SessionSetting
+	for _, statement := range SplitStatements(content, "") {
+		if statement.Kind != SetStatement {
+			continue
+		}
+		if parsed, ok := ParseSetStatement(statement.Text); ok {
+			settings = append(settings, parsed...)
+		}
+	}
+	return settings
+}
+
+// Setting returns the value last assigned to a session setting of the parsed dump,
+// such as the character set of SET NAMES. Names are compared case-insensitively.
+func (s *Schema) Setting(name string) (string, bool) {
+	for i := len(s.Settings) - 1; i >= 0; i-- {
+		if strings.EqualFold(s.Settings[i].Name, name) {
+			return s.Settings[i].Value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,145 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateOptions_Prologue(t *testing.T) {
+	session := SessionStatements{
+		Begin:                   "BEGIN",
+		Commit:                  "COMMIT",
+		DisableForeignKeyChecks: "SET checks = off",
+		EnableForeignKeyChecks:  "SET checks = on",
+	}
+
+	tests := []struct {
+		name         string
+		options      GenerateOptions
+		wantPrologue []string
+		wantEpilogue []string
+	}{
+		{
+			name:    "No wrapping",
+			options: GenerateOptions{},
+		},
+		{
+			name:         "Transaction",
+			options:      GenerateOptions{Transaction: true},
+			wantPrologue: []string{"BEGIN"},
+			wantEpilogue: []string{"COMMIT"},
+		},
+		{
+			name: "Session settings before transaction",
+			options: GenerateOptions{
+				Transaction:     true,
+				SessionSettings: []string{"SET NAMES utf8mb4;", "  ", " SET search_path = app "},
+			},
+			wantPrologue: []string{"SET NAMES utf8mb4", "SET search_path = app", "BEGIN"},
+			wantEpilogue: []string{"COMMIT"},
+		},
+		{
+			name:         "Foreign key checks",
+			options:      GenerateOptions{DisableForeignKeyChecks: true},
+			wantPrologue: []string{"SET checks = off"},
+			wantEpilogue: []string{"SET checks = on"},
+		},
+		{
+			name:         "Foreign key checks around transaction",
+			options:      GenerateOptions{Transaction: true, DisableForeignKeyChecks: true},
+			wantPrologue: []string{"SET checks = off", "BEGIN"},
+			wantEpilogue: []string{"COMMIT", "SET checks = on"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantPrologue, tt.options.Prologue(session))
+			assert.Equal(t, tt.wantEpilogue, tt.options.Epilogue(session))
+		})
+	}
+}
+
+func TestGenerateOptions_Prologue_UnsupportedStatements(t *testing.T) {
+	options := GenerateOptions{
+		Transaction:             true,
+		DisableForeignKeyChecks: true,
+		SessionSettings:         []string{"ALTER SESSION SET NLS_DATE_FORMAT = 'YYYY-MM-DD'"},
+	}
+
+	assert.Equal(t, []string{"ALTER SESSION SET NLS_DATE_FORMAT = 'YYYY-MM-DD'"}, options.Prologue(SessionStatements{}))
+	assert.Nil(t, options.Epilogue(SessionStatements{}))
+}
+
+func TestParseSetStatement(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      []SessionSetting
+		ok        bool
+	}{
+		{
+			name:      "Names with collation",
+			statement: "SET NAMES utf8mb4 COLLATE utf8mb4_unicode_ci;",
+			want:      []SessionSetting{{Name: "NAMES", Value: "utf8mb4"}},
+			ok:        true,
+		},
+		{
+			name:      "Character set",
+			statement: "SET CHARACTER SET 'latin1'",
+			want:      []SessionSetting{{Name: "CHARACTER SET", Value: "latin1"}},
+			ok:        true,
+		},
+		{
+			name:      "Several assignments",
+			statement: "SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0",
+			want: []SessionSetting{
+				{Name: "@OLD_FOREIGN_KEY_CHECKS", Value: "@@FOREIGN_KEY_CHECKS"},
+				{Name: "FOREIGN_KEY_CHECKS", Value: "0"},
+			},
+			ok: true,
+		},
+		{
+			name:      "Scoped assignment",
+			statement: "SET SESSION sql_mode = 'NO_AUTO_VALUE_ON_ZERO'",
+			want:      []SessionSetting{{Name: "sql_mode", Value: "NO_AUTO_VALUE_ON_ZERO"}},
+			ok:        true,
+		},
+		{
+			name:      "Value list",
+			statement: "SET search_path TO public, pg_catalog",
+			want:      []SessionSetting{{Name: "search_path", Value: "public, pg_catalog"}},
+			ok:        true,
+		},
+		{
+			name:      "Option",
+			statement: "SET ANSI_NULLS ON",
+			want:      []SessionSetting{{Name: "ANSI_NULLS", Value: "ON"}},
+			ok:        true,
+		},
+		{
+			name:      "Not a SET statement",
+			statement: "SELECT 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseSetStatement(tt.statement)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSchema_Setting(t *testing.T) {
+	schema := &Schema{Settings: ParseSessionSettings("SET NAMES latin1; CREATE TABLE t (id INT); SET names utf8mb4;")}
+
+	value, ok := schema.Setting("NAMES")
+	assert.True(t, ok)
+	assert.Equal(t, "utf8mb4", value)
+
+	_, ok = schema.Setting("sql_mode")
+	assert.False(t, ok)
+}
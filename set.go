@@ -0,0 +1,59 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// setTypeRe matches the name of a column of a MySQL SET type and its quoted members
+var setTypeRe = regexp.MustCompile(`(?is)^(\S+\s+)SET\s*\(((?:\s*'(?:[^'\\]|''|\\.)*'\s*,?)*)\)`)
+
+// ParseSetType recognizes a column definition of a MySQL SET type, such as
+// "tags SET('news', 'sport')", returning its members and the definition with the
+// type written as a bare SET, so that the rest can be split into words. The members
+// are returned unquoted; the last return value is false for other definitions.
+func ParseSetType(definition string) ([]string, string, bool) {
+	match := setTypeRe.FindStringSubmatch(definition)
+	if match == nil {
+		return nil, definition, false
+	}
+
+	var members []string
+	for _, member := range splitTopLevel(match[2]) {
+		if member = strings.TrimSpace(member); member != "" {
+			members = append(members, UnquoteLiteral(member))
+		}
+	}
+	return members, match[1] + "SET" + definition[len(match[0]):], true
+}
+
+// IsSetType reports whether a column is of a MySQL SET type, which holds any
+// combination of its members
+func IsSetType(column Column) bool {
+	return strings.EqualFold(column.DataType, "SET") && len(column.Members) > 0
+}
+
+// SetCharacterType converts a SET column to a character column of the given type for
+// dialects without SET, long enough to hold all of its members separated by commas,
+// as MySQL stores them. The members are no longer checked. Other columns are
+// returned unchanged; the second return value reports whether the column was converted.
+func SetCharacterType(column Column, dataType string) (Column, bool) {
+	if !IsSetType(column) {
+		return column, false
+	}
+
+	column.Length = len(strings.Join(column.Members, ","))
+	column.DataType = dataType
+	column.Members = nil
+	return column, true
+}
+
+// ConvertSetType converts a SET column with SetCharacterType for the dialects without
+// SET, warning that its members are no longer checked
+func (o GenerateOptions) ConvertSetType(table string, column Column, dataType string) Column {
+	converted, ok := SetCharacterType(column, dataType)
+	if ok {
+		o.Warnf("SET column %s.%s was converted to %s, its members are not checked and could be moved to a junction table", table, column.Name, dataType)
+	}
+	return converted
+}
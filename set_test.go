@@ -0,0 +1,74 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSetType(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		members    []string
+		rest       string
+		ok         bool
+	}{
+		{
+			name:       "Members with spaces",
+			definition: "tags set('news', 'sport', 'not null') NOT NULL DEFAULT 'news'",
+			members:    []string{"news", "sport", "not null"},
+			rest:       "tags SET NOT NULL DEFAULT 'news'",
+			ok:         true,
+		},
+		{
+			name:       "Escaped quote",
+			definition: "`flags` SET ( 'it''s','b' )",
+			members:    []string{"it's", "b"},
+			rest:       "`flags` SET",
+			ok:         true,
+		},
+		{
+			name:       "Not a SET",
+			definition: "kind enum('a','b') NOT NULL",
+			rest:       "kind enum('a','b') NOT NULL",
+		},
+		{
+			name:       "SET default",
+			definition: "status varchar(10) DEFAULT 'set'",
+			rest:       "status varchar(10) DEFAULT 'set'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members, rest, ok := ParseSetType(tt.definition)
+			assert.Equal(t, tt.members, members)
+			assert.Equal(t, tt.rest, rest)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestSetCharacterType(t *testing.T) {
+	column := Column{Name: "tags", DataType: "SET", Members: []string{"news", "sport", "it's"}, IsNullable: true}
+	assert.Equal(t, "SET('news','sport','it''s')", FormatDataType(column))
+
+	converted, ok := SetCharacterType(column, "VARCHAR")
+	assert.True(t, ok)
+	assert.Equal(t, Column{Name: "tags", DataType: "VARCHAR", Length: 15, IsNullable: true}, converted)
+	assert.Equal(t, "VARCHAR(15)", FormatDataType(converted))
+
+	other := Column{Name: "name", DataType: "VARCHAR", Length: 20}
+	converted, ok = SetCharacterType(other, "TEXT")
+	assert.False(t, ok)
+	assert.Equal(t, other, converted)
+
+	var warnings []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}}
+	assert.Equal(t, "TEXT", options.ConvertSetType("posts", column, "TEXT").DataType)
+	assert.Equal(t, other, options.ConvertSetType("posts", other, "TEXT"))
+	assert.Equal(t, []string{"SET column posts.tags was converted to TEXT, its members are not checked and could be moved to a junction table"}, warnings)
+}
@@ -6,28 +6,84 @@ import (
 	"bytes"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/mstgnz/sqlmapper"
 )
 
+// sessionStatements wrap the generated output. The foreign_keys pragma has no effect
+// inside a transaction, so it is written before the transaction is opened.
+var sessionStatements = sqlmapper.SessionStatements{
+	Begin:                   "BEGIN TRANSACTION",
+	Commit:                  "COMMIT",
+	DisableForeignKeyChecks: "PRAGMA foreign_keys = OFF",
+	EnableForeignKeyChecks:  "PRAGMA foreign_keys = ON",
+}
+
+// castTypes map the types of PostgreSQL casts to SQLite type affinities
+var castTypes = map[string]string{
+	"json":              "TEXT",
+	"jsonb":             "TEXT",
+	"character varying": "TEXT",
+	"varchar":           "TEXT",
+	"uuid":              "TEXT",
+	"boolean":           "INTEGER",
+	"bigint":            "INTEGER",
+	"int8":              "INTEGER",
+	"double precision":  "REAL",
+}
+
+// checkRules translate the operators and functions of other dialects in CHECK
+// expressions. SQLite parses REGEXP but only matches with a function the application
+// registers, and has no binary ~ operator.
+var checkRules = sqlmapper.ExpressionRules{
+	Operators: map[string]string{
+		"ILIKE":     "LIKE",
+		"NOT ILIKE": "NOT LIKE",
+		"<=>":       "IS",
+	},
+	Functions: map[string]string{
+		"nvl":         "IFNULL",
+		"len":         "LENGTH",
+		"char_length": "LENGTH",
+		"lcase":       "LOWER",
+		"ucase":       "UPPER",
+	},
+	Unsupported: []string{"REGEXP", "NOT REGEXP", "RLIKE", "NOT RLIKE", "~", "~*", "!~", "!~*", "SIMILAR", "NOT SIMILAR"},
+}
+
+// capabilities are the features of SQLite the generator falls back on
+var capabilities = sqlmapper.Capabilities(sqlmapper.SQLite)
+
 // SQLite represents a SQLite parser implementation that handles parsing and generating
 // SQLite database schemas. It maintains an internal schema representation and provides
 // methods for converting between SQLite SQL and the common schema format.
 type SQLite struct {
-	schema *sqlmapper.Schema
-	buf    *bytes.Buffer
+	schema  *sqlmapper.Schema
+	buf     *bytes.Buffer
+	options sqlmapper.GenerateOptions
 }
 
 // NewSQLite creates and initializes a new SQLite parser instance.
 // It returns a parser that can handle SQLite specific SQL syntax and schema structures.
-func NewSQLite() sqlmapper.Database {
+func NewSQLite() sqlmapper.Dialect {
 	return &SQLite{
 		schema: &sqlmapper.Schema{},
 		buf:    &bytes.Buffer{},
 	}
 }
 
+// SetGenerateOptions configures optional output of the generator
+func (s *SQLite) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	s.options = options
+}
+
+// Type returns the database type of the dialect, sqlmapper.SQLite
+func (s *SQLite) Type() sqlmapper.DatabaseType {
+	return sqlmapper.SQLite
+}
+
 // Parse takes a SQLite SQL dump content and parses it into a common schema structure.
 // It processes various SQLite objects including:
 // - Tables with columns and constraints
@@ -43,66 +99,81 @@ func NewSQLite() sqlmapper.Database {
 //   - error: An error if parsing fails or if the content is empty
 func (s *SQLite) Parse(content string) (*sqlmapper.Schema, error) {
 	if content == "" {
-		return nil, fmt.Errorf("empty content")
+		return nil, &sqlmapper.ValidationError{Problems: []string{"empty content"}}
 	}
 
 	s.buf = bytes.NewBuffer([]byte(content))
 	s.schema = &sqlmapper.Schema{}
 
-	// Split content into statements
-	statements := bytes.Split([]byte(content), []byte(";"))
-
-	for _, stmt := range statements {
-		stmt = bytes.TrimSpace(stmt)
-		if len(stmt) == 0 {
-			continue
-		}
-
-		// Skip comments
-		if bytes.HasPrefix(stmt, []byte("--")) || bytes.HasPrefix(stmt, []byte("/*")) {
-			continue
-		}
+	// Split content into statements, keeping BEGIN ... END trigger bodies intact
+	for _, statement := range sqlmapper.SplitStatements(content, "") {
+		stmt := []byte(statement.Text)
 
-		upperStmt := bytes.ToUpper(stmt)
-
-		switch {
-		case bytes.HasPrefix(upperStmt, []byte("CREATE TABLE")):
+		switch statement.Kind {
+		case sqlmapper.CreateTableStatement:
 			table, err := s.parseCreateTable(stmt)
 			if err != nil {
-				return nil, fmt.Errorf("error parsing CREATE TABLE: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE TABLE: %w", statement.WrapError(err))
 			}
 			s.schema.Tables = append(s.schema.Tables, table)
 
-		case bytes.HasPrefix(upperStmt, []byte("CREATE INDEX")) || bytes.HasPrefix(upperStmt, []byte("CREATE UNIQUE INDEX")):
+		case sqlmapper.CreateIndexStatement:
 			if err := s.parseCreateIndex(stmt); err != nil {
-				return nil, fmt.Errorf("error parsing CREATE INDEX: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE INDEX: %w", statement.WrapError(err))
 			}
 
-		case bytes.HasPrefix(upperStmt, []byte("CREATE VIEW")):
+		case sqlmapper.CreateViewStatement:
 			view, err := s.parseCreateView(stmt)
 			if err != nil {
-				return nil, fmt.Errorf("error parsing CREATE VIEW: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE VIEW: %w", statement.WrapError(err))
 			}
 			s.schema.Views = append(s.schema.Views, view)
 
-		case bytes.HasPrefix(upperStmt, []byte("CREATE TRIGGER")):
+		case sqlmapper.CreateTriggerStatement:
 			trigger, err := s.parseCreateTrigger(stmt)
 			if err != nil {
-				return nil, fmt.Errorf("error parsing CREATE TRIGGER: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE TRIGGER: %w", statement.WrapError(err))
 			}
 			s.schema.Triggers = append(s.schema.Triggers, trigger)
+
+		case sqlmapper.SetStatement:
+			if settings, ok := sqlmapper.ParseSetStatement(statement.Text); ok {
+				s.schema.Settings = append(s.schema.Settings, settings...)
+			}
+
+		default:
+			if drop, ok := sqlmapper.ParseDrop(string(stmt)); ok {
+				s.schema.Drops = append(s.schema.Drops, *drop)
+			}
 		}
 	}
 
 	return s.schema, nil
 }
 
+// tableCheckRe matches a table CHECK constraint, capturing its optional name
+var tableCheckRe = regexp.MustCompile(`(?i)^(?:CONSTRAINT\s+(\S+)\s+)?CHECK\b`)
+
+// tableKeyRe matches a MySQL KEY declared at table level, such as KEY idx_email (email),
+// but not a column named key, such as key VARCHAR(10), whose type takes numbers
+var tableKeyRe = regexp.MustCompile(`(?i)^KEY\s+(?:\w+\s*)?\(\s*[^\s\d']`)
+
 // parseCreateTable parses a CREATE TABLE statement and returns a Table structure.
 func (s *SQLite) parseCreateTable(stmt []byte) (sqlmapper.Table, error) {
+	stmt = []byte(sqlmapper.StripComments(string(stmt)))
+	if ctas, ok := sqlmapper.ParseCreateTableAs(string(stmt)); ok {
+		return *ctas, nil
+	}
+
 	table := sqlmapper.Table{}
 
 	// Extract table name
 	parts := bytes.Fields(stmt)
+	if len(parts) > 1 && (bytes.EqualFold(parts[1], []byte("TEMPORARY")) || bytes.EqualFold(parts[1], []byte("TEMP"))) {
+		table.Temporary = true
+		parts = parts[1:]
+	}
+	parts, table.IfNotExists = trimIfNotExists(parts, 2)
 	if len(parts) < 3 {
 		return table, fmt.Errorf("invalid CREATE TABLE statement")
 	}
@@ -115,53 +186,65 @@ func (s *SQLite) parseCreateTable(stmt []byte) (sqlmapper.Table, error) {
 	table.Name = tableName
 
 	// Extract columns and table options
-	startIdx := bytes.Index(stmt, []byte("("))
-	endIdx := bytes.LastIndex(stmt, []byte(")"))
-	if startIdx == -1 || endIdx == -1 {
+	body, _, ok := sqlmapper.Parenthesized(string(stmt))
+	if !ok {
 		return table, fmt.Errorf("no columns found in CREATE TABLE statement")
 	}
 
 	// Parse columns
-	columnDefs := bytes.Split(bytes.TrimSpace(stmt[startIdx+1:endIdx]), []byte(","))
-	for _, colDef := range columnDefs {
-		colDef = bytes.TrimSpace(colDef)
-		if len(colDef) == 0 {
-			continue
-		}
+	for _, definition := range sqlmapper.SplitDefinitions(body) {
+		colDef := []byte(definition)
 
-		// Skip if it's a constraint or key definition
+		// Keep CHECK constraints, skip the other constraint and key definitions
 		upperColDef := bytes.ToUpper(colDef)
+		if match := tableCheckRe.FindStringSubmatch(definition); match != nil {
+			check, _ := sqlmapper.CheckExpression(definition)
+			table.Constraints = append(table.Constraints, sqlmapper.Constraint{
+				Name:            match[1],
+				Type:            "CHECK",
+				CheckExpression: check,
+			})
+			continue
+		}
 		if bytes.HasPrefix(upperColDef, []byte("CONSTRAINT")) ||
 			bytes.HasPrefix(upperColDef, []byte("PRIMARY KEY")) ||
 			bytes.HasPrefix(upperColDef, []byte("FOREIGN KEY")) ||
 			bytes.HasPrefix(upperColDef, []byte("UNIQUE KEY")) ||
-			bytes.HasPrefix(upperColDef, []byte("KEY")) {
+			tableKeyRe.Match(colDef) ||
+			bytes.HasPrefix(upperColDef, []byte("CHECK")) {
 			continue
 		}
 
 		// Parse column
-		parts := bytes.Fields(colDef)
+		parts := bytes.Fields([]byte(sqlmapper.CompactTypeParameters(string(colDef))))
 		if len(parts) < 2 {
 			continue
 		}
 
 		column := sqlmapper.Column{
-			Name:     string(bytes.Trim(parts[0], "`")),
-			DataType: string(bytes.ToUpper(parts[1])),
+			Name: string(bytes.Trim(parts[0], "`")),
 		}
+		column.DataType, column.Length, column.Scale = sqlmapper.ParseDataType(string(bytes.ToUpper(parts[1])))
 
 		// Check for additional properties
 		upperDef := bytes.ToUpper(colDef)
 		column.IsNullable = !bytes.Contains(upperDef, []byte("NOT NULL"))
 		column.AutoIncrement = bytes.Contains(upperDef, []byte("AUTOINCREMENT"))
+		column.IsPrimaryKey = bytes.Contains(upperDef, []byte("PRIMARY KEY"))
+		column.IsUnique = bytes.Contains(upperDef, []byte("UNIQUE"))
+		column.CheckExpression, _ = sqlmapper.CheckExpression(definition)
+		column.ParseConstraintNames(definition)
 
 		if bytes.Contains(upperDef, []byte("DEFAULT")) {
 			if idx := bytes.Index(upperDef, []byte("DEFAULT")); idx != -1 {
-				rest := colDef[idx+7:]
-				if spaceIdx := bytes.Index(rest, []byte(" ")); spaceIdx != -1 {
-					column.DefaultValue = string(bytes.TrimSpace(rest[:spaceIdx]))
+				rest := bytes.TrimSpace(colDef[idx+7:])
+				if bytes.HasPrefix(rest, []byte("(")) {
+					// Expression defaults are written in parentheses, which may be nested
+					column.DefaultValue, _ = sqlmapper.DefaultExpression(definition)
+				} else if spaceIdx := bytes.Index(rest, []byte(" ")); spaceIdx != -1 {
+					column.DefaultValue = string(rest[:spaceIdx])
 				} else {
-					column.DefaultValue = string(bytes.TrimSpace(rest))
+					column.DefaultValue = string(rest)
 				}
 			}
 		}
@@ -177,11 +260,6 @@ func (s *SQLite) parseCreateIndex(stmt []byte) error {
 	isUnique := bytes.HasPrefix(bytes.ToUpper(stmt), []byte("CREATE UNIQUE"))
 
 	// Extract index name and table name
-	parts := bytes.Fields(stmt)
-	if len(parts) < 4 {
-		return fmt.Errorf("invalid CREATE INDEX statement")
-	}
-
 	var indexNamePos, tableNamePos int
 	if isUnique {
 		indexNamePos = 3
@@ -191,16 +269,27 @@ func (s *SQLite) parseCreateIndex(stmt []byte) error {
 		tableNamePos = 4
 	}
 
+	parts, ifNotExists := trimIfNotExists(bytes.Fields(stmt), indexNamePos)
+	if len(parts) < 4 {
+		return fmt.Errorf("invalid CREATE INDEX statement")
+	}
+
 	if len(parts) <= tableNamePos {
 		return fmt.Errorf("invalid CREATE INDEX statement: missing table name")
 	}
 
 	indexName := string(bytes.Trim(parts[indexNamePos], "`"))
-	tableName := string(bytes.Trim(parts[tableNamePos], "`"))
+
+	// The column list may directly follow the table name
+	tablePart := parts[tableNamePos]
+	if idx := bytes.IndexByte(tablePart, '('); idx != -1 {
+		tablePart = tablePart[:idx]
+	}
+	tableName := string(bytes.Trim(tablePart, "`"))
 
 	// Remove schema prefix if exists
-	if idx := bytes.LastIndex(parts[tableNamePos], []byte(".")); idx != -1 {
-		tableName = string(bytes.Trim(parts[tableNamePos][idx+1:], "`"))
+	if idx := bytes.LastIndex(tablePart, []byte(".")); idx != -1 {
+		tableName = string(bytes.Trim(tablePart[idx+1:], "`"))
 	}
 
 	// Extract columns
@@ -210,15 +299,16 @@ func (s *SQLite) parseCreateIndex(stmt []byte) error {
 		return fmt.Errorf("no columns found in CREATE INDEX statement")
 	}
 
-	columns := s.splitAndTrim(string(bytes.TrimSpace(stmt[startIdx+1 : endIdx])))
+	columns := sqlmapper.SplitIndexColumns(string(bytes.TrimSpace(stmt[startIdx+1 : endIdx])))
 
 	// Find the table and add the index
 	for i, table := range s.schema.Tables {
 		if table.Name == tableName {
 			s.schema.Tables[i].Indexes = append(s.schema.Tables[i].Indexes, sqlmapper.Index{
-				Name:     indexName,
-				Columns:  columns,
-				IsUnique: isUnique,
+				Name:        indexName,
+				Columns:     columns,
+				IsUnique:    isUnique,
+				IfNotExists: ifNotExists,
 			})
 			return nil
 		}
@@ -233,6 +323,7 @@ func (s *SQLite) parseCreateView(stmt []byte) (sqlmapper.View, error) {
 
 	// Extract view name
 	parts := bytes.Fields(stmt)
+	parts, view.IfNotExists = trimIfNotExists(parts, 2)
 	if len(parts) < 3 {
 		return view, fmt.Errorf("invalid CREATE VIEW statement")
 	}
@@ -252,154 +343,167 @@ func (s *SQLite) parseCreateView(stmt []byte) (sqlmapper.View, error) {
 	return view, nil
 }
 
+// trimIfNotExists removes an IF NOT EXISTS guard starting at the given field
+// and reports whether the guard was present
+func trimIfNotExists(parts [][]byte, pos int) ([][]byte, bool) {
+	if len(parts) > pos+2 && bytes.EqualFold(parts[pos], []byte("IF")) &&
+		bytes.EqualFold(parts[pos+1], []byte("NOT")) && bytes.EqualFold(parts[pos+2], []byte("EXISTS")) {
+		return append(parts[:pos:pos], parts[pos+3:]...), true
+	}
+	return parts, false
+}
+
+// triggerRe matches a CREATE TRIGGER statement up to the BEGIN of its body. The
+// timing is optional and defaults to BEFORE.
+var triggerRe = regexp.MustCompile(`(?is)\bCREATE\s+(?:TEMP(?:ORARY)?\s+)?TRIGGER\s+(?:IF\s+NOT\s+EXISTS\s+)?(\S+)\s+` +
+	`(?:(BEFORE|AFTER|INSTEAD\s+OF)\s+)?(DELETE|INSERT|UPDATE(?:\s+OF\s+.+?)?)\s+ON\s+(\S+)` +
+	`(\s+FOR\s+EACH\s+ROW)?(?:\s+WHEN\s+(.+?))?\s+BEGIN\b`)
+
 // parseCreateTrigger parses a CREATE TRIGGER statement and returns a Trigger structure.
 func (s *SQLite) parseCreateTrigger(stmt []byte) (sqlmapper.Trigger, error) {
-	trigger := sqlmapper.Trigger{}
-
-	// Extract trigger name
-	parts := bytes.Fields(stmt)
-	if len(parts) < 3 {
+	trigger, ok := parseTrigger(string(stmt))
+	if !ok {
 		return trigger, fmt.Errorf("invalid CREATE TRIGGER statement")
 	}
+	return trigger, nil
+}
 
-	triggerName := string(bytes.Trim(parts[2], "`"))
-	trigger.Name = triggerName
-
-	upperStmt := bytes.ToUpper(stmt)
-
-	// Extract timing (BEFORE/AFTER)
-	switch {
-	case bytes.Contains(upperStmt, []byte("BEFORE")):
-		trigger.Timing = "BEFORE"
-	case bytes.Contains(upperStmt, []byte("AFTER")):
-		trigger.Timing = "AFTER"
+// parseTrigger parses the first CREATE TRIGGER statement in statement, including
+// INSTEAD OF triggers of views, together with the body of its BEGIN ... END block
+func parseTrigger(statement string) (sqlmapper.Trigger, bool) {
+	blocks := sqlmapper.FindBlocks(triggerRe, statement)
+	if len(blocks) == 0 {
+		return sqlmapper.Trigger{}, false
 	}
 
-	// Extract event (INSERT/UPDATE/DELETE)
-	switch {
-	case bytes.Contains(upperStmt, []byte("INSERT")):
-		trigger.Event = "INSERT"
-	case bytes.Contains(upperStmt, []byte("UPDATE")):
-		trigger.Event = "UPDATE"
-	case bytes.Contains(upperStmt, []byte("DELETE")):
-		trigger.Event = "DELETE"
+	matches := blocks[0]
+	trigger := sqlmapper.Trigger{
+		Timing:     strings.ToUpper(strings.Join(strings.Fields(matches[2]), " ")),
+		Events:     sqlmapper.ParseTriggerEvents(matches[3]),
+		ForEachRow: matches[5] != "",
+		Condition:  sqlmapper.TrimParens(strings.TrimSpace(matches[6])),
+		Body:       matches[7],
 	}
-
-	// Extract table name
-	if idx := bytes.Index(upperStmt, []byte(" ON ")); idx != -1 {
-		rest := stmt[idx+4:]
-		if spaceIdx := bytes.Index(rest, []byte(" ")); spaceIdx != -1 {
-			tableName := bytes.TrimSpace(rest[:spaceIdx])
-			// Remove schema prefix if exists
-			if dotIdx := bytes.LastIndex(tableName, []byte(".")); dotIdx != -1 {
-				tableName = tableName[dotIdx+1:]
+	if trigger.Timing == "" {
+		trigger.Timing = "BEFORE"
+	}
+	for i, event := range trigger.Events {
+		// Keywords are upper-cased, the columns of UPDATE OF are kept
+		words := strings.SplitN(event, " ", 3)
+		for j := 0; j < len(words) && j < 2; j++ {
+			if j == 0 || strings.EqualFold(words[j], "OF") {
+				words[j] = strings.ToUpper(words[j])
 			}
-			trigger.Table = string(bytes.Trim(tableName, "`"))
 		}
+		trigger.Events[i] = strings.Join(words, " ")
 	}
 
-	// Extract trigger body
-	if idx := bytes.Index(upperStmt, []byte(" BEGIN ")); idx != -1 {
-		endIdx := bytes.LastIndex(upperStmt, []byte(" END"))
-		if endIdx != -1 {
-			trigger.Body = string(bytes.TrimSpace(stmt[idx+7 : endIdx]))
-		}
+	// The trigger may be qualified by its schema, its table never is
+	name := strings.Split(matches[1], ".")
+	if len(name) > 1 {
+		trigger.Schema = unquoteIdentifier(name[0])
 	}
+	trigger.Name = unquoteIdentifier(name[len(name)-1])
+	table := strings.Split(matches[4], ".")
+	trigger.Table = unquoteIdentifier(table[len(table)-1])
 
-	return trigger, nil
+	return trigger, true
 }
 
-// splitAndTrim splits a string by commas and trims whitespace and backticks from each part.
-func (s *SQLite) splitAndTrim(str string) []string {
-	parts := strings.Split(str, ",")
-	result := make([]string, len(parts))
-	for i, part := range parts {
-		result[i] = strings.Trim(strings.TrimSpace(part), "`")
-	}
-	return result
+// unquoteIdentifier removes the quotes SQLite accepts around an identifier
+func unquoteIdentifier(name string) string {
+	return strings.Trim(name, "`\"[]")
 }
 
 // Generate creates a SQLite SQL dump from a schema structure.
 func (s *SQLite) Generate(schema *sqlmapper.Schema) (string, error) {
 	if schema == nil {
-		return "", fmt.Errorf("empty schema")
+		return "", &sqlmapper.ValidationError{Problems: []string{"empty schema"}}
 	}
 
 	s.buf.Reset()
 
-	// Generate tables
-	for i, table := range schema.Tables {
-		s.buf.WriteString("CREATE TABLE ")
-		s.buf.WriteString(table.Name)
-		s.buf.WriteString(" (\n")
-
-		// Generate columns
-		for j, col := range table.Columns {
-			s.buf.WriteString("    ")
-			s.buf.WriteString(col.Name)
-			s.buf.WriteByte(' ')
-			s.buf.WriteString(col.DataType)
-
-			if col.IsPrimaryKey && col.DataType == "INTEGER" {
-				s.buf.WriteString(" PRIMARY KEY")
-			} else {
-				if col.Length > 0 && col.DataType != "TEXT" {
-					if col.Scale > 0 {
-						fmt.Fprintf(s.buf, "(%d,%d)", col.Length, col.Scale)
-					} else {
-						fmt.Fprintf(s.buf, "(%d)", col.Length)
-					}
-				}
-
-				if !col.IsNullable {
-					s.buf.WriteString(" NOT NULL")
-				}
-
-				if col.IsUnique {
-					s.buf.WriteString(" UNIQUE")
-				}
-			}
+	// Write session settings, disable foreign key checks and open the transaction
+	for _, stmt := range s.options.Prologue(sessionStatements) {
+		s.buf.WriteString(stmt + ";\n")
+	}
 
-			if j < len(table.Columns)-1 {
-				s.buf.WriteByte(',')
-			}
-			s.buf.WriteByte('\n')
-		}
+	// Generate drops
+	for _, drop := range schema.Drops {
+		s.buf.WriteString(s.generateDropSQL(drop))
+		s.buf.WriteString(";\n")
+	}
 
-		s.buf.WriteString(");\n")
+	// Generate tables
+	tables := s.options.WithoutPartitions(schema.Tables)
+	for i, table := range tables {
+		s.buf.WriteString(s.generateTableSQL(table) + ";\n")
 
 		// Add indexes
 		for _, idx := range table.Indexes {
+			idx = s.options.WholeColumnIndex(table.Name, s.options.PlainIndex(table.Name, idx))
 			if idx.IsUnique {
 				s.buf.WriteString("CREATE UNIQUE INDEX ")
 			} else {
 				s.buf.WriteString("CREATE INDEX ")
 			}
+			s.buf.WriteString(s.options.IfNotExists(idx.IfNotExists))
 			s.buf.WriteString(idx.Name)
 			s.buf.WriteString(" ON ")
 			s.buf.WriteString(table.Name)
 			s.buf.WriteByte('(')
-			s.buf.WriteString(strings.Join(idx.Columns, ", "))
+			s.buf.WriteString(idx.ColumnList(`"`, true))
 			s.buf.WriteString(");\n")
 		}
 
-		if i < len(schema.Tables)-1 {
+		if i < len(tables)-1 {
 			s.buf.WriteByte('\n')
 		}
 	}
 
-	return s.buf.String(), nil
+	// Generate views and triggers, including the INSTEAD OF triggers of views
+	for _, view := range schema.Views {
+		s.buf.WriteString("\n" + s.generateViewSQL(view) + ";\n")
+	}
+	for _, trigger := range sqlmapper.SplitTriggerEvents(schema.Triggers, s.options) {
+		if sql, ok := s.generateTriggerSQL(trigger); ok {
+			s.buf.WriteString("\n" + sql + ";\n")
+		}
+	}
+
+	// SQLite has no users, so there is nothing to grant
+	if s.options.IncludePermissions && !capabilities.Permissions && len(schema.Permissions) > 0 {
+		s.options.Warnf("SQLite does not support privileges, %d GRANT/REVOKE statements were skipped", len(schema.Permissions))
+	}
+
+	s.options.WarnSkipped(schema, sqlmapper.ViewObjects|sqlmapper.TriggerObjects)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range s.options.Epilogue(sessionStatements) {
+		s.buf.WriteString(stmt + ";\n")
+	}
+
+	return s.options.Format.Apply(s.buf.String()), nil
 }
 
 func (s *SQLite) parseTables(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\((.*?)\)`)
+	statement = sqlmapper.StripComments(statement)
+	if table, ok := sqlmapper.ParseCreateTableAs(statement); ok {
+		s.schema.Tables = append(s.schema.Tables, *table)
+		return nil
+	}
+
+	re := regexp.MustCompile(`CREATE\s+(TEMPORARY\s+|TEMP\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s*\(`)
 	matches := re.FindStringSubmatch(statement)
+	columnDefs, _, ok := sqlmapper.Parenthesized(statement)
 
-	if len(matches) > 2 {
-		tableName := matches[1]
-		columnDefs := matches[2]
+	if len(matches) > 2 && ok {
+		tableName := matches[2]
 
-		table := sqlmapper.Table{}
+		table := sqlmapper.Table{
+			Temporary:   matches[1] != "",
+			IfNotExists: sqlmapper.HasIfNotExists(matches[0]),
+		}
 
 		// Parse schema if exists
 		parts := strings.Split(tableName, ".")
@@ -411,14 +515,12 @@ func (s *SQLite) parseTables(statement string) error {
 		}
 
 		// Parse columns and constraints
-		columns := strings.Split(columnDefs, ",")
-		for _, col := range columns {
-			col = strings.TrimSpace(col)
-			if strings.HasPrefix(strings.ToUpper(col), "CONSTRAINT") {
+		for _, col := range sqlmapper.SplitDefinitions(columnDefs) {
+			if strings.HasPrefix(strings.ToUpper(col), "CONSTRAINT") || strings.HasPrefix(strings.ToUpper(col), "CHECK") {
 				continue // Skip constraints for now
 			}
 
-			parts := strings.Fields(col)
+			parts := strings.Fields(sqlmapper.CompactTypeParameters(col))
 			if len(parts) < 2 {
 				continue
 			}
@@ -430,16 +532,7 @@ func (s *SQLite) parseTables(statement string) error {
 			}
 
 			// Parse length/precision
-			if strings.Contains(column.DataType, "(") {
-				re := regexp.MustCompile(`(\w+)\((\d+)(?:,(\d+))?\)`)
-				if matches := re.FindStringSubmatch(column.DataType); len(matches) > 2 {
-					column.DataType = matches[1]
-					fmt.Sscanf(matches[2], "%d", &column.Length)
-					if len(matches) > 3 && matches[3] != "" {
-						fmt.Sscanf(matches[3], "%d", &column.Scale)
-					}
-				}
-			}
+			column.DataType, column.Length, column.Scale = sqlmapper.ParseDataType(column.DataType)
 
 			// Parse constraints
 			if strings.Contains(strings.ToUpper(col), "NOT NULL") {
@@ -454,11 +547,17 @@ func (s *SQLite) parseTables(statement string) error {
 			if strings.Contains(strings.ToUpper(col), "UNIQUE") {
 				column.IsUnique = true
 			}
+			column.CheckExpression, _ = sqlmapper.CheckExpression(col)
+			column.ParseConstraintNames(col)
 			if strings.Contains(strings.ToUpper(col), "DEFAULT") {
-				re := regexp.MustCompile(`DEFAULT\s+([^,\s]+)`)
+				re := regexp.MustCompile(`DEFAULT\s+('(?:[^']|'')*'|[^,\s]+)`)
 				if matches := re.FindStringSubmatch(col); len(matches) > 1 {
 					column.DefaultValue = matches[1]
 				}
+				if strings.HasPrefix(column.DefaultValue, "(") {
+					// Expression defaults are written in parentheses, which may be nested
+					column.DefaultValue, _ = sqlmapper.DefaultExpression(col)
+				}
 			}
 
 			table.Columns = append(table.Columns, column)
@@ -477,7 +576,8 @@ func (s *SQLite) parseViews(statement string) error {
 	if len(matches) > 2 {
 		viewName := matches[1]
 		view := sqlmapper.View{
-			Definition: matches[2],
+			Definition:  matches[2],
+			IfNotExists: sqlmapper.HasIfNotExists(matches[0]),
 		}
 
 		// Parse schema if exists
@@ -496,29 +596,7 @@ func (s *SQLite) parseViews(statement string) error {
 }
 
 func (s *SQLite) parseTriggers(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+TRIGGER\s+(?:IF\s+NOT\s+EXISTS\s+)?([.\w]+)\s+(BEFORE|AFTER|INSTEAD\s+OF)\s+(DELETE|INSERT|UPDATE(?:\s+OF\s+[^ON]+)?)\s+ON\s+([.\w]+)(?:\s+FOR\s+EACH\s+ROW)?(?:\s+WHEN\s+([^BEGIN]+))?\s+BEGIN\s+(.*?)\s+END`)
-	matches := re.FindStringSubmatch(statement)
-
-	if len(matches) > 6 {
-		triggerName := matches[1]
-		trigger := sqlmapper.Trigger{
-			Timing:     matches[2],
-			Event:      matches[3],
-			Table:      matches[4],
-			Condition:  matches[5],
-			Body:       matches[6],
-			ForEachRow: strings.Contains(statement, "FOR EACH ROW"),
-		}
-
-		// Parse schema if exists
-		parts := strings.Split(triggerName, ".")
-		if len(parts) > 1 {
-			trigger.Schema = parts[0]
-			trigger.Name = parts[1]
-		} else {
-			trigger.Name = triggerName
-		}
-
+	if trigger, ok := parseTrigger(statement); ok {
 		s.schema.Triggers = append(s.schema.Triggers, trigger)
 	}
 
@@ -532,20 +610,16 @@ func (s *SQLite) parseIndexes(statement string) error {
 	if len(matches) > 3 {
 		indexName := matches[1]
 		tableName := matches[2]
-		columns := strings.Split(matches[3], ",")
+		columns := sqlmapper.SplitIndexColumns(matches[3])
 
 		// Find the table
 		for i, table := range s.schema.Tables {
 			if table.Name == tableName || fmt.Sprintf("%s.%s", table.Schema, table.Name) == tableName {
 				index := sqlmapper.Index{
-					Name:     indexName,
-					Columns:  make([]string, len(columns)),
-					IsUnique: strings.Contains(statement, "UNIQUE"),
-				}
-
-				// Clean column names
-				for j, col := range columns {
-					index.Columns[j] = strings.TrimSpace(col)
+					Name:        indexName,
+					Columns:     columns,
+					IsUnique:    strings.Contains(statement, "UNIQUE"),
+					IfNotExists: sqlmapper.HasIfNotExists(matches[0]),
 				}
 
 				s.schema.Tables[i].Indexes = append(s.schema.Tables[i].Indexes, index)
@@ -557,49 +631,314 @@ func (s *SQLite) parseIndexes(statement string) error {
 	return nil
 }
 
-// generateTableSQL generates SQL for a table
+// unsignedCheck returns the CHECK expression keeping an unsigned column non-negative,
+// since SQLite has no unsigned types. Integers are stored in up to 8 bytes anyway, so
+// only BIGINT UNSIGNED loses part of its range.
+func (s *SQLite) unsignedCheck(table string, col sqlmapper.Column) string {
+	if col.Zerofill {
+		s.options.Warnf("ZEROFILL of column %s.%s is not supported and was dropped", table, col.Name)
+	}
+	if col.Unsigned && strings.EqualFold(col.DataType, "BIGINT") {
+		s.options.Warnf("values of BIGINT UNSIGNED column %s.%s above 9223372036854775807 cannot be stored", table, col.Name)
+	}
+	return sqlmapper.UnsignedCheck(col)
+}
+
+// generateTableSQL generates SQL for a table. Column types of other dialects are
+// kept, as SQLite accepts any type name, except for those it cannot parse such as
+// ENUM, which becomes TEXT with a CHECK constraint.
 func (s *SQLite) generateTableSQL(table sqlmapper.Table) string {
-	sql := "CREATE TABLE " + table.Name + " (\n"
+	ifNotExists := s.options.IfNotExists(table.IfNotExists)
+	if table.SourceQuery != "" {
+		return sqlmapper.CreateTableAsSQL(table, "TEMPORARY", ifNotExists, false)
+	}
+
+	s.options.WarnInherits(table)
+	if table.Comment != "" {
+		s.options.Warnf("SQLite does not support comments, the comment of table %s was dropped", table.Name)
+	}
+
+	var sql strings.Builder
+	sql.Grow(sqlmapper.TableSizeHint(table))
+	sql.WriteString("CREATE ")
+	if table.Temporary {
+		sql.WriteString("TEMPORARY ")
+	}
+	sql.WriteString("TABLE ")
+	sql.WriteString(ifNotExists)
+	sql.WriteString(table.Name)
+	sql.WriteString(" (\n")
 
 	// Generate columns
+	var definitions []string
 	for i, col := range table.Columns {
-		sql += "    " + col.Name + " " + col.DataType
-		if col.Length > 0 {
-			sql += fmt.Sprintf("(%d", col.Length)
-			if col.Scale > 0 {
-				sql += fmt.Sprintf(",%d", col.Scale)
-			}
-			sql += ")"
+		col, checks := s.convertColumn(table, col)
+		dataType := sqlmapper.FormatDataType(col)
+		if strings.EqualFold(col.DataType, "TEXT") {
+			// TEXT has no length in SQLite
+			dataType = col.DataType
 		}
+		s.options.MapColumn(table.Name, table.Columns[i], dataType)
+		definition := sqlmapper.QuoteIdentifier(col.Name, `"`) + " " + dataType
 
 		if col.IsPrimaryKey {
-			sql += " PRIMARY KEY"
+			definition += " " + sqlmapper.ConstraintPrefix(col.PrimaryKeyName) + "PRIMARY KEY"
 			if col.AutoIncrement {
-				sql += " AUTOINCREMENT"
+				definition += " AUTOINCREMENT"
 			}
 		}
-		if !col.IsNullable {
-			sql += " NOT NULL"
-		}
+		definition += s.options.Nullability(col, col.IsPrimaryKey)
 		if col.IsUnique {
-			sql += " UNIQUE"
+			definition += " " + sqlmapper.ConstraintPrefix(col.UniqueName) + "UNIQUE"
+		}
+		if defaultValue := s.defaultValue(table.Name, col); defaultValue != "" {
+			definition += " DEFAULT " + defaultValue
 		}
-		if col.DefaultValue != "" {
-			sql += " DEFAULT " + col.DefaultValue
+		checkExpression, _ := sqlmapper.TranslateExpression(sqlmapper.RewriteCasts(col.CheckExpression, castTypes), checkRules)
+		for _, check := range checks {
+			name := ""
+			if check == checkExpression {
+				name = col.CheckName
+			}
+			definition += " " + sqlmapper.ConstraintPrefix(name) + "CHECK (" + check + ")"
 		}
+		definitions = append(definitions, definition)
+	}
+
+	definitions = append(definitions, s.tableConstraints(table)...)
+	sql.WriteString("    ")
+	sql.WriteString(strings.Join(definitions, ",\n    "))
+	sql.WriteString("\n)")
+
+	return sql.String()
+}
+
+// enumRe matches a MySQL ENUM type and its values
+var enumRe = regexp.MustCompile(`(?is)^ENUM\s*\((.*)\)$`)
 
-		if i < len(table.Columns)-1 {
-			sql += ",\n"
+// convertColumn converts a column of another dialect, returning the CHECK expressions
+// of the column. An AUTO_INCREMENT column becomes an INTEGER PRIMARY KEY, the only
+// column SQLite can generate values for.
+func (s *SQLite) convertColumn(table sqlmapper.Table, col sqlmapper.Column) (sqlmapper.Column, []string) {
+	var checks []string
+	if check := s.unsignedCheck(table.Name, col); check != "" {
+		checks = append(checks, check)
+	}
+	if col.CheckExpression != "" {
+		checks = append(checks, s.options.TranslateCheck(table.Name, sqlmapper.RewriteCasts(col.CheckExpression, castTypes), checkRules))
+	}
+
+	if match := enumRe.FindStringSubmatch(col.DataType); match != nil {
+		col.DataType = "TEXT"
+		checks = append(checks, sqlmapper.QuoteIdentifier(col.Name, `"`)+" IN ("+match[1]+")")
+	}
+	col = s.options.ConvertSetType(table.Name, col, "TEXT")
+
+	if !col.IsPrimaryKey {
+		primaryKey := primaryKeyColumns(table)
+		col.IsPrimaryKey = len(primaryKey) == 1 && strings.EqualFold(primaryKey[0], col.Name)
+	}
+	if col.AutoIncrement {
+		if col.IsPrimaryKey {
+			col.DataType, col.Length, col.Precision, col.Scale = "INTEGER", 0, 0, 0
+		} else {
+			s.options.Warnf("AUTO_INCREMENT of column %s.%s is only supported on an INTEGER PRIMARY KEY and was dropped", table.Name, col.Name)
+			col.AutoIncrement = false
 		}
 	}
 
-	sql += "\n)"
+	if col.Comment != "" {
+		s.options.Warnf("SQLite does not support comments, the comment of column %s.%s was dropped", table.Name, col.Name)
+	}
 
-	return sql
+	return col, checks
+}
+
+// primaryKeyColumns returns the columns of the primary key declared as a constraint
+func primaryKeyColumns(table sqlmapper.Table) []string {
+	for _, constraint := range table.Constraints {
+		if strings.EqualFold(constraint.Type, "PRIMARY KEY") {
+			return constraint.Columns
+		}
+	}
+	return nil
+}
+
+// tableConstraints generates the constraints of a table that are not declared on a
+// single column
+func (s *SQLite) tableConstraints(table sqlmapper.Table) []string {
+	var definitions []string
+	for _, constraint := range table.Constraints {
+		var definition string
+		switch strings.ToUpper(constraint.Type) {
+		case "PRIMARY KEY":
+			if len(constraint.Columns) < 2 {
+				continue
+			}
+			definition = "PRIMARY KEY (" + sqlmapper.QuoteIdentifiers(constraint.Columns, `"`) + ")"
+		case "UNIQUE":
+			if len(constraint.Columns) == 0 || (len(constraint.Columns) == 1 && isUniqueColumn(table, constraint.Columns[0])) {
+				continue
+			}
+			definition = "UNIQUE (" + sqlmapper.QuoteIdentifiers(constraint.Columns, `"`) + ")"
+		case "FOREIGN KEY":
+			if len(constraint.Columns) == 0 || constraint.RefTable == "" {
+				continue
+			}
+			definition = "FOREIGN KEY (" + sqlmapper.QuoteIdentifiers(constraint.Columns, `"`) + ") REFERENCES " + constraint.RefTable
+			if len(constraint.RefColumns) > 0 {
+				definition += " (" + sqlmapper.QuoteIdentifiers(constraint.RefColumns, `"`) + ")"
+			}
+			if constraint.DeleteRule != "" {
+				definition += " ON DELETE " + constraint.DeleteRule
+			}
+			if constraint.UpdateRule != "" {
+				definition += " ON UPDATE " + constraint.UpdateRule
+			}
+		case "CHECK":
+			if constraint.CheckExpression == "" || isColumnCheck(table, constraint.CheckExpression) {
+				continue
+			}
+			definition = "CHECK (" + s.options.TranslateCheck(table.Name, sqlmapper.RewriteCasts(constraint.CheckExpression, castTypes), checkRules) + ")"
+		default:
+			continue
+		}
+
+		if constraint.Name != "" {
+			definition = "CONSTRAINT " + constraint.Name + " " + definition
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions
+}
+
+// isUniqueColumn reports whether a column of the table is declared UNIQUE
+func isUniqueColumn(table sqlmapper.Table, name string) bool {
+	for _, col := range table.Columns {
+		if strings.EqualFold(col.Name, name) {
+			return col.IsUnique
+		}
+	}
+	return false
+}
+
+// isColumnCheck reports whether a CHECK expression is declared on a column of the table
+func isColumnCheck(table sqlmapper.Table, expression string) bool {
+	for _, col := range table.Columns {
+		if col.CheckExpression == expression {
+			return true
+		}
+	}
+	return false
+}
+
+// intervalDefaultRe matches a MySQL default adding an interval to the current date
+// or time, such as (CURRENT_DATE + INTERVAL 1 DAY)
+var intervalDefaultRe = regexp.MustCompile(`(?i)^\(?\s*(CURRENT_DATE|CURDATE\(\s*\)|CURRENT_TIMESTAMP(?:\(\s*\d*\s*\))?|NOW\(\s*\d*\s*\))\s*([+-])\s*INTERVAL\s+'?(\d+)'?\s+(SECOND|MINUTE|HOUR|DAY|MONTH|YEAR)\s*\)?$`)
+
+// defaultValue returns the DEFAULT expression of a column, or an empty string if it
+// has none. Intervals added to the current date or time are rewritten with the date
+// functions of SQLite; other INTERVAL expressions, which SQLite cannot parse, are
+// dropped with a warning.
+func (s *SQLite) defaultValue(table string, col sqlmapper.Column) string {
+	if col.DefaultValue == "" {
+		return ""
+	}
+	if defaultValue := sqlmapper.RewriteCasts(col.DefaultValue, castTypes); defaultValue != col.DefaultValue {
+		// Expression defaults, such as the casts of PostgreSQL, are written in parentheses
+		return "(" + defaultValue + ")"
+	}
+
+	if match := intervalDefaultRe.FindStringSubmatch(strings.TrimSpace(col.DefaultValue)); match != nil {
+		function := "datetime"
+		if upper := strings.ToUpper(match[1]); upper == "CURRENT_DATE" || strings.HasPrefix(upper, "CURDATE") {
+			function = "date"
+		}
+		return fmt.Sprintf("(%s('now', '%s%s %ss'))", function, match[2], match[3], strings.ToLower(match[4]))
+	}
+	for _, token := range sqlmapper.Tokenize(col.DefaultValue) {
+		if token.Type == sqlmapper.WordToken && strings.EqualFold(token.Text, "INTERVAL") {
+			s.options.Warnf("default %s of column %s.%s uses INTERVAL, which SQLite does not support, and was dropped", col.DefaultValue, table, col.Name)
+			return ""
+		}
+	}
+
+	return defaultValueSQL(sqlmapper.CurrentTimeDefault(col.DefaultValue))
+}
+
+// defaultValueSQL returns a default value as a literal, quoting the strings that
+// other dialects store without their quotes
+func defaultValueSQL(value string) string {
+	upper := strings.ToUpper(value)
+	switch {
+	case upper == "NULL", upper == "TRUE", upper == "FALSE",
+		upper == "CURRENT_TIMESTAMP", upper == "CURRENT_DATE", upper == "CURRENT_TIME":
+		return value
+	case strings.HasPrefix(value, "'"), strings.Contains(value, "("):
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	return quoteLiteral(value)
+}
+
+// quoteLiteral quotes a value as a SQLite string literal. SQLite has no escape
+// sequences, so only the single quotes are doubled.
+func quoteLiteral(value string) string {
+	return sqlmapper.QuoteLiteral(value)
+}
+
+// GenerateTable generates the CREATE TABLE statement of a single table, without
+// its indexes and without a terminating semicolon
+func (s *SQLite) GenerateTable(table sqlmapper.Table) (string, error) {
+	return s.options.Format.Apply(s.generateTableSQL(table)), nil
+}
+
+// GenerateIndex generates the CREATE INDEX statement of a single index of a table,
+// without a terminating semicolon
+func (s *SQLite) GenerateIndex(tableName string, index sqlmapper.Index) (string, error) {
+	return s.options.Format.Apply(s.generateIndexSQL(tableName, index)), nil
+}
+
+// GenerateView generates the CREATE VIEW statement of a single view, without a
+// terminating semicolon
+func (s *SQLite) GenerateView(view sqlmapper.View) (string, error) {
+	return s.options.Format.Apply(s.generateViewSQL(view)), nil
+}
+
+// generateViewSQL generates SQL for a view
+func (s *SQLite) generateViewSQL(view sqlmapper.View) string {
+	return fmt.Sprintf("CREATE VIEW %s%s AS %s", s.options.IfNotExists(view.IfNotExists), view.Name, view.Definition)
+}
+
+// generateTriggerSQL generates SQL for a trigger. SQLite triggers fire for each row
+// and TRUNCATE triggers are not supported, they are skipped with a warning.
+func (s *SQLite) generateTriggerSQL(trigger sqlmapper.Trigger) (string, bool) {
+	if len(trigger.Events) > 0 && strings.EqualFold(trigger.Events[0], "TRUNCATE") && !capabilities.TruncateTriggers {
+		s.options.Warnf("SQLite does not support TRUNCATE triggers, trigger %s was skipped", trigger.Name)
+		return "", false
+	}
+
+	sql := "CREATE TRIGGER " + trigger.Name
+	if trigger.Timing != "" {
+		sql += " " + trigger.Timing
+	}
+	sql += " " + trigger.EventClause("") + " ON " + trigger.Table + "\n"
+	if trigger.ForEachRow {
+		sql += "FOR EACH ROW\n"
+	}
+	if trigger.Condition != "" {
+		sql += "WHEN " + sqlmapper.TrimParens(trigger.Condition) + "\n"
+	}
+	sql += "BEGIN\n" + trigger.Body + "\nEND"
+	return sql, true
 }
 
 // generateIndexSQL generates SQL for an index
 func (s *SQLite) generateIndexSQL(tableName string, index sqlmapper.Index) string {
+	index = s.options.WholeColumnIndex(tableName, s.options.PlainIndex(tableName, index))
+
 	var sql string
 	if index.IsUnique {
 		sql = "CREATE UNIQUE INDEX "
@@ -607,7 +946,12 @@ func (s *SQLite) generateIndexSQL(tableName string, index sqlmapper.Index) strin
 		sql = "CREATE INDEX "
 	}
 
-	sql += index.Name + " ON " + tableName + " (" + strings.Join(index.Columns, ", ") + ")"
+	sql += s.options.IfNotExists(index.IfNotExists) + index.Name + " ON " + tableName + " (" + index.ColumnList(`"`, true) + ")"
 
 	return sql
 }
+
+// generateDropSQL generates SQL for a dropped object
+func (s *SQLite) generateDropSQL(drop sqlmapper.Drop) string {
+	return "DROP " + drop.Type + " " + s.options.IfExists(drop.IfExists) + drop.QualifiedName()
+}
@@ -3,6 +3,7 @@ package sqlite
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -12,7 +13,8 @@ import (
 
 // SQLiteStreamParser implements the StreamParser interface for SQLite
 type SQLiteStreamParser struct {
-	sqlite *SQLite
+	sqlite  *SQLite
+	options stream.ParseOptions
 }
 
 // NewSQLiteStreamParser creates a new SQLite stream parser
@@ -22,103 +24,98 @@ func NewSQLiteStreamParser() *SQLiteStreamParser {
 	}
 }
 
+// SetOptions configures optional parsing behaviour such as comment capture
+func (p *SQLiteStreamParser) SetOptions(options stream.ParseOptions) {
+	p.options = options
+}
+
+// SetGenerateOptions configures optional output of GenerateStream
+func (p *SQLiteStreamParser) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	p.sqlite.SetGenerateOptions(options)
+}
+
 // ParseStream implements the StreamParser interface
 func (p *SQLiteStreamParser) ParseStream(reader io.Reader, callback func(stream.SchemaObject) error) error {
-	streamReader := stream.NewStreamReader(reader, ";")
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
 
 	for {
 		statement, err := streamReader.ReadStatement()
+		if err := budget.Check(); err != nil {
+			return err
+		}
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("error reading statement: %v", err)
+			return fmt.Errorf("error reading statement: %w", err)
 		}
 
 		statement = strings.TrimSpace(statement)
-		if statement == "" {
+		if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 			continue
 		}
 
-		// Parse CREATE TABLE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TABLE") {
-			table, err := p.parseTableStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TableObject,
-				Data: table,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		obj, err := p.parseStatement(statement)
+		if err == nil && obj == nil {
+			pos := streamReader.Position()
+			err = p.options.Unhandled(stream.Statement{Text: statement, Line: pos.Line, Offset: pos.Offset})
 		}
-
-		// Parse CREATE VIEW statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE VIEW") {
-			view, err := p.parseViewStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ViewObject,
-				Data: view,
-			})
-			if err != nil {
+		if err != nil {
+			if err := errs.Handle(stream.Statement{Text: statement, Line: streamReader.Line()}, err); err != nil {
 				return err
 			}
 			continue
 		}
-
-		// Parse CREATE INDEX statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE INDEX") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE UNIQUE INDEX") {
-			index, err := p.parseIndexStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.IndexObject,
-				Data: index,
-			})
-			if err != nil {
-				return err
-			}
+		if obj == nil || !p.options.Accept(obj) {
 			continue
 		}
+		obj.SetCreateFlags(statement)
 
-		// Parse CREATE TRIGGER statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TRIGGER") {
-			trigger, err := p.parseTriggerStatement(statement)
-			if err != nil {
-				return err
-			}
+		if p.options.CaptureComments {
+			obj.SetSourceComment(streamReader.LeadingComment())
+		}
 
-			err = callback(stream.SchemaObject{
-				Type: stream.TriggerObject,
-				Data: trigger,
-			})
-			if err != nil {
-				return err
+		if err := budget.Object(); err != nil {
+			return err
+		}
+		if err := callback(*obj); err != nil {
+			if stream.IsStopIteration(err) {
+				break
 			}
-			continue
+			return err
 		}
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // ParseStreamParallel implements parallel processing for SQLite stream parsing
 func (p *SQLiteStreamParser) ParseStreamParallel(reader io.Reader, callback func(stream.SchemaObject) error, workers int) error {
-	streamReader := stream.NewStreamReader(reader, ";")
-	statements := make(chan string, workers)
-	results := make(chan stream.SchemaObject, workers)
-	errors := make(chan error, workers)
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
+	workers, buffer := p.options.Parallelism(workers)
+	statements := make(chan stream.Statement, buffer)
+	results := make(chan stream.SchemaObject, buffer)
+	// Room for an error of each worker and one of the reader
+	errors := make(chan error, workers+1)
+	// Closed when the results are no longer read or a worker failed, so that the
+	// reader and the other workers do not block on their channels
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	halt := func() { stopOnce.Do(func() { close(stop) }) }
 	var wg sync.WaitGroup
 
 	// Start worker goroutines
@@ -127,13 +124,28 @@ func (p *SQLiteStreamParser) ParseStreamParallel(reader io.Reader, callback func
 		go func() {
 			defer wg.Done()
 			for statement := range statements {
-				obj, err := p.parseStatement(statement)
+				obj, err := p.parseStatement(statement.Text)
+				if err == nil && obj == nil {
+					err = p.options.Unhandled(statement)
+				}
 				if err != nil {
-					errors <- err
-					return
+					if err := errs.Handle(statement, err); err != nil {
+						errors <- err
+						halt()
+						return
+					}
+					continue
 				}
-				if obj != nil {
-					results <- *obj
+				if obj != nil && p.options.Accept(obj) {
+					obj.SetCreateFlags(statement.Text)
+					if p.options.CaptureComments {
+						obj.SetSourceComment(statement.LeadingComment)
+					}
+					select {
+					case results <- *obj:
+					case <-stop:
+						return
+					}
 				}
 			}
 		}()
@@ -149,26 +161,49 @@ func (p *SQLiteStreamParser) ParseStreamParallel(reader io.Reader, callback func
 	go func() {
 		for {
 			statement, err := streamReader.ReadStatement()
+			if err := budget.Check(); err != nil {
+				errors <- err
+				break
+			}
 			if err == io.EOF {
 				break
 			}
 			if err != nil {
-				errors <- fmt.Errorf("error reading statement: %v", err)
+				errors <- fmt.Errorf("error reading statement: %w", err)
 				break
 			}
 
 			statement = strings.TrimSpace(statement)
-			if statement == "" {
+			if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 				continue
 			}
-			statements <- statement
+			next := stream.Statement{
+				Text:           statement,
+				LeadingComment: streamReader.LeadingComment(),
+				Line:           streamReader.Line(),
+				Offset:         streamReader.Position().Offset,
+			}
+			select {
+			case statements <- next:
+			case <-stop:
+				close(statements)
+				return
+			}
 		}
 		close(statements)
 	}()
 
 	// Process results and handle errors
 	for obj := range results {
+		if err := budget.Object(); err != nil {
+			halt()
+			return err
+		}
 		if err := callback(obj); err != nil {
+			halt()
+			if stream.IsStopIteration(err) {
+				return errs.Err()
+			}
 			return err
 		}
 	}
@@ -178,16 +213,14 @@ func (p *SQLiteStreamParser) ParseStreamParallel(reader io.Reader, callback func
 	case err := <-errors:
 		return err
 	default:
-		return nil
+		return errs.Err()
 	}
 }
 
 // parseStatement parses a single SQL statement and returns a SchemaObject
 func (p *SQLiteStreamParser) parseStatement(statement string) (*stream.SchemaObject, error) {
-	upperStatement := strings.ToUpper(statement)
-
-	switch {
-	case strings.HasPrefix(upperStatement, "CREATE TABLE"):
+	switch sqlmapper.ClassifyStatement(statement) {
+	case sqlmapper.CreateTableStatement:
 		table, err := p.parseTableStatement(statement)
 		if err != nil {
 			return nil, err
@@ -197,7 +230,7 @@ func (p *SQLiteStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: table,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE VIEW"):
+	case sqlmapper.CreateViewStatement:
 		view, err := p.parseViewStatement(statement)
 		if err != nil {
 			return nil, err
@@ -207,7 +240,7 @@ func (p *SQLiteStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: view,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE INDEX"):
+	case sqlmapper.CreateIndexStatement:
 		index, err := p.parseIndexStatement(statement)
 		if err != nil {
 			return nil, err
@@ -217,7 +250,7 @@ func (p *SQLiteStreamParser) parseStatement(statement string) (*stream.SchemaObj
 			Data: index,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE TRIGGER"):
+	case sqlmapper.CreateTriggerStatement:
 		trigger, err := p.parseTriggerStatement(statement)
 		if err != nil {
 			return nil, err
@@ -228,26 +261,74 @@ func (p *SQLiteStreamParser) parseStatement(statement string) (*stream.SchemaObj
 		}, nil
 	}
 
+	if drop, ok := sqlmapper.ParseDrop(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.DropObject,
+			Data: drop,
+		}, nil
+	}
+
+	if settings, ok := sqlmapper.ParseSetStatement(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.SettingObject,
+			Data: settings,
+		}, nil
+	}
+
 	return nil, nil
 }
 
+// parseInto parses a single statement with the given SQLite parser method into a
+// schema of its own. The shared parser is never modified, so statements can be
+// parsed concurrently by the workers of ParseStreamParallel.
+func (p *SQLiteStreamParser) parseInto(parse func(*SQLite, string) error, statement string) (*sqlmapper.Schema, error) {
+	parser := &SQLite{schema: &sqlmapper.Schema{}, options: p.sqlite.options}
+	if err := parse(parser, statement); err != nil {
+		return nil, err
+	}
+	return parser.schema, nil
+}
+
+// TransformStream parses a dump, applies transform to every object and writes the
+// result right away, see stream.TransformStream
+func (p *SQLiteStreamParser) TransformStream(reader io.Reader, writer io.Writer, transform stream.TransformFunc) error {
+	return stream.TransformStream(p, p.sqlite, reader, writer, transform)
+}
+
 // GenerateStream implements the StreamParser interface
 func (p *SQLiteStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.Writer) error {
 	if schema == nil {
-		return fmt.Errorf("schema cannot be nil")
+		return &sqlmapper.ValidationError{Problems: []string{"schema cannot be nil"}}
+	}
+	schema = p.sqlite.options.Incremental(schema)
+	writer = p.sqlite.options.Format.Writer(writer)
+
+	// Write session settings, disable foreign key checks and open the transaction
+	for _, stmt := range p.sqlite.options.Prologue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write drops
+	for _, drop := range schema.Drops {
+		stmt := p.sqlite.generateDropSQL(drop)
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
+		}
 	}
 
 	// Write tables
-	for _, table := range schema.Tables {
+	for _, table := range p.sqlite.options.WithoutPartitions(schema.Tables) {
 		stmt := p.sqlite.generateTableSQL(table)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 
 		// Generate indexes for this table
 		for _, index := range table.Indexes {
 			stmt := p.sqlite.generateIndexSQL(table.Name, index)
-			if _, err := writer.Write([]byte(stmt + ";\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
 				return err
 			}
 		}
@@ -255,24 +336,32 @@ func (p *SQLiteStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.
 
 	// Write views
 	for _, view := range schema.Views {
-		stmt := fmt.Sprintf("CREATE VIEW %s AS %s", view.Name, view.Definition)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, p.sqlite.generateViewSQL(view), ";\n\n"); err != nil {
 			return err
 		}
 	}
 
 	// Write triggers
-	for _, trigger := range schema.Triggers {
-		stmt := fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s\n",
-			trigger.Name, trigger.Timing, trigger.Event, trigger.Table)
-		if trigger.ForEachRow {
-			stmt += "FOR EACH ROW\n"
+	for _, trigger := range sqlmapper.SplitTriggerEvents(schema.Triggers, p.sqlite.options) {
+		stmt, ok := p.sqlite.generateTriggerSQL(trigger)
+		if !ok {
+			continue
 		}
-		if trigger.Condition != "" {
-			stmt += "WHEN " + trigger.Condition + "\n"
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+			return err
 		}
-		stmt += fmt.Sprintf("BEGIN\n%s\nEND", trigger.Body)
-		if _, err := writer.Write([]byte(stmt + ";\n\n")); err != nil {
+	}
+
+	// SQLite has no users, so there is nothing to grant
+	if p.sqlite.options.IncludePermissions && !capabilities.Permissions && len(schema.Permissions) > 0 {
+		p.sqlite.options.Warnf("SQLite does not support privileges, %d GRANT/REVOKE statements were skipped", len(schema.Permissions))
+	}
+
+	p.sqlite.options.WarnSkipped(schema, sqlmapper.ViewObjects|sqlmapper.TriggerObjects)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range p.sqlite.options.Epilogue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
 			return err
 		}
 	}
@@ -282,10 +371,8 @@ func (p *SQLiteStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.
 
 // parseTableStatement parses a CREATE TABLE statement
 func (p *SQLiteStreamParser) parseTableStatement(statement string) (*sqlmapper.Table, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlite.schema = tempSchema
-
-	if err := p.sqlite.parseTables(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLite).parseTables, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -298,10 +385,8 @@ func (p *SQLiteStreamParser) parseTableStatement(statement string) (*sqlmapper.T
 
 // parseViewStatement parses a CREATE VIEW statement
 func (p *SQLiteStreamParser) parseViewStatement(statement string) (*sqlmapper.View, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlite.schema = tempSchema
-
-	if err := p.sqlite.parseViews(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLite).parseViews, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -314,10 +399,14 @@ func (p *SQLiteStreamParser) parseViewStatement(statement string) (*sqlmapper.Vi
 
 // parseIndexStatement parses a CREATE INDEX statement
 func (p *SQLiteStreamParser) parseIndexStatement(statement string) (*sqlmapper.Index, error) {
+	// Seed the indexed table so the parser can attach the index to it
 	tempSchema := &sqlmapper.Schema{}
-	p.sqlite.schema = tempSchema
+	if matches := regexp.MustCompile(`(?i)\sON\s+([.\w]+)`).FindStringSubmatch(statement); len(matches) > 1 {
+		tempSchema.Tables = []sqlmapper.Table{{Name: matches[1]}}
+	}
+	parser := &SQLite{schema: tempSchema, options: p.sqlite.options}
 
-	if err := p.sqlite.parseIndexes(statement); err != nil {
+	if err := parser.parseIndexes(statement); err != nil {
 		return nil, err
 	}
 
@@ -325,15 +414,15 @@ func (p *SQLiteStreamParser) parseIndexStatement(statement string) (*sqlmapper.I
 		return nil, fmt.Errorf("no index found in statement")
 	}
 
-	return &tempSchema.Tables[0].Indexes[0], nil
+	index := tempSchema.Tables[0].Indexes[0]
+	index.Table = tempSchema.Tables[0].Name
+	return &index, nil
 }
 
 // parseTriggerStatement parses a CREATE TRIGGER statement
 func (p *SQLiteStreamParser) parseTriggerStatement(statement string) (*sqlmapper.Trigger, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlite.schema = tempSchema
-
-	if err := p.sqlite.parseTriggers(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLite).parseTriggers, statement)
+	if err != nil {
 		return nil, err
 	}
 
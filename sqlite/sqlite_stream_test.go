@@ -1,3 +1,65 @@
 package sqlite
 
-// TODO implement tests
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteStreamParser_ParseStreamParallel_Concurrent(t *testing.T) {
+	var input strings.Builder
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&input, "CREATE TABLE t%[1]d (c%[1]d INTEGER NOT NULL, name VARCHAR(64));\n", i)
+	}
+
+	parser := NewSQLiteStreamParser()
+	seen := make(map[string]int)
+	err := parser.ParseStreamParallel(strings.NewReader(input.String()), func(obj stream.SchemaObject) error {
+		table := obj.Data.(*sqlmapper.Table)
+		seen[table.Name]++
+
+		// Every table must keep its own columns when workers parse concurrently
+		assert.Len(t, table.Columns, 2)
+		assert.Equal(t, "c"+strings.TrimPrefix(table.Name, "t"), table.Columns[0].Name)
+		return nil
+	}, 8)
+
+	assert.NoError(t, err)
+	assert.Len(t, seen, 200)
+	for name, count := range seen {
+		assert.Equal(t, 1, count, name)
+	}
+}
+
+func TestSQLiteStreamParser_InsteadOfTrigger(t *testing.T) {
+	input := `CREATE VIEW item_names AS SELECT id, name FROM items;
+CREATE TRIGGER rename_item INSTEAD OF UPDATE ON item_names
+BEGIN
+	UPDATE items SET name = NEW.name WHERE id = OLD.id;
+END;`
+
+	parser := NewSQLiteStreamParser()
+	var triggers []*sqlmapper.Trigger
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		if obj.Type == stream.TriggerObject {
+			triggers = append(triggers, obj.Data.(*sqlmapper.Trigger))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	if !assert.Len(t, triggers, 1) {
+		return
+	}
+	assert.Equal(t, "INSTEAD OF", triggers[0].Timing)
+	assert.Equal(t, []string{"UPDATE"}, triggers[0].Events)
+	assert.Equal(t, "item_names", triggers[0].Table)
+
+	var output strings.Builder
+	schema := &sqlmapper.Schema{Triggers: []sqlmapper.Trigger{*triggers[0]}}
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE TRIGGER rename_item INSTEAD OF UPDATE ON item_names\nBEGIN\n")
+}
@@ -49,7 +49,7 @@ func TestSQLite_Parse(t *testing.T) {
 				assert.Len(t, schema.Tables, 1)
 				assert.Len(t, schema.Tables[0].Indexes, 1)
 				assert.Equal(t, "idx_name", schema.Tables[0].Indexes[0].Name)
-				assert.Equal(t, []string{"name"}, schema.Tables[0].Indexes[0].Columns)
+				assert.Equal(t, []string{"name"}, schema.Tables[0].Indexes[0].ColumnNames())
 			},
 		},
 		{
@@ -70,6 +70,38 @@ func TestSQLite_Parse(t *testing.T) {
 				// Additional validation logic for triggers can be added here
 			},
 		},
+		{
+			name: "CREATE TRIGGER with multi-statement body",
+			content: `CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT);
+CREATE TRIGGER audit_users AFTER UPDATE ON users
+BEGIN
+	INSERT INTO audit_log (user_id) VALUES (NEW.id);
+	UPDATE stats SET updates = updates + 1;
+	DELETE FROM cache WHERE user_id = NEW.id;
+END;
+CREATE INDEX idx_users_name ON users (name);`,
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Triggers, 1)
+				assert.Equal(t, "audit_users", schema.Triggers[0].Name)
+				assert.Equal(t, "users", schema.Triggers[0].Table)
+				assert.Equal(t, "INSERT INTO audit_log (user_id) VALUES (NEW.id);\n"+
+					"\tUPDATE stats SET updates = updates + 1;\n"+
+					"\tDELETE FROM cache WHERE user_id = NEW.id;", schema.Triggers[0].Body)
+				assert.Len(t, schema.Tables[0].Indexes, 1)
+			},
+		},
+		{
+			name:    "CREATE TRIGGER with FOR EACH ROW and WHEN",
+			content: "CREATE TRIGGER positive_stock AFTER UPDATE ON items FOR EACH ROW WHEN (NEW.stock > 0) BEGIN UPDATE items SET available = 1 WHERE id = NEW.id; END;",
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Triggers, 1)
+				assert.True(t, schema.Triggers[0].ForEachRow)
+				assert.Equal(t, "NEW.stock > 0", schema.Triggers[0].Condition)
+				assert.Equal(t, "UPDATE items SET available = 1 WHERE id = NEW.id;", schema.Triggers[0].Body)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -132,8 +164,8 @@ CREATE TABLE users (
 							{Name: "price", DataType: "REAL", Length: 10, Scale: 2, IsNullable: true},
 						},
 						Indexes: []sqlmapper.Index{
-							{Name: "idx_name", Columns: []string{"name"}},
-							{Name: "idx_price", Columns: []string{"price"}, IsUnique: true},
+							{Name: "idx_name", Columns: sqlmapper.IndexColumns("name")},
+							{Name: "idx_price", Columns: sqlmapper.IndexColumns("price"), IsUnique: true},
 						},
 					},
 				},
@@ -172,3 +204,374 @@ func TestSQLite_Generate_ComplexSchema(t *testing.T) {
 	_, err := s.Generate(schema)
 	assert.NoError(t, err)
 }
+
+func TestSQLite_Generate_PreserveGuards(t *testing.T) {
+	content := `DROP VIEW IF EXISTS active_users;
+CREATE TABLE IF NOT EXISTS users (id INTEGER PRIMARY KEY, active INTEGER);
+CREATE INDEX IF NOT EXISTS idx_users_active ON users (active);
+CREATE VIEW IF NOT EXISTS active_users AS SELECT id FROM users WHERE active = 1;`
+
+	parser := NewSQLite()
+	schema, err := parser.Parse(content)
+	assert.NoError(t, err)
+	if assert.Len(t, schema.Drops, 1) && assert.Len(t, schema.Tables, 1) && assert.Len(t, schema.Views, 1) {
+		assert.Equal(t, sqlmapper.Drop{Type: "VIEW", Name: "active_users", IfExists: true}, schema.Drops[0])
+		assert.Equal(t, "users", schema.Tables[0].Name)
+		assert.True(t, schema.Tables[0].IfNotExists)
+		assert.Equal(t, "active_users", schema.Views[0].Name)
+		assert.True(t, schema.Views[0].IfNotExists)
+		if assert.Len(t, schema.Tables[0].Indexes, 1) {
+			assert.Equal(t, "idx_users_active", schema.Tables[0].Indexes[0].Name)
+			assert.True(t, schema.Tables[0].Indexes[0].IfNotExists)
+		}
+	}
+
+	generator := NewSQLite().(*SQLite)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{PreserveGuards: true})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "DROP VIEW IF EXISTS active_users;")
+	assert.Contains(t, result, "CREATE TABLE IF NOT EXISTS users (")
+	assert.Contains(t, result, "CREATE INDEX IF NOT EXISTS idx_users_active ON users(active);")
+
+	reparsed, err := NewSQLite().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Tables, 1) && assert.Len(t, reparsed.Tables[0].Indexes, 1) {
+		assert.True(t, reparsed.Tables[0].IfNotExists)
+		assert.True(t, reparsed.Tables[0].Indexes[0].IfNotExists)
+	}
+}
+
+func TestSQLite_Generate_Transaction(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER"}}}},
+	}
+
+	generator := NewSQLite().(*SQLite)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{
+		Transaction:     true,
+		SessionSettings: []string{"PRAGMA foreign_keys = OFF"},
+	})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "PRAGMA foreign_keys = OFF;\nBEGIN TRANSACTION;\n"))
+	assert.True(t, strings.HasSuffix(result, "COMMIT;\n"))
+}
+
+func TestSQLite_Generate_Unsigned(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "counters",
+			Columns: []sqlmapper.Column{
+				{Name: "qty", DataType: "INT", Unsigned: true},
+				{Name: "hits", DataType: "BIGINT", Unsigned: true, IsNullable: true},
+			},
+		}},
+	}
+
+	var warnings []string
+	generator := NewSQLite().(*SQLite)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "qty INT NOT NULL CHECK (qty >= 0)")
+	assert.Contains(t, result, "hits BIGINT CHECK (hits >= 0)")
+	assert.Len(t, warnings, 1)
+}
+
+func TestSQLite_Generate_TypeParameters(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "prices",
+			Columns: []sqlmapper.Column{
+				{Name: "amount", DataType: "DECIMAL", Length: 10, Scale: 2},
+				{Name: "total", DataType: "DECIMAL", Precision: 12, Scale: 4},
+				{Name: "name", DataType: "VARCHAR", Length: 255},
+				{Name: "flag", DataType: "CHAR", Length: 1},
+				{Name: "created", DataType: "TIMESTAMP", Length: 6},
+			},
+		}},
+	}
+
+	result, err := NewSQLite().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "amount DECIMAL(10,2)")
+	assert.Contains(t, result, "total DECIMAL(12,4)")
+	assert.Contains(t, result, "name VARCHAR(255)")
+	assert.Contains(t, result, "flag CHAR(1)")
+	assert.Contains(t, result, "created TIMESTAMP(6)")
+}
+
+func TestSQLite_Generate_FractionalSeconds(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "events",
+			Columns: []sqlmapper.Column{
+				{Name: "created", DataType: "DATETIME", Length: 3},
+				{Name: "duration", DataType: "TIME", Length: 6},
+			},
+		}},
+	}
+
+	result, err := NewSQLite().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "created DATETIME(3)")
+	assert.Contains(t, result, "duration TIME(6)")
+}
+
+func TestSQLite_Generate_Partitions(t *testing.T) {
+	var warnings []string
+	generator := NewSQLite().(*SQLite)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+
+	// A PostgreSQL range-partitioned table and one of its partitions
+	result, err := generator.Generate(&sqlmapper.Schema{Tables: []sqlmapper.Table{
+		{
+			Name:        "measurements",
+			Columns:     []sqlmapper.Column{{Name: "logdate", DataType: "DATE"}},
+			PartitionBy: "RANGE (logdate)",
+		},
+		{
+			Name:           "measurements_2024",
+			PartitionOf:    "measurements",
+			PartitionBound: "FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')",
+		},
+	}})
+	assert.NoError(t, err)
+	assert.Contains(t, result, "CREATE TABLE measurements (")
+	assert.NotContains(t, result, "measurements_2024")
+	assert.NotContains(t, result, "PARTITION")
+	assert.Equal(t, []string{
+		"table measurements_2024 is a partition of measurements and was skipped",
+		"table measurements is partitioned by RANGE (logdate), the partitioning was dropped",
+	}, warnings)
+}
+
+func TestSQLite_Generate_Constraints(t *testing.T) {
+	var warnings []string
+	generator := NewSQLite().(*SQLite)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+
+	result, err := generator.Generate(&sqlmapper.Schema{Tables: []sqlmapper.Table{{
+		Name: "order_items",
+		Columns: []sqlmapper.Column{
+			{Name: "order_id", DataType: "INT"},
+			{Name: "line", DataType: "INT"},
+			{Name: "seq", DataType: "BIGINT", AutoIncrement: true},
+			{Name: "quantity", DataType: "INT", DefaultValue: "1", CheckExpression: "quantity > 0"},
+			{Name: "note", DataType: "VARCHAR", Length: 50, IsNullable: true, DefaultValue: "it's"},
+		},
+		Constraints: []sqlmapper.Constraint{
+			{Type: "PRIMARY KEY", Columns: []string{"order_id", "line"}},
+			{Type: "CHECK", Columns: []string{"quantity"}, CheckExpression: "quantity > 0"},
+			{Name: "fk_items_order", Type: "FOREIGN KEY", Columns: []string{"order_id"}, RefTable: "orders", RefColumns: []string{"id"}, UpdateRule: "CASCADE"},
+		},
+	}}})
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE TABLE order_items (
+    order_id INT NOT NULL,
+    line INT NOT NULL,
+    seq BIGINT NOT NULL,
+    quantity INT NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    note VARCHAR(50) DEFAULT 'it''s',
+    PRIMARY KEY (order_id, line),
+    CONSTRAINT fk_items_order FOREIGN KEY (order_id) REFERENCES orders (id) ON UPDATE CASCADE
+);
+`, result)
+	assert.Equal(t, []string{"AUTO_INCREMENT of column order_items.seq is only supported on an INTEGER PRIMARY KEY and was dropped"}, warnings)
+}
+
+func TestSQLite_GenerateObjects(t *testing.T) {
+	db := NewSQLite()
+	table := sqlmapper.Table{
+		Name: "users",
+		Columns: []sqlmapper.Column{
+			{Name: "id", DataType: "INT"},
+			{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true},
+		},
+	}
+
+	sql, err := sqlmapper.GenerateTable(db, table)
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE TABLE users (")
+	assert.Contains(t, sql, "email VARCHAR(255)")
+	assert.False(t, strings.HasSuffix(sql, ";"))
+
+	sql, err = sqlmapper.GenerateIndex(db, "users", sqlmapper.Index{Name: "idx_users_email", Columns: sqlmapper.IndexColumns("email"), IsUnique: true})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE UNIQUE INDEX idx_users_email ON users")
+	assert.Contains(t, sql, "(email)")
+
+	sql, err = sqlmapper.GenerateView(db, sqlmapper.View{Name: "active_users", Definition: "SELECT * FROM users"})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE VIEW active_users AS")
+	assert.Contains(t, sql, "SELECT * FROM users")
+}
+
+func TestSQLite_Triggers(t *testing.T) {
+	content := `
+CREATE TABLE items (id INTEGER PRIMARY KEY, stock INTEGER, name TEXT);
+CREATE VIEW item_names AS SELECT id, name FROM items;
+
+CREATE TRIGGER check_stock BEFORE INSERT ON items
+WHEN NEW.stock < 0
+BEGIN
+	SELECT RAISE(ABORT, 'stock must not be negative');
+END;
+
+CREATE TRIGGER IF NOT EXISTS main.rename_item INSTEAD OF UPDATE OF name ON item_names
+FOR EACH ROW
+BEGIN
+	UPDATE items SET name = NEW.name WHERE id = OLD.id;
+END;
+
+CREATE TRIGGER log_delete delete ON items BEGIN INSERT INTO audit VALUES (OLD.id); END;`
+
+	schema, err := NewSQLite().Parse(content)
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Triggers, 3) {
+		return
+	}
+
+	before := schema.Triggers[0]
+	assert.Equal(t, "check_stock", before.Name)
+	assert.Equal(t, "BEFORE", before.Timing)
+	assert.Equal(t, []string{"INSERT"}, before.Events)
+	assert.Equal(t, "items", before.Table)
+	assert.Equal(t, "NEW.stock < 0", before.Condition)
+	assert.Equal(t, "SELECT RAISE(ABORT, 'stock must not be negative');", before.Body)
+
+	insteadOf := schema.Triggers[1]
+	assert.Equal(t, "main", insteadOf.Schema)
+	assert.Equal(t, "rename_item", insteadOf.Name)
+	assert.Equal(t, "INSTEAD OF", insteadOf.Timing)
+	assert.Equal(t, []string{"UPDATE OF name"}, insteadOf.Events)
+	assert.Equal(t, "item_names", insteadOf.Table)
+	assert.True(t, insteadOf.ForEachRow)
+	assert.Equal(t, "UPDATE items SET name = NEW.name WHERE id = OLD.id;", insteadOf.Body)
+
+	// The timing defaults to BEFORE, the INSERT of the body is not the event
+	assert.Equal(t, "BEFORE", schema.Triggers[2].Timing)
+	assert.Equal(t, []string{"DELETE"}, schema.Triggers[2].Events)
+
+	result, err := NewSQLite().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "CREATE VIEW item_names AS SELECT id, name FROM items;")
+	assert.Contains(t, result, "CREATE TRIGGER check_stock BEFORE INSERT ON items\nWHEN NEW.stock < 0\nBEGIN\n")
+	assert.Contains(t, result, "CREATE TRIGGER rename_item INSTEAD OF UPDATE OF name ON item_names\nFOR EACH ROW\nBEGIN\n")
+
+	reparsed, err := NewSQLite().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, reparsed.Triggers, 3) {
+		assert.Equal(t, before, reparsed.Triggers[0])
+		assert.Equal(t, "INSTEAD OF", reparsed.Triggers[1].Timing)
+		assert.Equal(t, insteadOf.Body, reparsed.Triggers[1].Body)
+	}
+}
+
+func TestSQLite_Generate_TruncateTrigger(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Triggers: []sqlmapper.Trigger{{Name: "audit_truncate", Timing: "AFTER", Events: []string{"TRUNCATE"}, Table: "items", Body: "SELECT 1;"}},
+	}
+
+	var warnings []string
+	generator := NewSQLite().(*SQLite)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.NotContains(t, result, "TRIGGER")
+	assert.Equal(t, []string{"SQLite does not support TRUNCATE triggers, trigger audit_truncate was skipped"}, warnings)
+}
+
+func TestSQLite_ExpressionDefaults(t *testing.T) {
+	s := NewSQLite()
+	schema, err := s.Parse("CREATE TABLE tasks (id INTEGER, total INTEGER DEFAULT ((1 + 2) * 3) NOT NULL, due TEXT DEFAULT (date('now', '+1 day')));")
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 1)
+
+	columns := schema.Tables[0].Columns
+	assert.Equal(t, "((1 + 2) * 3)", columns[1].DefaultValue)
+	assert.Equal(t, "(date('now', '+1 day'))", columns[2].DefaultValue)
+
+	result, err := s.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "DEFAULT ((1 + 2) * 3)")
+	assert.Contains(t, result, "DEFAULT (date('now', '+1 day'))")
+}
+
+func TestSQLite_Generate_IntervalDefaults(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "tasks",
+			Columns: []sqlmapper.Column{
+				{Name: "due", DataType: "DATE", IsNullable: true, DefaultValue: "(CURRENT_DATE + INTERVAL 1 DAY)"},
+				{Name: "remind_at", DataType: "DATETIME", IsNullable: true, DefaultValue: "(now() - interval 2 hour)"},
+				{Name: "expires_at", DataType: "DATETIME", IsNullable: true, DefaultValue: "(CURRENT_TIMESTAMP + INTERVAL 1 WEEK)"},
+			},
+		}},
+	}
+
+	var warnings []string
+	generator := NewSQLite().(*SQLite)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "due DATE DEFAULT (date('now', '+1 days'))")
+	assert.Contains(t, result, "remind_at DATETIME DEFAULT (datetime('now', '-2 hours'))")
+	assert.Contains(t, result, "expires_at DATETIME\n")
+	assert.Equal(t, []string{
+		"default (CURRENT_TIMESTAMP + INTERVAL 1 WEEK) of column tasks.expires_at uses INTERVAL, which SQLite does not support, and was dropped",
+	}, warnings)
+}
+
+func TestSQLite_ReservedWordIndexColumns(t *testing.T) {
+	s := NewSQLite()
+	schema, err := s.Parse("CREATE TABLE orders (id INTEGER PRIMARY KEY, \"order\" INTEGER, key TEXT);\n" +
+		"CREATE INDEX idx_order ON orders (\"order\" DESC, orders.key);\n" +
+		"CREATE INDEX idx_order_key ON orders (`order`, [key]);")
+	assert.NoError(t, err)
+
+	table := schema.Tables[0]
+	assert.Len(t, table.Columns, 3)
+	assert.Equal(t, "key", table.Columns[2].Name)
+
+	assert.Len(t, table.Indexes, 2)
+	assert.Equal(t, []sqlmapper.IndexColumn{{Name: "order", Descending: true}, {Name: "key"}}, table.Indexes[0].Columns)
+	assert.Equal(t, []string{"order", "key"}, table.Indexes[1].ColumnNames())
+
+	result, err := s.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, `CREATE INDEX idx_order ON orders("order" DESC, "key");`)
+	assert.Contains(t, result, `CREATE INDEX idx_order_key ON orders("order", "key");`)
+}
+
+func TestSQLite_ParseErrors(t *testing.T) {
+	s := NewSQLite()
+
+	_, err := s.Parse("")
+	var validationErr *sqlmapper.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	_, err = s.Parse("CREATE TABLE users (id INTEGER);\n\nCREATE INDEX idx_email ON accounts (email);")
+	assert.EqualError(t, err, `error parsing CREATE INDEX: line 3 near "CREATE INDEX idx_email ON accounts (emai...": table not found for index: accounts`)
+	var parseErr *sqlmapper.ParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, 3, parseErr.Line)
+	}
+
+	_, err = s.Parse("CREATE TABLE users;")
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, 1, parseErr.Line)
+		assert.EqualError(t, parseErr.Err, "no columns found in CREATE TABLE statement")
+	}
+}
@@ -4,31 +4,83 @@ package sqlserver
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/mstgnz/sqlmapper"
 )
 
+// sessionStatements wrap the generated output. Constraints are disabled on every
+// table of the database and validated again once the output has been loaded.
+var sessionStatements = sqlmapper.SessionStatements{
+	Begin:                   "BEGIN TRANSACTION",
+	Commit:                  "COMMIT TRANSACTION",
+	DisableForeignKeyChecks: "EXEC sp_MSforeachtable 'ALTER TABLE ? NOCHECK CONSTRAINT ALL'",
+	EnableForeignKeyChecks:  "EXEC sp_MSforeachtable 'ALTER TABLE ? WITH CHECK CHECK CONSTRAINT ALL'",
+}
+
+// temporalTypes map temporal types with fractional seconds to SQL Server. DATETIME and
+// TIMESTAMP, a row version in SQL Server, take no precision and become DATETIME2.
+var temporalTypes = map[string]string{
+	"DATETIME":    "DATETIME2",
+	"TIMESTAMP":   "DATETIME2",
+	"TIMESTAMPTZ": "DATETIMEOFFSET",
+	"TIMETZ":      "TIME",
+}
+
+// maxFractionalSeconds is the largest fractional-second precision of SQL Server
+const maxFractionalSeconds = 7
+
+// castTypes map the types of PostgreSQL casts to SQL Server
+var castTypes = map[string]string{
+	"json":                     "NVARCHAR(MAX)",
+	"jsonb":                    "NVARCHAR(MAX)",
+	"text":                     "NVARCHAR(MAX)",
+	"character varying":        "NVARCHAR(MAX)",
+	"varchar":                  "NVARCHAR(MAX)",
+	"integer":                  "INT",
+	"int4":                     "INT",
+	"int8":                     "BIGINT",
+	"boolean":                  "BIT",
+	"timestamp":                "DATETIME2",
+	"timestamptz":              "DATETIMEOFFSET",
+	"timestamp with time zone": "DATETIMEOFFSET",
+	"uuid":                     "UNIQUEIDENTIFIER",
+}
+
 // SQLServer represents a SQL Server parser implementation that handles parsing and generating
 // SQL Server database schemas. It maintains an internal schema representation and provides
 // methods for converting between SQL Server SQL and the common schema format.
 type SQLServer struct {
-	schema *sqlmapper.Schema
-	buf    *bytes.Buffer // Buffer for parsing operations
+	schema  *sqlmapper.Schema
+	buf     *bytes.Buffer // Buffer for parsing operations
+	options sqlmapper.GenerateOptions
 }
 
 // NewSQLServer creates and initializes a new SQL Server parser instance.
 // It returns a parser that can handle SQL Server specific SQL syntax and schema structures.
-func NewSQLServer() sqlmapper.Database {
+func NewSQLServer() sqlmapper.Dialect {
 	return &SQLServer{
 		schema: &sqlmapper.Schema{},
 		buf:    bytes.NewBuffer(nil),
 	}
 }
 
+// SetGenerateOptions configures optional output of the generator.
+// SQL Server has no IF NOT EXISTS clause, so only the IF EXISTS guards of drops are preserved.
+func (s *SQLServer) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	s.options = options
+}
+
+// Type returns the database type of the dialect, sqlmapper.SQLServer
+func (s *SQLServer) Type() sqlmapper.DatabaseType {
+	return sqlmapper.SQLServer
+}
+
 // Parse takes a SQL Server SQL dump content and parses it into a common schema structure.
 // It processes various SQL Server objects including:
 // - Tables with columns and constraints
@@ -45,91 +97,68 @@ func NewSQLServer() sqlmapper.Database {
 //   - error: An error if parsing fails or if the content is empty
 func (s *SQLServer) Parse(content string) (*sqlmapper.Schema, error) {
 	if content == "" {
-		return nil, errors.New("empty content")
+		return nil, &sqlmapper.ValidationError{Problems: []string{"empty content"}}
 	}
 
-	// Convert content to bytes
-	contentBytes := []byte(content)
+	// Split by GO batch separators and semicolons, keeping BEGIN ... END bodies intact
+	for _, statement := range sqlmapper.SplitStatements(content, "GO") {
+		stmt := []byte(statement.Text)
 
-	// Split content into statements
-	statements := s.splitStatements(contentBytes)
-
-	for _, stmt := range statements {
-		stmt = bytes.TrimSpace(stmt)
-		if len(stmt) == 0 {
-			continue
-		}
-
-		upperStmt := bytes.ToUpper(stmt)
-
-		switch {
-		case bytes.HasPrefix(upperStmt, []byte("CREATE TABLE")):
+		switch statement.Kind {
+		case sqlmapper.CreateTableStatement:
 			table, err := s.parseCreateTable(stmt)
 			if err != nil {
-				return nil, fmt.Errorf("error parsing CREATE TABLE: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE TABLE: %w", statement.WrapError(err))
 			}
 			s.schema.Tables = append(s.schema.Tables, table)
 
-		case bytes.HasPrefix(upperStmt, []byte("CREATE INDEX")) || bytes.HasPrefix(upperStmt, []byte("CREATE UNIQUE INDEX")):
+		case sqlmapper.CreateIndexStatement:
 			if err := s.parseCreateIndex(stmt); err != nil {
-				return nil, fmt.Errorf("error parsing CREATE INDEX: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE INDEX: %w", statement.WrapError(err))
 			}
 
-		case bytes.HasPrefix(upperStmt, []byte("ALTER TABLE")):
+		case sqlmapper.AlterTableStatement:
 			if err := s.parseAlterTable(stmt); err != nil {
-				return nil, fmt.Errorf("error parsing ALTER TABLE: %v", err)
+				return nil, fmt.Errorf("error parsing ALTER TABLE: %w", statement.WrapError(err))
 			}
 
-		case bytes.HasPrefix(upperStmt, []byte("CREATE VIEW")):
+		case sqlmapper.CreateViewStatement:
 			view, err := s.parseCreateView(stmt)
 			if err != nil {
-				return nil, fmt.Errorf("error parsing CREATE VIEW: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE VIEW: %w", statement.WrapError(err))
 			}
 			s.schema.Views = append(s.schema.Views, view)
 
-		case bytes.HasPrefix(upperStmt, []byte("CREATE TRIGGER")):
+		case sqlmapper.CreateTriggerStatement:
 			trigger, err := s.parseCreateTrigger(stmt)
 			if err != nil {
-				return nil, fmt.Errorf("error parsing CREATE TRIGGER: %v", err)
+				return nil, fmt.Errorf("error parsing CREATE TRIGGER: %w", statement.WrapError(err))
 			}
 			s.schema.Triggers = append(s.schema.Triggers, trigger)
-		}
-	}
-
-	return s.schema, nil
-}
 
-// splitStatements splits the SQL content into individual statements.
-// It handles both semicolon and GO statement terminators.
-func (s *SQLServer) splitStatements(content []byte) [][]byte {
-	var statements [][]byte
-	s.buf.Reset()
-
-	// First split by GO statements
-	goBlocks := bytes.Split(content, []byte("GO"))
+		case sqlmapper.GrantStatement, sqlmapper.RevokeStatement:
+			if permission, ok := sqlmapper.ParsePermission(statement.Text); ok {
+				s.schema.Permissions = append(s.schema.Permissions, *permission)
+			}
 
-	for _, block := range goBlocks {
-		block = bytes.TrimSpace(block)
-		if len(block) == 0 {
-			continue
-		}
+		case sqlmapper.DropStatement:
+			if drop, ok := sqlmapper.ParseDrop(statement.Text); ok {
+				s.schema.Drops = append(s.schema.Drops, *drop)
+			}
 
-		// Then split each GO block by semicolons
-		stmts := bytes.Split(block, []byte(";"))
-		for _, stmt := range stmts {
-			stmt = bytes.TrimSpace(stmt)
-			if len(stmt) == 0 || bytes.HasPrefix(stmt, []byte("--")) {
-				continue
+		case sqlmapper.SetStatement:
+			if settings, ok := sqlmapper.ParseSetStatement(statement.Text); ok {
+				s.schema.Settings = append(s.schema.Settings, settings...)
 			}
-			statements = append(statements, stmt)
 		}
 	}
 
-	return statements
+	return s.schema, nil
 }
 
 // parseCreateTable parses a CREATE TABLE statement and returns a Table structure.
 func (s *SQLServer) parseCreateTable(stmt []byte) (sqlmapper.Table, error) {
+	stmt = []byte(sqlmapper.StripComments(string(stmt)))
 	table := sqlmapper.Table{}
 
 	// Extract table name using bytes.Index and bytes.LastIndex
@@ -149,21 +178,21 @@ func (s *SQLServer) parseCreateTable(stmt []byte) (sqlmapper.Table, error) {
 	table.Name = string(tableName)
 
 	// Extract column definitions
-	columnsBytes := stmt[startIdx+endIdx+1 : bytes.LastIndex(stmt, []byte(")"))]
-	columnDefs := bytes.Split(columnsBytes, []byte(","))
+	columnsBody, _, ok := sqlmapper.Parenthesized(string(stmt[startIdx:]))
+	if !ok {
+		return table, fmt.Errorf("invalid CREATE TABLE statement")
+	}
 
-	for _, colDef := range columnDefs {
-		colDef = bytes.TrimSpace(colDef)
-		if len(colDef) == 0 {
-			continue
-		}
+	for _, definition := range sqlmapper.SplitDefinitions(columnsBody) {
+		colDef := []byte(definition)
 
 		// Handle table constraints
 		upperColDef := bytes.ToUpper(colDef)
 		if bytes.HasPrefix(upperColDef, []byte("CONSTRAINT")) ||
 			bytes.HasPrefix(upperColDef, []byte("PRIMARY KEY")) ||
 			bytes.HasPrefix(upperColDef, []byte("FOREIGN KEY")) ||
-			bytes.HasPrefix(upperColDef, []byte("UNIQUE")) {
+			bytes.HasPrefix(upperColDef, []byte("UNIQUE")) ||
+			bytes.HasPrefix(upperColDef, []byte("CHECK")) {
 			constraint := s.parseConstraint(colDef)
 			table.Constraints = append(table.Constraints, constraint)
 			continue
@@ -179,32 +208,23 @@ func (s *SQLServer) parseCreateTable(stmt []byte) (sqlmapper.Table, error) {
 
 // parseColumn parses a column definition and returns a Column structure.
 func (s *SQLServer) parseColumn(def []byte) sqlmapper.Column {
-	parts := bytes.Fields(def)
-	if len(parts) < 2 {
-		return sqlmapper.Column{}
-	}
-
 	column := sqlmapper.Column{
-		Name:       string(bytes.Trim(parts[0], "[]")),
-		DataType:   string(bytes.ToUpper(parts[1])),
 		IsNullable: true, // SQL Server columns are nullable by default
 	}
 
+	// Computed columns, such as total AS (price * quantity) PERSISTED, have no data type
+	rest, computed := column.ParseGenerated(string(def))
+	def = []byte(rest)
+
+	parts := bytes.Fields([]byte(sqlmapper.CompactTypeParameters(rest)))
+	if len(parts) == 0 || len(parts) < 2 && !computed {
+		return sqlmapper.Column{}
+	}
+	column.Name = string(bytes.Trim(parts[0], "[]"))
+
 	// Parse length/precision
-	if bytes.Contains(parts[1], []byte("(")) {
-		startIdx := bytes.Index(parts[1], []byte("("))
-		endIdx := bytes.Index(parts[1], []byte(")"))
-		if startIdx != -1 && endIdx != -1 {
-			column.DataType = string(parts[1][:startIdx])
-			sizeStr := string(parts[1][startIdx+1 : endIdx])
-			sizes := strings.Split(sizeStr, ",")
-			if len(sizes) > 0 {
-				fmt.Sscanf(sizes[0], "%d", &column.Length)
-				if len(sizes) > 1 {
-					fmt.Sscanf(sizes[1], "%d", &column.Scale)
-				}
-			}
-		}
+	if !computed {
+		column.DataType, column.Length, column.Scale = sqlmapper.ParseDataType(string(bytes.ToUpper(parts[1])))
 	}
 
 	upperDef := bytes.ToUpper(def)
@@ -230,14 +250,13 @@ func (s *SQLServer) parseColumn(def []byte) sqlmapper.Column {
 		column.AutoIncrement = true
 	}
 
+	// Handle CHECK
+	column.CheckExpression, _ = sqlmapper.CheckExpression(string(def))
+	column.ParseConstraintNames(string(def))
+
 	// Handle DEFAULT
-	if idx := bytes.Index(upperDef, []byte("DEFAULT")); idx != -1 {
-		restDef := upperDef[idx+7:]
-		endIdx := bytes.IndexAny(restDef, " ,")
-		if endIdx == -1 {
-			endIdx = len(restDef)
-		}
-		column.DefaultValue = string(bytes.Trim(restDef[:endIdx], "'()"))
+	if expression, ok := sqlmapper.DefaultExpression(string(def)); ok {
+		column.DefaultValue = parseDefault(expression)
 	}
 
 	return column
@@ -297,13 +316,7 @@ func (s *SQLServer) parseConstraint(def []byte) sqlmapper.Constraint {
 
 	case bytes.Contains(upperDef, []byte("CHECK")):
 		constraint.Type = "CHECK"
-		if idx := bytes.Index(upperDef, []byte("CHECK")); idx != -1 {
-			startIdx := bytes.Index(def[idx:], []byte("("))
-			endIdx := bytes.LastIndex(def, []byte(")"))
-			if startIdx != -1 && endIdx != -1 {
-				constraint.CheckExpression = string(bytes.TrimSpace(def[idx+startIdx+1 : endIdx]))
-			}
-		}
+		constraint.CheckExpression, _ = sqlmapper.CheckExpression(string(def))
 	}
 
 	return constraint
@@ -336,6 +349,15 @@ func (s *SQLServer) splitAndTrim(str string) []string {
 	return result
 }
 
+// splitIndexColumns splits the key columns of an index, naming them without brackets
+func (s *SQLServer) splitIndexColumns(list string) []sqlmapper.IndexColumn {
+	columns := sqlmapper.SplitIndexColumns(list)
+	for i := range columns {
+		columns[i].Name = strings.Trim(columns[i].Name, "[]")
+	}
+	return columns
+}
+
 // Generate creates a SQL Server SQL dump from a schema structure.
 // It generates SQL statements for all database objects in the schema, including:
 // - Tables with columns and constraints
@@ -351,54 +373,76 @@ func (s *SQLServer) splitAndTrim(str string) []string {
 //   - error: An error if generation fails or if the schema is nil
 func (s *SQLServer) Generate(schema *sqlmapper.Schema) (string, error) {
 	if schema == nil {
-		return "", errors.New("empty schema")
+		return "", &sqlmapper.ValidationError{Problems: []string{"empty schema"}}
 	}
 
 	s.buf.Reset()
 
-	for _, table := range schema.Tables {
-		s.buf.WriteString("CREATE TABLE ")
-		s.buf.WriteString(table.Name)
-		s.buf.WriteString(" (\n")
+	// Write session settings, disable foreign key checks and open the transaction
+	for _, stmt := range s.options.Prologue(sessionStatements) {
+		s.buf.WriteString(stmt + ";\n")
+	}
 
-		for i, col := range table.Columns {
-			s.buf.WriteString("    ")
-			s.buf.WriteString(col.Name)
-			s.buf.WriteByte(' ')
-			s.buf.WriteString(col.DataType)
+	for _, drop := range schema.Drops {
+		s.buf.WriteString(s.generateDropSQL(drop))
+		s.buf.WriteString(";\n")
+	}
 
-			if col.Length > 0 {
-				if col.Scale > 0 {
-					fmt.Fprintf(s.buf, "(%d,%d)", col.Length, col.Scale)
+	for _, table := range s.options.WithoutPartitions(schema.Tables) {
+		if table.SourceQuery != "" {
+			s.buf.WriteString(s.generateTableSQL(table) + ";\n")
+		} else {
+			s.options.WarnInherits(table)
+			s.buf.WriteString("CREATE TABLE ")
+			s.buf.WriteString(table.Name)
+			s.buf.WriteString(" (\n")
+
+			for i, col := range table.Columns {
+				s.buf.WriteString("    ")
+				s.buf.WriteString(sqlmapper.QuoteIdentifier(col.Name, "["))
+				col = s.options.ConvertSetType(table.Name, s.convertTemporal(table.Name, col), "VARCHAR")
+				s.options.MapColumn(table.Name, table.Columns[i], formatDataType(col))
+				if col.GeneratedExpression != "" {
+					s.buf.WriteString(s.computedColumn(col))
 				} else {
-					fmt.Fprintf(s.buf, "(%d)", col.Length)
+					s.buf.WriteByte(' ')
+					s.buf.WriteString(formatDataType(col))
 				}
-			}
 
-			if col.IsPrimaryKey {
-				s.buf.WriteString(" PRIMARY KEY")
-			} else if !col.IsNullable {
-				s.buf.WriteString(" NOT NULL")
-			}
+				if col.IsPrimaryKey {
+					s.buf.WriteString(" " + sqlmapper.ConstraintPrefix(col.PrimaryKeyName) + "PRIMARY KEY")
+				}
+				s.buf.WriteString(s.options.Nullability(col, col.IsPrimaryKey))
 
-			if col.IsUnique && !col.IsPrimaryKey {
-				s.buf.WriteString(" UNIQUE")
-			}
+				if col.IsUnique && !col.IsPrimaryKey {
+					s.buf.WriteString(" " + sqlmapper.ConstraintPrefix(col.UniqueName) + "UNIQUE")
+				}
 
-			if col.AutoIncrement {
-				s.buf.WriteString(" IDENTITY(1,1)")
-			}
+				if col.DefaultValue != "" {
+					s.buf.WriteString(" DEFAULT " + defaultValueSQL(col.DefaultValue))
+				}
+
+				if col.AutoIncrement {
+					s.buf.WriteString(" " + s.identityClause(table.Name, col))
+				}
 
-			if i < len(table.Columns)-1 {
-				s.buf.WriteByte(',')
+				if i < len(table.Columns)-1 {
+					s.buf.WriteByte(',')
+				}
+				s.buf.WriteByte('\n')
 			}
-			s.buf.WriteByte('\n')
-		}
 
-		s.buf.WriteString(");\n")
+			s.buf.WriteString(");\n")
+		}
 
 		// Add indexes
 		for _, idx := range table.Indexes {
+			idx = s.options.WholeColumnIndex(table.Name, idx)
+			if idx.Kind == sqlmapper.SpatialIndex {
+				s.buf.WriteString(s.generateIndexSQL(table.Name, idx) + ";\n")
+				continue
+			}
+			idx = s.options.PlainIndex(table.Name, idx)
 			if idx.IsUnique {
 				s.buf.WriteString("CREATE UNIQUE INDEX ")
 			} else {
@@ -408,14 +452,39 @@ func (s *SQLServer) Generate(schema *sqlmapper.Schema) (string, error) {
 			s.buf.WriteString(" ON ")
 			s.buf.WriteString(table.Name)
 			s.buf.WriteByte('(')
-			s.buf.WriteString(strings.Join(idx.Columns, ", "))
-			s.buf.WriteString(");\n")
+			s.buf.WriteString(idx.ColumnList("[", false))
+			s.buf.WriteByte(')')
+			if len(idx.IncludeColumns) > 0 {
+				s.buf.WriteString(" INCLUDE (")
+				s.buf.WriteString(strings.Join(idx.IncludeColumns, ", "))
+				s.buf.WriteByte(')')
+			}
+			s.buf.WriteString(";\n")
 		}
 	}
 
-	return s.buf.String(), nil
+	// Generate permissions
+	if s.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := s.generatePermissionSQL(permission); ok {
+				s.buf.WriteString(stmt + ";\n")
+			}
+		}
+	}
+
+	s.options.WarnSkipped(schema, 0)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range s.options.Epilogue(sessionStatements) {
+		s.buf.WriteString(stmt + ";\n")
+	}
+
+	return s.options.Format.Apply(s.buf.String()), nil
 }
 
+// includeRe matches the non-key columns of a covering index
+var includeRe = regexp.MustCompile(`(?i)\bINCLUDE\s*\(([^)]*)\)`)
+
 // parseCreateIndex parses a CREATE INDEX statement and adds the index to the appropriate table.
 func (s *SQLServer) parseCreateIndex(stmt []byte) error {
 	isUnique := bytes.HasPrefix(bytes.ToUpper(stmt), []byte("CREATE UNIQUE"))
@@ -447,22 +516,28 @@ func (s *SQLServer) parseCreateIndex(stmt []byte) error {
 		tableName = string(bytes.Trim(parts[tableNamePos][idx+1:], "[]"))
 	}
 
-	// Extract columns
-	startIdx := bytes.LastIndex(stmt, []byte("("))
-	endIdx := bytes.LastIndex(stmt, []byte(")"))
-	if startIdx == -1 || endIdx == -1 {
+	// Extract key columns, which precede any INCLUDE column list
+	startIdx := bytes.IndexByte(stmt, '(')
+	endIdx := bytes.IndexByte(stmt, ')')
+	if startIdx == -1 || endIdx < startIdx {
 		return fmt.Errorf("no columns found in CREATE INDEX statement")
 	}
 
-	columns := s.splitAndTrim(string(bytes.TrimSpace(stmt[startIdx+1 : endIdx])))
+	columns := s.splitIndexColumns(string(stmt[startIdx+1 : endIdx]))
+
+	var includeColumns []string
+	if match := includeRe.FindSubmatch(stmt); match != nil {
+		includeColumns = s.splitAndTrim(string(match[1]))
+	}
 
 	// Find the table and add the index
 	for i, table := range s.schema.Tables {
 		if table.Name == tableName {
 			s.schema.Tables[i].Indexes = append(s.schema.Tables[i].Indexes, sqlmapper.Index{
-				Name:     indexName,
-				Columns:  columns,
-				IsUnique: isUnique,
+				Name:           indexName,
+				Columns:        columns,
+				IncludeColumns: includeColumns,
+				IsUnique:       isUnique,
 			})
 			return nil
 		}
@@ -503,6 +578,9 @@ func (s *SQLServer) parseAlterTable(stmt []byte) error {
 	// Handle different ALTER TABLE operations
 	upperStmt := bytes.ToUpper(stmt)
 	switch {
+	case bytes.Contains(upperStmt, []byte(" DROP ")):
+		s.schema.ApplyAlterDrops(string(stmt))
+
 	case bytes.Contains(upperStmt, []byte("ADD CONSTRAINT")):
 		if idx := bytes.Index(upperStmt, []byte("ADD CONSTRAINT")); idx != -1 {
 			constraint := s.parseConstraint(stmt[idx:])
@@ -553,6 +631,9 @@ func (s *SQLServer) parseCreateView(stmt []byte) (sqlmapper.View, error) {
 	return view, nil
 }
 
+// triggerEventsRe matches the event list of a CREATE TRIGGER statement
+var triggerEventsRe = regexp.MustCompile(`(?is)\b(?:AFTER|FOR|INSTEAD\s+OF)\s+(.+?)\s+AS\b`)
+
 // parseCreateTrigger parses a CREATE TRIGGER statement and returns a Trigger structure.
 func (s *SQLServer) parseCreateTrigger(stmt []byte) (sqlmapper.Trigger, error) {
 	trigger := sqlmapper.Trigger{}
@@ -578,14 +659,9 @@ func (s *SQLServer) parseCreateTrigger(stmt []byte) (sqlmapper.Trigger, error) {
 		trigger.Timing = "FOR"
 	}
 
-	// Extract event (INSERT/UPDATE/DELETE)
-	switch {
-	case bytes.Contains(upperStmt, []byte("INSERT")):
-		trigger.Event = "INSERT"
-	case bytes.Contains(upperStmt, []byte("UPDATE")):
-		trigger.Event = "UPDATE"
-	case bytes.Contains(upperStmt, []byte("DELETE")):
-		trigger.Event = "DELETE"
+	// Extract events (INSERT/UPDATE/DELETE)
+	if match := triggerEventsRe.FindSubmatch(stmt); match != nil {
+		trigger.Events = sqlmapper.ParseTriggerEvents(string(match[1]))
 	}
 
 	// Extract table name
@@ -610,12 +686,13 @@ func (s *SQLServer) parseCreateTrigger(stmt []byte) (sqlmapper.Trigger, error) {
 }
 
 func (s *SQLServer) parseTables(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+TABLE\s+([.\w\[\]]+)\s*\((.*?)\)(?:\s+ON\s+(\w+))?`)
+	statement = sqlmapper.StripComments(statement)
+	re := regexp.MustCompile(`CREATE\s+TABLE\s+([.\w\[\]]+)\s*\(`)
 	matches := re.FindStringSubmatch(statement)
+	columnDefs, end, ok := sqlmapper.Parenthesized(statement)
 
-	if len(matches) > 2 {
+	if len(matches) > 1 && ok {
 		tableName := matches[1]
-		columnDefs := matches[2]
 
 		table := sqlmapper.Table{}
 
@@ -629,19 +706,17 @@ func (s *SQLServer) parseTables(statement string) error {
 		}
 
 		// Parse filegroup if exists
-		if len(matches) > 3 && matches[3] != "" {
-			table.TableSpace = matches[3]
+		if filegroup := regexp.MustCompile(`^\s+ON\s+(\w+)`).FindStringSubmatch(statement[end:]); filegroup != nil {
+			table.TableSpace = filegroup[1]
 		}
 
 		// Parse columns and constraints
-		columns := strings.Split(columnDefs, ",")
-		for _, col := range columns {
-			col = strings.TrimSpace(col)
-			if strings.HasPrefix(strings.ToUpper(col), "CONSTRAINT") {
+		for _, col := range sqlmapper.SplitDefinitions(columnDefs) {
+			if strings.HasPrefix(strings.ToUpper(col), "CONSTRAINT") || strings.HasPrefix(strings.ToUpper(col), "CHECK") {
 				continue // Skip constraints for now
 			}
 
-			parts := strings.Fields(col)
+			parts := strings.Fields(sqlmapper.CompactTypeParameters(col))
 			if len(parts) < 2 {
 				continue
 			}
@@ -653,20 +728,7 @@ func (s *SQLServer) parseTables(statement string) error {
 			}
 
 			// Parse length/precision
-			if strings.Contains(column.DataType, "(") {
-				re := regexp.MustCompile(`(\w+)\((\d+|MAX)(?:,(\d+))?\)`)
-				if matches := re.FindStringSubmatch(column.DataType); len(matches) > 2 {
-					column.DataType = matches[1]
-					if matches[2] == "MAX" {
-						column.Length = -1
-					} else {
-						fmt.Sscanf(matches[2], "%d", &column.Length)
-					}
-					if len(matches) > 3 && matches[3] != "" {
-						fmt.Sscanf(matches[3], "%d", &column.Scale)
-					}
-				}
-			}
+			column.DataType, column.Length, column.Scale = sqlmapper.ParseDataType(column.DataType)
 
 			// Parse constraints
 			if strings.Contains(strings.ToUpper(col), "NOT NULL") {
@@ -681,11 +743,10 @@ func (s *SQLServer) parseTables(statement string) error {
 			if strings.Contains(strings.ToUpper(col), "UNIQUE") {
 				column.IsUnique = true
 			}
-			if strings.Contains(strings.ToUpper(col), "DEFAULT") {
-				re := regexp.MustCompile(`DEFAULT\s+([^,\s]+)`)
-				if matches := re.FindStringSubmatch(col); len(matches) > 1 {
-					column.DefaultValue = matches[1]
-				}
+			column.CheckExpression, _ = sqlmapper.CheckExpression(col)
+			column.ParseConstraintNames(col)
+			if expression, ok := sqlmapper.DefaultExpression(col); ok {
+				column.DefaultValue = parseDefault(expression)
 			}
 
 			table.Columns = append(table.Columns, column)
@@ -698,7 +759,7 @@ func (s *SQLServer) parseTables(statement string) error {
 }
 
 func (s *SQLServer) parseViews(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+VIEW\s+([.\w\[\]]+)\s+AS\s+(.+)$`)
+	re := regexp.MustCompile(`CREATE(?:\s+OR\s+ALTER)?\s+VIEW\s+([.\w\[\]]+)\s+AS\s+(.+)$`)
 	matches := re.FindStringSubmatch(statement)
 
 	if len(matches) > 2 {
@@ -723,7 +784,7 @@ func (s *SQLServer) parseViews(statement string) error {
 }
 
 func (s *SQLServer) parseFunctions(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+(FUNCTION|PROCEDURE)\s+([.\w\[\]]+)\s*\((.*?)\)(?:\s+RETURNS\s+(\w+(?:\s*\([^)]*\))?))?\s+AS\s+BEGIN\s+(.*?)\s+END`)
+	re := regexp.MustCompile(`CREATE(?:\s+OR\s+ALTER)?\s+(FUNCTION|PROCEDURE)\s+([.\w\[\]]+)\s*\((.*?)\)(?:\s+RETURNS\s+(\w+(?:\s*\([^)]*\))?))?\s+AS\s+BEGIN\s+(.*?)\s+END`)
 	matches := re.FindStringSubmatch(statement)
 
 	if len(matches) > 4 {
@@ -769,16 +830,16 @@ func (s *SQLServer) parseFunctions(statement string) error {
 }
 
 func (s *SQLServer) parseTriggers(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+TRIGGER\s+([.\w\[\]]+)\s+ON\s+([.\w\[\]]+)\s+(AFTER|INSTEAD\s+OF|FOR)\s+(INSERT|UPDATE|DELETE)(?:\s*,\s*(INSERT|UPDATE|DELETE))*\s+AS\s+BEGIN\s+(.*?)\s+END`)
+	re := regexp.MustCompile(`CREATE(?:\s+OR\s+ALTER)?\s+TRIGGER\s+([.\w\[\]]+)\s+ON\s+([.\w\[\]]+)\s+(AFTER|INSTEAD\s+OF|FOR)\s+((?:INSERT|UPDATE|DELETE)(?:\s*,\s*(?:INSERT|UPDATE|DELETE))*)\s+AS\s+BEGIN\s+(.*?)\s+END`)
 	matches := re.FindStringSubmatch(statement)
 
-	if len(matches) > 6 {
+	if len(matches) > 5 {
 		triggerName := matches[1]
 		trigger := sqlmapper.Trigger{
 			Table:  matches[2],
 			Timing: matches[3],
-			Event:  matches[4],
-			Body:   matches[6],
+			Events: sqlmapper.ParseTriggerEvents(matches[4]),
+			Body:   matches[5],
 		}
 
 		// Parse schema if exists
@@ -797,31 +858,30 @@ func (s *SQLServer) parseTriggers(statement string) error {
 }
 
 func (s *SQLServer) parseIndexes(statement string) error {
-	re := regexp.MustCompile(`CREATE\s+(?:(UNIQUE|CLUSTERED|NONCLUSTERED)\s+)*INDEX\s+([.\w\[\]]+)\s+ON\s+([.\w\[\]]+)\s*\((.*?)\)(?:\s+INCLUDE\s*\((.*?)\))?(?:\s+WITH\s*\((.*?)\))?(?:\s+ON\s+(\w+))?`)
+	re := regexp.MustCompile(`CREATE\s+((?:(?:UNIQUE|CLUSTERED|NONCLUSTERED)\s+)*)INDEX\s+([.\w\[\]]+)\s+ON\s+([.\w\[\]]+)\s*\((.*?)\)(?:\s+INCLUDE\s*\((.*?)\))?(?:\s+WITH\s*\((.*?)\))?(?:\s+ON\s+(\w+))?`)
 	matches := re.FindStringSubmatch(statement)
 
 	if len(matches) > 4 {
 		indexName := matches[2]
 		tableName := matches[3]
-		columns := strings.Split(matches[4], ",")
+		columns := s.splitIndexColumns(matches[4])
+		modifiers := strings.Fields(matches[1])
 
 		// Find the table
 		for i, table := range s.schema.Tables {
 			if table.Name == tableName || fmt.Sprintf("%s.%s", table.Schema, table.Name) == tableName {
 				index := sqlmapper.Index{
 					Name:        strings.Trim(indexName, "[]"),
-					Columns:     make([]string, len(columns)),
-					IsUnique:    strings.Contains(matches[1], "UNIQUE"),
-					IsClustered: strings.Contains(matches[1], "CLUSTERED"),
+					Columns:     columns,
+					IsUnique:    slices.Contains(modifiers, "UNIQUE"),
+					IsClustered: slices.Contains(modifiers, "CLUSTERED"),
 				}
 
-				// Clean column names
-				for j, col := range columns {
-					index.Columns[j] = strings.Trim(strings.TrimSpace(col), "[]")
+				// Parse the non-key columns of a covering index
+				if matches[5] != "" {
+					index.IncludeColumns = s.splitAndTrim(matches[5])
 				}
 
-				// Skip INCLUDE columns since they're not supported in the common schema
-
 				// Parse filegroup
 				if len(matches) > 7 && matches[7] != "" {
 					index.TableSpace = matches[7]
@@ -838,55 +898,149 @@ func (s *SQLServer) parseIndexes(statement string) error {
 
 // generateTableSQL generates SQL for a table
 func (s *SQLServer) generateTableSQL(table sqlmapper.Table) string {
-	sql := "CREATE TABLE " + table.Name + " (\n"
+	if table.SourceQuery != "" {
+		// SQL Server has no CREATE TABLE ... AS SELECT, use SELECT ... INTO instead. A
+		// temporary table is a # table, and the column names become derived table aliases.
+		name := table.Name
+		if table.Temporary && !strings.HasPrefix(name, "#") {
+			name = "#" + name
+		}
+		source := "source"
+		if names := sqlmapper.CreateTableAsColumns(table); names != nil {
+			source += " (" + strings.Join(names, ", ") + ")"
+		}
+		return "SELECT * INTO " + name + " FROM (" + table.SourceQuery + ") AS " + source
+	}
+
+	s.options.WarnInherits(table)
+	var sql strings.Builder
+	sql.Grow(sqlmapper.TableSizeHint(table))
+	sql.WriteString("CREATE TABLE ")
+	sql.WriteString(table.Name)
+	sql.WriteString(" (\n")
 
 	// Generate columns
 	for i, col := range table.Columns {
-		sql += "    " + col.Name + " " + col.DataType
-		if col.Length > 0 {
-			if strings.ToUpper(col.DataType) == "NVARCHAR" || strings.ToUpper(col.DataType) == "NCHAR" {
-				if col.Length == -1 {
-					sql += "(MAX)"
-				} else {
-					sql += fmt.Sprintf("(%d)", col.Length)
-				}
-			} else {
-				sql += fmt.Sprintf("(%d", col.Length)
-				if col.Scale > 0 {
-					sql += fmt.Sprintf(",%d", col.Scale)
-				}
-				sql += ")"
-			}
+		col = s.options.ConvertSetType(table.Name, s.convertTemporal(table.Name, col), "VARCHAR")
+		s.options.MapColumn(table.Name, table.Columns[i], formatDataType(col))
+		sql.WriteString("    ")
+		sql.WriteString(sqlmapper.QuoteIdentifier(col.Name, "["))
+		if col.GeneratedExpression != "" {
+			sql.WriteString(s.computedColumn(col))
+		} else {
+			sql.WriteString(" ")
+			sql.WriteString(formatDataType(col))
 		}
 
 		if col.IsPrimaryKey {
-			sql += " PRIMARY KEY"
+			sql.WriteString(" ")
+			sql.WriteString(sqlmapper.ConstraintPrefix(col.PrimaryKeyName))
+			sql.WriteString("PRIMARY KEY")
 			if col.AutoIncrement {
-				sql += " IDENTITY(1,1)"
+				sql.WriteString(" ")
+				sql.WriteString(s.identityClause(table.Name, col))
 			}
 		}
-		if !col.IsNullable {
-			sql += " NOT NULL"
-		}
+		sql.WriteString(s.options.Nullability(col, false))
 		if col.IsUnique {
-			sql += " UNIQUE"
+			sql.WriteString(" ")
+			sql.WriteString(sqlmapper.ConstraintPrefix(col.UniqueName))
+			sql.WriteString("UNIQUE")
 		}
 		if col.DefaultValue != "" {
-			sql += " DEFAULT " + col.DefaultValue
+			sql.WriteString(" DEFAULT ")
+			sql.WriteString(defaultValueSQL(col.DefaultValue))
 		}
 
 		if i < len(table.Columns)-1 {
-			sql += ",\n"
+			sql.WriteString(",\n")
 		}
 	}
 
-	sql += "\n)"
+	sql.WriteString("\n)")
 
-	return sql
+	return sql.String()
+}
+
+// computedColumn returns the AS (expr) clause of a generated column, which SQL Server
+// declares without a data type, followed by PERSISTED for a stored column. The type
+// of the column is kept by converting the expression to it.
+func (s *SQLServer) computedColumn(col sqlmapper.Column) string {
+	expression := col.GeneratedExpression
+	if col.DataType != "" {
+		expression = "CAST(" + expression + " AS " + formatDataType(col) + ")"
+	}
+	if col.GeneratedStored {
+		return " AS (" + expression + ") PERSISTED"
+	}
+	return " AS (" + expression + ")"
+}
+
+// formatDataType returns the data type of a column with its parameters, writing a
+// maximum length as MAX
+func formatDataType(col sqlmapper.Column) string {
+	if col.Length < 0 {
+		return col.DataType + "(MAX)"
+	}
+	return sqlmapper.FormatDataType(col)
+}
+
+// identityClause returns the IDENTITY property of an auto-increment column, with the
+// first value and increment of an identity column. SQL Server rejects explicit values
+// of an IDENTITY column unless IDENTITY_INSERT is on, so converting a GENERATED BY
+// DEFAULT identity column is warned about.
+func (s *SQLServer) identityClause(table string, col sqlmapper.Column) string {
+	if col.Identity == "BY DEFAULT" {
+		s.options.Warnf("identity column %s.%s was generated BY DEFAULT, SQL Server accepts explicit values only with SET IDENTITY_INSERT ON", table, col.Name)
+	}
+	start, increment := sqlmapper.IdentitySeed(col)
+	return fmt.Sprintf("IDENTITY(%d,%d)", start, increment)
+}
+
+// convertTemporal converts a temporal column with fractional seconds to a SQL Server
+// type taking a precision. Columns without fractional seconds are kept.
+func (s *SQLServer) convertTemporal(table string, col sqlmapper.Column) sqlmapper.Column {
+	if sqlmapper.FractionalSeconds(col) == 0 {
+		return col
+	}
+	col, reduced := sqlmapper.ConvertTemporalType(col, temporalTypes, maxFractionalSeconds)
+	if reduced {
+		s.options.Warnf("fractional seconds of column %s.%s were reduced to %d digits", table, col.Name, maxFractionalSeconds)
+	}
+	return col
+}
+
+// GenerateTable generates the CREATE TABLE statement of a single table, without
+// its indexes and without a terminating semicolon
+func (s *SQLServer) GenerateTable(table sqlmapper.Table) (string, error) {
+	return s.options.Format.Apply(s.generateTableSQL(table)), nil
+}
+
+// GenerateIndex generates the CREATE INDEX statement of a single index of a table,
+// without a terminating semicolon
+func (s *SQLServer) GenerateIndex(tableName string, index sqlmapper.Index) (string, error) {
+	return s.options.Format.Apply(s.generateIndexSQL(tableName, index)), nil
+}
+
+// GenerateView generates the CREATE VIEW statement of a single view, without a
+// terminating semicolon
+func (s *SQLServer) GenerateView(view sqlmapper.View) (string, error) {
+	return s.options.Format.Apply(s.generateViewSQL(view)), nil
+}
+
+// generateViewSQL generates SQL for a view
+func (s *SQLServer) generateViewSQL(view sqlmapper.View) string {
+	return fmt.Sprintf("CREATE VIEW %s AS\n%s", view.Name, view.Definition)
 }
 
 // generateIndexSQL generates SQL for an index
 func (s *SQLServer) generateIndexSQL(tableName string, index sqlmapper.Index) string {
+	index = s.options.WholeColumnIndex(tableName, index)
+	if index.Kind == sqlmapper.SpatialIndex {
+		return "CREATE SPATIAL INDEX " + index.Name + " ON " + tableName + " (" + index.ColumnList("[", false) + ")"
+	}
+	index = s.options.PlainIndex(tableName, index)
+
 	var sql string
 	if index.IsClustered {
 		sql = "CREATE CLUSTERED "
@@ -900,7 +1054,86 @@ func (s *SQLServer) generateIndexSQL(tableName string, index sqlmapper.Index) st
 		sql += "INDEX "
 	}
 
-	sql += index.Name + " ON " + tableName + " (" + strings.Join(index.Columns, ", ") + ")"
+	sql += index.Name + " ON " + tableName + " (" + index.ColumnList("[", false) + ")"
+	if len(index.IncludeColumns) > 0 {
+		sql += " INCLUDE (" + strings.Join(index.IncludeColumns, ", ") + ")"
+	}
 
 	return sql
 }
+
+// generateDropSQL generates SQL for a dropped object
+func (s *SQLServer) generateDropSQL(drop sqlmapper.Drop) string {
+	sql := "DROP " + drop.Type + " " + s.options.IfExists(drop.IfExists) + drop.QualifiedName()
+	if drop.Type == "INDEX" && drop.Table != "" {
+		sql += " ON " + drop.Table
+	}
+	return sql
+}
+
+// generatePermissionSQL creates a GRANT or REVOKE statement for the given permission.
+// Grants on every table of a schema become grants on the schema securable.
+func (s *SQLServer) generatePermissionSQL(permission sqlmapper.Permission) (string, bool) {
+	if strings.Contains(permission.Object, "*") {
+		s.options.Warnf("global privileges on %s granted to %s are not supported", permission.Object, permission.Grantee)
+		return "", false
+	}
+
+	object := permission.Object
+	if permission.ObjectType == "SCHEMA" || permission.ObjectType == "ALL TABLES IN SCHEMA" {
+		object = "SCHEMA::" + object
+	}
+
+	user, _ := permission.Account()
+	return sqlmapper.PermissionStatement(permission, object, user), true
+}
+
+// parseDefault returns the value of a DEFAULT expression as the other parsers store it,
+// without the parentheses SQL Server encloses defaults in and with string literals,
+// including Unicode N'...' literals, unquoted
+func parseDefault(expression string) string {
+	value := sqlmapper.TrimParens(expression)
+	if len(value) > 2 && (value[0] == 'N' || value[0] == 'n') && value[1] == '\'' {
+		if unquoted := sqlmapper.UnquoteLiteral(value[1:]); unquoted != value[1:] {
+			return unquoted
+		}
+	}
+	return sqlmapper.UnquoteLiteral(value)
+}
+
+// defaultValueSQL returns the SQL of a column default. The parsers store string
+// defaults without their quotes, so values other than keywords, numbers, function
+// calls, casts and literals are quoted again.
+func defaultValueSQL(value string) string {
+	switch strings.ToUpper(value) {
+	case "NULL", "TRUE", "FALSE", "CURRENT_TIMESTAMP":
+		return value
+	}
+	if strings.HasPrefix(value, "'") || strings.HasPrefix(strings.ToUpper(value), "N'") ||
+		strings.Contains(value, "(") || strings.Contains(value, "::") {
+		return sqlmapper.RewriteCasts(sqlmapper.CurrentTimeDefault(value), castTypes)
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	if current := sqlmapper.CurrentTimeDefault(value); current != value {
+		return current
+	}
+	return quoteLiteral(value)
+}
+
+// quoteLiteral quotes a value as a SQL Server string literal, doubling its single
+// quotes. Values with characters outside of ASCII are written as Unicode N'...'
+// literals. A backslash followed by a line break continues the line in SQL Server, so
+// such line breaks are concatenated as CHAR(10) instead.
+func quoteLiteral(value string) string {
+	prefix := ""
+	if strings.IndexFunc(value, func(r rune) bool { return r > unicode.MaxASCII }) >= 0 {
+		prefix = "N"
+	}
+	quoted := prefix + sqlmapper.QuoteLiteral(value)
+	if strings.Contains(value, "\\\n") {
+		quoted = "(" + strings.ReplaceAll(quoted, "\\\n", "\\' + CHAR(10) + "+prefix+"'") + ")"
+	}
+	return quoted
+}
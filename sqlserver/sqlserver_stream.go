@@ -3,6 +3,7 @@ package sqlserver
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -13,6 +14,7 @@ import (
 // SQLServerStreamParser implements the StreamParser interface for SQL Server
 type SQLServerStreamParser struct {
 	sqlserver *SQLServer
+	options   stream.ParseOptions
 }
 
 // NewSQLServerStreamParser creates a new SQL Server stream parser
@@ -22,140 +24,98 @@ func NewSQLServerStreamParser() *SQLServerStreamParser {
 	}
 }
 
+// SetOptions configures optional parsing behaviour such as comment capture
+func (p *SQLServerStreamParser) SetOptions(options stream.ParseOptions) {
+	p.options = options
+}
+
+// SetGenerateOptions configures optional output of GenerateStream
+func (p *SQLServerStreamParser) SetGenerateOptions(options sqlmapper.GenerateOptions) {
+	p.sqlserver.SetGenerateOptions(options)
+}
+
 // ParseStream implements the StreamParser interface
 func (p *SQLServerStreamParser) ParseStream(reader io.Reader, callback func(stream.SchemaObject) error) error {
-	streamReader := stream.NewStreamReader(reader, "GO")
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithBatchSeparator("GO").WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
 
 	for {
 		statement, err := streamReader.ReadStatement()
+		if err := budget.Check(); err != nil {
+			return err
+		}
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("error reading statement: %v", err)
+			return fmt.Errorf("error reading statement: %w", err)
 		}
 
 		statement = strings.TrimSpace(statement)
-		if statement == "" {
+		if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 			continue
 		}
 
-		// Parse CREATE TABLE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TABLE") {
-			table, err := p.parseTableStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TableObject,
-				Data: table,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		obj, err := p.parseStatement(statement)
+		if err == nil && obj == nil {
+			pos := streamReader.Position()
+			err = p.options.Unhandled(stream.Statement{Text: statement, Line: pos.Line, Offset: pos.Offset})
 		}
-
-		// Parse CREATE VIEW statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE VIEW") {
-			view, err := p.parseViewStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ViewObject,
-				Data: view,
-			})
-			if err != nil {
+		if err != nil {
+			if err := errs.Handle(stream.Statement{Text: statement, Line: streamReader.Line()}, err); err != nil {
 				return err
 			}
 			continue
 		}
-
-		// Parse CREATE FUNCTION statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE FUNCTION") {
-			function, err := p.parseFunctionStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.FunctionObject,
-				Data: function,
-			})
-			if err != nil {
-				return err
-			}
+		if obj == nil || !p.options.Accept(obj) {
 			continue
 		}
+		obj.SetCreateFlags(statement)
 
-		// Parse CREATE PROCEDURE statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE PROCEDURE") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE PROC") {
-			procedure, err := p.parseProcedureStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.ProcedureObject,
-				Data: procedure,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		if p.options.CaptureComments {
+			obj.SetSourceComment(streamReader.LeadingComment())
 		}
 
-		// Parse CREATE TRIGGER statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE TRIGGER") {
-			trigger, err := p.parseTriggerStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.TriggerObject,
-				Data: trigger,
-			})
-			if err != nil {
-				return err
-			}
-			continue
+		if err := budget.Object(); err != nil {
+			return err
 		}
-
-		// Parse CREATE INDEX statements
-		if strings.HasPrefix(strings.ToUpper(statement), "CREATE INDEX") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE UNIQUE INDEX") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE CLUSTERED INDEX") ||
-			strings.HasPrefix(strings.ToUpper(statement), "CREATE NONCLUSTERED INDEX") {
-			index, err := p.parseIndexStatement(statement)
-			if err != nil {
-				return err
-			}
-
-			err = callback(stream.SchemaObject{
-				Type: stream.IndexObject,
-				Data: index,
-			})
-			if err != nil {
-				return err
+		if err := callback(*obj); err != nil {
+			if stream.IsStopIteration(err) {
+				break
 			}
-			continue
+			return err
 		}
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // ParseStreamParallel implements parallel processing for SQL Server stream parsing
 func (p *SQLServerStreamParser) ParseStreamParallel(reader io.Reader, callback func(stream.SchemaObject) error, workers int) error {
-	streamReader := stream.NewStreamReader(reader, "GO")
-	statements := make(chan string, workers)
-	results := make(chan stream.SchemaObject, workers)
-	errors := make(chan error, workers)
+	if err := stream.CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
+	budget := p.options.NewBudget()
+	streamReader := stream.NewStreamReader(budget.Reader(reader), ";").WithBatchSeparator("GO").WithCommentCapture(p.options.CaptureComments).
+		WithMaxStatementSize(p.options.MaxStatementSize)
+	errs := p.options.NewErrorCollector()
+	workers, buffer := p.options.Parallelism(workers)
+	statements := make(chan stream.Statement, buffer)
+	results := make(chan stream.SchemaObject, buffer)
+	// Room for an error of each worker and one of the reader
+	errors := make(chan error, workers+1)
+	// Closed when the results are no longer read or a worker failed, so that the
+	// reader and the other workers do not block on their channels
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	halt := func() { stopOnce.Do(func() { close(stop) }) }
 	var wg sync.WaitGroup
 
 	// Start worker goroutines
@@ -164,13 +124,28 @@ func (p *SQLServerStreamParser) ParseStreamParallel(reader io.Reader, callback f
 		go func() {
 			defer wg.Done()
 			for statement := range statements {
-				obj, err := p.parseStatement(statement)
+				obj, err := p.parseStatement(statement.Text)
+				if err == nil && obj == nil {
+					err = p.options.Unhandled(statement)
+				}
 				if err != nil {
-					errors <- err
-					return
+					if err := errs.Handle(statement, err); err != nil {
+						errors <- err
+						halt()
+						return
+					}
+					continue
 				}
-				if obj != nil {
-					results <- *obj
+				if obj != nil && p.options.Accept(obj) {
+					obj.SetCreateFlags(statement.Text)
+					if p.options.CaptureComments {
+						obj.SetSourceComment(statement.LeadingComment)
+					}
+					select {
+					case results <- *obj:
+					case <-stop:
+						return
+					}
 				}
 			}
 		}()
@@ -186,26 +161,49 @@ func (p *SQLServerStreamParser) ParseStreamParallel(reader io.Reader, callback f
 	go func() {
 		for {
 			statement, err := streamReader.ReadStatement()
+			if err := budget.Check(); err != nil {
+				errors <- err
+				break
+			}
 			if err == io.EOF {
 				break
 			}
 			if err != nil {
-				errors <- fmt.Errorf("error reading statement: %v", err)
+				errors <- fmt.Errorf("error reading statement: %w", err)
 				break
 			}
 
 			statement = strings.TrimSpace(statement)
-			if statement == "" {
+			if stream.BlankStatement(statement) || p.options.SkipStatement(statement) {
 				continue
 			}
-			statements <- statement
+			next := stream.Statement{
+				Text:           statement,
+				LeadingComment: streamReader.LeadingComment(),
+				Line:           streamReader.Line(),
+				Offset:         streamReader.Position().Offset,
+			}
+			select {
+			case statements <- next:
+			case <-stop:
+				close(statements)
+				return
+			}
 		}
 		close(statements)
 	}()
 
 	// Process results and handle errors
 	for obj := range results {
+		if err := budget.Object(); err != nil {
+			halt()
+			return err
+		}
 		if err := callback(obj); err != nil {
+			halt()
+			if stream.IsStopIteration(err) {
+				return errs.Err()
+			}
 			return err
 		}
 	}
@@ -215,16 +213,14 @@ func (p *SQLServerStreamParser) ParseStreamParallel(reader io.Reader, callback f
 	case err := <-errors:
 		return err
 	default:
-		return nil
+		return errs.Err()
 	}
 }
 
 // parseStatement parses a single SQL statement and returns a SchemaObject
 func (p *SQLServerStreamParser) parseStatement(statement string) (*stream.SchemaObject, error) {
-	upperStatement := strings.ToUpper(statement)
-
-	switch {
-	case strings.HasPrefix(upperStatement, "CREATE TABLE"):
+	switch sqlmapper.ClassifyStatement(statement) {
+	case sqlmapper.CreateTableStatement:
 		table, err := p.parseTableStatement(statement)
 		if err != nil {
 			return nil, err
@@ -234,7 +230,7 @@ func (p *SQLServerStreamParser) parseStatement(statement string) (*stream.Schema
 			Data: table,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE VIEW"):
+	case sqlmapper.CreateViewStatement:
 		view, err := p.parseViewStatement(statement)
 		if err != nil {
 			return nil, err
@@ -244,7 +240,7 @@ func (p *SQLServerStreamParser) parseStatement(statement string) (*stream.Schema
 			Data: view,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE FUNCTION"):
+	case sqlmapper.CreateFunctionStatement:
 		function, err := p.parseFunctionStatement(statement)
 		if err != nil {
 			return nil, err
@@ -254,7 +250,7 @@ func (p *SQLServerStreamParser) parseStatement(statement string) (*stream.Schema
 			Data: function,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE PROCEDURE") || strings.HasPrefix(upperStatement, "CREATE PROC"):
+	case sqlmapper.CreateProcedureStatement:
 		procedure, err := p.parseProcedureStatement(statement)
 		if err != nil {
 			return nil, err
@@ -264,7 +260,7 @@ func (p *SQLServerStreamParser) parseStatement(statement string) (*stream.Schema
 			Data: procedure,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE TRIGGER"):
+	case sqlmapper.CreateTriggerStatement:
 		trigger, err := p.parseTriggerStatement(statement)
 		if err != nil {
 			return nil, err
@@ -274,10 +270,7 @@ func (p *SQLServerStreamParser) parseStatement(statement string) (*stream.Schema
 			Data: trigger,
 		}, nil
 
-	case strings.HasPrefix(upperStatement, "CREATE INDEX") ||
-		strings.HasPrefix(upperStatement, "CREATE UNIQUE INDEX") ||
-		strings.HasPrefix(upperStatement, "CREATE CLUSTERED INDEX") ||
-		strings.HasPrefix(upperStatement, "CREATE NONCLUSTERED INDEX"):
+	case sqlmapper.CreateIndexStatement:
 		index, err := p.parseIndexStatement(statement)
 		if err != nil {
 			return nil, err
@@ -288,26 +281,81 @@ func (p *SQLServerStreamParser) parseStatement(statement string) (*stream.Schema
 		}, nil
 	}
 
+	if permission, ok := sqlmapper.ParsePermission(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.PermissionObject,
+			Data: permission,
+		}, nil
+	}
+
+	if drop, ok := sqlmapper.ParseDrop(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.DropObject,
+			Data: drop,
+		}, nil
+	}
+
+	if settings, ok := sqlmapper.ParseSetStatement(statement); ok {
+		return &stream.SchemaObject{
+			Type: stream.SettingObject,
+			Data: settings,
+		}, nil
+	}
+
 	return nil, nil
 }
 
+// parseInto parses a single statement with the given SQLServer parser method into a
+// schema of its own. The shared parser is never modified, so statements can be
+// parsed concurrently by the workers of ParseStreamParallel.
+func (p *SQLServerStreamParser) parseInto(parse func(*SQLServer, string) error, statement string) (*sqlmapper.Schema, error) {
+	parser := &SQLServer{schema: &sqlmapper.Schema{}, options: p.sqlserver.options}
+	if err := parse(parser, statement); err != nil {
+		return nil, err
+	}
+	return parser.schema, nil
+}
+
+// TransformStream parses a dump, applies transform to every object and writes the
+// result right away, see stream.TransformStream
+func (p *SQLServerStreamParser) TransformStream(reader io.Reader, writer io.Writer, transform stream.TransformFunc) error {
+	return stream.TransformStream(p, p.sqlserver, reader, writer, transform)
+}
+
 // GenerateStream implements the StreamParser interface
 func (p *SQLServerStreamParser) GenerateStream(schema *sqlmapper.Schema, writer io.Writer) error {
 	if schema == nil {
-		return fmt.Errorf("schema cannot be nil")
+		return &sqlmapper.ValidationError{Problems: []string{"schema cannot be nil"}}
+	}
+	schema = p.sqlserver.options.Incremental(schema)
+	writer = p.sqlserver.options.Format.Writer(writer)
+
+	// Write session settings, disable foreign key checks and open the transaction
+	for _, stmt := range p.sqlserver.options.Prologue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\nGO\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write drops
+	for _, drop := range schema.Drops {
+		stmt := p.sqlserver.generateDropSQL(drop)
+		if err := sqlmapper.WriteStatement(writer, stmt, "\nGO\n\n"); err != nil {
+			return err
+		}
 	}
 
 	// Write tables
-	for _, table := range schema.Tables {
+	for _, table := range p.sqlserver.options.WithoutPartitions(schema.Tables) {
 		stmt := p.sqlserver.generateTableSQL(table)
-		if _, err := writer.Write([]byte(stmt + "\nGO\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, stmt, "\nGO\n\n"); err != nil {
 			return err
 		}
 
 		// Generate indexes for this table
 		for _, index := range table.Indexes {
 			stmt := p.sqlserver.generateIndexSQL(table.Name, index)
-			if _, err := writer.Write([]byte(stmt + "\nGO\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, "\nGO\n"); err != nil {
 				return err
 			}
 		}
@@ -315,8 +363,7 @@ func (p *SQLServerStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 
 	// Write views
 	for _, view := range schema.Views {
-		stmt := fmt.Sprintf("CREATE VIEW %s AS\n%s", view.Name, view.Definition)
-		if _, err := writer.Write([]byte(stmt + "\nGO\n\n")); err != nil {
+		if err := sqlmapper.WriteStatement(writer, p.sqlserver.generateViewSQL(view), "\nGO\n\n"); err != nil {
 			return err
 		}
 	}
@@ -333,7 +380,7 @@ func (p *SQLServerStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 			}
 			stmt += fmt.Sprintf(")\nRETURNS %s\nAS\nBEGIN\n%s\nEND",
 				function.Returns, function.Body)
-			if _, err := writer.Write([]byte(stmt + "\nGO\n\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, "\nGO\n\n"); err != nil {
 				return err
 			}
 		}
@@ -354,7 +401,7 @@ func (p *SQLServerStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 				stmt += ")"
 			}
 			stmt += fmt.Sprintf("\nAS\nBEGIN\n%s\nEND", function.Body)
-			if _, err := writer.Write([]byte(stmt + "\nGO\n\n")); err != nil {
+			if err := sqlmapper.WriteStatement(writer, stmt, "\nGO\n\n"); err != nil {
 				return err
 			}
 		}
@@ -363,8 +410,28 @@ func (p *SQLServerStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 	// Write triggers
 	for _, trigger := range schema.Triggers {
 		stmt := fmt.Sprintf("CREATE TRIGGER %s ON %s\n%s %s\nAS\nBEGIN\n%s\nEND",
-			trigger.Name, trigger.Table, trigger.Timing, trigger.Event, trigger.Body)
-		if _, err := writer.Write([]byte(stmt + "\nGO\n\n")); err != nil {
+			trigger.Name, trigger.Table, trigger.Timing, trigger.EventClause(", "), trigger.Body)
+		if err := sqlmapper.WriteStatement(writer, stmt, "\nGO\n\n"); err != nil {
+			return err
+		}
+	}
+
+	// Write permissions
+	if p.sqlserver.options.IncludePermissions {
+		for _, permission := range schema.Permissions {
+			if stmt, ok := p.sqlserver.generatePermissionSQL(permission); ok {
+				if err := sqlmapper.WriteStatement(writer, stmt, ";\n"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	p.sqlserver.options.WarnSkipped(schema, sqlmapper.ViewObjects|sqlmapper.FunctionObjects|sqlmapper.ProcedureObjects|sqlmapper.TriggerObjects)
+
+	// Commit the transaction and enable foreign key checks again
+	for _, stmt := range p.sqlserver.options.Epilogue(sessionStatements) {
+		if err := sqlmapper.WriteStatement(writer, stmt, ";\nGO\n\n"); err != nil {
 			return err
 		}
 	}
@@ -374,10 +441,8 @@ func (p *SQLServerStreamParser) GenerateStream(schema *sqlmapper.Schema, writer
 
 // parseTableStatement parses a CREATE TABLE statement
 func (p *SQLServerStreamParser) parseTableStatement(statement string) (*sqlmapper.Table, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlserver.schema = tempSchema
-
-	if err := p.sqlserver.parseTables(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLServer).parseTables, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -390,10 +455,8 @@ func (p *SQLServerStreamParser) parseTableStatement(statement string) (*sqlmappe
 
 // parseViewStatement parses a CREATE VIEW statement
 func (p *SQLServerStreamParser) parseViewStatement(statement string) (*sqlmapper.View, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlserver.schema = tempSchema
-
-	if err := p.sqlserver.parseViews(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLServer).parseViews, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -406,10 +469,8 @@ func (p *SQLServerStreamParser) parseViewStatement(statement string) (*sqlmapper
 
 // parseFunctionStatement parses a CREATE FUNCTION statement
 func (p *SQLServerStreamParser) parseFunctionStatement(statement string) (*sqlmapper.Function, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlserver.schema = tempSchema
-
-	if err := p.sqlserver.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLServer).parseFunctions, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -428,10 +489,8 @@ func (p *SQLServerStreamParser) parseFunctionStatement(statement string) (*sqlma
 
 // parseProcedureStatement parses a CREATE PROCEDURE statement
 func (p *SQLServerStreamParser) parseProcedureStatement(statement string) (*sqlmapper.Procedure, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlserver.schema = tempSchema
-
-	if err := p.sqlserver.parseFunctions(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLServer).parseFunctions, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -456,10 +515,8 @@ func (p *SQLServerStreamParser) parseProcedureStatement(statement string) (*sqlm
 
 // parseTriggerStatement parses a CREATE TRIGGER statement
 func (p *SQLServerStreamParser) parseTriggerStatement(statement string) (*sqlmapper.Trigger, error) {
-	tempSchema := &sqlmapper.Schema{}
-	p.sqlserver.schema = tempSchema
-
-	if err := p.sqlserver.parseTriggers(statement); err != nil {
+	tempSchema, err := p.parseInto((*SQLServer).parseTriggers, statement)
+	if err != nil {
 		return nil, err
 	}
 
@@ -472,10 +529,14 @@ func (p *SQLServerStreamParser) parseTriggerStatement(statement string) (*sqlmap
 
 // parseIndexStatement parses a CREATE INDEX statement
 func (p *SQLServerStreamParser) parseIndexStatement(statement string) (*sqlmapper.Index, error) {
+	// Seed the indexed table so the parser can attach the index to it
 	tempSchema := &sqlmapper.Schema{}
-	p.sqlserver.schema = tempSchema
+	if matches := regexp.MustCompile(`(?i)\sON\s+([.\w\[\]]+)`).FindStringSubmatch(statement); len(matches) > 1 {
+		tempSchema.Tables = []sqlmapper.Table{{Name: matches[1]}}
+	}
+	parser := &SQLServer{schema: tempSchema, options: p.sqlserver.options}
 
-	if err := p.sqlserver.parseIndexes(statement); err != nil {
+	if err := parser.parseIndexes(statement); err != nil {
 		return nil, err
 	}
 
@@ -483,5 +544,7 @@ func (p *SQLServerStreamParser) parseIndexStatement(statement string) (*sqlmappe
 		return nil, fmt.Errorf("no index found in statement")
 	}
 
-	return &tempSchema.Tables[0].Indexes[0], nil
+	index := tempSchema.Tables[0].Indexes[0]
+	index.Table = tempSchema.Tables[0].Name
+	return &index, nil
 }
@@ -1,3 +1,179 @@
 package sqlserver
 
-// TODO implement tests
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLServerStreamParser_ParseStream_GOSeparator(t *testing.T) {
+	input := `CREATE TABLE users (id INT PRIMARY KEY, name TEXT NOT NULL)
+GO
+
+CREATE TABLE orders (id INT PRIMARY KEY, user_id INT NOT NULL)
+go 2
+
+CREATE VIEW active_users AS SELECT id, name FROM users
+GO
+`
+
+	parser := NewSQLServerStreamParser()
+
+	var objects []stream.SchemaObject
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		objects = append(objects, obj)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, objects, 3)
+
+	assert.Equal(t, stream.TableObject, objects[0].Type)
+	assert.Equal(t, "users", objects[0].Data.(*sqlmapper.Table).Name)
+	assert.Len(t, objects[0].Data.(*sqlmapper.Table).Columns, 2)
+
+	assert.Equal(t, stream.TableObject, objects[1].Type)
+	assert.Equal(t, "orders", objects[1].Data.(*sqlmapper.Table).Name)
+
+	assert.Equal(t, stream.ViewObject, objects[2].Type)
+	view := objects[2].Data.(*sqlmapper.View)
+	assert.Equal(t, "active_users", view.Name)
+	assert.Equal(t, "SELECT id, name FROM users", view.Definition)
+}
+
+func TestSQLServerStreamParser_ParseStreamParallel_GOSeparator(t *testing.T) {
+	input := "CREATE TABLE users (id INT PRIMARY KEY)\nGO\nCREATE TABLE orders (id INT PRIMARY KEY)\nGO\n"
+
+	parser := NewSQLServerStreamParser()
+
+	names := make(map[string]bool)
+	err := parser.ParseStreamParallel(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		names[obj.Data.(*sqlmapper.Table).Name] = true
+		return nil
+	}, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"users": true, "orders": true}, names)
+}
+
+func TestSQLServerStreamParser_ParseStream_CreateOrAlter(t *testing.T) {
+	input := `CREATE OR ALTER VIEW active_users AS SELECT id FROM users
+GO
+CREATE OR ALTER PROCEDURE cleanup (@days INT) AS BEGIN SELECT @days END
+GO
+CREATE OR ALTER FUNCTION add_one (@a INT) RETURNS INT AS BEGIN RETURN @a END
+GO
+CREATE OR ALTER TRIGGER audit_users ON users AFTER INSERT AS BEGIN SELECT 1 END
+GO
+`
+
+	parser := NewSQLServerStreamParser()
+	var names []string
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		names = append(names, obj.Name())
+		switch data := obj.Data.(type) {
+		case *sqlmapper.View:
+			assert.True(t, data.OrReplace)
+		case *sqlmapper.Procedure:
+			assert.True(t, data.OrReplace)
+		case *sqlmapper.Function:
+			assert.True(t, data.OrReplace)
+		case *sqlmapper.Trigger:
+			assert.True(t, data.OrReplace)
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"active_users", "cleanup", "add_one", "audit_users"}, names)
+}
+
+func TestSQLServerStreamParser_CoveringIndex(t *testing.T) {
+	input := "CREATE NONCLUSTERED INDEX idx_orders_customer ON orders (customer_id) INCLUDE (total, [status])\nGO\n"
+
+	parser := NewSQLServerStreamParser()
+	var index *sqlmapper.Index
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		index = obj.Data.(*sqlmapper.Index)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"customer_id"}, index.ColumnNames())
+	assert.Equal(t, []string{"total", "status"}, index.IncludeColumns)
+
+	schema := &sqlmapper.Schema{Tables: []sqlmapper.Table{{
+		Name:    "orders",
+		Columns: []sqlmapper.Column{{Name: "customer_id", DataType: "INT"}},
+		Indexes: []sqlmapper.Index{*index},
+	}}}
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "CREATE NONCLUSTERED INDEX idx_orders_customer ON orders (customer_id) INCLUDE (total, status)")
+}
+
+func TestSQLServerStreamParser_ParseStreamParallel_Concurrent(t *testing.T) {
+	var input strings.Builder
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&input, "CREATE TABLE t%[1]d (c%[1]d INT NOT NULL, name NVARCHAR(64));\n", i)
+	}
+
+	parser := NewSQLServerStreamParser()
+	seen := make(map[string]int)
+	err := parser.ParseStreamParallel(strings.NewReader(input.String()), func(obj stream.SchemaObject) error {
+		table := obj.Data.(*sqlmapper.Table)
+		seen[table.Name]++
+
+		// Every table must keep its own columns when workers parse concurrently
+		assert.Len(t, table.Columns, 2)
+		assert.Equal(t, "c"+strings.TrimPrefix(table.Name, "t"), table.Columns[0].Name)
+		return nil
+	}, 8)
+
+	assert.NoError(t, err)
+	assert.Len(t, seen, 200)
+	for name, count := range seen {
+		assert.Equal(t, 1, count, name)
+	}
+}
+
+func TestSQLServerStreamParser_StringDefaults(t *testing.T) {
+	input := `CREATE TABLE notes (
+    title NVARCHAR(50) DEFAULT (N'it''s'),
+    path VARCHAR(50) DEFAULT 'C:\Temp',
+    status VARCHAR(10) DEFAULT 'Draft' NOT NULL,
+    priority INT DEFAULT ((0))
+)
+GO
+`
+
+	parser := NewSQLServerStreamParser()
+
+	schema := &sqlmapper.Schema{}
+	err := parser.ParseStream(strings.NewReader(input), func(obj stream.SchemaObject) error {
+		schema.Tables = append(schema.Tables, *obj.Data.(*sqlmapper.Table))
+		return nil
+	})
+	assert.NoError(t, err)
+	if !assert.Len(t, schema.Tables, 1) {
+		return
+	}
+
+	var defaults []string
+	for _, column := range schema.Tables[0].Columns {
+		defaults = append(defaults, column.DefaultValue)
+	}
+	assert.Equal(t, []string{"it's", `C:\Temp`, "Draft", "0"}, defaults)
+
+	var output strings.Builder
+	assert.NoError(t, parser.GenerateStream(schema, &output))
+	assert.Contains(t, output.String(), "title NVARCHAR(50) DEFAULT 'it''s'")
+	assert.Contains(t, output.String(), `path VARCHAR(50) DEFAULT 'C:\Temp'`)
+	assert.Contains(t, output.String(), "status VARCHAR(10) NOT NULL DEFAULT 'Draft'")
+	assert.Contains(t, output.String(), "priority INT DEFAULT 0")
+}
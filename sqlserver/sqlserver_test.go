@@ -1,6 +1,7 @@
 package sqlserver
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -32,6 +33,28 @@ func TestSQLServer_Parse(t *testing.T) {
 				// Additional validation logic can be added here
 			},
 		},
+		{
+			name:    "GRANT and REVOKE",
+			content: "GRANT SELECT, UPDATE ON dbo.orders TO [app_user];\nGO\nREVOKE UPDATE ON OBJECT::dbo.orders FROM [app_user];\nGRANT EXECUTE ON SCHEMA::sales TO reporting;",
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				if assert.Len(t, schema.Permissions, 3) {
+					assert.Equal(t, []string{"SELECT", "UPDATE"}, schema.Permissions[0].Privileges)
+					assert.Equal(t, "dbo.orders", schema.Permissions[0].Object)
+					assert.Equal(t, "app_user", schema.Permissions[0].Grantee)
+					assert.Equal(t, "REVOKE", schema.Permissions[1].Type)
+					assert.Equal(t, "SCHEMA", schema.Permissions[2].ObjectType)
+				}
+
+				generator := NewSQLServer().(*SQLServer)
+				generator.SetGenerateOptions(sqlmapper.GenerateOptions{IncludePermissions: true})
+				result, err := generator.Generate(schema)
+				assert.NoError(t, err)
+				assert.Contains(t, result, "GRANT SELECT, UPDATE ON dbo.orders TO app_user;")
+				assert.Contains(t, result, "REVOKE UPDATE ON dbo.orders FROM app_user;")
+				assert.Contains(t, result, "GRANT EXECUTE ON SCHEMA::sales TO reporting;")
+			},
+		},
 		{
 			name:    "CREATE INDEX",
 			content: "CREATE TABLE test (id INT PRIMARY KEY, name NVARCHAR(50)); CREATE INDEX idx_name ON test (name);",
@@ -41,7 +64,23 @@ func TestSQLServer_Parse(t *testing.T) {
 				assert.Len(t, schema.Tables, 1)
 				assert.Len(t, schema.Tables[0].Indexes, 1)
 				assert.Equal(t, "idx_name", schema.Tables[0].Indexes[0].Name)
-				assert.Equal(t, []string{"name"}, schema.Tables[0].Indexes[0].Columns)
+				assert.Equal(t, []string{"name"}, schema.Tables[0].Indexes[0].ColumnNames())
+			},
+		},
+		{
+			name:    "CREATE INDEX with INCLUDE",
+			content: "CREATE TABLE orders (id INT PRIMARY KEY, customer_id INT, total DECIMAL(10,2), status NVARCHAR(20));\nCREATE INDEX idx_orders_customer ON orders (customer_id) INCLUDE ([total], status);",
+			wantErr: false,
+			validate: func(t *testing.T, schema *sqlmapper.Schema) {
+				assert.Len(t, schema.Tables, 1)
+				assert.Len(t, schema.Tables[0].Indexes, 1)
+				index := schema.Tables[0].Indexes[0]
+				assert.Equal(t, []string{"customer_id"}, index.ColumnNames())
+				assert.Equal(t, []string{"total", "status"}, index.IncludeColumns)
+
+				result, err := NewSQLServer().Generate(schema)
+				assert.NoError(t, err)
+				assert.Contains(t, result, "CREATE INDEX idx_orders_customer ON orders(customer_id) INCLUDE (total, status);")
 			},
 		},
 		{
@@ -142,8 +181,8 @@ CREATE TABLE users (
 							{Name: "price", DataType: "DECIMAL", Length: 10, Scale: 2, IsNullable: true},
 						},
 						Indexes: []sqlmapper.Index{
-							{Name: "idx_name", Columns: []string{"name"}},
-							{Name: "idx_price", Columns: []string{"price"}, IsUnique: true},
+							{Name: "idx_name", Columns: sqlmapper.IndexColumns("name")},
+							{Name: "idx_price", Columns: sqlmapper.IndexColumns("price"), IsUnique: true},
 						},
 					},
 				},
@@ -182,3 +221,179 @@ func TestSQLServer_Generate_ComplexSchema(t *testing.T) {
 	_, err := s.Generate(schema)
 	assert.NoError(t, err)
 }
+
+func TestSQLServer_Generate_Transaction(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INT"}}}},
+	}
+
+	generator := NewSQLServer().(*SQLServer)
+	generator.SetGenerateOptions(sqlmapper.GenerateOptions{
+		Transaction:     true,
+		SessionSettings: []string{"SET XACT_ABORT ON"},
+	})
+	result, err := generator.Generate(schema)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "SET XACT_ABORT ON;\nBEGIN TRANSACTION;\n"))
+	assert.True(t, strings.HasSuffix(result, "COMMIT TRANSACTION;\n"))
+}
+
+func TestSQLServer_Generate_TypeParameters(t *testing.T) {
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "prices",
+			Columns: []sqlmapper.Column{
+				{Name: "amount", DataType: "DECIMAL", Length: 10, Scale: 2},
+				{Name: "total", DataType: "DECIMAL", Precision: 12, Scale: 4},
+				{Name: "name", DataType: "VARCHAR", Length: 255},
+				{Name: "flag", DataType: "CHAR", Length: 1},
+				{Name: "created", DataType: "TIMESTAMP", Length: 6},
+				{Name: "notes", DataType: "NVARCHAR", Length: -1},
+			},
+		}},
+	}
+
+	result, err := NewSQLServer().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "amount DECIMAL(10,2)")
+	assert.Contains(t, result, "total DECIMAL(12,4)")
+	assert.Contains(t, result, "name VARCHAR(255)")
+	assert.Contains(t, result, "flag CHAR(1)")
+	assert.Contains(t, result, "created DATETIME2(6)")
+	assert.Contains(t, result, "notes NVARCHAR(MAX)")
+}
+
+func TestSQLServer_Generate_FractionalSeconds(t *testing.T) {
+	// Columns as parsed from MySQL DATETIME(3), DATETIME and TIME(6)
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "events",
+			Columns: []sqlmapper.Column{
+				{Name: "created", DataType: "DATETIME", Length: 3},
+				{Name: "logged", DataType: "DATETIME"},
+				{Name: "duration", DataType: "TIME", Length: 6},
+				{Name: "stamp", DataType: "DATETIME2", Length: 7},
+			},
+		}},
+	}
+
+	result, err := NewSQLServer().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "created DATETIME2(3)")
+	assert.Contains(t, result, "logged DATETIME NOT NULL")
+	assert.Contains(t, result, "duration TIME(6)")
+	assert.Contains(t, result, "stamp DATETIME2(7)")
+}
+
+func TestSQLServer_Generate_StringDefaults(t *testing.T) {
+	// String defaults as the other parsers store them, without their quotes
+	schema := &sqlmapper.Schema{
+		Tables: []sqlmapper.Table{{
+			Name: "notes",
+			Columns: []sqlmapper.Column{
+				{Name: "status", DataType: "VARCHAR", Length: 10, IsNullable: true, DefaultValue: "abc"},
+				{Name: "quote", DataType: "VARCHAR", Length: 10, IsNullable: true, DefaultValue: "it's"},
+				{Name: "path", DataType: "VARCHAR", Length: 50, IsNullable: true, DefaultValue: `C:\Temp`},
+				{Name: "body", DataType: "VARCHAR", Length: 50, IsNullable: true, DefaultValue: "line 1\nline 2"},
+				{Name: "continued", DataType: "VARCHAR", Length: 50, IsNullable: true, DefaultValue: "line 1\\\nline 2"},
+				{Name: "city", DataType: "NVARCHAR", Length: 50, IsNullable: true, DefaultValue: "İzmir"},
+				{Name: "amount", DataType: "INT", IsNullable: true, DefaultValue: "-1"},
+			},
+		}},
+	}
+
+	result, err := NewSQLServer().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "status VARCHAR(10) DEFAULT 'abc'")
+	assert.Contains(t, result, "quote VARCHAR(10) DEFAULT 'it''s'")
+	assert.Contains(t, result, `path VARCHAR(50) DEFAULT 'C:\Temp'`)
+	assert.Contains(t, result, "body VARCHAR(50) DEFAULT 'line 1\nline 2'")
+	assert.Contains(t, result, "continued VARCHAR(50) DEFAULT ('line 1\\' + CHAR(10) + 'line 2')")
+	assert.Contains(t, result, "city NVARCHAR(50) DEFAULT N'İzmir'")
+	assert.Contains(t, result, "amount INT DEFAULT -1")
+
+	// Parsing the output gives the defaults back
+	parsed, err := NewSQLServer().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, parsed.Tables, 1) {
+		assert.Equal(t, "it's", parsed.Tables[0].Columns[1].DefaultValue)
+		assert.Equal(t, `C:\Temp`, parsed.Tables[0].Columns[2].DefaultValue)
+		assert.Equal(t, "line 1\nline 2", parsed.Tables[0].Columns[3].DefaultValue)
+		assert.Equal(t, "İzmir", parsed.Tables[0].Columns[5].DefaultValue)
+	}
+}
+
+func TestSQLServer_GenerateObjects(t *testing.T) {
+	db := NewSQLServer()
+	table := sqlmapper.Table{
+		Name: "users",
+		Columns: []sqlmapper.Column{
+			{Name: "id", DataType: "INT"},
+			{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true},
+		},
+	}
+
+	sql, err := sqlmapper.GenerateTable(db, table)
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE TABLE users (")
+	assert.Contains(t, sql, "email VARCHAR(255)")
+	assert.False(t, strings.HasSuffix(sql, ";"))
+
+	sql, err = sqlmapper.GenerateIndex(db, "users", sqlmapper.Index{Name: "idx_users_email", Columns: sqlmapper.IndexColumns("email"), IsUnique: true})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "UNIQUE INDEX idx_users_email ON users")
+	assert.Contains(t, sql, "(email)")
+
+	sql, err = sqlmapper.GenerateView(db, sqlmapper.View{Name: "active_users", Definition: "SELECT * FROM users"})
+	assert.NoError(t, err)
+	assert.Contains(t, sql, "CREATE VIEW active_users AS")
+	assert.Contains(t, sql, "SELECT * FROM users")
+}
+
+func TestSQLServer_ParseErrorContext(t *testing.T) {
+	s := NewSQLServer()
+	_, err := s.Parse("CREATE TABLE users (id INT);\nGO\nCREATE INDEX ix_email ON (email);")
+	assert.EqualError(t, err, `error parsing CREATE INDEX: line 3 near "CREATE INDEX ix_email ON (email)": table not found for index: (email)`)
+
+	var parseErr *sqlmapper.ParseError
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Equal(t, 3, parseErr.Line)
+		assert.Equal(t, "CREATE INDEX ix_email ON (email)", parseErr.Near)
+	}
+}
+
+func TestSQLServer_ComputedColumns(t *testing.T) {
+	s := NewSQLServer()
+	schema, err := s.Parse(`CREATE TABLE order_lines (
+    id INT PRIMARY KEY IDENTITY(1,1),
+    price DECIMAL(10,2) NOT NULL,
+    quantity INT NOT NULL,
+    total AS (price * quantity) PERSISTED NOT NULL,
+    code AS ('#' + CAST(id AS VARCHAR(10)))
+);`)
+	assert.NoError(t, err)
+
+	columns := schema.Tables[0].Columns
+	assert.Len(t, columns, 5)
+	assert.Equal(t, "total", columns[3].Name)
+	assert.Equal(t, "", columns[3].DataType)
+	assert.Equal(t, "price * quantity", columns[3].GeneratedExpression)
+	assert.True(t, columns[3].GeneratedStored)
+	assert.False(t, columns[3].IsNullable)
+	assert.Equal(t, "code", columns[4].Name)
+	assert.Equal(t, "'#' + CAST(id AS VARCHAR(10))", columns[4].GeneratedExpression)
+	assert.False(t, columns[4].GeneratedStored)
+
+	result, err := s.Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "    total AS (price * quantity) PERSISTED NOT NULL,\n")
+	assert.Contains(t, result, "    code AS ('#' + CAST(id AS VARCHAR(10)))\n")
+
+	// Generated columns of other dialects keep their type
+	table := sqlmapper.Table{Name: "orders", Columns: []sqlmapper.Column{
+		{Name: "total", DataType: "DECIMAL", Length: 12, Scale: 2, IsNullable: true, GeneratedExpression: "price * qty", GeneratedStored: true},
+	}}
+	result, err = sqlmapper.GenerateTable(s, table)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "total AS (CAST(price * qty AS DECIMAL(12,2))) PERSISTED")
+}
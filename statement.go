@@ -0,0 +1,309 @@
+package sqlmapper
+
+import "strings"
+
+// StatementKind classifies a SQL statement by what it does
+type StatementKind int
+
+const (
+	// UnknownStatement is any statement that is not classified otherwise
+	UnknownStatement StatementKind = iota
+	// CreateStatement is a CREATE statement for an object without a kind of its own,
+	// such as CREATE SCHEMA or CREATE EXTENSION
+	CreateStatement
+	CreateTableStatement
+	CreateViewStatement
+	CreateIndexStatement
+	CreateSequenceStatement
+	CreateTypeStatement
+	CreateFunctionStatement
+	CreateProcedureStatement
+	CreateTriggerStatement
+	// AlterTableStatement is an ALTER TABLE statement
+	AlterTableStatement
+	// AlterStatement is an ALTER statement for any other object
+	AlterStatement
+	DropStatement
+	// CommentStatement is a COMMENT ON statement
+	CommentStatement
+	GrantStatement
+	RevokeStatement
+	// SetStatement sets a session option, such as SET NAMES or SET search_path
+	SetStatement
+	// UseStatement selects the database of the statements following it
+	UseStatement
+)
+
+// leadingKinds maps the first keyword of a statement to its kind
+var leadingKinds = map[string]StatementKind{
+	"DROP":    DropStatement,
+	"COMMENT": CommentStatement,
+	"GRANT":   GrantStatement,
+	"REVOKE":  RevokeStatement,
+	"SET":     SetStatement,
+	"USE":     UseStatement,
+}
+
+// createKinds maps the object keyword of a CREATE statement to its kind
+var createKinds = map[string]StatementKind{
+	"TABLE":     CreateTableStatement,
+	"VIEW":      CreateViewStatement,
+	"INDEX":     CreateIndexStatement,
+	"SEQUENCE":  CreateSequenceStatement,
+	"TYPE":      CreateTypeStatement,
+	"FUNCTION":  CreateFunctionStatement,
+	"PROCEDURE": CreateProcedureStatement,
+	"PROC":      CreateProcedureStatement,
+	"TRIGGER":   CreateTriggerStatement,
+}
+
+// Statement is a single SQL statement of a script together with the comment that
+// immediately preceded it and its position in the script
+type Statement struct {
+	Text           string // Without the terminating delimiter
+	LeadingComment string
+	Line           int // Line on which the statement starts, counting from 1
+	Offset         int // Byte offset of the statement in the script split by SplitStatements
+	Kind           StatementKind
+	Database       string // Database selected by the last USE statement up to this one
+}
+
+// SplitStatements splits a SQL script into its statements. Statements end at semicolons
+// outside string literals, quoted identifiers, comments and the BEGIN ... END blocks
+// of routine bodies. A batchSeparator, such as SQL Server's GO or Oracle's "/", also
+// ends a statement when it stands alone on a line, optionally followed by a repeat
+// count; pass an empty string for dialects without one.
+//
+// Comments preceding a statement on lines of their own are returned as its
+// LeadingComment, unless a blank line separates them from the statement. Each
+// statement records the database selected by the USE statements before it.
+func SplitStatements(content, batchSeparator string) []*Statement {
+	return splitStatements(NewLexer(content), content, batchSeparator)
+}
+
+// SplitPostgresStatements splits a PostgreSQL script into its statements like
+// SplitStatements, also ignoring the semicolons of dollar-quoted function bodies
+func SplitPostgresStatements(content string) []*Statement {
+	return splitStatements(NewLexer(content).WithDollarQuotes(), content, "")
+}
+
+// SplitMySQLStatements splits a MySQL script into its statements like SplitStatements,
+// following the MySQL rules for # and -- comments and the backslash escapes of its
+// string literals
+func SplitMySQLStatements(content string) []*Statement {
+	return splitStatements(NewLexer(content).WithMySQLComments().WithBackslashEscapes(), content, "")
+}
+
+// splitStatements splits content into the statements of the tokens read by lexer
+func splitStatements(lexer *Lexer, content, batchSeparator string) []*Statement {
+	var tokens []Token
+	for token, ok := lexer.Next(); ok; token, ok = lexer.Next() {
+		tokens = append(tokens, token)
+	}
+
+	var statements []*Statement
+	var comments []string
+	var blocks BlockScanner
+	current := &Statement{Offset: -1}
+	end := 0
+	lastLine := 0  // Line on which the previous token ends
+	ended := 0     // Line on which the previous statement ended
+	database := "" // Database selected by the last USE statement
+
+	flush := func() {
+		if current.Offset >= 0 {
+			current.Text = content[current.Offset:end]
+			current.LeadingComment = strings.Join(comments, "\n")
+			current.Kind = ClassifyStatement(current.Text)
+			if current.Kind == UseStatement {
+				if name, ok := ParseUseStatement(current.Text); ok {
+					database = name
+				}
+			}
+			current.Database = database
+			statements = append(statements, current)
+		}
+		current = &Statement{Offset: -1}
+		comments = nil
+		blocks.Reset()
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		firstOnLine := token.Line > lastLine
+		pending := current.Offset < 0
+		blankBefore := token.Line > lastLine+1
+		lastLine = token.EndLine()
+
+		if pending && blankBefore {
+			comments = nil
+		}
+
+		switch {
+		case token.Type == CommentToken:
+			// Comments trailing the previous statement on its last line belong to it
+			if pending && token.Line != ended {
+				comments = append(comments, commentText(token.Text))
+			}
+			continue
+
+		case batchSeparator != "" && firstOnLine && strings.EqualFold(token.Text, batchSeparator):
+			next := i + 1
+			if next < len(tokens) && tokens[next].Line == token.Line && isCount(tokens[next].Text) {
+				next++
+			}
+			if next == len(tokens) || tokens[next].Line > token.Line {
+				flush()
+				i = next - 1
+				ended = token.Line
+				continue
+			}
+
+		case token.Type == SymbolToken && token.Text == ";" && blocks.Depth() == 0:
+			flush()
+			ended = token.Line
+			continue
+		}
+
+		if pending {
+			current.Offset = token.Offset
+			current.Line = token.Line
+		}
+		if token.Type == WordToken {
+			blocks.Word(token.Text)
+		}
+		end = token.Offset + len(token.Text)
+	}
+	flush()
+
+	return statements
+}
+
+// ClassifyStatement returns the kind of a statement from its leading keywords
+func ClassifyStatement(statement string) StatementKind {
+	lexer := NewLexer(statement)
+	first, ok := nextWord(lexer)
+	if !ok || first.Type != WordToken {
+		return UnknownStatement
+	}
+
+	keyword := strings.ToUpper(first.Text)
+	if keyword == "ALTER" {
+		if second, ok := nextWord(lexer); ok && strings.EqualFold(second.Text, "TABLE") {
+			return AlterTableStatement
+		}
+		return AlterStatement
+	}
+	if keyword != "CREATE" {
+		return leadingKinds[keyword]
+	}
+
+	return createHeader(lexer).Kind
+}
+
+// CreateHeader describes the keywords of a CREATE statement up to the name of the
+// object it creates
+type CreateHeader struct {
+	Kind        StatementKind
+	Name        string // Unqualified, unquoted object name, empty for CreateStatement
+	OrReplace   bool   // OR REPLACE, or SQL Server's OR ALTER
+	IfNotExists bool   // IF NOT EXISTS
+}
+
+// ParseCreateHeader reads the keywords of a CREATE statement up to the name of the
+// object, tolerating modifiers such as TEMPORARY, UNIQUE or MySQL's DEFINER clause.
+// The second return value is false for any other statement.
+func ParseCreateHeader(statement string) (CreateHeader, bool) {
+	lexer := NewLexer(statement)
+	if first, ok := nextWord(lexer); !ok || first.Type != WordToken || !strings.EqualFold(first.Text, "CREATE") {
+		return CreateHeader{}, false
+	}
+	return createHeader(lexer), true
+}
+
+// createHeader reads the header of a CREATE statement following its CREATE keyword.
+// The object keyword follows modifiers such as OR REPLACE, TEMPORARY or DEFINER = ...
+func createHeader(lexer *Lexer) CreateHeader {
+	header := CreateHeader{Kind: CreateStatement}
+	previous := ""
+	for token, ok := nextWord(lexer); ok; token, ok = nextWord(lexer) {
+		if token.Text == "(" || strings.EqualFold(token.Text, "AS") || strings.EqualFold(token.Text, "CREATE") {
+			break
+		}
+		if token.Type != WordToken {
+			previous = ""
+			continue
+		}
+
+		word := strings.ToUpper(token.Text)
+		if kind, ok := createKinds[word]; ok {
+			header.Kind = kind
+			header.Name, header.IfNotExists = createName(lexer)
+			break
+		}
+		header.OrReplace = header.OrReplace || previous == "OR" && (word == "REPLACE" || word == "ALTER")
+		previous = word
+	}
+	return header
+}
+
+// createName reads the possibly qualified name following the object keyword of a
+// CREATE statement and returns it unqualified and unquoted. IF NOT EXISTS and the
+// BODY and CONCURRENTLY keywords before the name are skipped.
+func createName(lexer *Lexer) (name string, ifNotExists bool) {
+	keyword := "" // BODY or CONCURRENTLY, unless it is the name itself
+	for token, ok := nextWord(lexer); ok; token, ok = nextWord(lexer) {
+		switch {
+		case name == "" && token.Type == WordToken && strings.EqualFold(token.Text, "IF"):
+			nextWord(lexer) // NOT
+			nextWord(lexer) // EXISTS
+			ifNotExists = true
+		case name == "" && keyword == "" && token.Type == WordToken &&
+			(strings.EqualFold(token.Text, "BODY") || strings.EqualFold(token.Text, "CONCURRENTLY")):
+			keyword = token.Text
+		case token.Type == WordToken || token.Type == QuotedIdentifierToken:
+			name = strings.Trim(token.Text, "`\"[]")
+			// A dot continues a qualified name
+			if next, ok := nextWord(lexer); !ok || next.Text != "." {
+				return name, ifNotExists
+			}
+		default:
+			if name == "" {
+				name = keyword
+			}
+			return name, ifNotExists
+		}
+	}
+	if name == "" {
+		name = keyword
+	}
+	return name, ifNotExists
+}
+
+// nextWord returns the next token that is not a comment
+func nextWord(lexer *Lexer) (Token, bool) {
+	for {
+		token, ok := lexer.Next()
+		if !ok || token.Type != CommentToken {
+			return token, ok
+		}
+	}
+}
+
+// commentText returns the text of a comment without its markers
+func commentText(comment string) string {
+	if strings.HasPrefix(comment, "--") {
+		return strings.TrimSpace(comment[2:])
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(comment, "/*"), "*/"))
+}
+
+// isCount reports whether the word is a repeat count such as the 5 of "GO 5"
+func isCount(word string) bool {
+	for i := 0; i < len(word); i++ {
+		if word[i] < '0' || word[i] > '9' {
+			return false
+		}
+	}
+	return word != ""
+}
@@ -0,0 +1,234 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		separator string
+		want      []*Statement
+	}{
+		{
+			name:    "Delimiters inside literals and comments",
+			content: "INSERT INTO t VALUES ('a;b', \"c;d\"); -- trailing; comment\nSELECT 1 /* ; */ ;",
+			want: []*Statement{
+				{Text: "INSERT INTO t VALUES ('a;b', \"c;d\")", Line: 1, Offset: 0},
+				{Text: "SELECT 1", Line: 2, Offset: 58},
+			},
+		},
+		{
+			name: "Leading comments",
+			content: "-- detached\n\n-- Users of the app\n/* v2 */\nCREATE TABLE users (id INT);\n" +
+				"CREATE VIEW v AS SELECT id FROM users;",
+			want: []*Statement{
+				{Text: "CREATE TABLE users (id INT)", LeadingComment: "Users of the app\nv2", Line: 5, Offset: 42, Kind: CreateTableStatement},
+				{Text: "CREATE VIEW v AS SELECT id FROM users", Line: 6, Offset: 71, Kind: CreateViewStatement},
+			},
+		},
+		{
+			name:    "Trigger body",
+			content: "CREATE TRIGGER trg AFTER INSERT ON t BEGIN UPDATE s SET n = n + 1; END;;",
+			want: []*Statement{
+				{Text: "CREATE TRIGGER trg AFTER INSERT ON t BEGIN UPDATE s SET n = n + 1; END", Line: 1, Offset: 0, Kind: CreateTriggerStatement},
+			},
+		},
+		{
+			name:      "Batch separator",
+			content:   "CREATE PROC p AS SELECT 1\nGO 2\nSELECT go FROM t\ngo",
+			separator: "GO",
+			want: []*Statement{
+				{Text: "CREATE PROC p AS SELECT 1", Line: 1, Offset: 0, Kind: CreateProcedureStatement},
+				{Text: "SELECT go FROM t", Line: 3, Offset: 31},
+			},
+		},
+		{
+			name:      "Oracle slash",
+			content:   "CREATE OR REPLACE TRIGGER trg\nBEFORE UPDATE ON t\nBEGIN\n  :NEW.a := 1;\nEND;\n/\nSELECT a / b FROM t;",
+			separator: "/",
+			want: []*Statement{
+				{Text: "CREATE OR REPLACE TRIGGER trg\nBEFORE UPDATE ON t\nBEGIN\n  :NEW.a := 1;\nEND", Line: 1, Offset: 0, Kind: CreateTriggerStatement},
+				{Text: "SELECT a / b FROM t", Line: 7, Offset: 77},
+			},
+		},
+		{
+			name:      "Oracle declarations and compound trigger",
+			content:   "CREATE TRIGGER a\nBEFORE INSERT ON t\nDECLARE\n  v NUMBER;\nBEGIN\n  v := 1;\nEND;\n/\nCREATE TRIGGER b\nFOR INSERT ON t\nCOMPOUND TRIGGER\n  AFTER STATEMENT IS\n  BEGIN\n    NULL;\n  END AFTER STATEMENT;\nEND b;\n/",
+			separator: "/",
+			want: []*Statement{
+				{Text: "CREATE TRIGGER a\nBEFORE INSERT ON t\nDECLARE\n  v NUMBER;\nBEGIN\n  v := 1;\nEND", Line: 1, Offset: 0, Kind: CreateTriggerStatement},
+				{Text: "CREATE TRIGGER b\nFOR INSERT ON t\nCOMPOUND TRIGGER\n  AFTER STATEMENT IS\n  BEGIN\n    NULL;\n  END AFTER STATEMENT;\nEND b", Line: 9, Offset: 79, Kind: CreateTriggerStatement},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SplitStatements(tt.content, tt.separator))
+		})
+	}
+}
+
+func TestSplitPostgresStatements(t *testing.T) {
+	content := "CREATE FUNCTION f() RETURNS int AS $$ SELECT 1; $$ LANGUAGE sql;\nDROP TABLE t"
+	assert.Equal(t, []*Statement{
+		{Text: "CREATE FUNCTION f() RETURNS int AS $$ SELECT 1; $$ LANGUAGE sql", Line: 1, Offset: 0, Kind: CreateFunctionStatement},
+		{Text: "DROP TABLE t", Line: 2, Offset: 65, Kind: DropStatement},
+	}, SplitPostgresStatements(content))
+
+	// Other dialects split at the semicolon
+	assert.Len(t, SplitStatements(content, ""), 3)
+}
+
+func TestSplitStatements_Backslashes(t *testing.T) {
+	content := "INSERT INTO paths VALUES ('C:\\');\nDROP TABLE t;"
+
+	// Only MySQL escapes the closing quote with a backslash
+	assert.Len(t, SplitStatements(content, ""), 2)
+	assert.Len(t, SplitMySQLStatements(content), 1)
+	assert.Len(t, SplitMySQLStatements("INSERT INTO t VALUES ('a\\';b');\nDROP TABLE t;"), 2)
+}
+
+func TestClassifyStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      StatementKind
+	}{
+		{"CREATE TABLE users (id INT)", CreateTableStatement},
+		{"create global temporary table tmp (id NUMBER)", CreateTableStatement},
+		{"CREATE UNIQUE INDEX idx ON users (email)", CreateIndexStatement},
+		{"CREATE OR REPLACE MATERIALIZED VIEW mv AS SELECT 1", CreateViewStatement},
+		{"CREATE DEFINER=`root`@`localhost` TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET NEW.a = 1", CreateTriggerStatement},
+		{"CREATE PROCEDURE p() BEGIN SELECT 1; END", CreateProcedureStatement},
+		{"CREATE SEQUENCE seq START WITH 1", CreateSequenceStatement},
+		{"CREATE TYPE mood AS ENUM ('happy')", CreateTypeStatement},
+		{"/* header */ CREATE FUNCTION f() RETURNS int", CreateFunctionStatement},
+		{"CREATE EXTENSION IF NOT EXISTS hstore", CreateStatement},
+		{"CREATE TABLE \"view\" (id INT)", CreateTableStatement},
+		{"alter table t drop column a", AlterTableStatement},
+		{"ALTER /* online */ INDEX idx REBUILD", AlterStatement},
+		{"-- reset\nSET search_path TO public", SetStatement},
+		{"INSERT INTO t VALUES (1)", UnknownStatement},
+		{"", UnknownStatement},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyStatement(tt.statement))
+		})
+	}
+}
+
+func TestParseCreateHeader(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      CreateHeader
+		wantOK    bool
+	}{
+		{
+			name:      "IF NOT EXISTS table",
+			statement: "CREATE TABLE IF NOT EXISTS users (id INT)",
+			want:      CreateHeader{Kind: CreateTableStatement, Name: "users", IfNotExists: true},
+			wantOK:    true,
+		},
+		{
+			name:      "OR REPLACE view",
+			statement: "CREATE OR REPLACE VIEW active_users AS SELECT 1",
+			want:      CreateHeader{Kind: CreateViewStatement, Name: "active_users", OrReplace: true},
+			wantOK:    true,
+		},
+		{
+			name:      "OR REPLACE function",
+			statement: "create or replace function public.total() returns int",
+			want:      CreateHeader{Kind: CreateFunctionStatement, Name: "total", OrReplace: true},
+			wantOK:    true,
+		},
+		{
+			name:      "SQL Server OR ALTER procedure",
+			statement: "CREATE OR ALTER PROC [dbo].[cleanup] AS BEGIN SELECT 1 END",
+			want:      CreateHeader{Kind: CreateProcedureStatement, Name: "cleanup", OrReplace: true},
+			wantOK:    true,
+		},
+		{
+			name:      "MySQL view modifiers",
+			statement: "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`localhost` SQL SECURITY DEFINER VIEW v AS SELECT 1",
+			want:      CreateHeader{Kind: CreateViewStatement, Name: "v"},
+			wantOK:    true,
+		},
+		{
+			name:      "Trigger with IF NOT EXISTS",
+			statement: "CREATE TRIGGER IF NOT EXISTS audit AFTER INSERT ON users BEGIN SELECT 1; END",
+			want:      CreateHeader{Kind: CreateTriggerStatement, Name: "audit", IfNotExists: true},
+			wantOK:    true,
+		},
+		{
+			name:      "Unique index with IF NOT EXISTS",
+			statement: "CREATE UNIQUE INDEX IF NOT EXISTS idx_email ON users (email)",
+			want:      CreateHeader{Kind: CreateIndexStatement, Name: "idx_email", IfNotExists: true},
+			wantOK:    true,
+		},
+		{
+			name:      "Temporary table",
+			statement: "CREATE GLOBAL TEMPORARY TABLE tmp (id NUMBER)",
+			want:      CreateHeader{Kind: CreateTableStatement, Name: "tmp"},
+			wantOK:    true,
+		},
+		{
+			name:      "Package body",
+			statement: "CREATE OR REPLACE PACKAGE BODY pkg AS BEGIN NULL; END",
+			want:      CreateHeader{Kind: CreateStatement, OrReplace: true},
+			wantOK:    true,
+		},
+		{
+			name:      "Not a CREATE statement",
+			statement: "DROP TABLE users",
+			wantOK:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseCreateHeader(tt.statement)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitStatements_Kinds(t *testing.T) {
+	script := `SET NAMES utf8mb4;
+CREATE TABLE users (id INT, email VARCHAR(255));
+CREATE UNIQUE INDEX idx_users_email ON users (email);
+ALTER TABLE users ADD CONSTRAINT chk_id CHECK (id > 0);
+ALTER SEQUENCE users_seq RESTART WITH 10;
+COMMENT ON TABLE users IS 'Registered; active users';
+GRANT SELECT ON users TO reader;
+REVOKE SELECT ON users FROM reader;
+CREATE SCHEMA reporting;
+DROP VIEW IF EXISTS old_users;
+INSERT INTO users VALUES (1, 'a@b.c');`
+
+	var kinds []StatementKind
+	for _, statement := range SplitStatements(script, "") {
+		kinds = append(kinds, statement.Kind)
+	}
+
+	assert.Equal(t, []StatementKind{
+		SetStatement,
+		CreateTableStatement,
+		CreateIndexStatement,
+		AlterTableStatement,
+		AlterStatement,
+		CommentStatement,
+		GrantStatement,
+		RevokeStatement,
+		CreateStatement,
+		DropStatement,
+		UnknownStatement,
+	}, kinds)
+}
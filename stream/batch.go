@@ -0,0 +1,236 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mstgnz/sqlmapper"
+)
+
+// DefaultBatchSize is the number of statements in a batch unless configured otherwise
+const DefaultBatchSize = 100
+
+// BatchConfig configures a BatchProcessor
+type BatchConfig struct {
+	// BatchSize is the number of statements handed to the handler at once. The last
+	// batch holds the remaining statements. Zero or less uses DefaultBatchSize.
+	BatchSize int
+
+	// Timeout bounds a whole ProcessBatch or ProcessStatements call. Zero disables it.
+	Timeout time.Duration
+
+	// StatementTimeout bounds the handling of a single statement by ProcessStatements,
+	// so that one slow statement, such as an index build, does not fail the others.
+	// Zero disables it.
+	StatementTimeout time.Duration
+
+	// StatementsPerSecond throttles ProcessStatements so that statements are handed to
+	// the handler at no more than this rate, to avoid overwhelming a live database.
+	// Zero disables it.
+	StatementsPerSecond float64
+
+	// Delimiter terminates the statements of the script. Empty uses ";".
+	Delimiter string
+
+	// BatchSeparator, such as SQL Server's GO, also ends a statement when it stands
+	// alone on a line
+	BatchSeparator string
+}
+
+// BatchProcessor reads the statements of a SQL script and hands them to a handler in
+// batches, so that callers can apply each batch in a transaction of its own
+type BatchProcessor struct {
+	config BatchConfig
+}
+
+// NewBatchProcessor creates a BatchProcessor with the given configuration
+func NewBatchProcessor(config BatchConfig) *BatchProcessor {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	if config.Delimiter == "" {
+		config.Delimiter = ";"
+	}
+	return &BatchProcessor{config: config}
+}
+
+// ProcessBatch reads the statements from the reader and calls handler with consecutive
+// batches of BatchSize statements, in the order they appear in the script. Processing
+// stops at the first error returned by the handler, or once the context is cancelled
+// or the timeout expires.
+func (bp *BatchProcessor) ProcessBatch(ctx context.Context, reader io.Reader, handler func([]*Statement) error) error {
+	return bp.process(ctx, reader, func(_ context.Context, batch []*Statement) error {
+		return handler(batch)
+	})
+}
+
+// ProcessStatements reads the statements from the reader and calls handler with each of
+// them in turn, batch by batch, at no more than StatementsPerSecond. With a
+// StatementTimeout, every statement is handled with a context of its own that expires
+// after that duration; the handler must honour it. Statements exceeding their timeout
+// are skipped and returned together as TimeoutErrors once the whole script has been
+// processed. Any other error stops processing.
+func (bp *BatchProcessor) ProcessStatements(ctx context.Context, reader io.Reader, handler func(context.Context, *Statement) error) error {
+	var timeouts TimeoutErrors
+	limiter := newRateLimiter(bp.config.StatementsPerSecond)
+
+	err := bp.process(ctx, reader, func(ctx context.Context, batch []*Statement) error {
+		for _, statement := range batch {
+			if err := limiter.wait(ctx); err != nil {
+				return err
+			}
+
+			err := bp.processStatement(ctx, statement, handler)
+			var timeout *StatementError
+			if errors.As(err, &timeout) {
+				timeouts = append(timeouts, timeout)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(timeouts) > 0 {
+		return timeouts
+	}
+	return nil
+}
+
+// processStatement calls the handler for a single statement with a context bounded by
+// the StatementTimeout. It returns a *StatementError when the statement exceeded its own
+// timeout, as opposed to the deadline of the whole call.
+func (bp *BatchProcessor) processStatement(ctx context.Context, statement *Statement, handler func(context.Context, *Statement) error) error {
+	if bp.config.StatementTimeout <= 0 {
+		return handler(ctx, statement)
+	}
+
+	statementCtx, cancel := context.WithTimeout(ctx, bp.config.StatementTimeout)
+	defer cancel()
+
+	err := handler(statementCtx, statement)
+	if err != nil && ctx.Err() == nil && statementCtx.Err() == context.DeadlineExceeded {
+		return &StatementError{Line: statement.Line, Statement: statement.Text, Err: err}
+	}
+	return err
+}
+
+// rateLimiter spaces out statements so that they do not exceed a maximum rate
+type rateLimiter struct {
+	interval time.Duration
+	next     time.Time
+}
+
+// newRateLimiter creates a limiter for the given number of statements per second.
+// A rate of zero or less never waits.
+func newRateLimiter(perSecond float64) *rateLimiter {
+	if perSecond <= 0 {
+		return &rateLimiter{}
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// wait blocks until the next statement may be processed, or until the context is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return ctx.Err()
+	}
+
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// process reads the statements from the reader and calls handler with each batch
+func (bp *BatchProcessor) process(ctx context.Context, reader io.Reader, handler func(context.Context, []*Statement) error) error {
+	if bp.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, bp.config.Timeout)
+		defer cancel()
+	}
+
+	streamReader := NewStreamReader(reader, bp.config.Delimiter).WithBatchSeparator(bp.config.BatchSeparator).WithCommentCapture(true)
+	batch := make([]*Statement, 0, bp.config.BatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := handler(ctx, batch); err != nil {
+			return err
+		}
+		batch = make([]*Statement, 0, bp.config.BatchSize)
+		return nil
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		text, err := streamReader.ReadStatement()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading statement: %w", err)
+		}
+
+		text = strings.TrimSpace(text)
+		if BlankStatement(text) {
+			continue
+		}
+
+		batch = append(batch, &Statement{
+			Text:           text,
+			LeadingComment: streamReader.LeadingComment(),
+			Line:           streamReader.Line(),
+			Kind:           sqlmapper.ClassifyStatement(text),
+		})
+		if len(batch) == bp.config.BatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
+
+// TimeoutErrors lists the statements that exceeded the StatementTimeout, in script order
+type TimeoutErrors []*StatementError
+
+// Error implements the error interface
+func (e TimeoutErrors) Error() string {
+	if len(e) == 1 {
+		return fmt.Sprintf("1 statement exceeded the statement timeout: %v", e[0])
+	}
+	return fmt.Sprintf("%d statements exceeded the statement timeout, first at %v", len(e), e[0])
+}
@@ -0,0 +1,86 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// ErrBudgetExceeded is returned when parsing a stream exceeds one of the limits of
+// its ParseOptions: MaxDuration, MaxObjects or MaxTotalBytes
+var ErrBudgetExceeded = errors.New("parse budget exceeded")
+
+// Budget enforces the overall limits of ParseOptions on a single parse of a stream.
+// The bytes read and the objects parsed may be counted on different goroutines.
+type Budget struct {
+	options ParseOptions
+	start   time.Time
+	bytes   atomic.Int64
+	objects atomic.Int64
+}
+
+// NewBudget creates a Budget for a single parse of a stream, starting its clock
+func (o ParseOptions) NewBudget() *Budget {
+	return &Budget{options: o, start: time.Now()}
+}
+
+// Reader returns a reader counting the bytes read from reader. Its reads fail with
+// an error wrapping ErrBudgetExceeded as soon as more than MaxTotalBytes have been
+// read or MaxDuration has passed, so that an oversized statement is never buffered
+// in full.
+func (b *Budget) Reader(reader io.Reader) io.Reader {
+	return &budgetReader{reader: reader, budget: b}
+}
+
+// Check returns an error wrapping ErrBudgetExceeded once parsing has taken longer
+// than MaxDuration or more than MaxTotalBytes have been read
+func (b *Budget) Check() error {
+	if b.options.MaxDuration > 0 && time.Since(b.start) > b.options.MaxDuration {
+		return fmt.Errorf("%w: parsing took longer than %v", ErrBudgetExceeded, b.options.MaxDuration)
+	}
+	if b.options.MaxTotalBytes > 0 && b.bytes.Load() > b.options.MaxTotalBytes {
+		return fmt.Errorf("%w: more than %d bytes read", ErrBudgetExceeded, b.options.MaxTotalBytes)
+	}
+	return nil
+}
+
+// Object counts an object about to be passed to the callback. It returns an error
+// wrapping ErrBudgetExceeded when the object is one more than MaxObjects, or when
+// parsing has taken longer than MaxDuration.
+func (b *Budget) Object() error {
+	if b.options.MaxObjects > 0 && b.objects.Add(1) > int64(b.options.MaxObjects) {
+		return fmt.Errorf("%w: more than %d objects parsed", ErrBudgetExceeded, b.options.MaxObjects)
+	}
+	if b.options.MaxDuration > 0 && time.Since(b.start) > b.options.MaxDuration {
+		return fmt.Errorf("%w: parsing took longer than %v", ErrBudgetExceeded, b.options.MaxDuration)
+	}
+	return nil
+}
+
+// budgetReader counts the bytes read from a stream
+type budgetReader struct {
+	reader io.Reader
+	budget *Budget
+}
+
+// Read implements io.Reader
+func (r *budgetReader) Read(p []byte) (int, error) {
+	if err := r.budget.Check(); err != nil {
+		return 0, err
+	}
+	// Read at most one byte past the limit, which is enough to know it was exceeded
+	if limit := r.budget.options.MaxTotalBytes; limit > 0 {
+		if remaining := limit + 1 - r.budget.bytes.Load(); int64(len(p)) > remaining {
+			p = p[:remaining]
+		}
+	}
+
+	n, err := r.reader.Read(p)
+	r.budget.bytes.Add(int64(n))
+	if budgetErr := r.budget.Check(); budgetErr != nil {
+		return n, budgetErr
+	}
+	return n, err
+}
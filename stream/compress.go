@@ -0,0 +1,115 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Decoder returns a reader that decompresses the data read from the given reader
+type Decoder func(reader io.Reader) (io.Reader, error)
+
+// format describes a compression format recognized by its leading magic bytes
+type format struct {
+	name   string
+	magic  []byte
+	decode Decoder
+}
+
+var (
+	formatsMu sync.RWMutex
+	// formats lists the compression formats detected by Decompress. Formats without
+	// a decoder are detected but can only be read once a decoder is registered.
+	formats = []format{
+		{
+			name:  "gzip",
+			magic: []byte{0x1f, 0x8b},
+			decode: func(reader io.Reader) (io.Reader, error) {
+				return gzip.NewReader(reader)
+			},
+		},
+		{
+			name:  "bzip2",
+			magic: []byte("BZh"),
+			decode: func(reader io.Reader) (io.Reader, error) {
+				return bzip2.NewReader(reader), nil
+			},
+		},
+		{
+			name:  "zstd",
+			magic: []byte{0x28, 0xb5, 0x2f, 0xfd},
+		},
+	}
+)
+
+// RegisterDecoder registers the decoder for a compression format identified by the
+// given magic bytes, replacing any decoder previously registered under the same name.
+// The standard library has no zstd support, so zstd dumps can only be read after
+// registering a decoder, for example one backed by github.com/klauspost/compress/zstd.
+func RegisterDecoder(name string, magic []byte, decode Decoder) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+
+	for i := range formats {
+		if formats[i].name == name {
+			formats[i].magic = magic
+			formats[i].decode = decode
+			return
+		}
+	}
+	formats = append(formats, format{name: name, magic: magic, decode: decode})
+}
+
+// Decompress inspects the magic bytes at the start of the reader and returns a reader
+// that decompresses the data on the fly if a supported compression format is detected.
+// Uncompressed input is returned unchanged. The data is never decompressed into memory
+// as a whole, so the result can be passed directly to ParseStream.
+func Decompress(reader io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(reader)
+
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+
+	for _, f := range formats {
+		magic, err := buffered.Peek(len(f.magic))
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("error reading stream header: %w", err)
+		}
+		if !bytes.Equal(magic, f.magic) {
+			continue
+		}
+
+		if f.decode == nil {
+			return nil, fmt.Errorf("no decoder registered for %s stream", f.name)
+		}
+		decompressed, err := f.decode(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("error opening %s stream: %w", f.name, err)
+		}
+		return decompressed, nil
+	}
+
+	return buffered, nil
+}
+
+// ParseFile opens the SQL dump at the given path and parses it with the given parser.
+// Compressed dumps are decompressed transparently while they are streamed.
+func ParseFile(parser StreamParser, path string, callback func(SchemaObject) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening file: %w", err)
+	}
+	defer file.Close()
+
+	reader, err := Decompress(file)
+	if err != nil {
+		return err
+	}
+
+	return parser.ParseStream(reader, callback)
+}
@@ -0,0 +1,167 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mstgnz/sqlmapper"
+)
+
+// ErrUnhandledStatement is reported in Strict mode for a statement the parser does
+// not handle
+var ErrUnhandledStatement = errors.New("unhandled statement")
+
+// ErrStopIteration can be returned by the callback of ParseStream and
+// ParseStreamParallel to stop parsing early, such as after the first objects of a
+// dump shown as a preview. Parsing then ends as if the stream had ended.
+var ErrStopIteration = errors.New("stop iteration")
+
+// IsStopIteration reports whether a callback error asks to stop parsing early
+func IsStopIteration(err error) bool {
+	return errors.Is(err, ErrStopIteration)
+}
+
+// CheckArguments returns an error for a nil reader or callback passed to ParseStream
+// or ParseStreamParallel, which would otherwise panic once parsing starts
+func CheckArguments(reader io.Reader, callback func(SchemaObject) error) error {
+	if reader == nil {
+		return &sqlmapper.ValidationError{Problems: []string{"reader cannot be nil"}}
+	}
+	if callback == nil {
+		return &sqlmapper.ValidationError{Problems: []string{"callback cannot be nil"}}
+	}
+	return nil
+}
+
+// StatementError describes a statement that could not be parsed
+type StatementError struct {
+	Line      int
+	Statement string
+	Err       error
+}
+
+// Error implements the error interface
+func (e *StatementError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying parse error
+func (e *StatementError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors lists the statements skipped while parsing with ContinueOnError,
+// ordered by the line they start on
+type ParseErrors []*StatementError
+
+// Error implements the error interface
+func (e ParseErrors) Error() string {
+	if len(e) == 1 {
+		return fmt.Sprintf("1 statement failed to parse: %v", e[0])
+	}
+	return fmt.Sprintf("%d statements failed to parse, first at %v", len(e), e[0])
+}
+
+// ErrorCollector handles the statements that fail to parse according to the
+// ContinueOnError option. It is safe for concurrent use by parsing workers.
+type ErrorCollector struct {
+	mu      sync.Mutex
+	options ParseOptions
+	errors  ParseErrors
+}
+
+// NewErrorCollector creates an ErrorCollector for a single parse of a stream
+func (o ParseOptions) NewErrorCollector() *ErrorCollector {
+	return &ErrorCollector{options: o}
+}
+
+// Handle records that the statement failed to parse. It returns the error when
+// parsing should stop, wrapped in a sqlmapper.ParseError with the line the statement
+// starts on, or nil when the statement is skipped because ContinueOnError is enabled.
+func (c *ErrorCollector) Handle(statement Statement, err error) error {
+	if !c.options.ContinueOnError {
+		if _, ok := err.(*StatementError); ok {
+			return err
+		}
+		return &sqlmapper.ParseError{Line: statement.Line, Near: sqlmapper.Snippet(statement.Text), Err: err}
+	}
+
+	statementErr, ok := err.(*StatementError)
+	if !ok {
+		statementErr = &StatementError{
+			Line:      statement.Line,
+			Statement: statement.Text,
+			Err:       err,
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errors = append(c.errors, statementErr)
+	if c.options.OnError != nil {
+		c.options.OnError(statementErr)
+	}
+
+	return nil
+}
+
+// Unhandled handles a statement the parser does not handle. In Strict mode it
+// returns an error naming the kind of the statement and the line it starts on;
+// otherwise the statement is passed to OnSkip and nil is returned.
+func (o ParseOptions) Unhandled(statement Statement) error {
+	if !o.Strict {
+		if o.OnSkip != nil {
+			o.OnSkip(statement.Text, Position{Line: statement.Line, Offset: statement.Offset})
+		}
+		return nil
+	}
+	return &StatementError{
+		Line:      statement.Line,
+		Statement: statement.Text,
+		Err:       fmt.Errorf("%w: %s", ErrUnhandledStatement, statementKind(statement.Text)),
+	}
+}
+
+// statementKind returns the leading keywords of a statement, such as INSERT or
+// CREATE EXTENSION
+func statementKind(statement string) string {
+	words := strings.Fields(sqlmapper.StripComments(statement))
+	if len(words) == 0 {
+		return "empty"
+	}
+
+	kind := strings.ToUpper(words[0])
+	switch kind {
+	case "CREATE", "ALTER", "DROP":
+		for _, word := range words[1:] {
+			word = strings.ToUpper(word)
+			switch word {
+			case "OR", "REPLACE", "TEMP", "TEMPORARY", "GLOBAL", "UNIQUE", "UNLOGGED":
+				continue
+			}
+			return kind + " " + word
+		}
+	}
+	return kind
+}
+
+// Err returns the collected errors as ParseErrors, or nil if every statement parsed
+func (c *ErrorCollector) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.errors) == 0 {
+		return nil
+	}
+
+	errors := make(ParseErrors, len(c.errors))
+	copy(errors, c.errors)
+	sort.SliceStable(errors, func(i, j int) bool {
+		return errors[i].Line < errors[j].Line
+	})
+	return errors
+}
@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mstgnz/sqlmapper"
+)
+
+// FilterFunc decides whether an object of the given type and name should be parsed
+type FilterFunc func(objectType SchemaObjectType, name string) bool
+
+// permissionRe matches the object of a GRANT or REVOKE statement
+var permissionRe = regexp.MustCompile(`(?is)^(?:GRANT|REVOKE)\s+.*?\s+ON\s+(?:TABLE\s+)?([^\s;]+)`)
+
+// TypeFilter returns a filter accepting only the given object types
+func TypeFilter(types ...SchemaObjectType) FilterFunc {
+	return func(objectType SchemaObjectType, name string) bool {
+		for _, t := range types {
+			if t == objectType {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NameFilter returns a filter accepting only objects whose name matches the expression
+func NameFilter(re *regexp.Regexp) FilterFunc {
+	return func(objectType SchemaObjectType, name string) bool {
+		return re.MatchString(name)
+	}
+}
+
+// DetectObject inspects the header of a statement and returns the type and unqualified
+// name of the object it defines without fully parsing it. The last return value is
+// false when the statement is not recognized.
+func DetectObject(statement string) (SchemaObjectType, string, bool) {
+	statement = strings.TrimSpace(statement)
+
+	if header, ok := sqlmapper.ParseCreateHeader(statement); ok {
+		if objectType, ok := createTypes[header.Kind]; ok {
+			return objectType, header.Name, true
+		}
+	}
+
+	if match := permissionRe.FindStringSubmatch(statement); match != nil {
+		return PermissionObject, unqualifiedName(match[1]), true
+	}
+
+	if drop, ok := sqlmapper.ParseDrop(statement); ok {
+		return DropObject, drop.Name, true
+	}
+
+	if comment, ok := sqlmapper.ParseCommentOn(statement); ok {
+		return CommentObject, comment.Table, true
+	}
+
+	return 0, "", false
+}
+
+// unqualifiedName strips the schema prefix and identifier quotes from a name
+func unqualifiedName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.Trim(name, "`\"[]")
+}
+
+// SkipStatement reports whether the statement can be skipped without parsing
+// because the configured filter rejects the object it defines
+func (o ParseOptions) SkipStatement(statement string) bool {
+	if o.Filter == nil {
+		return false
+	}
+
+	objectType, name, ok := DetectObject(statement)
+	if !ok {
+		return false
+	}
+
+	return !o.Filter(objectType, name)
+}
+
+// Accept reports whether a parsed object passes the configured filter. Session
+// settings are only accepted when CaptureSettings is set.
+func (o ParseOptions) Accept(obj *SchemaObject) bool {
+	if obj.Type == SettingObject && !o.CaptureSettings {
+		return false
+	}
+	if o.Filter == nil {
+		return true
+	}
+	return o.Filter(obj.Type, obj.Name())
+}
+
+// Name returns the name of the underlying object, or an empty string if it has none
+func (o *SchemaObject) Name() string {
+	switch data := o.Data.(type) {
+	case *sqlmapper.Table:
+		return data.Name
+	case *sqlmapper.View:
+		return data.Name
+	case *sqlmapper.Function:
+		return data.Name
+	case *sqlmapper.Procedure:
+		return data.Name
+	case *sqlmapper.Trigger:
+		return data.Name
+	case *sqlmapper.Index:
+		return data.Name
+	case *sqlmapper.Sequence:
+		return data.Name
+	case *sqlmapper.Type:
+		return data.Name
+	case *sqlmapper.Permission:
+		return unqualifiedName(data.Object)
+	case *sqlmapper.Drop:
+		return data.Name
+	case *sqlmapper.Comment:
+		return data.Table
+	case *sqlmapper.Extension:
+		return data.Name
+	case []sqlmapper.SessionSetting:
+		if len(data) > 0 {
+			return data[0].Name
+		}
+	}
+	return ""
+}
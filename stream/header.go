@@ -0,0 +1,42 @@
+package stream
+
+import (
+	"github.com/mstgnz/sqlmapper"
+)
+
+// createTypes maps the kinds of CREATE statements to the type of the object they create
+var createTypes = map[sqlmapper.StatementKind]SchemaObjectType{
+	sqlmapper.CreateTableStatement:     TableObject,
+	sqlmapper.CreateViewStatement:      ViewObject,
+	sqlmapper.CreateFunctionStatement:  FunctionObject,
+	sqlmapper.CreateProcedureStatement: ProcedureObject,
+	sqlmapper.CreateTriggerStatement:   TriggerObject,
+	sqlmapper.CreateIndexStatement:     IndexObject,
+	sqlmapper.CreateSequenceStatement:  SequenceObject,
+	sqlmapper.CreateTypeStatement:      TypeObject,
+}
+
+// SetCreateFlags records the OR REPLACE and IF NOT EXISTS flags of the CREATE
+// statement that defined the object
+func (o *SchemaObject) SetCreateFlags(statement string) {
+	header, ok := sqlmapper.ParseCreateHeader(statement)
+	if !ok {
+		return
+	}
+
+	switch data := o.Data.(type) {
+	case *sqlmapper.Table:
+		data.IfNotExists = header.IfNotExists
+	case *sqlmapper.View:
+		data.OrReplace = header.OrReplace
+		data.IfNotExists = header.IfNotExists
+	case *sqlmapper.Index:
+		data.IfNotExists = header.IfNotExists
+	case *sqlmapper.Function:
+		data.OrReplace = header.OrReplace
+	case *sqlmapper.Procedure:
+		data.OrReplace = header.OrReplace
+	case *sqlmapper.Trigger:
+		data.OrReplace = header.OrReplace
+	}
+}
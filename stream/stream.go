@@ -1,21 +1,24 @@
 package stream
 
 import (
-	"bufio"
 	"fmt"
 	"io"
+	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mstgnz/sqlmapper"
 )
 
 // StreamParser represents an interface for streaming database dump operations
 type StreamParser interface {
-	// ParseStream parses a SQL dump from a reader and calls the callback for each parsed object
+	// ParseStream parses a SQL dump from a reader and calls the callback for each parsed
+	// object. The callback stops parsing without an error by returning ErrStopIteration.
 	ParseStream(reader io.Reader, callback func(SchemaObject) error) error
 
-	// ParseStreamParallel parses a SQL dump from a reader in parallel using worker pools
+	// ParseStreamParallel parses a SQL dump from a reader in parallel using worker pools.
+	// A number of workers below one uses runtime.NumCPU().
 	ParseStreamParallel(reader io.Reader, callback func(SchemaObject) error, workers int) error
 
 	// GenerateStream generates SQL statements for schema objects and writes them to the writer
@@ -32,8 +35,10 @@ type WorkerPool struct {
 	parser  StreamParser
 }
 
-// NewWorkerPool creates a new worker pool with the specified number of workers
+// NewWorkerPool creates a new worker pool with the specified number of workers. A
+// number of workers below one uses runtime.NumCPU().
 func NewWorkerPool(workers int, parser StreamParser) *WorkerPool {
+	workers, _ = ParseOptions{}.Parallelism(workers)
 	return &WorkerPool{
 		workers: workers,
 		jobs:    make(chan string, workers),
@@ -62,7 +67,7 @@ func (wp *WorkerPool) worker() {
 		})
 
 		if err != nil {
-			wp.errors <- fmt.Errorf("error processing statement: %v", err)
+			wp.errors <- fmt.Errorf("error processing statement: %w", err)
 			return
 		}
 	}
@@ -93,6 +98,10 @@ func (wp *WorkerPool) Wait() {
 
 // Process processes a stream of SQL statements in parallel
 func (wp *WorkerPool) Process(reader io.Reader, callback func(SchemaObject) error) error {
+	if err := CheckArguments(reader, callback); err != nil {
+		return err
+	}
+
 	// Start the worker pool
 	wp.Start()
 
@@ -105,7 +114,7 @@ func (wp *WorkerPool) Process(reader io.Reader, callback func(SchemaObject) erro
 				break
 			}
 			if err != nil {
-				wp.errors <- fmt.Errorf("error reading statement: %v", err)
+				wp.errors <- fmt.Errorf("error reading statement: %w", err)
 				break
 			}
 
@@ -148,6 +157,10 @@ const (
 	SequenceObject
 	TypeObject
 	PermissionObject
+	DropObject
+	CommentObject
+	SettingObject
+	ExtensionObject
 )
 
 // SchemaObject represents a parsed database object
@@ -156,100 +169,524 @@ type SchemaObject struct {
 	Data interface{} // Table, View, Function, etc.
 }
 
-// StreamReader provides buffered reading of SQL statements
+// SetSourceComment attaches a comment found in the source dump to the underlying
+// object. Objects without a SourceComment field are left unchanged.
+func (o *SchemaObject) SetSourceComment(comment string) {
+	if comment == "" {
+		return
+	}
+
+	switch data := o.Data.(type) {
+	case *sqlmapper.Table:
+		data.SourceComment = comment
+	case *sqlmapper.View:
+		data.SourceComment = comment
+	case *sqlmapper.Function:
+		data.SourceComment = comment
+	case *sqlmapper.Procedure:
+		data.SourceComment = comment
+	case *sqlmapper.Trigger:
+		data.SourceComment = comment
+	}
+}
+
+// SetDefaultSchema sets the schema of an object that does not name one, such as a
+// table created after a USE statement selecting a database
+func (o *SchemaObject) SetDefaultSchema(schema string) {
+	if schema == "" {
+		return
+	}
+
+	switch data := o.Data.(type) {
+	case *sqlmapper.Table:
+		if data.Schema == "" {
+			data.Schema = schema
+		}
+	case *sqlmapper.View:
+		if data.Schema == "" {
+			data.Schema = schema
+		}
+	case *sqlmapper.Function:
+		if data.Schema == "" {
+			data.Schema = schema
+		}
+	case *sqlmapper.Procedure:
+		if data.Schema == "" {
+			data.Schema = schema
+		}
+	case *sqlmapper.Trigger:
+		if data.Schema == "" {
+			data.Schema = schema
+		}
+	case *sqlmapper.Drop:
+		if data.Schema == "" {
+			data.Schema = schema
+		}
+	}
+}
+
+// Statement represents a single SQL statement read from a stream together with
+// the comment that immediately preceded it and the line on which it starts
+type Statement = sqlmapper.Statement
+
+// BlankStatement reports whether a statement holds no SQL: only whitespace,
+// comments or stray semicolons, as left by a comment terminated by the delimiter.
+// Such statements are skipped by the parsers without being reported.
+func BlankStatement(statement string) bool {
+	return strings.Trim(sqlmapper.StripComments(statement), "; \t\r\n") == ""
+}
+
+// ParseOptions configures the behaviour of the dialect stream parsers
+type ParseOptions struct {
+	// CaptureComments attaches the comments immediately preceding a statement
+	// to the parsed object as its SourceComment
+	CaptureComments bool
+
+	// CaptureSettings passes the session settings of SET statements, such as
+	// SET NAMES, to the callback as SettingObjects holding a []sqlmapper.SessionSetting.
+	// Otherwise SET statements are recognized and skipped.
+	CaptureSettings bool
+
+	// Filter restricts parsing to the objects it accepts. Statements whose type and
+	// name can be detected from their header are skipped before being parsed.
+	Filter FilterFunc
+
+	// MaxStatementSize limits the number of bytes buffered for a single statement.
+	// Zero uses DefaultMaxStatementSize.
+	MaxStatementSize int
+
+	// BufferSize is the capacity of the channels passing statements from the reader
+	// to the workers of ParseStreamParallel, and parsed objects from the workers to
+	// the callback. A larger buffer lets the reader and fast workers run ahead of a
+	// slow stage. Zero uses the number of workers.
+	BufferSize int
+
+	// MaxDuration, MaxObjects and MaxTotalBytes bound a whole parse of a stream,
+	// protecting services that parse DDL uploaded by their users. Parsing stops
+	// with an error wrapping ErrBudgetExceeded once it has taken longer than
+	// MaxDuration, once the callback would receive more than MaxObjects objects,
+	// or once more than MaxTotalBytes have been read. Zero disables a limit.
+	MaxDuration   time.Duration
+	MaxObjects    int
+	MaxTotalBytes int64
+
+	// ContinueOnError skips statements that fail to parse instead of aborting. Each
+	// failure is reported to OnError, and all of them are returned together as
+	// ParseErrors once the whole stream has been read.
+	ContinueOnError bool
+
+	// OnError is called for every statement skipped because of ContinueOnError
+	OnError func(err *StatementError)
+
+	// Strict reports statements the parser does not handle, such as INSERT or an
+	// unsupported CREATE, as errors wrapping ErrUnhandledStatement instead of
+	// skipping them silently. Statements skipped by Filter are not reported.
+	Strict bool
+
+	// OnSkip is called with every statement the parser does not handle and skips,
+	// unless Strict is set. It may be called concurrently by the workers of
+	// ParseStreamParallel.
+	OnSkip func(statement string, pos Position)
+}
+
+// Parallelism returns the number of workers and the channel buffer size used by
+// ParseStreamParallel for the requested number of workers. A number of workers
+// below one uses runtime.NumCPU().
+func (o ParseOptions) Parallelism(workers int) (int, int) {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	buffer := o.BufferSize
+	if buffer < 1 {
+		buffer = workers
+	}
+	return workers, buffer
+}
+
+// DefaultMaxStatementSize is the maximum number of bytes a StreamReader buffers for
+// a single statement unless configured otherwise
+const DefaultMaxStatementSize = 64 << 20
+
+// readSize is the number of bytes a StreamReader reads from its stream at least
+const readSize = 4096
+
+// StreamReader reads the SQL statements of a stream. The input is split into
+// statements with sqlmapper.Lexer, so that delimiters inside string literals, quoted
+// identifiers, comments and, for PostgreSQL, dollar-quoted bodies never end a statement.
 type StreamReader struct {
-	reader    *bufio.Reader
-	delimiter string
-	buffer    []byte
+	reader         io.Reader
+	delimiter      string
+	batchSeparator string
+	maxSize        int
+
+	// Input read but not yet returned, starting right after the last statement
+	pending string
+	chunk   []byte
+	eof     bool
+
+	// Delimiter given to NewStreamReader, and whether DELIMITER directives change it
+	defaultDelimiter    string
+	delimiterDirectives bool
+
+	// BEGIN ... END blocks in routine bodies whose delimiters do not end the statement
+	blocks sqlmapper.BlockScanner
+
+	captureComments  bool
+	mysqlComments    bool
+	dollarQuotes     bool
+	backslashEscapes bool
+	comments         []string
+
+	// Line and byte offset of the start of the pending input, and the line on which
+	// the last statement ended
+	line   int
+	offset int
+	ended  int
+
+	// Line and byte offset of the start of the last statement
+	startLine   int
+	startOffset int
+}
+
+// Position is the location of a statement in a stream
+type Position struct {
+	Line   int // Line on which the statement starts, counting from 1
+	Offset int // Byte offset of the first character of the statement
 }
 
 // NewStreamReader creates a new StreamReader with the given reader and delimiter
 func NewStreamReader(reader io.Reader, delimiter string) *StreamReader {
 	return &StreamReader{
-		reader:    bufio.NewReader(reader),
-		delimiter: delimiter,
-		buffer:    make([]byte, 0, 4096),
+		reader:           reader,
+		delimiter:        delimiter,
+		defaultDelimiter: delimiter,
+		maxSize:          DefaultMaxStatementSize,
+		line:             1,
 	}
 }
 
-// ReadStatement reads the next SQL statement from the reader
+// WithMaxStatementSize limits the number of bytes buffered for a single statement,
+// including its leading comments. ReadStatement returns an error instead of growing
+// the buffer beyond this size, which protects against dumps missing a terminating
+// delimiter. A size of zero or less restores DefaultMaxStatementSize.
+func (sr *StreamReader) WithMaxStatementSize(size int) *StreamReader {
+	if size <= 0 {
+		size = DefaultMaxStatementSize
+	}
+	sr.maxSize = size
+	return sr
+}
+
+// WithBatchSeparator configures a keyword that ends the current statement when it
+// appears alone on a line, optionally followed by a repeat count (e.g. SQL Server's
+// "GO" or "GO 5", or Oracle's "/"). The keyword is matched case-insensitively and is
+// applied in addition to the regular delimiter.
+func (sr *StreamReader) WithBatchSeparator(separator string) *StreamReader {
+	sr.batchSeparator = separator
+	return sr
+}
+
+// WithDelimiterDirectives makes the reader honor the DELIMITER directives of the
+// MySQL client. A line such as DELIMITER // or DELIMITER $$ before a statement
+// selects the delimiter ending the statements that follow, such as the routines
+// whose bodies contain semicolons, until another directive restores it. The
+// directives themselves are not returned as statements.
+func (sr *StreamReader) WithDelimiterDirectives() *StreamReader {
+	sr.delimiterDirectives = true
+	return sr
+}
+
+// WithCommentCapture enables collecting the comments that immediately precede each
+// statement so they can be retrieved with LeadingComment.
+func (sr *StreamReader) WithCommentCapture(enabled bool) *StreamReader {
+	sr.captureComments = enabled
+	return sr
+}
+
+// WithMySQLComments makes the reader follow the comment rules of MySQL: # up to the
+// end of the line is a comment, so that delimiters inside it do not end a statement,
+// while -- only starts a comment when followed by whitespace or a control character.
+func (sr *StreamReader) WithMySQLComments() *StreamReader {
+	sr.mysqlComments = true
+	return sr
+}
+
+// WithBackslashEscapes makes a backslash escape the character following it in the
+// string literals of the stream, as in MySQL, so that the quote of 'It\'s' does not
+// end the literal.
+func (sr *StreamReader) WithBackslashEscapes() *StreamReader {
+	sr.backslashEscapes = true
+	return sr
+}
+
+// WithDollarQuotes makes the reader read the dollar-quoted strings of PostgreSQL,
+// such as the $$ ... $$ or $body$ ... $body$ bodies of functions, as string literals
+// whose semicolons do not end the statement.
+func (sr *StreamReader) WithDollarQuotes() *StreamReader {
+	sr.dollarQuotes = true
+	return sr
+}
+
+// ReadStatement reads the next SQL statement from the reader. Comments are removed
+// from the statement, and io.EOF is returned once the stream holds no more statements.
 func (sr *StreamReader) ReadStatement() (string, error) {
-	var statement []byte
-	inString := false
-	inComment := false
-	lineComment := false
-	escaped := false
+	sr.blocks.Reset()
+	sr.comments = sr.comments[:0]
+
+	var comments []int // Start and end offsets of the comments inside the statement
+	begun := false
+	start, end := 0, 0           // Offsets of the statement in the pending input
+	scan, scanLine := 0, sr.line // Offset and line of the next token to read
+	lastLine := sr.ended         // Line on which the previous token ends
 
+read:
 	for {
-		b, err := sr.reader.ReadByte()
-		if err != nil {
-			if err == io.EOF && len(statement) > 0 {
-				return string(statement), nil
+		base, baseLine := scan, scanLine
+		lexer := sr.lexer(sr.pending[base:])
+
+	tokens:
+		for token, ok := lexer.Next(); ok; token, ok = lexer.Next() {
+			offset := base + token.Offset
+			tokenEnd := offset + len(token.Text)
+			line := baseLine + token.Line - 1
+			if tokenEnd == len(sr.pending) && !sr.eof {
+				// The token may continue in the input not read yet
+				break
 			}
-			return "", err
-		}
 
-		// Handle string literals
-		if b == '\'' && !inComment && !escaped {
-			inString = !inString
-		}
+			firstOnLine := line > lastLine
+			if !begun && line > lastLine+1 {
+				// A blank line separates comments from the statement that follows
+				sr.comments = sr.comments[:0]
+			}
 
-		// Handle escape characters
-		if b == '\\' && !inComment {
-			escaped = !escaped
-		} else {
-			escaped = false
+			switch {
+			case token.Type == sqlmapper.CommentToken:
+				if begun {
+					comments = append(comments, offset, tokenEnd)
+				} else if sr.captureComments && line != sr.ended {
+					// Comments trailing the previous statement on its last line belong to it
+					sr.comments = append(sr.comments, commentText(token.Text))
+				}
+
+			case sr.delimiterDirectives && !begun && strings.EqualFold(token.Text, "DELIMITER"):
+				rest, ok := sr.restOfLine(tokenEnd)
+				if !ok {
+					break tokens
+				}
+				if fields := strings.Fields(rest); len(fields) == 1 {
+					sr.delimiter = fields[0]
+					scan, scanLine, lastLine = tokenEnd+len(rest), line, line
+					continue read
+				}
+				begun, start = true, offset
+				sr.startLine, sr.startOffset = line, sr.offset+offset
+				end = tokenEnd
+
+			case sr.batchSeparator != "" && firstOnLine && strings.EqualFold(token.Text, sr.batchSeparator):
+				rest, ok := sr.restOfLine(tokenEnd)
+				if !ok {
+					break tokens
+				}
+				if sr.separatorLine(rest) {
+					sr.ended = line
+					return sr.statement(start, end, comments, tokenEnd+len(rest))
+				}
+				fallthrough
+
+			default:
+				// A delimiter selected by a DELIMITER directive ends the statement
+				// wherever it appears, as in the MySQL client
+				if sr.blocks.Depth() == 0 || sr.delimiter != sr.defaultDelimiter {
+					if i := sr.delimiterIndex(token, offset); i >= 0 {
+						if i > 0 {
+							if !begun {
+								begun, start = true, offset
+								sr.startLine, sr.startOffset = line, sr.offset+offset
+							}
+							end = offset + i
+						}
+						sr.ended = line
+						return sr.statement(start, end, comments, offset+i+len(sr.delimiter))
+					}
+				}
+
+				if !begun {
+					begun, start = true, offset
+					sr.startLine, sr.startOffset = line, sr.offset+offset
+				}
+				if token.Type == sqlmapper.WordToken {
+					sr.blocks.Word(token.Text)
+				}
+				end = tokenEnd
+			}
+
+			scan, scanLine = tokenEnd, baseLine+token.EndLine()-1
+			lastLine = scanLine
 		}
 
-		// Handle comments
-		if !inString && !inComment && b == '-' {
-			nextByte, err := sr.reader.ReadByte()
-			if err == nil && nextByte == '-' {
-				lineComment = true
-				inComment = true
-				continue
+		// Once the stream has been read, the lexer reaches the end of the input
+		if sr.eof {
+			if !begun {
+				sr.consume(len(sr.pending))
+				return "", io.EOF
 			}
-			sr.reader.UnreadByte()
+			return sr.statement(start, end, comments, len(sr.pending))
 		}
 
-		if !inString && !inComment && b == '/' {
-			nextByte, err := sr.reader.ReadByte()
-			if err == nil && nextByte == '*' {
-				inComment = true
-				continue
-			}
-			sr.reader.UnreadByte()
+		// Refuse to buffer statements that never reach a delimiter
+		if len(sr.pending) > sr.maxSize {
+			return "", sr.sizeError()
+		}
+		if err := sr.fill(); err != nil {
+			return "", err
 		}
+	}
+}
 
-		if inComment && !lineComment && b == '*' {
-			nextByte, err := sr.reader.ReadByte()
-			if err == nil && nextByte == '/' {
-				inComment = false
-				continue
+// LeadingComment returns the comments that immediately preceded the statement most
+// recently returned by ReadStatement. Multiple comments are joined by newlines.
+// It always returns an empty string unless comment capture is enabled.
+func (sr *StreamReader) LeadingComment() string {
+	return strings.Join(sr.comments, "\n")
+}
+
+// Line returns the line on which the statement most recently returned by
+// ReadStatement starts, counting from 1
+func (sr *StreamReader) Line() int {
+	return sr.startLine
+}
+
+// Position returns the position of the statement most recently returned by
+// ReadStatement
+func (sr *StreamReader) Position() Position {
+	return Position{Line: sr.startLine, Offset: sr.startOffset}
+}
+
+// lexer creates a lexer for the given input following the rules of the reader
+func (sr *StreamReader) lexer(input string) *sqlmapper.Lexer {
+	lexer := sqlmapper.NewLexer(input)
+	if sr.mysqlComments {
+		lexer.WithMySQLComments()
+	}
+	if sr.dollarQuotes {
+		lexer.WithDollarQuotes()
+	}
+	if sr.backslashEscapes {
+		lexer.WithBackslashEscapes()
+	}
+	return lexer
+}
+
+// fill appends the next chunk of the stream to the pending input. Once a statement
+// spans more than a chunk, at least as much as is pending is read, so that a long
+// statement is copied and its last token lexed again only a few times.
+func (sr *StreamReader) fill() error {
+	size := max(readSize, len(sr.pending))
+	least := 1
+	if len(sr.pending) > readSize {
+		least = len(sr.pending)
+	}
+	if limit := sr.maxSize + 1 - len(sr.pending); size > limit {
+		size = max(limit, 1)
+		least = min(least, size)
+	}
+
+	if cap(sr.chunk) < size {
+		sr.chunk = make([]byte, size)
+	}
+	n, err := io.ReadAtLeast(sr.reader, sr.chunk[:size], least)
+	sr.pending += string(sr.chunk[:n])
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		sr.eof = true
+		return nil
+	}
+	return err
+}
+
+// consume drops the first n bytes of the pending input
+func (sr *StreamReader) consume(n int) {
+	sr.line += strings.Count(sr.pending[:n], "\n")
+	sr.offset += n
+	sr.pending = sr.pending[n:]
+}
+
+// statement returns the statement between the offsets start and end of the pending
+// input without the comments inside it, and consumes the input up to next
+func (sr *StreamReader) statement(start, end int, comments []int, next int) (string, error) {
+	if next > sr.maxSize {
+		return "", sr.sizeError()
+	}
+
+	var sb strings.Builder
+	last := start
+	for i := 0; i < len(comments) && comments[i] < end; i += 2 {
+		sb.WriteString(sr.pending[last:comments[i]])
+		// Keep the words around a block comment apart, and the lines it spans
+		if comment := sr.pending[comments[i]:comments[i+1]]; strings.HasPrefix(comment, "/*") {
+			if lines := strings.Count(comment, "\n"); lines > 0 {
+				sb.WriteString(strings.Repeat("\n", lines))
+			} else {
+				sb.WriteByte(' ')
 			}
-			sr.reader.UnreadByte()
 		}
+		last = comments[i+1]
+	}
+	if last < end {
+		sb.WriteString(sr.pending[last:end])
+	}
 
-		if lineComment && b == '\n' {
-			inComment = false
-			lineComment = false
-			continue
-		}
+	sr.consume(next)
+	return sb.String(), nil
+}
 
-		// Skip comments
-		if inComment {
-			continue
-		}
+// sizeError returns the error for a statement exceeding the maximum size
+func (sr *StreamReader) sizeError() error {
+	return fmt.Errorf("statement exceeds maximum size of %d bytes; missing %q delimiter?", sr.maxSize, sr.delimiter)
+}
+
+// restOfLine returns the pending input from offset up to the end of its line. The
+// second return value is false if the end of the line has not been read yet.
+func (sr *StreamReader) restOfLine(offset int) (string, bool) {
+	rest := sr.pending[offset:]
+	if i := strings.IndexByte(rest, '\n'); i >= 0 {
+		return rest[:i], true
+	}
+	return rest, sr.eof
+}
 
-		// Add character to statement
-		statement = append(statement, b)
+// separatorLine reports whether the rest of the line following a batch separator
+// holds nothing but an optional repeat count and a line comment
+func (sr *StreamReader) separatorLine(rest string) bool {
+	rest = strings.TrimSpace(rest)
+	rest = strings.TrimSpace(strings.TrimLeft(rest, "0123456789"))
+	return rest == "" || strings.HasPrefix(rest, "--") || sr.mysqlComments && rest[0] == '#'
+}
 
-		// Check for delimiter
-		if !inString && len(statement) >= len(sr.delimiter) {
-			lastIdx := len(statement) - len(sr.delimiter)
-			if string(statement[lastIdx:]) == sr.delimiter {
-				return string(statement[:lastIdx]), nil
-			}
+// delimiterIndex returns the index in the token at offset at which the delimiter
+// starts, or -1. A delimiter may end a word, as the $$ of END$$, or span several
+// symbols, as the // of a DELIMITER // directive.
+func (sr *StreamReader) delimiterIndex(token sqlmapper.Token, offset int) int {
+	switch token.Type {
+	case sqlmapper.WordToken:
+		return strings.Index(token.Text, sr.delimiter)
+	case sqlmapper.SymbolToken:
+		if strings.HasPrefix(sr.pending[offset:], sr.delimiter) {
+			return 0
 		}
 	}
+	return -1
+}
+
+// commentText returns the text of a comment without its markers
+func commentText(comment string) string {
+	switch {
+	case strings.HasPrefix(comment, "--"):
+		comment = comment[2:]
+	case strings.HasPrefix(comment, "#"):
+		comment = comment[1:]
+	default:
+		comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/*"), "*/")
+	}
+	return strings.TrimSpace(comment)
 }
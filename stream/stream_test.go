@@ -2,10 +2,18 @@ package stream
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/mstgnz/sqlmapper"
 	"github.com/stretchr/testify/assert"
@@ -102,6 +110,46 @@ func TestStreamReader_ReadStatement(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Trigger with BEGIN...END body",
+			input: "CREATE TRIGGER audit_users AFTER UPDATE ON users FOR EACH ROW\nBEGIN\n" +
+				"  INSERT INTO audit_log (user_id) VALUES (NEW.id);\n" +
+				"  UPDATE stats SET updates = updates + 1;\n" +
+				"  DELETE FROM cache WHERE user_id = NEW.id;\n" +
+				"END;\nCREATE TABLE posts (id INT);",
+			delimiter: ";",
+			want: []string{
+				"CREATE TRIGGER audit_users AFTER UPDATE ON users FOR EACH ROW\nBEGIN\n" +
+					"  INSERT INTO audit_log (user_id) VALUES (NEW.id);\n" +
+					"  UPDATE stats SET updates = updates + 1;\n" +
+					"  DELETE FROM cache WHERE user_id = NEW.id;\n" +
+					"END",
+				"CREATE TABLE posts (id INT)",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Procedure with nested blocks and compound statements",
+			input: "CREATE PROCEDURE sync() BEGIN IF 'end;' = x THEN BEGIN SET y = 1; END; END IF; " +
+				"SET z = CASE WHEN y THEN 1 ELSE 0 END; END; SELECT 1;",
+			delimiter: ";",
+			want: []string{
+				"CREATE PROCEDURE sync() BEGIN IF 'end;' = x THEN BEGIN SET y = 1; END; END IF; SET z = CASE WHEN y THEN 1 ELSE 0 END; END",
+				"SELECT 1",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Transactions and BEGIN columns are not blocks",
+			input:     "BEGIN; CREATE TABLE events (begin DATE, `end` DATE); COMMIT;",
+			delimiter: ";",
+			want: []string{
+				"BEGIN",
+				"CREATE TABLE events (begin DATE, `end` DATE)",
+				"COMMIT",
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -132,6 +180,549 @@ func TestStreamReader_ReadStatement(t *testing.T) {
 	}
 }
 
+func TestStreamReader_BatchSeparator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "GO between statements",
+			input: "CREATE TABLE users (id INT)\nGO\nCREATE TABLE posts (id INT)\nGO\n",
+			want: []string{
+				"CREATE TABLE users (id INT)",
+				"CREATE TABLE posts (id INT)",
+			},
+		},
+		{
+			name:  "Case-insensitive GO with repeat count",
+			input: "CREATE TABLE users (id INT)\n  go 5  \nCREATE TABLE posts (id INT)\nGo",
+			want: []string{
+				"CREATE TABLE users (id INT)",
+				"CREATE TABLE posts (id INT)",
+			},
+		},
+		{
+			name:  "GO mixed with semicolons",
+			input: "CREATE TABLE users (id INT);\nCREATE TABLE posts (id INT)\nGO\n",
+			want: []string{
+				"CREATE TABLE users (id INT)",
+				"CREATE TABLE posts (id INT)",
+			},
+		},
+		{
+			name:  "GO inside identifiers and strings is ignored",
+			input: "CREATE TABLE cargo (algo INT DEFAULT 'GO\n')\nGO\n",
+			want: []string{
+				"CREATE TABLE cargo (algo INT DEFAULT 'GO\n')",
+			},
+		},
+		{
+			name:  "GO followed by a line comment",
+			input: "CREATE TABLE users (id INT)\nGO -- end of batch\nCREATE TABLE posts (id INT)\n",
+			want: []string{
+				"CREATE TABLE users (id INT)",
+				"CREATE TABLE posts (id INT)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewStreamReader(strings.NewReader(tt.input), ";").WithBatchSeparator("GO")
+			var got []string
+
+			for {
+				stmt, err := reader.ReadStatement()
+				if err == io.EOF {
+					break
+				}
+				assert.NoError(t, err)
+
+				stmt = strings.TrimSpace(stmt)
+				if stmt != "" {
+					got = append(got, stmt)
+				}
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStreamReader_DelimiterDirectives(t *testing.T) {
+	trigger := "CREATE TRIGGER trg BEFORE INSERT ON users FOR EACH ROW\nBEGIN\n" +
+		"  SET NEW.id = 1;\n  SET NEW.name = UPPER(NEW.name);\n  INSERT INTO audit_log (user_id) VALUES (NEW.id);\nEND"
+
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "Slashes",
+			input: "CREATE TABLE users (id INT);\nDELIMITER //\n" + trigger + "//\nDELIMITER ;\nCREATE TABLE posts (id INT);\n",
+			want:  []string{"CREATE TABLE users (id INT)", trigger, "CREATE TABLE posts (id INT)"},
+		},
+		{
+			name:  "Dollars",
+			input: "DELIMITER $$\n" + trigger + "$$\n\nCREATE PROCEDURE p() BEGIN SELECT 1; END $$\ndelimiter ;\nCREATE TABLE posts (id INT);",
+			want:  []string{trigger, "CREATE PROCEDURE p() BEGIN SELECT 1; END", "CREATE TABLE posts (id INT)"},
+		},
+		{
+			name:  "Double semicolons",
+			input: "DELIMITER ;;\n" + trigger + ";;\nDELIMITER ;",
+			want:  []string{trigger},
+		},
+		{
+			name:  "Not a directive",
+			input: "CREATE TABLE t (delimiter CHAR(1));\nSELECT delimiter FROM t;",
+			want:  []string{"CREATE TABLE t (delimiter CHAR(1))", "SELECT delimiter FROM t"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewStreamReader(strings.NewReader(tt.input), ";").WithDelimiterDirectives()
+			var got []string
+
+			for {
+				stmt, err := reader.ReadStatement()
+				if err == io.EOF {
+					break
+				}
+				assert.NoError(t, err)
+
+				stmt = strings.TrimSpace(stmt)
+				if stmt != "" {
+					got = append(got, stmt)
+				}
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStreamReader_MySQLComments(t *testing.T) {
+	input := "# Dump of table users; generated by mysqldump\nCREATE TABLE users (id INT, color CHAR(4) DEFAULT '#fff'); # trailing;\n" +
+		"CREATE TABLE `#posts` (id INT);\n# the end"
+
+	reader := NewStreamReader(strings.NewReader(input), ";").WithMySQLComments().WithCommentCapture(true)
+	var got, comments []string
+	for {
+		stmt, err := reader.ReadStatement()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+
+		if stmt = strings.TrimSpace(stmt); stmt != "" {
+			got = append(got, stmt)
+			comments = append(comments, reader.LeadingComment())
+		}
+	}
+
+	assert.Equal(t, []string{
+		"CREATE TABLE users (id INT, color CHAR(4) DEFAULT '#fff')",
+		"CREATE TABLE `#posts` (id INT)",
+	}, got)
+	assert.Equal(t, []string{"Dump of table users; generated by mysqldump", ""}, comments)
+
+	// -- only starts a comment when followed by whitespace
+	input = "SELECT a--b;\nSELECT a---b; -- note;\n--\tend;\nSELECT 1;--"
+	for _, mysql := range []bool{false, true} {
+		reader = NewStreamReader(strings.NewReader(input), ";")
+		if mysql {
+			reader.WithMySQLComments()
+		}
+		got = nil
+		for {
+			stmt, err := reader.ReadStatement()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			if stmt = strings.TrimSpace(stmt); stmt != "" {
+				got = append(got, stmt)
+			}
+		}
+
+		if mysql {
+			assert.Equal(t, []string{"SELECT a--b", "SELECT a---b", "SELECT 1"}, got)
+		} else {
+			assert.Equal(t, []string{"SELECT a\nSELECT a\n\nSELECT 1"}, got)
+		}
+	}
+}
+
+func TestStreamReader_LeadingComment(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "Line comment before statement",
+			input: "-- Stores customer orders\nCREATE TABLE orders (id INT);",
+			want:  []string{"Stores customer orders"},
+		},
+		{
+			name:  "Multiple comments are joined",
+			input: "/* Orders */\n-- one row per order\nCREATE TABLE orders (id INT);",
+			want:  []string{"Orders\none row per order"},
+		},
+		{
+			name:  "Blank line detaches comment",
+			input: "-- File header\n\nCREATE TABLE orders (id INT);",
+			want:  []string{""},
+		},
+		{
+			name:  "Trailing comment belongs to previous statement",
+			input: "CREATE TABLE a (id INT); -- about a\nCREATE TABLE b (id INT);",
+			want:  []string{"", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewStreamReader(strings.NewReader(tt.input), ";").WithCommentCapture(true)
+			var got []string
+
+			for {
+				stmt, err := reader.ReadStatement()
+				if err == io.EOF {
+					break
+				}
+				assert.NoError(t, err)
+
+				if strings.TrimSpace(stmt) != "" {
+					got = append(got, reader.LeadingComment())
+				}
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStreamReader_MaxStatementSize(t *testing.T) {
+	t.Run("Never-terminated statement", func(t *testing.T) {
+		input := "CREATE TABLE a (id INT);\nINSERT INTO a VALUES " + strings.Repeat("(1), ", 1000)
+		reader := NewStreamReader(strings.NewReader(input), ";").WithMaxStatementSize(256)
+
+		stmt, err := reader.ReadStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, "CREATE TABLE a (id INT)", stmt)
+
+		_, err = reader.ReadStatement()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "statement exceeds maximum size of 256 bytes")
+	})
+
+	t.Run("Statement within limit", func(t *testing.T) {
+		input := "INSERT INTO a VALUES " + strings.Repeat("(1), ", 40) + "(1);"
+		reader := NewStreamReader(strings.NewReader(input), ";").WithMaxStatementSize(256)
+
+		stmt, err := reader.ReadStatement()
+		assert.NoError(t, err)
+		assert.True(t, strings.HasSuffix(stmt, "(1)"))
+	})
+
+	t.Run("Zero restores default", func(t *testing.T) {
+		reader := NewStreamReader(strings.NewReader(""), ";").WithMaxStatementSize(0)
+		assert.Equal(t, DefaultMaxStatementSize, reader.maxSize)
+	})
+}
+
+func TestStreamReader_Line(t *testing.T) {
+	input := "CREATE TABLE a (id INT);\n\n-- users\nCREATE TABLE b (\n  id INT\n); CREATE TABLE c (id INT);"
+	reader := NewStreamReader(strings.NewReader(input), ";")
+
+	var lines []int
+	for {
+		_, err := reader.ReadStatement()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		lines = append(lines, reader.Line())
+	}
+
+	assert.Equal(t, []int{1, 4, 6}, lines)
+}
+
+func TestErrorCollector(t *testing.T) {
+	parseErr := errors.New("no table found in statement")
+
+	t.Run("Abort by default", func(t *testing.T) {
+		collector := ParseOptions{}.NewErrorCollector()
+		err := collector.Handle(Statement{Text: "CREATE TABLE", Line: 3}, parseErr)
+		assert.ErrorIs(t, err, parseErr)
+		assert.EqualError(t, err, `line 3 near "CREATE TABLE": no table found in statement`)
+		var positioned *sqlmapper.ParseError
+		if assert.True(t, errors.As(err, &positioned)) {
+			assert.Equal(t, 3, positioned.Line)
+		}
+		assert.NoError(t, collector.Err())
+	})
+
+	t.Run("Continue on error", func(t *testing.T) {
+		var reported []int
+		collector := ParseOptions{
+			ContinueOnError: true,
+			OnError: func(err *StatementError) {
+				reported = append(reported, err.Line)
+			},
+		}.NewErrorCollector()
+
+		assert.NoError(t, collector.Handle(Statement{Text: "CREATE VIEW", Line: 9}, parseErr))
+		assert.NoError(t, collector.Handle(Statement{Text: "CREATE TABLE", Line: 3}, parseErr))
+		assert.Equal(t, []int{9, 3}, reported)
+
+		err := collector.Err()
+		var parseErrors ParseErrors
+		assert.True(t, errors.As(err, &parseErrors))
+		assert.Len(t, parseErrors, 2)
+		assert.Equal(t, 3, parseErrors[0].Line)
+		assert.Equal(t, "CREATE TABLE", parseErrors[0].Statement)
+		assert.ErrorIs(t, parseErrors[0], parseErr)
+		assert.Equal(t, "2 statements failed to parse, first at line 3: no table found in statement", err.Error())
+	})
+}
+
+func TestStreamReader_Lexer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		setup func(*StreamReader)
+		want  []string
+	}{
+		{
+			name:  "Quoted identifiers and comments hide delimiters",
+			input: "CREATE TABLE \"it's;\" (`a;b` INT) -- it's;\n/* don't; */;\nSELECT 1;",
+			want:  []string{"CREATE TABLE \"it's;\" (`a;b` INT)", "SELECT 1"},
+		},
+		{
+			name:  "Dollar-quoted bodies",
+			input: "CREATE FUNCTION f() RETURNS int AS $$ SELECT 1; $$ LANGUAGE sql;\nCREATE FUNCTION g() RETURNS int AS $body$ SELECT ';'; $body$ LANGUAGE sql;",
+			setup: func(sr *StreamReader) { sr.WithDollarQuotes() },
+			want: []string{
+				"CREATE FUNCTION f() RETURNS int AS $$ SELECT 1; $$ LANGUAGE sql",
+				"CREATE FUNCTION g() RETURNS int AS $body$ SELECT ';'; $body$ LANGUAGE sql",
+			},
+		},
+		{
+			name:  "Backslash escapes",
+			input: "INSERT INTO t VALUES ('a\\';b');\nINSERT INTO t VALUES ('C:\\\\');",
+			setup: func(sr *StreamReader) { sr.WithBackslashEscapes() },
+			want:  []string{"INSERT INTO t VALUES ('a\\';b')", "INSERT INTO t VALUES ('C:\\\\')"},
+		},
+		{
+			name:  "Backslash is an ordinary character",
+			input: "INSERT INTO t VALUES ('C:\\');\nDROP TABLE t;",
+			want:  []string{"INSERT INTO t VALUES ('C:\\')", "DROP TABLE t"},
+		},
+		{
+			name:  "Slash separates batches only on its own line",
+			input: "CREATE VIEW v AS SELECT a/b FROM t;\nCREATE TABLE t (id NUMBER)\n/\nCREATE VIEW w AS SELECT a / 2 FROM t\n  /  \n",
+			setup: func(sr *StreamReader) { sr.WithBatchSeparator("/") },
+			want:  []string{"CREATE VIEW v AS SELECT a/b FROM t", "CREATE TABLE t (id NUMBER)", "CREATE VIEW w AS SELECT a / 2 FROM t"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Reading one byte at a time splits every token across reads
+			for _, reader := range []io.Reader{strings.NewReader(tt.input), iotest.OneByteReader(strings.NewReader(tt.input))} {
+				streamReader := NewStreamReader(reader, ";")
+				if tt.setup != nil {
+					tt.setup(streamReader)
+				}
+
+				var got []string
+				for {
+					stmt, err := streamReader.ReadStatement()
+					if err == io.EOF {
+						break
+					}
+					assert.NoError(t, err)
+					if stmt = strings.TrimSpace(stmt); stmt != "" {
+						got = append(got, stmt)
+					}
+				}
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStreamReader_Position(t *testing.T) {
+	input := "CREATE TABLE a (id INT);\n-- b\n  CREATE TABLE b (id INT);\n\nINSERT INTO a VALUES (1);"
+	reader := NewStreamReader(strings.NewReader(input), ";")
+
+	var positions []Position
+	for {
+		statement, err := reader.ReadStatement()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		pos := reader.Position()
+		assert.True(t, strings.HasPrefix(input[pos.Offset:], strings.TrimSpace(statement)))
+		positions = append(positions, pos)
+	}
+
+	assert.Equal(t, []Position{{Line: 1, Offset: 0}, {Line: 3, Offset: 32}, {Line: 5, Offset: 58}}, positions)
+}
+
+func TestParseOptions_Unhandled(t *testing.T) {
+	statement := Statement{Text: "-- load fixtures\nINSERT INTO users VALUES (1)", Line: 7}
+	assert.NoError(t, ParseOptions{}.Unhandled(statement))
+
+	err := ParseOptions{Strict: true}.Unhandled(statement)
+	var statementErr *StatementError
+	assert.ErrorAs(t, err, &statementErr)
+	assert.ErrorIs(t, err, ErrUnhandledStatement)
+	assert.Equal(t, 7, statementErr.Line)
+	assert.Equal(t, "line 7: unhandled statement: INSERT", err.Error())
+
+	err = ParseOptions{Strict: true}.Unhandled(Statement{Text: "CREATE OR REPLACE EXTENSION hstore", Line: 1})
+	assert.EqualError(t, err, "line 1: unhandled statement: CREATE EXTENSION")
+
+	// Skipped statements are passed to OnSkip unless Strict is set
+	var skipped []Position
+	onSkip := func(statement string, pos Position) { skipped = append(skipped, pos) }
+	assert.NoError(t, ParseOptions{OnSkip: onSkip}.Unhandled(Statement{Text: "SET NAMES utf8", Line: 2, Offset: 10}))
+	assert.Error(t, ParseOptions{Strict: true, OnSkip: onSkip}.Unhandled(statement))
+	assert.Equal(t, []Position{{Line: 2, Offset: 10}}, skipped)
+
+	// Collected errors keep the position of the statement
+	collector := ParseOptions{Strict: true, ContinueOnError: true}.NewErrorCollector()
+	assert.NoError(t, collector.Handle(statement, ParseOptions{Strict: true}.Unhandled(statement)))
+	assert.EqualError(t, collector.Err(), "1 statement failed to parse: line 7: unhandled statement: INSERT")
+}
+
+func TestBlankStatement(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      bool
+	}{
+		{name: "Empty", statement: "", want: true},
+		{name: "Whitespace", statement: " \n\t", want: true},
+		{name: "Block comment", statement: "/* nothing to see */", want: true},
+		{name: "Line comments", statement: "-- first\n-- second\n", want: true},
+		{name: "Comment and semicolon", statement: "/* x */ ;", want: true},
+		{name: "Statement", statement: "/* x */ CREATE TABLE t (id INT)", want: false},
+		{name: "Comment marker in literal", statement: "SELECT '/* x */'", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, BlankStatement(tt.statement))
+		})
+	}
+}
+
+func TestDetectObject(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		wantType  SchemaObjectType
+		wantName  string
+		wantOK    bool
+	}{
+		{"Table", "CREATE TABLE users (id INT)", TableObject, "users", true},
+		{"Qualified and quoted table", "CREATE TABLE IF NOT EXISTS `shop`.`orders` (id INT)", TableObject, "orders", true},
+		{"Temporary table", "CREATE GLOBAL TEMPORARY TABLE tmp_data (id NUMBER)", TableObject, "tmp_data", true},
+		{"Replaced view", "create or replace view active_users as select 1", ViewObject, "active_users", true},
+		{"Materialized view", "CREATE MATERIALIZED VIEW mv_sales AS SELECT 1", ViewObject, "mv_sales", true},
+		{"Unique index", "CREATE UNIQUE INDEX idx_email ON users (email)", IndexObject, "idx_email", true},
+		{"SQL Server procedure", "CREATE PROC [dbo].[cleanup] AS BEGIN SELECT 1 END", ProcedureObject, "cleanup", true},
+		{"Grant", "GRANT SELECT ON public.users TO reader", PermissionObject, "users", true},
+		{"Unknown", "INSERT INTO users VALUES (1)", 0, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotName, gotOK := DetectObject(tt.statement)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.Equal(t, tt.wantType, gotType)
+			assert.Equal(t, tt.wantName, gotName)
+		})
+	}
+}
+
+func TestParseOptions_Filter(t *testing.T) {
+	options := ParseOptions{Filter: TypeFilter(TableObject)}
+
+	assert.False(t, options.SkipStatement("CREATE TABLE users (id INT)"))
+	assert.True(t, options.SkipStatement("CREATE VIEW v AS SELECT 1"))
+	assert.False(t, options.SkipStatement("ALTER TABLE users ADD COLUMN age INT"))
+
+	assert.True(t, options.Accept(&SchemaObject{Type: TableObject, Data: &sqlmapper.Table{Name: "users"}}))
+	assert.False(t, options.Accept(&SchemaObject{Type: ViewObject, Data: &sqlmapper.View{Name: "v"}}))
+	assert.True(t, ParseOptions{}.Accept(&SchemaObject{Type: ViewObject}))
+}
+
+// repeatReader endlessly repeats its text, counting the bytes read
+type repeatReader struct {
+	text  string
+	read  int
+	delay time.Duration
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	time.Sleep(r.delay)
+	n := 0
+	for n < len(p) {
+		n += copy(p[n:], r.text[(r.read+n)%len(r.text):])
+	}
+	r.read += n
+	return n, nil
+}
+
+func TestBudget_Reader(t *testing.T) {
+	t.Run("Unterminated oversized statement", func(t *testing.T) {
+		source := &repeatReader{text: "(1), "}
+		budget := ParseOptions{MaxTotalBytes: 1024}.NewBudget()
+		reader := NewStreamReader(budget.Reader(io.MultiReader(strings.NewReader("INSERT INTO a VALUES "), source)), ";")
+
+		_, err := reader.ReadStatement()
+		assert.ErrorIs(t, err, ErrBudgetExceeded)
+		assert.EqualError(t, err, "parse budget exceeded: more than 1024 bytes read")
+		assert.LessOrEqual(t, source.read, 1025)
+	})
+
+	t.Run("Deadline passes while reading", func(t *testing.T) {
+		source := &repeatReader{text: "(1), ", delay: time.Millisecond}
+		budget := ParseOptions{MaxDuration: 20 * time.Millisecond}.NewBudget()
+		reader := NewStreamReader(budget.Reader(source), ";")
+
+		_, err := reader.ReadStatement()
+		assert.ErrorIs(t, err, ErrBudgetExceeded)
+		assert.Contains(t, err.Error(), "parsing took longer than 20ms")
+	})
+}
+
+func TestParseOptions_Parallelism(t *testing.T) {
+	workers, buffer := ParseOptions{}.Parallelism(4)
+	assert.Equal(t, 4, workers)
+	assert.Equal(t, 4, buffer)
+
+	workers, buffer = ParseOptions{BufferSize: 256}.Parallelism(4)
+	assert.Equal(t, 4, workers)
+	assert.Equal(t, 256, buffer)
+
+	workers, buffer = ParseOptions{}.Parallelism(0)
+	assert.Equal(t, runtime.NumCPU(), workers)
+	assert.Equal(t, runtime.NumCPU(), buffer)
+
+	workers, _ = ParseOptions{}.Parallelism(-3)
+	assert.Equal(t, runtime.NumCPU(), workers)
+}
+
 func TestWorkerPool(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -213,3 +804,240 @@ func TestWorkerPool(t *testing.T) {
 		})
 	}
 }
+
+func TestDecompress(t *testing.T) {
+	dump := "CREATE TABLE users (id INT);\nCREATE TABLE posts (id INT);\n"
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err := writer.Write([]byte(dump))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	bzip2Dump, err := os.ReadFile(filepath.Join("testdata", "dump.sql.bz2"))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{name: "Plain text", input: []byte(dump)},
+		{name: "Gzip", input: compressed.Bytes()},
+		{name: "Bzip2", input: bzip2Dump},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := Decompress(bytes.NewReader(tt.input))
+			assert.NoError(t, err)
+
+			streamReader := NewStreamReader(reader, ";")
+			var statements []string
+			for {
+				statement, err := streamReader.ReadStatement()
+				if err == io.EOF {
+					break
+				}
+				assert.NoError(t, err)
+				if statement = strings.TrimSpace(statement); statement != "" {
+					statements = append(statements, statement)
+				}
+			}
+
+			assert.Equal(t, []string{"CREATE TABLE users (id INT)", "CREATE TABLE posts (id INT)"}, statements)
+		})
+	}
+
+	t.Run("Empty input", func(t *testing.T) {
+		reader, err := Decompress(bytes.NewReader(nil))
+		assert.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Empty(t, data)
+	})
+}
+
+func TestDecompress_Zstd(t *testing.T) {
+	zstdDump, err := os.ReadFile(filepath.Join("testdata", "dump.sql.zst"))
+	assert.NoError(t, err)
+
+	t.Run("Without decoder", func(t *testing.T) {
+		_, err := Decompress(bytes.NewReader(zstdDump))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no decoder registered for zstd stream")
+	})
+
+	t.Run("With registered decoder", func(t *testing.T) {
+		magic := []byte{0x28, 0xb5, 0x2f, 0xfd}
+		defer RegisterDecoder("zstd", magic, nil)
+
+		var received []byte
+		RegisterDecoder("zstd", magic, func(reader io.Reader) (io.Reader, error) {
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				return nil, err
+			}
+			received = data
+			return strings.NewReader("CREATE TABLE users (id INT);"), nil
+		})
+
+		reader, err := Decompress(bytes.NewReader(zstdDump))
+		assert.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "CREATE TABLE users (id INT);", string(data))
+		assert.Equal(t, zstdDump, received)
+	})
+}
+
+func TestBatchProcessor_ProcessBatch(t *testing.T) {
+	script := `CREATE TABLE a (id INT);
+CREATE TABLE b (id INT);
+-- Lookup index
+CREATE INDEX idx_b ON b (id);
+ALTER TABLE a ADD COLUMN name TEXT;
+DROP TABLE old_a;
+GRANT SELECT ON a TO reader;
+INSERT INTO a VALUES (1);`
+
+	t.Run("Chunks with remainder", func(t *testing.T) {
+		var sizes []int
+		var statements []*Statement
+		processor := NewBatchProcessor(BatchConfig{BatchSize: 3})
+		err := processor.ProcessBatch(context.Background(), strings.NewReader(script), func(batch []*Statement) error {
+			sizes = append(sizes, len(batch))
+			statements = append(statements, batch...)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 3, 1}, sizes)
+		if assert.Len(t, statements, 7) {
+			assert.Equal(t, "CREATE INDEX idx_b ON b (id)", statements[2].Text)
+			assert.Equal(t, "Lookup index", statements[2].LeadingComment)
+			assert.Equal(t, 4, statements[2].Line)
+			assert.Equal(t, sqlmapper.CreateIndexStatement, statements[2].Kind)
+			assert.Equal(t, sqlmapper.GrantStatement, statements[5].Kind)
+		}
+	})
+
+	t.Run("Even chunks", func(t *testing.T) {
+		var sizes []int
+		processor := NewBatchProcessor(BatchConfig{BatchSize: 7})
+		err := processor.ProcessBatch(context.Background(), strings.NewReader(script), func(batch []*Statement) error {
+			sizes = append(sizes, len(batch))
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{7}, sizes)
+	})
+
+	t.Run("Handler error stops processing", func(t *testing.T) {
+		calls := 0
+		processor := NewBatchProcessor(BatchConfig{BatchSize: 2})
+		err := processor.ProcessBatch(context.Background(), strings.NewReader(script), func(batch []*Statement) error {
+			calls++
+			return errors.New("rollback")
+		})
+		assert.EqualError(t, err, "rollback")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		processor := NewBatchProcessor(BatchConfig{})
+		err := processor.ProcessBatch(ctx, strings.NewReader(script), func(batch []*Statement) error {
+			t.Fatal("handler called after cancellation")
+			return nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestBatchProcessor_StatementTimeout(t *testing.T) {
+	script := "CREATE TABLE a (id INT);\nCREATE INDEX idx_slow ON a (id);\nCREATE TABLE b (id INT);"
+
+	var applied []string
+	processor := NewBatchProcessor(BatchConfig{BatchSize: 2, StatementTimeout: 20 * time.Millisecond})
+	err := processor.ProcessStatements(context.Background(), strings.NewReader(script), func(ctx context.Context, statement *Statement) error {
+		if statement.Kind == sqlmapper.CreateIndexStatement {
+			// Simulate an index build that takes longer than the statement timeout
+			select {
+			case <-time.After(time.Second):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		applied = append(applied, statement.Text)
+		return nil
+	})
+
+	assert.Equal(t, []string{"CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)"}, applied)
+
+	var timeouts TimeoutErrors
+	if assert.ErrorAs(t, err, &timeouts) && assert.Len(t, timeouts, 1) {
+		assert.Equal(t, 2, timeouts[0].Line)
+		assert.Equal(t, "CREATE INDEX idx_slow ON a (id)", timeouts[0].Statement)
+		assert.ErrorIs(t, timeouts[0], context.DeadlineExceeded)
+	}
+
+	// The overall timeout still fails the whole call
+	processor = NewBatchProcessor(BatchConfig{Timeout: 20 * time.Millisecond, StatementTimeout: time.Second})
+	err = processor.ProcessStatements(context.Background(), strings.NewReader(script), func(ctx context.Context, statement *Statement) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorAs(t, err, &timeouts)
+}
+
+func TestBatchProcessor_RateLimit(t *testing.T) {
+	var script strings.Builder
+	for i := 0; i < 11; i++ {
+		fmt.Fprintf(&script, "CREATE TABLE t%d (id INT);\n", i)
+	}
+
+	count := 0
+	processor := NewBatchProcessor(BatchConfig{BatchSize: 4, StatementsPerSecond: 100})
+	start := time.Now()
+	err := processor.ProcessStatements(context.Background(), strings.NewReader(script.String()), func(ctx context.Context, statement *Statement) error {
+		count++
+		return nil
+	})
+	elapsed := time.Since(start)
+
+	// The first statement is not delayed, each of the other ten waits 10ms
+	assert.NoError(t, err)
+	assert.Equal(t, 11, count)
+	assert.GreaterOrEqual(t, elapsed, 95*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+
+	// Waiting for the limiter stops when the context is cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	processor = NewBatchProcessor(BatchConfig{StatementsPerSecond: 0.5})
+	start = time.Now()
+	err = processor.ProcessStatements(ctx, strings.NewReader(script.String()), func(ctx context.Context, statement *Statement) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestSchemaObject_Schema(t *testing.T) {
+	obj := SchemaObject{Type: TableObject, Data: &sqlmapper.Table{Name: "users"}}
+	schema, err := obj.Schema()
+	assert.NoError(t, err)
+	assert.Equal(t, &sqlmapper.Schema{Tables: []sqlmapper.Table{{Name: "users"}}}, schema)
+
+	settings := []sqlmapper.SessionSetting{{Name: "NAMES", Value: "utf8mb4"}}
+	obj = SchemaObject{Type: SettingObject, Data: settings}
+	schema, err = obj.Schema()
+	assert.NoError(t, err)
+	assert.Equal(t, settings, schema.Settings)
+
+	obj = SchemaObject{Type: IndexObject, Data: &sqlmapper.Index{Name: "idx_users_email"}}
+	_, err = obj.Schema()
+	assert.EqualError(t, err, "schema object *sqlmapper.Index is not supported")
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+}
@@ -0,0 +1,99 @@
+package stream
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mstgnz/sqlmapper"
+)
+
+// TransformFunc rewrites an object read from a stream before it is generated, see
+// TransformStream
+type TransformFunc func(SchemaObject) (SchemaObject, error)
+
+// TransformStream parses a dump with parser, passes every object to transform and
+// writes the returned object right away, so that a dump of any size is rewritten
+// holding a single object at a time. Indexes parsed on their own are generated with
+// generator. Statements the parser does not return, such as INSERT statements, are
+// not written, and options writing statements around the output, such as
+// Transaction, apply to every object. The transform stops the stream without an
+// error by returning ErrStopIteration.
+func TransformStream(parser StreamParser, generator sqlmapper.ObjectGenerator, reader io.Reader, writer io.Writer, transform TransformFunc) error {
+	if writer == nil {
+		return &sqlmapper.ValidationError{Problems: []string{"writer cannot be nil"}}
+	}
+	if transform == nil {
+		return &sqlmapper.ValidationError{Problems: []string{"transform cannot be nil"}}
+	}
+
+	return parser.ParseStream(reader, func(obj SchemaObject) error {
+		obj, err := transform(obj)
+		if err != nil {
+			return err
+		}
+
+		switch data := obj.Data.(type) {
+		case *sqlmapper.Index:
+			// The index is generated on its own, GenerateStream would create its table
+			stmt, err := generator.GenerateIndex(data.Table, *data)
+			if err != nil {
+				return err
+			}
+			err = sqlmapper.WriteStatement(writer, stmt, ";\n\n")
+			return err
+		case *sqlmapper.Comment:
+			table := sqlmapper.Table{Name: data.Table, Comment: data.Text}
+			if data.Schema != "" {
+				table.Name = data.Schema + "." + data.Table
+			}
+			if data.Column != "" {
+				table.Comment = ""
+				table.Columns = []sqlmapper.Column{{Name: data.Column, Comment: data.Text}}
+			}
+			for _, stmt := range sqlmapper.CommentStatements(table) {
+				if err := sqlmapper.WriteStatement(writer, stmt, ";\n\n"); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		schema, err := obj.Schema()
+		if err != nil {
+			return err
+		}
+		return parser.GenerateStream(schema, writer)
+	})
+}
+
+// Schema returns a schema holding only the object, to be generated on its own
+func (o *SchemaObject) Schema() (*sqlmapper.Schema, error) {
+	schema := &sqlmapper.Schema{}
+	switch data := o.Data.(type) {
+	case *sqlmapper.Table:
+		schema.Tables = []sqlmapper.Table{*data}
+	case *sqlmapper.View:
+		schema.Views = []sqlmapper.View{*data}
+	case *sqlmapper.Function:
+		schema.Functions = []sqlmapper.Function{*data}
+	case *sqlmapper.Procedure:
+		schema.Procedures = []sqlmapper.Procedure{*data}
+	case *sqlmapper.Trigger:
+		schema.Triggers = []sqlmapper.Trigger{*data}
+	case *sqlmapper.Sequence:
+		schema.Sequences = []sqlmapper.Sequence{*data}
+	case *sqlmapper.Type:
+		schema.Types = []sqlmapper.Type{*data}
+	case *sqlmapper.Extension:
+		schema.Extensions = []sqlmapper.Extension{*data}
+	case *sqlmapper.Permission:
+		schema.Permissions = []sqlmapper.Permission{*data}
+	case *sqlmapper.Drop:
+		schema.Drops = []sqlmapper.Drop{*data}
+	case []sqlmapper.SessionSetting:
+		schema.Settings = data
+	default:
+		return nil, &sqlmapper.UnsupportedFeatureError{Feature: fmt.Sprintf("schema object %T", o.Data)}
+	}
+	return schema, nil
+}
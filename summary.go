@@ -0,0 +1,115 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SchemaSummary holds aggregate counts of a schema, see Schema.Summary
+type SchemaSummary struct {
+	Tables      int
+	Columns     int
+	Indexes     int
+	ForeignKeys int
+	Views       int
+	Functions   int
+	Procedures  int
+	Triggers    int
+	Sequences   int
+
+	// LargestTable is the table with the most columns, the first one on a tie
+	LargestTable        string
+	LargestTableColumns int
+
+	// TablesWithoutPrimaryKey lists the tables lacking a primary key
+	TablesWithoutPrimaryKey []string
+}
+
+// Summary returns an overview of the objects of the schema. Table names are
+// qualified by their schema, if any.
+func (s *Schema) Summary() SchemaSummary {
+	summary := SchemaSummary{
+		Tables:     len(s.Tables),
+		Views:      len(s.Views),
+		Functions:  len(s.Functions),
+		Procedures: len(s.Procedures),
+		Triggers:   len(s.Triggers),
+		Sequences:  len(s.Sequences),
+	}
+
+	for _, table := range s.Tables {
+		summary.Columns += len(table.Columns)
+		summary.Indexes += len(table.Indexes)
+		for _, constraint := range table.Constraints {
+			if strings.EqualFold(constraint.Type, "FOREIGN KEY") {
+				summary.ForeignKeys++
+			}
+		}
+
+		if summary.LargestTable == "" || len(table.Columns) > summary.LargestTableColumns {
+			summary.LargestTable = table.QualifiedName()
+			summary.LargestTableColumns = len(table.Columns)
+		}
+	}
+	summary.TablesWithoutPrimaryKey = s.TablesWithoutPrimaryKey()
+
+	return summary
+}
+
+// TablesWithoutPrimaryKey returns the names of the tables lacking a primary key, which
+// row-based replication and most ORMs require, qualified by their schema if any.
+// Temporary tables and tables created from a query are included.
+func (s *Schema) TablesWithoutPrimaryKey() []string {
+	var names []string
+	for _, table := range s.Tables {
+		if !table.HasPrimaryKey() {
+			names = append(names, table.QualifiedName())
+		}
+	}
+	return names
+}
+
+// String returns the summary as a human-readable report
+func (s SchemaSummary) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Tables: %d\n", s.Tables)
+	fmt.Fprintf(&b, "Columns: %d\n", s.Columns)
+	fmt.Fprintf(&b, "Indexes: %d\n", s.Indexes)
+	fmt.Fprintf(&b, "Foreign keys: %d\n", s.ForeignKeys)
+	fmt.Fprintf(&b, "Views: %d\n", s.Views)
+	fmt.Fprintf(&b, "Functions: %d\n", s.Functions)
+	fmt.Fprintf(&b, "Procedures: %d\n", s.Procedures)
+	fmt.Fprintf(&b, "Triggers: %d\n", s.Triggers)
+	fmt.Fprintf(&b, "Sequences: %d\n", s.Sequences)
+	if s.LargestTable != "" {
+		fmt.Fprintf(&b, "Largest table: %s (%d columns)\n", s.LargestTable, s.LargestTableColumns)
+	}
+	if len(s.TablesWithoutPrimaryKey) > 0 {
+		fmt.Fprintf(&b, "Tables without primary key: %s\n", strings.Join(s.TablesWithoutPrimaryKey, ", "))
+	}
+	return b.String()
+}
+
+// QualifiedName returns the name of the table qualified by its schema, if any
+func (t Table) QualifiedName() string {
+	if t.Schema != "" {
+		return t.Schema + "." + t.Name
+	}
+	return t.Name
+}
+
+// HasPrimaryKey reports whether the table has a primary key, declared on a column or
+// as a table constraint
+func (t Table) HasPrimaryKey() bool {
+	for _, column := range t.Columns {
+		if column.IsPrimaryKey {
+			return true
+		}
+	}
+	for _, constraint := range t.Constraints {
+		if strings.EqualFold(constraint.Type, "PRIMARY KEY") {
+			return true
+		}
+	}
+	return false
+}
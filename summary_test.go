@@ -0,0 +1,74 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_Summary(t *testing.T) {
+	schema := &Schema{
+		Tables: []Table{
+			{
+				Name:    "users",
+				Columns: []Column{{Name: "id", IsPrimaryKey: true}, {Name: "email"}},
+				Indexes: []Index{{Name: "idx_users_email", Columns: IndexColumns("email")}},
+			},
+			{
+				Name:    "orders",
+				Schema:  "sales",
+				Columns: []Column{{Name: "id"}, {Name: "user_id"}, {Name: "total"}},
+				Indexes: []Index{{Name: "idx_orders_user", Columns: IndexColumns("user_id")}},
+				Constraints: []Constraint{
+					{Name: "pk_orders", Type: "PRIMARY KEY", Columns: []string{"id"}},
+					{Name: "fk_orders_user", Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users"},
+				},
+			},
+			{
+				Name:        "audit_log",
+				Columns:     []Column{{Name: "user_id"}, {Name: "action"}, {Name: "created_at"}},
+				Constraints: []Constraint{{Type: "foreign key", Columns: []string{"user_id"}, RefTable: "users"}},
+			},
+		},
+		Views:     []View{{Name: "active_users"}},
+		Triggers:  []Trigger{{Name: "audit_users"}},
+		Sequences: []Sequence{{Name: "orders_seq"}},
+	}
+
+	summary := schema.Summary()
+	assert.Equal(t, SchemaSummary{
+		Tables:                  3,
+		Columns:                 8,
+		Indexes:                 2,
+		ForeignKeys:             2,
+		Views:                   1,
+		Triggers:                1,
+		Sequences:               1,
+		LargestTable:            "sales.orders",
+		LargestTableColumns:     3,
+		TablesWithoutPrimaryKey: []string{"audit_log"},
+	}, summary)
+
+	assert.Contains(t, summary.String(), "Tables: 3\n")
+	assert.Contains(t, summary.String(), "Foreign keys: 2\n")
+	assert.Contains(t, summary.String(), "Largest table: sales.orders (3 columns)\n")
+	assert.Contains(t, summary.String(), "Tables without primary key: audit_log\n")
+
+	assert.Equal(t, SchemaSummary{}, (&Schema{}).Summary())
+	assert.NotContains(t, SchemaSummary{}.String(), "Largest table")
+}
+
+func TestSchema_TablesWithoutPrimaryKey(t *testing.T) {
+	schema := &Schema{
+		Tables: []Table{
+			{Name: "users", Columns: []Column{{Name: "id", IsPrimaryKey: true}}},
+			{Name: "sessions", Columns: []Column{{Name: "token", IsUnique: true}}},
+			{Name: "orders", Columns: []Column{{Name: "id"}}, Constraints: []Constraint{{Type: "PRIMARY KEY", Columns: []string{"id"}}}},
+			{Name: "events", Schema: "audit", Columns: []Column{{Name: "id"}}, Constraints: []Constraint{{Type: "UNIQUE", Columns: []string{"id"}}}},
+			{Name: "order_items", Constraints: []Constraint{{Type: "primary key", Columns: []string{"order_id", "line"}}}},
+		},
+	}
+
+	assert.Equal(t, []string{"sessions", "audit.events"}, schema.TablesWithoutPrimaryKey())
+	assert.Nil(t, (&Schema{Tables: []Table{{Name: "users", Columns: []Column{{Name: "id", IsPrimaryKey: true}}}}}).TablesWithoutPrimaryKey())
+}
@@ -0,0 +1,22 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// tableOptionRe matches a single NAME=value or NAME value table option
+var tableOptionRe = regexp.MustCompile(`(?i)(?:^|\s)((?:DEFAULT\s+)?[A-Z_]+(?:\s+SET)?)\s*(?:=\s*|\s+)('(?:[^']|'')*'|[^\s']+)`)
+
+// TableOptions returns the options of a table, such as ENGINE or ROW_FORMAT, by their
+// upper case name. DEFAULT prefixes are dropped, so DEFAULT CHARSET=utf8mb4 is
+// returned as CHARSET.
+func (t Table) TableOptions() map[string]string {
+	options := make(map[string]string)
+	for _, match := range tableOptionRe.FindAllStringSubmatch(t.Options, -1) {
+		name := strings.ToUpper(spacesRe.ReplaceAllString(match[1], " "))
+		name = strings.TrimPrefix(name, "DEFAULT ")
+		options[name] = match[2]
+	}
+	return options
+}
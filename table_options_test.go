@@ -0,0 +1,43 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_TableOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options string
+		want    map[string]string
+	}{
+		{
+			name:    "No options",
+			options: "",
+			want:    map[string]string{},
+		},
+		{
+			name:    "Engine and charset",
+			options: "ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+			want:    map[string]string{"ENGINE": "InnoDB", "CHARSET": "utf8mb4", "COLLATE": "utf8mb4_unicode_ci"},
+		},
+		{
+			name:    "Storage tuning",
+			options: "ENGINE = InnoDB row_format=COMPRESSED KEY_BLOCK_SIZE=8",
+			want:    map[string]string{"ENGINE": "InnoDB", "ROW_FORMAT": "COMPRESSED", "KEY_BLOCK_SIZE": "8"},
+		},
+		{
+			name:    "Character set without equals sign",
+			options: "DEFAULT CHARACTER SET utf8mb4 AUTO_INCREMENT=100",
+			want:    map[string]string{"CHARACTER SET": "utf8mb4", "AUTO_INCREMENT": "100"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := Table{Options: tt.options}
+			assert.Equal(t, tt.want, table.TableOptions())
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// temporalTypes are the data types whose parameter is a fractional-second precision
+var temporalTypes = map[string]bool{
+	"TIME":           true,
+	"TIMETZ":         true,
+	"TIMESTAMP":      true,
+	"TIMESTAMPTZ":    true,
+	"DATETIME":       true,
+	"DATETIME2":      true,
+	"DATETIMEOFFSET": true,
+}
+
+// currentTimeRe matches a default or ON UPDATE value reading the current time, such
+// as CURRENT_TIMESTAMP, CURRENT_TIMESTAMP(3) or NOW(6), capturing its
+// fractional-second precision
+var currentTimeRe = regexp.MustCompile(`(?i)^(?:(?:CURRENT_TIMESTAMP|LOCALTIMESTAMP)(?:\s*\(\s*(\d*)\s*\))?|NOW\s*\(\s*(\d*)\s*\))$`)
+
+// IsTemporalType reports whether the parameter of a data type, such as the 6 of
+// TIMESTAMP(6), is a fractional-second precision
+func IsTemporalType(dataType string) bool {
+	return temporalTypes[strings.ToUpper(dataType)]
+}
+
+// FractionalSeconds returns the fractional-second precision of a temporal column,
+// or 0 when the column is not temporal or has no precision
+func FractionalSeconds(column Column) int {
+	if !IsTemporalType(column.DataType) {
+		return 0
+	}
+	if column.Length > 0 {
+		return column.Length
+	}
+	if column.Precision > 0 {
+		return column.Precision
+	}
+	return 0
+}
+
+// ConvertTemporalType converts a temporal column for another dialect. The data type is
+// renamed as listed in types, keyed by upper-case type name, and the fractional-second
+// precision is limited to max digits. It reports whether the precision was reduced.
+// Other columns are returned unchanged.
+func ConvertTemporalType(column Column, types map[string]string, max int) (Column, bool) {
+	if !IsTemporalType(column.DataType) {
+		return column, false
+	}
+	if renamed, ok := types[strings.ToUpper(column.DataType)]; ok {
+		column.DataType = renamed
+	}
+
+	if FractionalSeconds(column) <= max {
+		return column, false
+	}
+	column.Length, column.Precision = max, 0
+	return column, true
+}
+
+// IsCurrentTime reports whether a default or ON UPDATE value reads the current time,
+// such as CURRENT_TIMESTAMP(3) or NOW()
+func IsCurrentTime(value string) bool {
+	return currentTimeRe.MatchString(strings.TrimSpace(value))
+}
+
+// CurrentTimeDefault returns the CURRENT_TIMESTAMP of a default reading the current
+// time with a fractional-second precision, such as CURRENT_TIMESTAMP(3), for the
+// dialects whose CURRENT_TIMESTAMP takes no precision. Other values are returned
+// unchanged.
+func CurrentTimeDefault(value string) string {
+	if match := currentTimeRe.FindStringSubmatch(strings.TrimSpace(value)); match != nil && match[1]+match[2] != "" {
+		return "CURRENT_TIMESTAMP"
+	}
+	return value
+}
@@ -0,0 +1,68 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFractionalSeconds(t *testing.T) {
+	assert.Equal(t, 6, FractionalSeconds(Column{DataType: "timestamp", Length: 6}))
+	assert.Equal(t, 3, FractionalSeconds(Column{DataType: "DATETIME", Precision: 3}))
+	assert.Equal(t, 0, FractionalSeconds(Column{DataType: "TIME"}))
+	assert.Equal(t, 0, FractionalSeconds(Column{DataType: "VARCHAR", Length: 6}))
+}
+
+func TestConvertTemporalType(t *testing.T) {
+	types := map[string]string{"DATETIME": "TIMESTAMP"}
+
+	tests := []struct {
+		name        string
+		column      Column
+		want        Column
+		wantReduced bool
+	}{
+		{
+			name:   "Renamed with precision",
+			column: Column{Name: "created", DataType: "datetime", Length: 3},
+			want:   Column{Name: "created", DataType: "TIMESTAMP", Length: 3},
+		},
+		{
+			name:   "Renamed without precision",
+			column: Column{Name: "created", DataType: "DATETIME"},
+			want:   Column{Name: "created", DataType: "TIMESTAMP"},
+		},
+		{
+			name:        "Precision reduced",
+			column:      Column{Name: "created", DataType: "DATETIME2", Length: 7},
+			want:        Column{Name: "created", DataType: "DATETIME2", Length: 6},
+			wantReduced: true,
+		},
+		{
+			name:   "Other types unchanged",
+			column: Column{Name: "code", DataType: "CHAR", Length: 8},
+			want:   Column{Name: "code", DataType: "CHAR", Length: 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			column, reduced := ConvertTemporalType(tt.column, types, 6)
+			assert.Equal(t, tt.want, column)
+			assert.Equal(t, tt.wantReduced, reduced)
+		})
+	}
+}
+
+func TestCurrentTimeDefault(t *testing.T) {
+	assert.True(t, IsCurrentTime("CURRENT_TIMESTAMP"))
+	assert.True(t, IsCurrentTime("current_timestamp(3)"))
+	assert.True(t, IsCurrentTime("NOW()"))
+	assert.False(t, IsCurrentTime("now"))
+	assert.False(t, IsCurrentTime("CURRENT_TIMESTAMP + 1"))
+
+	assert.Equal(t, "CURRENT_TIMESTAMP", CurrentTimeDefault("CURRENT_TIMESTAMP(3)"))
+	assert.Equal(t, "CURRENT_TIMESTAMP", CurrentTimeDefault("NOW(6)"))
+	assert.Equal(t, "CURRENT_TIMESTAMP", CurrentTimeDefault("CURRENT_TIMESTAMP"))
+	assert.Equal(t, "0", CurrentTimeDefault("0"))
+}
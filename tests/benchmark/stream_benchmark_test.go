@@ -0,0 +1,177 @@
+package benchmark
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/mysql"
+	"github.com/mstgnz/sqlmapper/oracle"
+	"github.com/mstgnz/sqlmapper/postgres"
+	"github.com/mstgnz/sqlmapper/sqlite"
+	"github.com/mstgnz/sqlmapper/sqlserver"
+	"github.com/mstgnz/sqlmapper/stream"
+)
+
+// loadDump reads the multi-table MySQL dump used by the stream benchmarks
+func loadDump(b *testing.B) []byte {
+	b.Helper()
+
+	dump, err := os.ReadFile(filepath.Join("testdata", "dump.sql"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	return dump
+}
+
+// collectSchema parses the dump into a schema for the generation benchmarks
+func collectSchema(b *testing.B, dump []byte) *sqlmapper.Schema {
+	b.Helper()
+
+	schema := &sqlmapper.Schema{}
+	err := mysql.NewMySQLStreamParser().ParseStream(bytes.NewReader(dump), func(obj stream.SchemaObject) error {
+		switch data := obj.Data.(type) {
+		case *sqlmapper.Table:
+			schema.Tables = append(schema.Tables, *data)
+		case *sqlmapper.View:
+			schema.Views = append(schema.Views, *data)
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return schema
+}
+
+func BenchmarkParseStream(b *testing.B) {
+	dump := loadDump(b)
+	parser := mysql.NewMySQLStreamParser()
+
+	b.SetBytes(int64(len(dump)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := parser.ParseStream(bytes.NewReader(dump), func(stream.SchemaObject) error {
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkParseStreamParallel compares serial parsing with the worker pool
+func BenchmarkParseStreamParallel(b *testing.B) {
+	dump := loadDump(b)
+	discard := func(stream.SchemaObject) error { return nil }
+
+	b.Run("serial", func(b *testing.B) {
+		b.SetBytes(int64(len(dump)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := mysql.NewMySQLStreamParser().ParseStream(bytes.NewReader(dump), discard); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	for _, workers := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			b.SetBytes(int64(len(dump)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				parser := mysql.NewMySQLStreamParser()
+				if err := parser.ParseStreamParallel(bytes.NewReader(dump), discard, workers); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkParseStreamParallel_BufferSize compares channel buffer sizes of the worker
+// pool, from unbuffered hand-offs to buffers letting the reader run far ahead
+func BenchmarkParseStreamParallel_BufferSize(b *testing.B) {
+	dump := loadDump(b)
+	discard := func(stream.SchemaObject) error { return nil }
+
+	for _, size := range []int{1, 4, 64, 1024} {
+		b.Run(fmt.Sprintf("buffer=%d", size), func(b *testing.B) {
+			b.SetBytes(int64(len(dump)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				parser := mysql.NewMySQLStreamParser()
+				parser.SetOptions(stream.ParseOptions{BufferSize: size})
+				if err := parser.ParseStreamParallel(bytes.NewReader(dump), discard, 4); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGenerateStream(b *testing.B) {
+	schema := collectSchema(b, loadDump(b))
+	parser := mysql.NewMySQLStreamParser()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := parser.GenerateStream(schema, io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// wideSchema builds a schema of the given number of tables, each with a primary key,
+// typed columns with defaults and an index
+func wideSchema(tables int) *sqlmapper.Schema {
+	schema := &sqlmapper.Schema{}
+	for i := 0; i < tables; i++ {
+		name := fmt.Sprintf("table_%d", i)
+		schema.Tables = append(schema.Tables, sqlmapper.Table{
+			Name: name,
+			Columns: []sqlmapper.Column{
+				{Name: "id", DataType: "INT", IsPrimaryKey: true, AutoIncrement: true},
+				{Name: "name", DataType: "VARCHAR", Length: 100},
+				{Name: "email", DataType: "VARCHAR", Length: 255, IsNullable: true, IsUnique: true},
+				{Name: "price", DataType: "DECIMAL", Length: 10, Scale: 2, DefaultValue: "0"},
+				{Name: "notes", DataType: "TEXT", IsNullable: true},
+				{Name: "created_at", DataType: "TIMESTAMP", DefaultValue: "CURRENT_TIMESTAMP"},
+			},
+			Indexes: []sqlmapper.Index{{Name: "idx_" + name + "_name", Columns: sqlmapper.IndexColumns("name")}},
+		})
+	}
+	return schema
+}
+
+// BenchmarkGenerateStream_Tables generates a schema of 100 tables with every dialect
+func BenchmarkGenerateStream_Tables(b *testing.B) {
+	parsers := []struct {
+		name   string
+		parser stream.StreamParser
+	}{
+		{"mysql", mysql.NewMySQLStreamParser()},
+		{"postgres", postgres.NewPostgreSQLStreamParser()},
+		{"sqlite", sqlite.NewSQLiteStreamParser()},
+		{"sqlserver", sqlserver.NewSQLServerStreamParser()},
+		{"oracle", oracle.NewOracleStreamParser()},
+	}
+	schema := wideSchema(100)
+
+	for _, tt := range parsers {
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := tt.parser.GenerateStream(schema, io.Discard); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
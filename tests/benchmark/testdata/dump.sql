@@ -0,0 +1,3133 @@
+-- Benchmark fixture: a representative multi-table MySQL dump
+
+CREATE TABLE table_000 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 INT DEFAULT 0,
+    col_02 DATE,
+    col_03 BIGINT,
+    col_04 DECIMAL(10,2),
+    col_05 JSON,
+    col_06 INT,
+    col_07 VARCHAR(100) NOT NULL,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_000_col_00 ON table_000(col_00);
+
+CREATE TABLE table_001 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 INT,
+    col_03 BIGINT,
+    col_04 VARCHAR(100),
+    col_05 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_001_col_00 ON table_001(col_00);
+
+CREATE TABLE table_002 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 INT,
+    col_02 VARCHAR(255) NOT NULL,
+    col_03 VARCHAR(255),
+    col_04 JSON,
+    col_05 TEXT,
+    col_06 DATE,
+    col_07 VARCHAR(255) NOT NULL,
+    col_08 JSON,
+    col_09 VARCHAR(100),
+    col_10 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_002_col_00 ON table_002(col_00);
+
+CREATE TABLE table_003 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 DATE,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 DECIMAL(10,2),
+    col_04 BOOLEAN,
+    col_05 JSON,
+    col_06 BOOLEAN,
+    col_07 DECIMAL(10,2),
+    col_08 TEXT,
+    col_09 VARCHAR(100),
+    col_10 VARCHAR(100) NOT NULL,
+    col_11 TEXT,
+    col_12 DATE,
+    col_13 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_004 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 BIGINT,
+    col_02 BIGINT,
+    col_03 DATE,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 VARCHAR(255),
+    col_06 VARCHAR(255),
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 INT,
+    table_002_id INT,
+    FOREIGN KEY (table_002_id) REFERENCES table_002(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_004_col_00 ON table_004(col_00);
+
+CREATE TABLE table_005 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 BOOLEAN,
+    col_02 JSON,
+    col_03 BOOLEAN,
+    col_04 BIGINT,
+    col_05 BIGINT,
+    col_06 TEXT,
+    col_07 BOOLEAN,
+    col_08 BIGINT,
+    col_09 INT,
+    table_004_id INT,
+    FOREIGN KEY (table_004_id) REFERENCES table_004(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_006 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 DECIMAL(10,2),
+    col_03 INT,
+    col_04 DECIMAL(10,2),
+    col_05 VARCHAR(255),
+    col_06 BOOLEAN,
+    col_07 INT DEFAULT 0,
+    col_08 TEXT,
+    col_09 VARCHAR(255),
+    col_10 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_006_col_00 ON table_006(col_00);
+
+CREATE TABLE table_007 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 DATE,
+    col_03 TEXT,
+    col_04 VARCHAR(255),
+    col_05 DATE,
+    col_06 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_008 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100) NOT NULL,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 VARCHAR(100) NOT NULL,
+    col_03 JSON,
+    col_04 VARCHAR(255) NOT NULL,
+    col_05 INT DEFAULT 0,
+    col_06 DATE,
+    col_07 DECIMAL(10,2),
+    col_08 JSON,
+    col_09 JSON,
+    col_10 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_009 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 INT,
+    col_02 DATE,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 BIGINT,
+    col_08 BOOLEAN,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_10 INT DEFAULT 0,
+    col_11 VARCHAR(100),
+    col_12 BIGINT,
+    table_000_id INT,
+    FOREIGN KEY (table_000_id) REFERENCES table_000(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_009_col_00 ON table_009(col_00);
+
+CREATE TABLE table_010 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 DECIMAL(10,2),
+    col_02 JSON,
+    col_03 INT DEFAULT 0,
+    col_04 VARCHAR(100),
+    col_05 VARCHAR(255),
+    col_06 DECIMAL(10,2),
+    col_07 JSON,
+    col_08 DECIMAL(10,2),
+    col_09 BOOLEAN,
+    col_10 BIGINT,
+    col_11 BIGINT,
+    col_12 BOOLEAN,
+    col_13 BOOLEAN,
+    table_004_id INT,
+    FOREIGN KEY (table_004_id) REFERENCES table_004(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_010_col_00 ON table_010(col_00);
+
+CREATE TABLE table_011 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 TEXT,
+    col_02 BOOLEAN,
+    col_03 VARCHAR(255),
+    col_04 VARCHAR(100),
+    col_05 DATE,
+    table_008_id INT,
+    FOREIGN KEY (table_008_id) REFERENCES table_008(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_012 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BIGINT,
+    col_02 TEXT,
+    col_03 DATE,
+    col_04 DECIMAL(10,2),
+    col_05 VARCHAR(255),
+    col_06 VARCHAR(100),
+    col_07 DATE,
+    col_08 DECIMAL(10,2),
+    col_09 VARCHAR(100),
+    col_10 VARCHAR(100),
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_12 VARCHAR(100) NOT NULL,
+    table_011_id INT,
+    FOREIGN KEY (table_011_id) REFERENCES table_011(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_012_col_00 ON table_012(col_00);
+
+CREATE TABLE table_013 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BOOLEAN,
+    col_02 TEXT,
+    col_03 VARCHAR(100),
+    col_04 DECIMAL(10,2),
+    table_011_id INT,
+    FOREIGN KEY (table_011_id) REFERENCES table_011(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_014 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 VARCHAR(100) NOT NULL,
+    col_02 BOOLEAN,
+    col_03 VARCHAR(100),
+    col_04 BOOLEAN,
+    col_05 JSON,
+    col_06 JSON,
+    col_07 INT,
+    col_08 DECIMAL(10,2),
+    col_09 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_014_col_00 ON table_014(col_00);
+
+CREATE TABLE table_015 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 VARCHAR(255),
+    col_02 DECIMAL(10,2),
+    col_03 BIGINT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 BOOLEAN,
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 BIGINT,
+    col_08 VARCHAR(255) NOT NULL,
+    col_09 VARCHAR(255) NOT NULL,
+    col_10 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_016 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 JSON,
+    col_02 BOOLEAN,
+    col_03 DECIMAL(10,2),
+    col_04 VARCHAR(255),
+    col_05 VARCHAR(255) NOT NULL,
+    col_06 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_017 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 VARCHAR(100) NOT NULL,
+    col_02 VARCHAR(100) NOT NULL,
+    col_03 VARCHAR(100),
+    col_04 DECIMAL(10,2),
+    col_05 TEXT,
+    col_06 DATE,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 VARCHAR(255) NOT NULL,
+    col_09 DECIMAL(10,2),
+    col_10 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_018 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 DATE,
+    col_02 VARCHAR(255),
+    col_03 DATE,
+    col_04 DATE,
+    col_05 INT,
+    col_06 VARCHAR(255),
+    col_07 VARCHAR(255) NOT NULL,
+    col_08 BOOLEAN,
+    col_09 JSON,
+    col_10 BIGINT,
+    col_11 DATE,
+    col_12 INT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_018_col_00 ON table_018(col_00);
+
+CREATE TABLE table_019 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 INT DEFAULT 0,
+    col_02 TEXT,
+    col_03 INT,
+    col_04 DATE,
+    col_05 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_020 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 DECIMAL(10,2),
+    col_02 JSON,
+    col_03 DATE,
+    col_04 JSON,
+    col_05 DATE,
+    table_008_id INT,
+    FOREIGN KEY (table_008_id) REFERENCES table_008(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_020_col_00 ON table_020(col_00);
+
+CREATE TABLE table_021 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 DATE,
+    col_02 VARCHAR(100),
+    col_03 TEXT,
+    col_04 DATE,
+    col_05 VARCHAR(100),
+    col_06 VARCHAR(255),
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 BOOLEAN,
+    col_09 DECIMAL(10,2),
+    col_10 BIGINT,
+    col_11 VARCHAR(100),
+    col_12 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_022 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 VARCHAR(255),
+    col_03 VARCHAR(100),
+    col_04 BIGINT,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 BOOLEAN,
+    table_021_id INT,
+    FOREIGN KEY (table_021_id) REFERENCES table_021(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_023 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 DATE,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 DECIMAL(10,2),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 VARCHAR(100),
+    col_06 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_023_col_00 ON table_023(col_00);
+
+CREATE TABLE table_024 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 BOOLEAN,
+    col_02 INT,
+    col_03 DATE,
+    col_04 JSON,
+    col_05 TEXT,
+    col_06 DATE,
+    col_07 BIGINT,
+    col_08 BIGINT,
+    col_09 VARCHAR(100),
+    col_10 BIGINT,
+    col_11 BIGINT,
+    col_12 TEXT,
+    table_005_id INT,
+    FOREIGN KEY (table_005_id) REFERENCES table_005(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_024_col_00 ON table_024(col_00);
+
+CREATE TABLE table_025 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 TEXT,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 VARCHAR(255),
+    col_04 DATE,
+    col_05 JSON,
+    col_06 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_025_col_00 ON table_025(col_00);
+
+CREATE TABLE table_026 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 BIGINT,
+    col_02 TEXT,
+    col_03 INT,
+    col_04 TEXT,
+    table_007_id INT,
+    FOREIGN KEY (table_007_id) REFERENCES table_007(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_026_col_00 ON table_026(col_00);
+
+CREATE TABLE table_027 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 INT,
+    col_02 DATE,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 TEXT,
+    col_05 JSON,
+    table_016_id INT,
+    FOREIGN KEY (table_016_id) REFERENCES table_016(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_028 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 TEXT,
+    col_03 TEXT,
+    col_04 DATE,
+    col_05 VARCHAR(100) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_028_col_00 ON table_028(col_00);
+
+CREATE TABLE table_029 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 INT DEFAULT 0,
+    col_02 DATE,
+    col_03 DATE,
+    col_04 VARCHAR(100),
+    col_05 VARCHAR(100),
+    col_06 BIGINT,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 BOOLEAN,
+    col_09 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_029_col_00 ON table_029(col_00);
+
+CREATE TABLE table_030 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 VARCHAR(100),
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(100),
+    col_04 VARCHAR(255),
+    col_05 DECIMAL(10,2),
+    col_06 INT,
+    col_07 INT DEFAULT 0,
+    col_08 TEXT,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_10 VARCHAR(255) NOT NULL,
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_12 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_030_col_00 ON table_030(col_00);
+
+CREATE TABLE table_031 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 INT,
+    col_02 VARCHAR(255) NOT NULL,
+    col_03 INT DEFAULT 0,
+    col_04 DECIMAL(10,2),
+    col_05 DATE,
+    col_06 DECIMAL(10,2),
+    col_07 VARCHAR(100) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_031_col_00 ON table_031(col_00);
+
+CREATE TABLE table_032 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 BIGINT,
+    col_02 BOOLEAN,
+    col_03 TEXT,
+    col_04 DATE,
+    col_05 VARCHAR(100) NOT NULL,
+    col_06 INT DEFAULT 0
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_032_col_00 ON table_032(col_00);
+
+CREATE TABLE table_033 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 TEXT,
+    col_02 TEXT,
+    col_03 VARCHAR(100) NOT NULL,
+    col_04 DATE,
+    col_05 VARCHAR(255),
+    col_06 JSON,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 DECIMAL(10,2),
+    col_09 BOOLEAN,
+    col_10 VARCHAR(255) NOT NULL,
+    col_11 JSON,
+    col_12 VARCHAR(255) NOT NULL,
+    col_13 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_034 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 DATE,
+    col_02 JSON,
+    col_03 INT,
+    col_04 JSON,
+    col_05 VARCHAR(100) NOT NULL,
+    col_06 INT DEFAULT 0,
+    col_07 DECIMAL(10,2),
+    col_08 BIGINT,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_10 BOOLEAN,
+    col_11 DATE,
+    col_12 INT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_035 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 INT,
+    col_02 BIGINT,
+    col_03 DATE,
+    col_04 DATE,
+    col_05 BIGINT,
+    col_06 DATE,
+    col_07 BIGINT,
+    col_08 BOOLEAN,
+    col_09 TEXT,
+    col_10 BIGINT,
+    col_11 TEXT,
+    table_013_id INT,
+    FOREIGN KEY (table_013_id) REFERENCES table_013(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_035_col_00 ON table_035(col_00);
+
+CREATE TABLE table_036 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 BIGINT,
+    col_03 BOOLEAN,
+    col_04 TEXT,
+    col_05 INT,
+    col_06 VARCHAR(100) NOT NULL,
+    col_07 VARCHAR(255),
+    col_08 TEXT,
+    col_09 JSON,
+    col_10 JSON,
+    col_11 VARCHAR(255) NOT NULL,
+    table_017_id INT,
+    FOREIGN KEY (table_017_id) REFERENCES table_017(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_037 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 TEXT,
+    col_02 DATE,
+    col_03 TEXT,
+    col_04 BOOLEAN,
+    col_05 BOOLEAN,
+    table_007_id INT,
+    FOREIGN KEY (table_007_id) REFERENCES table_007(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_038 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 BIGINT,
+    col_02 BOOLEAN,
+    col_03 INT DEFAULT 0,
+    col_04 BIGINT,
+    col_05 DATE,
+    col_06 BOOLEAN,
+    col_07 TEXT,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 VARCHAR(100),
+    col_10 VARCHAR(100) NOT NULL,
+    col_11 BIGINT,
+    col_12 VARCHAR(255),
+    table_023_id INT,
+    FOREIGN KEY (table_023_id) REFERENCES table_023(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_038_col_00 ON table_038(col_00);
+
+CREATE TABLE table_039 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BIGINT,
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(100),
+    col_04 BOOLEAN,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 INT DEFAULT 0,
+    col_07 BOOLEAN,
+    col_08 BOOLEAN,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_10 TEXT,
+    col_11 VARCHAR(255),
+    col_12 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_021_id INT,
+    FOREIGN KEY (table_021_id) REFERENCES table_021(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_039_col_00 ON table_039(col_00);
+
+CREATE TABLE table_040 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BIGINT,
+    col_02 VARCHAR(100),
+    col_03 TEXT,
+    col_04 TEXT,
+    col_05 DECIMAL(10,2),
+    col_06 BIGINT,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 JSON,
+    table_027_id INT,
+    FOREIGN KEY (table_027_id) REFERENCES table_027(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_041 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BIGINT,
+    col_02 INT,
+    col_03 TEXT,
+    col_04 VARCHAR(255) NOT NULL,
+    table_032_id INT,
+    FOREIGN KEY (table_032_id) REFERENCES table_032(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_041_col_00 ON table_041(col_00);
+
+CREATE TABLE table_042 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 INT,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 DATE,
+    col_04 DATE,
+    col_05 VARCHAR(100),
+    col_06 INT,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 BOOLEAN,
+    col_09 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_043 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 INT,
+    col_02 DATE,
+    col_03 VARCHAR(255) NOT NULL,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 DECIMAL(10,2),
+    col_06 TEXT,
+    col_07 TEXT,
+    col_08 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_044 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 VARCHAR(100),
+    col_02 DATE,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 BIGINT,
+    col_05 VARCHAR(255),
+    col_06 BIGINT,
+    col_07 VARCHAR(100),
+    col_08 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_044_col_00 ON table_044(col_00);
+
+CREATE TABLE table_045 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 VARCHAR(255),
+    col_03 VARCHAR(100) NOT NULL,
+    col_04 DECIMAL(10,2),
+    col_05 DATE,
+    col_06 BIGINT,
+    col_07 DECIMAL(10,2),
+    col_08 VARCHAR(100),
+    col_09 JSON,
+    table_001_id INT,
+    FOREIGN KEY (table_001_id) REFERENCES table_001(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_046 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 DATE,
+    col_03 VARCHAR(100),
+    col_04 DECIMAL(10,2),
+    col_05 INT,
+    col_06 JSON,
+    col_07 DECIMAL(10,2),
+    col_08 VARCHAR(255),
+    col_09 DATE,
+    col_10 VARCHAR(100) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_046_col_00 ON table_046(col_00);
+
+CREATE TABLE table_047 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 TEXT,
+    col_02 INT DEFAULT 0,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 BOOLEAN,
+    col_05 JSON,
+    col_06 BOOLEAN,
+    col_07 INT DEFAULT 0,
+    col_08 DATE,
+    col_09 BOOLEAN,
+    col_10 BOOLEAN,
+    col_11 VARCHAR(100),
+    table_009_id INT,
+    FOREIGN KEY (table_009_id) REFERENCES table_009(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_047_col_00 ON table_047(col_00);
+
+CREATE TABLE table_048 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 BIGINT,
+    col_02 DATE,
+    col_03 INT DEFAULT 0,
+    col_04 VARCHAR(255) NOT NULL,
+    col_05 INT,
+    table_008_id INT,
+    FOREIGN KEY (table_008_id) REFERENCES table_008(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_049 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BIGINT,
+    col_02 BIGINT,
+    col_03 BIGINT,
+    col_04 TEXT,
+    col_05 DATE,
+    col_06 JSON,
+    col_07 VARCHAR(100),
+    col_08 VARCHAR(100),
+    col_09 INT DEFAULT 0,
+    col_10 TEXT,
+    col_11 BOOLEAN,
+    col_12 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_050 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 DATE,
+    col_02 VARCHAR(100),
+    col_03 INT,
+    col_04 TEXT,
+    col_05 INT DEFAULT 0,
+    col_06 BOOLEAN,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_014_id INT,
+    FOREIGN KEY (table_014_id) REFERENCES table_014(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_051 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 DECIMAL(10,2),
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 DECIMAL(10,2),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 VARCHAR(100) NOT NULL,
+    col_06 TEXT,
+    col_07 DATE,
+    col_08 BIGINT,
+    col_09 VARCHAR(100),
+    table_049_id INT,
+    FOREIGN KEY (table_049_id) REFERENCES table_049(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_052 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(100) NOT NULL,
+    col_02 TEXT,
+    col_03 BIGINT,
+    col_04 JSON,
+    col_05 BOOLEAN,
+    col_06 JSON,
+    col_07 VARCHAR(255),
+    table_042_id INT,
+    FOREIGN KEY (table_042_id) REFERENCES table_042(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_052_col_00 ON table_052(col_00);
+
+CREATE TABLE table_053 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 INT DEFAULT 0,
+    col_02 JSON,
+    col_03 VARCHAR(255),
+    col_04 INT DEFAULT 0,
+    col_05 BOOLEAN,
+    col_06 DECIMAL(10,2),
+    col_07 BIGINT,
+    col_08 BIGINT,
+    col_09 VARCHAR(255),
+    col_10 VARCHAR(255),
+    col_11 DATE,
+    col_12 BOOLEAN,
+    col_13 INT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_054 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 BIGINT,
+    col_03 TEXT,
+    col_04 BIGINT,
+    col_05 DECIMAL(10,2),
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 BIGINT,
+    col_08 DATE,
+    col_09 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_054_col_00 ON table_054(col_00);
+
+CREATE TABLE table_055 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 INT,
+    col_02 VARCHAR(100),
+    col_03 BOOLEAN,
+    col_04 VARCHAR(100),
+    col_05 BOOLEAN,
+    col_06 INT,
+    col_07 VARCHAR(100),
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 INT,
+    col_10 BOOLEAN,
+    table_003_id INT,
+    FOREIGN KEY (table_003_id) REFERENCES table_003(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_055_col_00 ON table_055(col_00);
+
+CREATE TABLE table_056 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 DECIMAL(10,2),
+    col_02 DECIMAL(10,2),
+    col_03 TEXT,
+    col_04 DECIMAL(10,2),
+    col_05 JSON,
+    table_047_id INT,
+    FOREIGN KEY (table_047_id) REFERENCES table_047(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_057 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 TEXT,
+    col_02 INT,
+    col_03 JSON,
+    col_04 BIGINT,
+    col_05 INT,
+    col_06 BIGINT,
+    col_07 BOOLEAN,
+    col_08 BOOLEAN,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_058 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 TEXT,
+    col_03 VARCHAR(255),
+    col_04 DECIMAL(10,2),
+    col_05 DECIMAL(10,2),
+    col_06 BOOLEAN,
+    col_07 DECIMAL(10,2),
+    col_08 JSON,
+    col_09 BIGINT,
+    col_10 DATE,
+    col_11 VARCHAR(100),
+    table_026_id INT,
+    FOREIGN KEY (table_026_id) REFERENCES table_026(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_058_col_00 ON table_058(col_00);
+
+CREATE TABLE table_059 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 DATE,
+    col_02 DATE,
+    col_03 DECIMAL(10,2),
+    col_04 VARCHAR(255)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_060 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 BIGINT,
+    col_02 VARCHAR(100) NOT NULL,
+    col_03 BOOLEAN,
+    col_04 BOOLEAN,
+    col_05 VARCHAR(255) NOT NULL,
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 BOOLEAN,
+    col_08 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_060_col_00 ON table_060(col_00);
+
+CREATE TABLE table_061 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 TEXT,
+    col_02 TEXT,
+    col_03 TEXT,
+    col_04 JSON,
+    col_05 TEXT,
+    col_06 DECIMAL(10,2),
+    col_07 TEXT,
+    col_08 TEXT,
+    col_09 VARCHAR(100),
+    col_10 VARCHAR(255) NOT NULL,
+    col_11 VARCHAR(255) NOT NULL,
+    col_12 JSON,
+    table_004_id INT,
+    FOREIGN KEY (table_004_id) REFERENCES table_004(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_061_col_00 ON table_061(col_00);
+
+CREATE TABLE table_062 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 DATE,
+    col_02 VARCHAR(100),
+    col_03 BIGINT,
+    col_04 BOOLEAN,
+    col_05 INT DEFAULT 0,
+    col_06 BOOLEAN,
+    col_07 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_062_col_00 ON table_062(col_00);
+
+CREATE TABLE table_063 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100) NOT NULL,
+    col_01 VARCHAR(100),
+    col_02 JSON,
+    col_03 VARCHAR(100),
+    col_04 DECIMAL(10,2),
+    col_05 DATE,
+    col_06 VARCHAR(255),
+    col_07 TEXT,
+    col_08 INT DEFAULT 0
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_064 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 TEXT,
+    col_03 INT,
+    col_04 VARCHAR(100),
+    col_05 DECIMAL(10,2),
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 DECIMAL(10,2),
+    table_039_id INT,
+    FOREIGN KEY (table_039_id) REFERENCES table_039(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_064_col_00 ON table_064(col_00);
+
+CREATE TABLE table_065 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 DATE,
+    col_02 BOOLEAN,
+    col_03 BIGINT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_050_id INT,
+    FOREIGN KEY (table_050_id) REFERENCES table_050(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_066 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 BIGINT,
+    col_02 VARCHAR(255),
+    col_03 TEXT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 TEXT,
+    col_06 TEXT,
+    table_006_id INT,
+    FOREIGN KEY (table_006_id) REFERENCES table_006(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_066_col_00 ON table_066(col_00);
+
+CREATE TABLE table_067 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 INT,
+    col_04 DECIMAL(10,2),
+    col_05 VARCHAR(100),
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 VARCHAR(100),
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 VARCHAR(255),
+    col_10 BIGINT,
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_12 JSON,
+    col_13 DECIMAL(10,2),
+    table_020_id INT,
+    FOREIGN KEY (table_020_id) REFERENCES table_020(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_067_col_00 ON table_067(col_00);
+
+CREATE TABLE table_068 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 VARCHAR(255),
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 BIGINT,
+    col_04 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_068_col_00 ON table_068(col_00);
+
+CREATE TABLE table_069 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 TEXT,
+    col_02 VARCHAR(255),
+    col_03 BIGINT,
+    col_04 BIGINT,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 BOOLEAN,
+    col_07 VARCHAR(100),
+    col_08 INT,
+    col_09 BOOLEAN,
+    col_10 DECIMAL(10,2),
+    col_11 INT,
+    col_12 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_020_id INT,
+    FOREIGN KEY (table_020_id) REFERENCES table_020(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_070 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 JSON,
+    col_03 VARCHAR(100),
+    col_04 VARCHAR(255),
+    col_05 INT,
+    col_06 DATE,
+    col_07 VARCHAR(255),
+    table_031_id INT,
+    FOREIGN KEY (table_031_id) REFERENCES table_031(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_071 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 INT,
+    col_02 DECIMAL(10,2),
+    col_03 BIGINT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 JSON,
+    col_06 BOOLEAN,
+    col_07 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_072 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 JSON,
+    col_02 VARCHAR(100),
+    col_03 DECIMAL(10,2),
+    col_04 BOOLEAN,
+    col_05 DATE,
+    col_06 BOOLEAN,
+    col_07 VARCHAR(255) NOT NULL,
+    col_08 JSON,
+    col_09 BOOLEAN,
+    col_10 BOOLEAN,
+    table_058_id INT,
+    FOREIGN KEY (table_058_id) REFERENCES table_058(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_073 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BIGINT,
+    col_02 BIGINT,
+    col_03 VARCHAR(255),
+    col_04 DECIMAL(10,2),
+    col_05 BIGINT,
+    col_06 BOOLEAN,
+    col_07 DATE,
+    col_08 DATE,
+    col_09 INT DEFAULT 0,
+    col_10 VARCHAR(255) NOT NULL,
+    col_11 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_073_col_00 ON table_073(col_00);
+
+CREATE TABLE table_074 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 VARCHAR(255) NOT NULL,
+    col_03 BIGINT,
+    col_04 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_075 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 BOOLEAN,
+    col_02 TEXT,
+    col_03 VARCHAR(255),
+    col_04 VARCHAR(100) NOT NULL,
+    col_05 DECIMAL(10,2),
+    col_06 JSON,
+    col_07 TEXT,
+    table_035_id INT,
+    FOREIGN KEY (table_035_id) REFERENCES table_035(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_076 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 BOOLEAN,
+    col_02 VARCHAR(100),
+    col_03 JSON,
+    col_04 DATE,
+    col_05 VARCHAR(100),
+    col_06 INT DEFAULT 0,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 VARCHAR(255),
+    col_09 TEXT,
+    col_10 DECIMAL(10,2),
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_033_id INT,
+    FOREIGN KEY (table_033_id) REFERENCES table_033(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_076_col_00 ON table_076(col_00);
+
+CREATE TABLE table_077 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 DECIMAL(10,2),
+    col_02 BOOLEAN,
+    col_03 DATE,
+    col_04 DATE,
+    col_05 JSON,
+    col_06 BIGINT,
+    col_07 TEXT,
+    col_08 DATE,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_10 DECIMAL(10,2),
+    col_11 TEXT,
+    col_12 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_077_col_00 ON table_077(col_00);
+
+CREATE TABLE table_078 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 BIGINT,
+    col_02 BOOLEAN,
+    col_03 VARCHAR(100) NOT NULL,
+    col_04 INT DEFAULT 0,
+    col_05 DATE,
+    col_06 TEXT,
+    col_07 TEXT,
+    col_08 JSON,
+    col_09 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_079 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 DATE,
+    col_04 DECIMAL(10,2),
+    col_05 INT DEFAULT 0,
+    col_06 VARCHAR(100),
+    col_07 INT DEFAULT 0,
+    table_045_id INT,
+    FOREIGN KEY (table_045_id) REFERENCES table_045(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_079_col_00 ON table_079(col_00);
+
+CREATE TABLE table_080 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 DATE,
+    col_02 VARCHAR(100),
+    col_03 TEXT,
+    col_04 JSON,
+    col_05 VARCHAR(255) NOT NULL,
+    col_06 JSON,
+    col_07 BOOLEAN,
+    col_08 VARCHAR(255) NOT NULL,
+    col_09 VARCHAR(100),
+    col_10 BOOLEAN,
+    col_11 BIGINT,
+    col_12 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_081 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 TEXT,
+    col_02 INT DEFAULT 0,
+    col_03 DATE,
+    col_04 DECIMAL(10,2),
+    col_05 JSON,
+    col_06 JSON,
+    col_07 BOOLEAN,
+    col_08 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_082 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 INT DEFAULT 0,
+    col_02 INT,
+    col_03 VARCHAR(100) NOT NULL,
+    col_04 BIGINT,
+    col_05 INT,
+    col_06 VARCHAR(100) NOT NULL,
+    col_07 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_083 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 VARCHAR(255),
+    col_02 BIGINT,
+    col_03 TEXT,
+    col_04 INT,
+    col_05 BOOLEAN,
+    col_06 DATE,
+    col_07 INT,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 BOOLEAN,
+    col_10 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_083_col_00 ON table_083(col_00);
+
+CREATE TABLE table_084 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 TEXT,
+    col_02 VARCHAR(100),
+    col_03 BIGINT,
+    col_04 DECIMAL(10,2),
+    col_05 TEXT,
+    col_06 INT DEFAULT 0,
+    col_07 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_085 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 TEXT,
+    col_02 VARCHAR(100) NOT NULL,
+    col_03 DATE,
+    col_04 INT DEFAULT 0,
+    col_05 VARCHAR(100),
+    col_06 VARCHAR(100),
+    col_07 DECIMAL(10,2),
+    col_08 VARCHAR(100),
+    col_09 DECIMAL(10,2),
+    col_10 JSON,
+    col_11 VARCHAR(100),
+    col_12 DATE,
+    table_067_id INT,
+    FOREIGN KEY (table_067_id) REFERENCES table_067(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_086 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 VARCHAR(100),
+    col_02 TEXT,
+    col_03 VARCHAR(100),
+    col_04 JSON,
+    table_021_id INT,
+    FOREIGN KEY (table_021_id) REFERENCES table_021(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_086_col_00 ON table_086(col_00);
+
+CREATE TABLE table_087 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 BIGINT,
+    col_02 JSON,
+    col_03 VARCHAR(255),
+    col_04 VARCHAR(255),
+    table_017_id INT,
+    FOREIGN KEY (table_017_id) REFERENCES table_017(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_088 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 INT DEFAULT 0,
+    col_02 JSON,
+    col_03 DECIMAL(10,2),
+    col_04 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_089 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BIGINT,
+    col_02 VARCHAR(100) NOT NULL,
+    col_03 BIGINT,
+    col_04 INT DEFAULT 0,
+    col_05 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_090 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 BIGINT,
+    col_02 VARCHAR(255) NOT NULL,
+    col_03 VARCHAR(100) NOT NULL,
+    col_04 DECIMAL(10,2),
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 TEXT,
+    col_07 INT,
+    col_08 TEXT,
+    table_047_id INT,
+    FOREIGN KEY (table_047_id) REFERENCES table_047(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_091 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 BOOLEAN,
+    col_02 TEXT,
+    col_03 JSON,
+    col_04 INT,
+    col_05 INT,
+    col_06 BIGINT,
+    col_07 DECIMAL(10,2),
+    col_08 BOOLEAN,
+    col_09 INT,
+    col_10 VARCHAR(100),
+    col_11 BIGINT,
+    col_12 JSON,
+    col_13 TEXT,
+    table_000_id INT,
+    FOREIGN KEY (table_000_id) REFERENCES table_000(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_091_col_00 ON table_091(col_00);
+
+CREATE TABLE table_092 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT DEFAULT 0,
+    col_01 BOOLEAN,
+    col_02 BIGINT,
+    col_03 BOOLEAN,
+    col_04 VARCHAR(255),
+    col_05 JSON,
+    col_06 DECIMAL(10,2),
+    col_07 DATE,
+    col_08 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_092_col_00 ON table_092(col_00);
+
+CREATE TABLE table_093 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 BIGINT,
+    col_02 BIGINT,
+    col_03 BOOLEAN,
+    col_04 DATE,
+    col_05 BIGINT,
+    col_06 DECIMAL(10,2),
+    col_07 DECIMAL(10,2),
+    table_050_id INT,
+    FOREIGN KEY (table_050_id) REFERENCES table_050(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_094 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 INT,
+    col_02 TEXT,
+    col_03 TEXT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_094_col_00 ON table_094(col_00);
+
+CREATE TABLE table_095 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(255),
+    col_02 JSON,
+    col_03 INT,
+    col_04 DECIMAL(10,2),
+    col_05 DATE,
+    col_06 VARCHAR(255),
+    col_07 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_096 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 BOOLEAN,
+    col_02 TEXT,
+    col_03 JSON,
+    col_04 VARCHAR(100) NOT NULL,
+    col_05 BOOLEAN,
+    col_06 VARCHAR(100),
+    table_090_id INT,
+    FOREIGN KEY (table_090_id) REFERENCES table_090(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_097 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 VARCHAR(100),
+    col_02 JSON,
+    col_03 DATE,
+    col_04 DECIMAL(10,2),
+    col_05 VARCHAR(255) NOT NULL,
+    col_06 VARCHAR(100) NOT NULL,
+    col_07 BIGINT,
+    col_08 VARCHAR(255),
+    col_09 BIGINT,
+    col_10 VARCHAR(100),
+    col_11 VARCHAR(255),
+    col_12 TEXT,
+    col_13 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_013_id INT,
+    FOREIGN KEY (table_013_id) REFERENCES table_013(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_098 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 VARCHAR(100),
+    col_02 BOOLEAN,
+    col_03 INT DEFAULT 0,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_098_col_00 ON table_098(col_00);
+
+CREATE TABLE table_099 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 JSON,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 INT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 JSON,
+    col_06 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_099_col_00 ON table_099(col_00);
+
+CREATE TABLE table_100 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 VARCHAR(100),
+    col_02 BIGINT,
+    col_03 BOOLEAN,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 DECIMAL(10,2),
+    col_06 TEXT,
+    col_07 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_100_col_00 ON table_100(col_00);
+
+CREATE TABLE table_101 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 BOOLEAN,
+    col_03 BOOLEAN,
+    col_04 INT,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 DATE,
+    col_07 VARCHAR(255),
+    col_08 DECIMAL(10,2),
+    col_09 INT,
+    col_10 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_101_col_00 ON table_101(col_00);
+
+CREATE TABLE table_102 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 VARCHAR(100) NOT NULL,
+    col_02 VARCHAR(100),
+    col_03 BIGINT,
+    col_04 JSON,
+    col_05 BOOLEAN,
+    col_06 DATE,
+    col_07 VARCHAR(100),
+    col_08 DATE,
+    table_101_id INT,
+    FOREIGN KEY (table_101_id) REFERENCES table_101(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_103 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 BOOLEAN,
+    col_03 VARCHAR(100),
+    col_04 VARCHAR(255),
+    col_05 BIGINT,
+    col_06 JSON,
+    col_07 DECIMAL(10,2),
+    col_08 INT DEFAULT 0,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_10 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_11 INT DEFAULT 0,
+    col_12 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_104 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 TEXT,
+    col_02 BIGINT,
+    col_03 VARCHAR(100),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 DATE,
+    col_06 VARCHAR(100),
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 BOOLEAN,
+    col_09 VARCHAR(100) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_104_col_00 ON table_104(col_00);
+
+CREATE TABLE table_105 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 DATE,
+    col_02 VARCHAR(100),
+    col_03 VARCHAR(255),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 BOOLEAN,
+    col_06 TEXT,
+    col_07 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_106 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 VARCHAR(100) NOT NULL,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 TEXT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 VARCHAR(255),
+    col_06 TEXT,
+    col_07 DECIMAL(10,2),
+    col_08 VARCHAR(100),
+    col_09 DECIMAL(10,2),
+    col_10 BOOLEAN,
+    col_11 BOOLEAN,
+    table_081_id INT,
+    FOREIGN KEY (table_081_id) REFERENCES table_081(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_106_col_00 ON table_106(col_00);
+
+CREATE TABLE table_107 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 INT DEFAULT 0,
+    col_03 JSON,
+    col_04 DECIMAL(10,2),
+    col_05 VARCHAR(255),
+    col_06 DECIMAL(10,2),
+    col_07 JSON,
+    col_08 INT,
+    col_09 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_107_col_00 ON table_107(col_00);
+
+CREATE TABLE table_108 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 VARCHAR(255),
+    col_02 VARCHAR(255),
+    col_03 DECIMAL(10,2),
+    col_04 VARCHAR(255) NOT NULL,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_108_col_00 ON table_108(col_00);
+
+CREATE TABLE table_109 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 DATE,
+    col_02 TEXT,
+    col_03 VARCHAR(100),
+    col_04 VARCHAR(100),
+    col_05 BOOLEAN,
+    col_06 BIGINT,
+    col_07 DATE,
+    col_08 BIGINT,
+    col_09 TEXT,
+    col_10 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_11 VARCHAR(100),
+    col_12 BOOLEAN,
+    col_13 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_109_col_00 ON table_109(col_00);
+
+CREATE TABLE table_110 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(100),
+    col_02 DATE,
+    col_03 JSON,
+    col_04 INT DEFAULT 0,
+    col_05 DECIMAL(10,2),
+    col_06 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_110_col_00 ON table_110(col_00);
+
+CREATE TABLE table_111 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 DECIMAL(10,2),
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 BIGINT,
+    col_05 VARCHAR(255),
+    col_06 INT DEFAULT 0,
+    col_07 INT,
+    col_08 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_111_col_00 ON table_111(col_00);
+
+CREATE TABLE table_112 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 VARCHAR(255),
+    col_04 DECIMAL(10,2),
+    col_05 DECIMAL(10,2),
+    col_06 BOOLEAN,
+    col_07 DATE,
+    col_08 DATE,
+    col_09 VARCHAR(100) NOT NULL,
+    col_10 DECIMAL(10,2),
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_006_id INT,
+    FOREIGN KEY (table_006_id) REFERENCES table_006(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_113 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 BOOLEAN,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 DECIMAL(10,2),
+    col_04 DATE,
+    col_05 TEXT,
+    col_06 DATE,
+    col_07 DECIMAL(10,2),
+    col_08 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_113_col_00 ON table_113(col_00);
+
+CREATE TABLE table_114 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 VARCHAR(255),
+    col_02 BIGINT,
+    col_03 INT,
+    col_04 DATE,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 DATE,
+    col_07 JSON,
+    col_08 INT,
+    col_09 BIGINT,
+    table_024_id INT,
+    FOREIGN KEY (table_024_id) REFERENCES table_024(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_115 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 INT,
+    col_02 DATE,
+    col_03 JSON,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 JSON,
+    col_06 VARCHAR(255),
+    col_07 JSON,
+    col_08 BIGINT,
+    col_09 VARCHAR(100) NOT NULL,
+    col_10 BOOLEAN,
+    col_11 VARCHAR(255) NOT NULL,
+    table_004_id INT,
+    FOREIGN KEY (table_004_id) REFERENCES table_004(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_115_col_00 ON table_115(col_00);
+
+CREATE TABLE table_116 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 VARCHAR(255),
+    col_02 DATE,
+    col_03 TEXT,
+    col_04 TEXT,
+    col_05 VARCHAR(255),
+    table_055_id INT,
+    FOREIGN KEY (table_055_id) REFERENCES table_055(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_116_col_00 ON table_116(col_00);
+
+CREATE TABLE table_117 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 DATE,
+    col_02 INT,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 JSON,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 BOOLEAN,
+    col_07 BIGINT,
+    col_08 INT,
+    col_09 JSON,
+    col_10 JSON,
+    col_11 VARCHAR(255),
+    col_12 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_13 DATE,
+    table_082_id INT,
+    FOREIGN KEY (table_082_id) REFERENCES table_082(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_117_col_00 ON table_117(col_00);
+
+CREATE TABLE table_118 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 INT,
+    col_02 BIGINT,
+    col_03 BIGINT,
+    col_04 VARCHAR(100),
+    col_05 VARCHAR(255),
+    col_06 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_118_col_00 ON table_118(col_00);
+
+CREATE TABLE table_119 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 VARCHAR(255),
+    col_02 BIGINT,
+    col_03 TEXT,
+    col_04 DATE,
+    col_05 BOOLEAN,
+    col_06 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_120 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT DEFAULT 0,
+    col_01 INT,
+    col_02 JSON,
+    col_03 BIGINT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_093_id INT,
+    FOREIGN KEY (table_093_id) REFERENCES table_093(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_121 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 INT,
+    col_02 JSON,
+    col_03 BOOLEAN,
+    col_04 BOOLEAN,
+    col_05 VARCHAR(255) NOT NULL,
+    col_06 BIGINT,
+    col_07 DECIMAL(10,2),
+    col_08 VARCHAR(255),
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_10 BOOLEAN,
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_121_col_00 ON table_121(col_00);
+
+CREATE TABLE table_122 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 DECIMAL(10,2),
+    col_02 TEXT,
+    col_03 TEXT,
+    col_04 INT,
+    col_05 JSON,
+    col_06 DECIMAL(10,2),
+    col_07 JSON,
+    col_08 INT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_122_col_00 ON table_122(col_00);
+
+CREATE TABLE table_123 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 JSON,
+    col_03 VARCHAR(100),
+    col_04 TEXT,
+    col_05 INT,
+    col_06 TEXT,
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 VARCHAR(255),
+    col_09 INT DEFAULT 0,
+    col_10 VARCHAR(255)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_123_col_00 ON table_123(col_00);
+
+CREATE TABLE table_124 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 BOOLEAN,
+    col_02 DECIMAL(10,2),
+    col_03 DATE,
+    col_04 BIGINT,
+    col_05 DATE,
+    col_06 DATE,
+    col_07 BOOLEAN,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_096_id INT,
+    FOREIGN KEY (table_096_id) REFERENCES table_096(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_125 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 JSON,
+    col_02 INT,
+    col_03 BOOLEAN,
+    col_04 VARCHAR(100),
+    col_05 JSON,
+    col_06 INT,
+    col_07 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_125_col_00 ON table_125(col_00);
+
+CREATE TABLE table_126 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 VARCHAR(100),
+    col_02 DATE,
+    col_03 TEXT,
+    col_04 DATE,
+    col_05 DECIMAL(10,2),
+    col_06 BOOLEAN,
+    col_07 DATE,
+    col_08 JSON,
+    col_09 VARCHAR(100) NOT NULL,
+    table_023_id INT,
+    FOREIGN KEY (table_023_id) REFERENCES table_023(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_127 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 JSON,
+    col_02 JSON,
+    col_03 DECIMAL(10,2),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 DATE,
+    col_06 VARCHAR(255) NOT NULL,
+    col_07 BOOLEAN,
+    col_08 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_127_col_00 ON table_127(col_00);
+
+CREATE TABLE table_128 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 VARCHAR(255),
+    col_02 INT,
+    col_03 DATE,
+    col_04 JSON,
+    col_05 INT DEFAULT 0,
+    col_06 VARCHAR(100),
+    col_07 JSON,
+    col_08 BOOLEAN,
+    col_09 JSON,
+    col_10 JSON,
+    col_11 VARCHAR(100) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_128_col_00 ON table_128(col_00);
+
+CREATE TABLE table_129 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 JSON,
+    col_02 VARCHAR(255) NOT NULL,
+    col_03 INT,
+    col_04 VARCHAR(255),
+    col_05 INT DEFAULT 0,
+    col_06 DATE,
+    col_07 DECIMAL(10,2),
+    col_08 BOOLEAN,
+    col_09 BOOLEAN,
+    col_10 BIGINT,
+    col_11 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_130 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 DECIMAL(10,2),
+    col_02 JSON,
+    col_03 VARCHAR(100),
+    col_04 DATE,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_040_id INT,
+    FOREIGN KEY (table_040_id) REFERENCES table_040(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_130_col_00 ON table_130(col_00);
+
+CREATE TABLE table_131 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100) NOT NULL,
+    col_01 TEXT,
+    col_02 DECIMAL(10,2),
+    col_03 INT,
+    col_04 INT,
+    col_05 INT DEFAULT 0,
+    col_06 DATE,
+    col_07 BOOLEAN,
+    table_037_id INT,
+    FOREIGN KEY (table_037_id) REFERENCES table_037(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_131_col_00 ON table_131(col_00);
+
+CREATE TABLE table_132 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 TEXT,
+    col_02 JSON,
+    col_03 JSON,
+    col_04 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_132_col_00 ON table_132(col_00);
+
+CREATE TABLE table_133 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 TEXT,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 BIGINT,
+    col_04 DECIMAL(10,2),
+    col_05 BOOLEAN,
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 VARCHAR(255),
+    col_08 VARCHAR(255),
+    col_09 INT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_134 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100) NOT NULL,
+    col_01 JSON,
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(255),
+    col_04 BIGINT,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 INT,
+    table_086_id INT,
+    FOREIGN KEY (table_086_id) REFERENCES table_086(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_134_col_00 ON table_134(col_00);
+
+CREATE TABLE table_135 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 BIGINT,
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(255),
+    col_04 INT DEFAULT 0,
+    col_05 BOOLEAN,
+    col_06 DATE,
+    col_07 VARCHAR(255),
+    table_107_id INT,
+    FOREIGN KEY (table_107_id) REFERENCES table_107(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_135_col_00 ON table_135(col_00);
+
+CREATE TABLE table_136 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT DEFAULT 0,
+    col_01 TEXT,
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(255) NOT NULL,
+    col_04 BIGINT,
+    col_05 DECIMAL(10,2),
+    col_06 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_136_col_00 ON table_136(col_00);
+
+CREATE TABLE table_137 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 VARCHAR(100),
+    col_02 TEXT,
+    col_03 BIGINT,
+    col_04 TEXT,
+    col_05 VARCHAR(100),
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 TEXT,
+    col_08 VARCHAR(100),
+    col_09 BIGINT,
+    col_10 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_11 TEXT,
+    col_12 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_137_col_00 ON table_137(col_00);
+
+CREATE TABLE table_138 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 INT,
+    col_02 DATE,
+    col_03 DECIMAL(10,2),
+    col_04 DATE,
+    col_05 VARCHAR(255),
+    col_06 DATE,
+    col_07 TEXT,
+    col_08 VARCHAR(255),
+    table_104_id INT,
+    FOREIGN KEY (table_104_id) REFERENCES table_104(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_138_col_00 ON table_138(col_00);
+
+CREATE TABLE table_139 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 VARCHAR(255),
+    col_02 VARCHAR(100),
+    col_03 VARCHAR(100),
+    col_04 BIGINT,
+    col_05 JSON,
+    col_06 BOOLEAN,
+    col_07 TEXT,
+    col_08 VARCHAR(255) NOT NULL,
+    col_09 JSON,
+    col_10 VARCHAR(100),
+    col_11 VARCHAR(100) NOT NULL,
+    col_12 DATE,
+    col_13 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_140 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 DECIMAL(10,2),
+    col_02 TEXT,
+    col_03 BOOLEAN,
+    col_04 BIGINT,
+    col_05 INT,
+    col_06 BOOLEAN,
+    col_07 VARCHAR(255),
+    col_08 TEXT,
+    col_09 VARCHAR(100) NOT NULL,
+    col_10 DECIMAL(10,2),
+    col_11 INT DEFAULT 0,
+    col_12 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_141 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 BOOLEAN,
+    col_02 DATE,
+    col_03 BIGINT,
+    col_04 BIGINT,
+    col_05 DECIMAL(10,2),
+    col_06 VARCHAR(100),
+    col_07 DECIMAL(10,2),
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_141_col_00 ON table_141(col_00);
+
+CREATE TABLE table_142 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 BOOLEAN,
+    col_02 DATE,
+    col_03 INT,
+    col_04 DATE,
+    col_05 VARCHAR(255) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_142_col_00 ON table_142(col_00);
+
+CREATE TABLE table_143 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 TEXT,
+    col_02 DATE,
+    col_03 INT DEFAULT 0,
+    col_04 VARCHAR(100) NOT NULL,
+    col_05 JSON,
+    col_06 JSON,
+    table_061_id INT,
+    FOREIGN KEY (table_061_id) REFERENCES table_061(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_144 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 BIGINT,
+    col_02 VARCHAR(255) NOT NULL,
+    col_03 BIGINT,
+    col_04 BOOLEAN,
+    col_05 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_145 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 BIGINT,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 VARCHAR(255),
+    col_04 VARCHAR(100),
+    col_05 VARCHAR(255),
+    table_101_id INT,
+    FOREIGN KEY (table_101_id) REFERENCES table_101(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_145_col_00 ON table_145(col_00);
+
+CREATE TABLE table_146 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 JSON,
+    col_03 JSON,
+    col_04 DATE,
+    table_013_id INT,
+    FOREIGN KEY (table_013_id) REFERENCES table_013(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_147 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 VARCHAR(100),
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 JSON,
+    col_04 DECIMAL(10,2),
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 DATE,
+    col_07 INT,
+    col_08 VARCHAR(255),
+    col_09 DECIMAL(10,2),
+    table_108_id INT,
+    FOREIGN KEY (table_108_id) REFERENCES table_108(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_148 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 BIGINT,
+    col_02 DATE,
+    col_03 VARCHAR(255) NOT NULL,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_005_id INT,
+    FOREIGN KEY (table_005_id) REFERENCES table_005(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_148_col_00 ON table_148(col_00);
+
+CREATE TABLE table_149 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BOOLEAN,
+    col_02 INT,
+    col_03 INT DEFAULT 0,
+    col_04 JSON,
+    col_05 TEXT,
+    col_06 JSON,
+    col_07 TEXT,
+    col_08 DATE,
+    col_09 INT,
+    col_10 TEXT,
+    table_003_id INT,
+    FOREIGN KEY (table_003_id) REFERENCES table_003(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_149_col_00 ON table_149(col_00);
+
+CREATE TABLE table_150 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BIGINT,
+    col_02 TEXT,
+    col_03 DECIMAL(10,2),
+    col_04 VARCHAR(255) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_151 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BIGINT,
+    col_02 BOOLEAN,
+    col_03 JSON,
+    col_04 DATE,
+    col_05 VARCHAR(255),
+    col_06 DATE,
+    col_07 VARCHAR(255),
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 JSON,
+    col_10 TEXT,
+    col_11 TEXT,
+    col_12 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_151_col_00 ON table_151(col_00);
+
+CREATE TABLE table_152 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 JSON,
+    col_02 VARCHAR(100),
+    col_03 VARCHAR(100),
+    col_04 DECIMAL(10,2),
+    col_05 BOOLEAN,
+    col_06 DATE,
+    col_07 TEXT,
+    col_08 JSON,
+    col_09 BOOLEAN,
+    col_10 BOOLEAN,
+    col_11 TEXT,
+    table_085_id INT,
+    FOREIGN KEY (table_085_id) REFERENCES table_085(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_152_col_00 ON table_152(col_00);
+
+CREATE TABLE table_153 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 JSON,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 INT,
+    col_05 VARCHAR(255),
+    col_06 VARCHAR(100),
+    col_07 DECIMAL(10,2),
+    col_08 BOOLEAN,
+    col_09 TEXT,
+    col_10 TEXT,
+    col_11 VARCHAR(100) NOT NULL,
+    col_12 INT DEFAULT 0,
+    table_089_id INT,
+    FOREIGN KEY (table_089_id) REFERENCES table_089(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_153_col_00 ON table_153(col_00);
+
+CREATE TABLE table_154 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 BOOLEAN,
+    col_03 DECIMAL(10,2),
+    col_04 BIGINT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_155 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 DECIMAL(10,2),
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(255),
+    col_04 JSON,
+    col_05 JSON,
+    col_06 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_155_col_00 ON table_155(col_00);
+
+CREATE TABLE table_156 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 VARCHAR(255),
+    col_02 BIGINT,
+    col_03 INT,
+    col_04 DATE,
+    col_05 JSON,
+    col_06 BIGINT,
+    col_07 BOOLEAN,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 JSON,
+    col_10 VARCHAR(255),
+    col_11 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_157 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 BOOLEAN,
+    col_02 TEXT,
+    col_03 DECIMAL(10,2),
+    col_04 TEXT,
+    col_05 DECIMAL(10,2),
+    col_06 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_07 DATE,
+    col_08 DATE,
+    col_09 JSON,
+    col_10 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_157_col_00 ON table_157(col_00);
+
+CREATE TABLE table_158 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BOOLEAN,
+    col_02 TEXT,
+    col_03 VARCHAR(255),
+    col_04 VARCHAR(255),
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 JSON,
+    col_07 VARCHAR(100) NOT NULL,
+    col_08 DECIMAL(10,2),
+    col_09 DECIMAL(10,2),
+    col_10 JSON,
+    col_11 VARCHAR(100),
+    table_109_id INT,
+    FOREIGN KEY (table_109_id) REFERENCES table_109(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_159 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT DEFAULT 0,
+    col_01 JSON,
+    col_02 BOOLEAN,
+    col_03 TEXT,
+    col_04 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_159_col_00 ON table_159(col_00);
+
+CREATE TABLE table_160 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 DATE,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 BOOLEAN,
+    col_05 DECIMAL(10,2),
+    col_06 INT,
+    col_07 DECIMAL(10,2),
+    col_08 BOOLEAN,
+    col_09 INT,
+    col_10 DATE,
+    table_104_id INT,
+    FOREIGN KEY (table_104_id) REFERENCES table_104(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_160_col_00 ON table_160(col_00);
+
+CREATE TABLE table_161 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 JSON,
+    col_02 VARCHAR(255),
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 BOOLEAN,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 BOOLEAN,
+    col_07 JSON,
+    col_08 JSON,
+    col_09 DECIMAL(10,2),
+    col_10 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_161_col_00 ON table_161(col_00);
+
+CREATE TABLE table_162 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 DECIMAL(10,2),
+    col_02 BIGINT,
+    col_03 TEXT,
+    col_04 DATE,
+    col_05 VARCHAR(255) NOT NULL,
+    col_06 TEXT,
+    col_07 DECIMAL(10,2),
+    col_08 DATE,
+    col_09 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_162_col_00 ON table_162(col_00);
+
+CREATE TABLE table_163 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 VARCHAR(100),
+    col_02 INT,
+    col_03 JSON,
+    col_04 BIGINT,
+    col_05 DECIMAL(10,2),
+    col_06 JSON,
+    col_07 INT,
+    col_08 INT,
+    col_09 TEXT,
+    col_10 DATE,
+    col_11 INT,
+    col_12 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_163_col_00 ON table_163(col_00);
+
+CREATE TABLE table_164 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100) NOT NULL,
+    col_01 DATE,
+    col_02 JSON,
+    col_03 TEXT,
+    col_04 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_164_col_00 ON table_164(col_00);
+
+CREATE TABLE table_165 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 JSON,
+    col_02 BIGINT,
+    col_03 VARCHAR(255) NOT NULL,
+    col_04 DATE,
+    col_05 BIGINT,
+    col_06 INT DEFAULT 0,
+    col_07 VARCHAR(255),
+    table_119_id INT,
+    FOREIGN KEY (table_119_id) REFERENCES table_119(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_166 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 JSON,
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(255),
+    col_04 DECIMAL(10,2),
+    table_008_id INT,
+    FOREIGN KEY (table_008_id) REFERENCES table_008(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_166_col_00 ON table_166(col_00);
+
+CREATE TABLE table_167 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 BIGINT,
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(100),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 INT DEFAULT 0
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_167_col_00 ON table_167(col_00);
+
+CREATE TABLE table_168 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 INT,
+    col_02 VARCHAR(100) NOT NULL,
+    col_03 VARCHAR(255),
+    col_04 VARCHAR(255)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_169 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 JSON,
+    col_02 TEXT,
+    col_03 BOOLEAN,
+    col_04 BIGINT,
+    col_05 VARCHAR(100),
+    col_06 JSON,
+    col_07 VARCHAR(100),
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_169_col_00 ON table_169(col_00);
+
+CREATE TABLE table_170 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 VARCHAR(255) NOT NULL,
+    col_04 TEXT,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 DATE,
+    col_07 DECIMAL(10,2),
+    table_136_id INT,
+    FOREIGN KEY (table_136_id) REFERENCES table_136(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_171 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BIGINT,
+    col_02 BIGINT,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 DECIMAL(10,2),
+    col_05 DATE,
+    col_06 VARCHAR(100),
+    col_07 BOOLEAN,
+    col_08 TEXT,
+    col_09 DECIMAL(10,2),
+    table_008_id INT,
+    FOREIGN KEY (table_008_id) REFERENCES table_008(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_171_col_00 ON table_171(col_00);
+
+CREATE TABLE table_172 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 VARCHAR(255) NOT NULL,
+    col_03 TEXT,
+    col_04 DATE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_172_col_00 ON table_172(col_00);
+
+CREATE TABLE table_173 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(100) NOT NULL,
+    col_02 DECIMAL(10,2),
+    col_03 VARCHAR(100),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 JSON,
+    col_06 VARCHAR(100) NOT NULL,
+    col_07 BOOLEAN,
+    col_08 DATE,
+    col_09 VARCHAR(100) NOT NULL,
+    col_10 BOOLEAN,
+    col_11 VARCHAR(255)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_173_col_00 ON table_173(col_00);
+
+CREATE TABLE table_174 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 DECIMAL(10,2),
+    col_02 DATE,
+    col_03 VARCHAR(100),
+    col_04 DATE,
+    col_05 VARCHAR(100) NOT NULL,
+    col_06 BIGINT,
+    col_07 DATE,
+    col_08 BIGINT,
+    col_09 DATE,
+    col_10 TEXT,
+    col_11 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    table_145_id INT,
+    FOREIGN KEY (table_145_id) REFERENCES table_145(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_174_col_00 ON table_174(col_00);
+
+CREATE TABLE table_175 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 BIGINT,
+    col_02 VARCHAR(255),
+    col_03 VARCHAR(100),
+    col_04 BIGINT,
+    table_092_id INT,
+    FOREIGN KEY (table_092_id) REFERENCES table_092(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_176 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100) NOT NULL,
+    col_01 TEXT,
+    col_02 BIGINT,
+    col_03 VARCHAR(100) NOT NULL,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 TEXT,
+    col_06 DECIMAL(10,2),
+    col_07 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_08 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_177 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 DECIMAL(10,2),
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 INT,
+    col_05 BOOLEAN,
+    col_06 VARCHAR(100),
+    table_160_id INT,
+    FOREIGN KEY (table_160_id) REFERENCES table_160(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_177_col_00 ON table_177(col_00);
+
+CREATE TABLE table_178 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BIGINT,
+    col_01 TEXT,
+    col_02 JSON,
+    col_03 VARCHAR(100),
+    col_04 INT,
+    col_05 JSON,
+    col_06 VARCHAR(255),
+    col_07 TEXT,
+    col_08 VARCHAR(255),
+    table_079_id INT,
+    FOREIGN KEY (table_079_id) REFERENCES table_079(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_179 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 JSON,
+    col_01 VARCHAR(100),
+    col_02 DATE,
+    col_03 TEXT,
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 JSON,
+    col_06 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_179_col_00 ON table_179(col_00);
+
+CREATE TABLE table_180 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 JSON,
+    col_02 JSON,
+    col_03 INT,
+    col_04 BIGINT,
+    col_05 INT,
+    col_06 VARCHAR(100),
+    col_07 DECIMAL(10,2),
+    col_08 BIGINT,
+    table_100_id INT,
+    FOREIGN KEY (table_100_id) REFERENCES table_100(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_181 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100) NOT NULL,
+    col_01 BIGINT,
+    col_02 DECIMAL(10,2),
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 BOOLEAN,
+    col_05 DECIMAL(10,2),
+    col_06 DATE,
+    col_07 BOOLEAN,
+    col_08 DATE,
+    col_09 INT,
+    col_10 VARCHAR(100),
+    col_11 DATE,
+    col_12 VARCHAR(255),
+    col_13 VARCHAR(100) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_182 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 VARCHAR(100),
+    col_02 VARCHAR(100),
+    col_03 VARCHAR(255),
+    col_04 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_05 BIGINT,
+    col_06 VARCHAR(100),
+    col_07 VARCHAR(255) NOT NULL,
+    col_08 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_182_col_00 ON table_182(col_00);
+
+CREATE TABLE table_183 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 BOOLEAN,
+    col_02 VARCHAR(255),
+    col_03 DECIMAL(10,2),
+    col_04 TEXT,
+    col_05 VARCHAR(255),
+    col_06 VARCHAR(255),
+    col_07 VARCHAR(100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_183_col_00 ON table_183(col_00);
+
+CREATE TABLE table_184 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 BOOLEAN,
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 VARCHAR(100) NOT NULL,
+    col_04 TEXT,
+    col_05 INT,
+    col_06 VARCHAR(100) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_184_col_00 ON table_184(col_00);
+
+CREATE TABLE table_185 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BOOLEAN,
+    col_02 BIGINT,
+    col_03 VARCHAR(255),
+    col_04 BOOLEAN,
+    col_05 JSON,
+    table_043_id INT,
+    FOREIGN KEY (table_043_id) REFERENCES table_043(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_185_col_00 ON table_185(col_00);
+
+CREATE TABLE table_186 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 INT,
+    col_01 BOOLEAN,
+    col_02 BIGINT,
+    col_03 DECIMAL(10,2),
+    col_04 JSON,
+    table_165_id INT,
+    FOREIGN KEY (table_165_id) REFERENCES table_165(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_186_col_00 ON table_186(col_00);
+
+CREATE TABLE table_187 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(100),
+    col_02 DECIMAL(10,2),
+    col_03 INT,
+    col_04 BIGINT,
+    col_05 TEXT,
+    col_06 JSON,
+    col_07 TEXT,
+    col_08 VARCHAR(100) NOT NULL,
+    col_09 INT DEFAULT 0,
+    col_10 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_187_col_00 ON table_187(col_00);
+
+CREATE TABLE table_188 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 TEXT,
+    col_03 JSON,
+    col_04 DECIMAL(10,2),
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 VARCHAR(255),
+    table_058_id INT,
+    FOREIGN KEY (table_058_id) REFERENCES table_058(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_188_col_00 ON table_188(col_00);
+
+CREATE TABLE table_189 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 TEXT,
+    col_02 VARCHAR(100) NOT NULL,
+    col_03 BIGINT,
+    col_04 JSON,
+    col_05 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_06 INT,
+    col_07 BOOLEAN,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_09 BOOLEAN,
+    col_10 VARCHAR(255),
+    col_11 JSON,
+    col_12 JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_189_col_00 ON table_189(col_00);
+
+CREATE TABLE table_190 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255) NOT NULL,
+    col_01 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_02 BIGINT,
+    col_03 INT,
+    col_04 BOOLEAN,
+    col_05 VARCHAR(100) NOT NULL,
+    col_06 DECIMAL(10,2),
+    col_07 INT DEFAULT 0
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_191 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 INT,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 DECIMAL(10,2),
+    col_05 BIGINT,
+    col_06 BOOLEAN,
+    col_07 INT,
+    col_08 VARCHAR(255),
+    col_09 VARCHAR(255),
+    col_10 INT,
+    col_11 JSON,
+    col_12 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_191_col_00 ON table_191(col_00);
+
+CREATE TABLE table_192 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DECIMAL(10,2),
+    col_01 DATE,
+    col_02 BOOLEAN,
+    col_03 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_04 DATE,
+    col_05 VARCHAR(255),
+    col_06 JSON,
+    col_07 JSON,
+    col_08 BIGINT,
+    col_09 INT,
+    col_10 DECIMAL(10,2),
+    col_11 JSON,
+    col_12 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_192_col_00 ON table_192(col_00);
+
+CREATE TABLE table_193 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(255) NOT NULL,
+    col_02 DECIMAL(10,2),
+    col_03 DATE,
+    col_04 INT,
+    col_05 VARCHAR(100),
+    col_06 BOOLEAN,
+    col_07 BIGINT,
+    col_08 VARCHAR(255),
+    col_09 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_194 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 VARCHAR(100),
+    col_02 TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    col_03 TEXT,
+    col_04 BIGINT,
+    col_05 VARCHAR(100) NOT NULL,
+    col_06 VARCHAR(100),
+    col_07 BIGINT,
+    col_08 VARCHAR(100),
+    col_09 TEXT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_194_col_00 ON table_194(col_00);
+
+CREATE TABLE table_195 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 BOOLEAN,
+    col_01 VARCHAR(100),
+    col_02 VARCHAR(100),
+    col_03 BIGINT,
+    col_04 DATE,
+    col_05 JSON,
+    col_06 JSON,
+    col_07 BIGINT,
+    col_08 TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_196 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 DATE,
+    col_01 DATE,
+    col_02 DATE,
+    col_03 BIGINT,
+    col_04 DATE,
+    col_05 BIGINT,
+    col_06 BOOLEAN
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_196_col_00 ON table_196(col_00);
+
+CREATE TABLE table_197 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(100),
+    col_01 BIGINT,
+    col_02 VARCHAR(255),
+    col_03 JSON,
+    col_04 INT,
+    col_05 INT,
+    col_06 INT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_197_col_00 ON table_197(col_00);
+
+CREATE TABLE table_198 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 VARCHAR(255),
+    col_01 BIGINT,
+    col_02 JSON,
+    col_03 VARCHAR(100),
+    col_04 DECIMAL(10,2),
+    col_05 VARCHAR(255)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE table_199 (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    col_00 TEXT,
+    col_01 BIGINT,
+    col_02 VARCHAR(100),
+    col_03 DATE,
+    col_04 DECIMAL(10,2)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_table_199_col_00 ON table_199(col_00);
+
+CREATE VIEW view_00 AS
+SELECT id, col_00, col_01
+FROM table_154
+WHERE id > 0;
+
+CREATE VIEW view_01 AS
+SELECT id, col_00, col_01
+FROM table_090
+WHERE id > 1;
+
+CREATE VIEW view_02 AS
+SELECT id, col_00, col_01
+FROM table_025
+WHERE id > 2;
+
+CREATE VIEW view_03 AS
+SELECT id, col_00, col_01
+FROM table_091
+WHERE id > 3;
+
+CREATE VIEW view_04 AS
+SELECT id, col_00, col_01
+FROM table_140
+WHERE id > 4;
+
+CREATE VIEW view_05 AS
+SELECT id, col_00, col_01
+FROM table_083
+WHERE id > 5;
+
+CREATE VIEW view_06 AS
+SELECT id, col_00, col_01
+FROM table_154
+WHERE id > 6;
+
+CREATE VIEW view_07 AS
+SELECT id, col_00, col_01
+FROM table_028
+WHERE id > 7;
+
+CREATE VIEW view_08 AS
+SELECT id, col_00, col_01
+FROM table_008
+WHERE id > 8;
+
+CREATE VIEW view_09 AS
+SELECT id, col_00, col_01
+FROM table_172
+WHERE id > 9;
+
+CREATE VIEW view_10 AS
+SELECT id, col_00, col_01
+FROM table_062
+WHERE id > 10;
+
+CREATE VIEW view_11 AS
+SELECT id, col_00, col_01
+FROM table_065
+WHERE id > 11;
+
+CREATE VIEW view_12 AS
+SELECT id, col_00, col_01
+FROM table_090
+WHERE id > 12;
+
+CREATE VIEW view_13 AS
+SELECT id, col_00, col_01
+FROM table_049
+WHERE id > 13;
+
+CREATE VIEW view_14 AS
+SELECT id, col_00, col_01
+FROM table_177
+WHERE id > 14;
+
+CREATE VIEW view_15 AS
+SELECT id, col_00, col_01
+FROM table_114
+WHERE id > 15;
+
+CREATE VIEW view_16 AS
+SELECT id, col_00, col_01
+FROM table_005
+WHERE id > 16;
+
+CREATE VIEW view_17 AS
+SELECT id, col_00, col_01
+FROM table_148
+WHERE id > 17;
+
+CREATE VIEW view_18 AS
+SELECT id, col_00, col_01
+FROM table_112
+WHERE id > 18;
+
+CREATE VIEW view_19 AS
+SELECT id, col_00, col_01
+FROM table_029
+WHERE id > 19;
@@ -0,0 +1,195 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/sqlite"
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+)
+
+// openSQLite opens an in-memory SQLite database. A single connection keeps every
+// statement on the same database.
+func openSQLite(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite", ":memory:")
+	assert.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	return db
+}
+
+// objects lists the tables and indexes of a SQLite database, except for the
+// existing table the strategy tests start with
+func objects(t *testing.T, db *sql.DB) []string {
+	rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type IN ('table', 'index')
+		AND name NOT LIKE 'sqlite_%' AND name <> 'existing' ORDER BY name`)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		assert.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	assert.NoError(t, rows.Err())
+	return names
+}
+
+func TestApply_SQLite(t *testing.T) {
+	schema := &sqlmapper.Schema{Tables: []sqlmapper.Table{
+		{
+			Name: "posts",
+			Columns: []sqlmapper.Column{
+				{Name: "id", DataType: "INTEGER", IsPrimaryKey: true},
+				{Name: "user_id", DataType: "INTEGER", IsNullable: true},
+			},
+			Constraints: []sqlmapper.Constraint{{Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}}},
+			Indexes:     []sqlmapper.Index{{Name: "idx_posts_user", Columns: sqlmapper.IndexColumns("user_id")}},
+		},
+		{
+			Name:    "users",
+			Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER", IsPrimaryKey: true}},
+		},
+	}}
+
+	db := openSQLite(t)
+	defer db.Close()
+
+	err := sqlmapper.Apply(context.Background(), db, schema, sqlite.NewSQLite(), sqlmapper.ApplyOptions{Transaction: true})
+	assert.NoError(t, err)
+
+	// Referenced tables are created first
+	var names []string
+	rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'table' ORDER BY rowid`)
+	if assert.NoError(t, err) {
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			assert.NoError(t, rows.Scan(&name))
+			names = append(names, name)
+		}
+	}
+	assert.Equal(t, []string{"users", "posts"}, names)
+
+	applied, err := sqlmapper.IntrospectDatabase(context.Background(), db, sqlite.NewSQLite())
+	assert.NoError(t, err)
+	assert.Equal(t, []sqlmapper.Table{
+		{
+			Name: "posts",
+			Columns: []sqlmapper.Column{
+				{Name: "id", DataType: "INTEGER", IsPrimaryKey: true, Order: 1},
+				{Name: "user_id", DataType: "INTEGER", IsNullable: true, Order: 2},
+			},
+			Indexes: []sqlmapper.Index{{Name: "idx_posts_user", Columns: sqlmapper.IndexColumns("user_id")}},
+			Constraints: []sqlmapper.Constraint{
+				{Type: "PRIMARY KEY", Columns: []string{"id"}},
+				{Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name:        "users",
+			Columns:     []sqlmapper.Column{{Name: "id", DataType: "INTEGER", IsPrimaryKey: true, Order: 1}},
+			Constraints: []sqlmapper.Constraint{{Type: "PRIMARY KEY", Columns: []string{"id"}}},
+		},
+	}, applied.Tables)
+}
+
+func TestApply_FailingStatement(t *testing.T) {
+	schema := &sqlmapper.Schema{Tables: []sqlmapper.Table{
+		{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER", IsPrimaryKey: true}}},
+		{Name: "existing", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER"}}},
+	}}
+
+	db := openSQLite(t)
+	defer db.Close()
+	_, err := db.Exec("CREATE TABLE existing (id INTEGER)")
+	assert.NoError(t, err)
+
+	err = sqlmapper.Apply(context.Background(), db, schema, sqlite.NewSQLite(), sqlmapper.ApplyOptions{Transaction: true})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to execute statement at line 5")
+		assert.Contains(t, err.Error(), "table existing already exists")
+		assert.Contains(t, err.Error(), "CREATE TABLE existing (")
+	}
+
+	// The transaction was rolled back
+	assert.Empty(t, objects(t, db))
+}
+
+// TestApply_TransactionStrategies checks which tables and indexes each strategy
+// leaves in a SQLite database when a statement fails. DDL committed implicitly, as
+// by MySQL, is not exercised.
+func TestApply_TransactionStrategies(t *testing.T) {
+	// Tables are created in the order users, existing, posts, followed by the index
+	// of posts. Creating existing fails, as the database already has it.
+	schema := &sqlmapper.Schema{Tables: []sqlmapper.Table{
+		{Name: "users", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER", IsPrimaryKey: true}}},
+		{Name: "existing", Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER"}}},
+		{
+			Name:    "posts",
+			Columns: []sqlmapper.Column{{Name: "id", DataType: "INTEGER", IsPrimaryKey: true}, {Name: "user_id", DataType: "INTEGER"}},
+			Indexes: []sqlmapper.Index{{Name: "idx_posts_user", Columns: sqlmapper.IndexColumns("user_id")}},
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		options sqlmapper.ApplyOptions
+		objects []string
+	}{
+		{
+			name:    "Single transaction",
+			options: sqlmapper.ApplyOptions{Strategy: sqlmapper.SingleTransaction, ContinueOnError: true},
+		},
+		{
+			name:    "Default for SQLite",
+			options: sqlmapper.ApplyOptions{},
+		},
+		{
+			name:    "Per object",
+			options: sqlmapper.ApplyOptions{Strategy: sqlmapper.PerObject},
+			objects: []string{"users"},
+		},
+		{
+			name:    "Per object continuing on error",
+			options: sqlmapper.ApplyOptions{Strategy: sqlmapper.PerObject, ContinueOnError: true},
+			objects: []string{"idx_posts_user", "posts", "users"},
+		},
+		{
+			name:    "Per batch continuing on error",
+			options: sqlmapper.ApplyOptions{Strategy: sqlmapper.PerBatch, BatchSize: 2, ContinueOnError: true},
+			objects: []string{"idx_posts_user", "posts"},
+		},
+		{
+			name:    "Per statement",
+			options: sqlmapper.ApplyOptions{Strategy: sqlmapper.PerStatement},
+			objects: []string{"users"},
+		},
+		{
+			name:    "Per statement continuing on error",
+			options: sqlmapper.ApplyOptions{Strategy: sqlmapper.PerStatement, ContinueOnError: true},
+			objects: []string{"idx_posts_user", "posts", "users"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openSQLite(t)
+			defer db.Close()
+			_, err := db.Exec("CREATE TABLE existing (id INTEGER)")
+			assert.NoError(t, err)
+
+			err = sqlmapper.Apply(context.Background(), db, schema, sqlite.NewSQLite(), tt.options)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), "failed to execute statement at line 5")
+				assert.Contains(t, err.Error(), "table existing already exists")
+			}
+			assert.Equal(t, tt.objects, objects(t, db))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/mysql"
+	"github.com/mstgnz/sqlmapper/sqlite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Generate(t *testing.T) {
+	schema := &sqlmapper.Schema{}
+	schema.AddTable("users").
+		AddColumn("id", "INT", sqlmapper.WithPrimaryKey(), sqlmapper.WithAutoIncrement()).
+		AddColumn("email", "VARCHAR(255)", sqlmapper.WithNotNull(), sqlmapper.WithUnique()).
+		AddColumn("balance", "DECIMAL(10,2)", sqlmapper.WithDefault("0")).
+		AddIndex("idx_users_balance", "balance").
+		AddTable("posts").
+		AddColumn("id", "INT", sqlmapper.WithPrimaryKey()).
+		AddColumn("user_id", "INT", sqlmapper.WithNotNull()).
+		AddForeignKey("fk_posts_user", "user_id", "users", "id")
+
+	result, err := mysql.NewMySQL().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "CREATE TABLE users (\n"+
+		"    id INT AUTO_INCREMENT PRIMARY KEY,\n"+
+		"    email VARCHAR(255) NOT NULL UNIQUE,\n"+
+		"    balance DECIMAL(10,2) DEFAULT '0'\n"+
+		");")
+	assert.Contains(t, result, "CREATE INDEX idx_users_balance ON users(balance);")
+
+	result, err = sqlite.NewSQLite().Generate(schema)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "    id INTEGER PRIMARY KEY AUTOINCREMENT,\n")
+	assert.Contains(t, result, "CREATE TABLE posts (\n"+
+		"    id INT PRIMARY KEY,\n"+
+		"    user_id INT NOT NULL,\n"+
+		"    CONSTRAINT fk_posts_user FOREIGN KEY (user_id) REFERENCES users (id)\n"+
+		");")
+
+	// The built schema parses back to the same tables
+	parsed, err := sqlite.NewSQLite().Parse(result)
+	assert.NoError(t, err)
+	if assert.Len(t, parsed.Tables, 2) {
+		assert.Equal(t, "users", parsed.Tables[0].Name)
+		assert.Len(t, parsed.Tables[0].Columns, 3)
+		assert.Len(t, parsed.Tables[1].Columns, 2)
+	}
+}
@@ -0,0 +1,48 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/mysql"
+	"github.com/mstgnz/sqlmapper/oracle"
+	"github.com/mstgnz/sqlmapper/postgres"
+	"github.com/mstgnz/sqlmapper/sqlite"
+	"github.com/mstgnz/sqlmapper/sqlserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_CommentsBetweenColumns(t *testing.T) {
+	input := `CREATE TABLE users (
+    id INT NOT NULL, -- primary key, surrogate
+    /* the login, unique */ email VARCHAR(255),
+    name VARCHAR(100) /* display, name */
+);
+`
+
+	databases := map[string]sqlmapper.Database{
+		"MySQL":      mysql.NewMySQL(),
+		"PostgreSQL": postgres.NewPostgreSQL(),
+		"SQLite":     sqlite.NewSQLite(),
+		"SQLServer":  sqlserver.NewSQLServer(),
+		"Oracle":     oracle.NewOracle(),
+	}
+
+	for name, db := range databases {
+		t.Run(name, func(t *testing.T) {
+			schema, err := db.Parse(input)
+			assert.NoError(t, err)
+			if !assert.Len(t, schema.Tables, 1) {
+				return
+			}
+
+			var columns []string
+			for _, column := range schema.Tables[0].Columns {
+				columns = append(columns, column.Name)
+			}
+			assert.Equal(t, []string{"id", "email", "name"}, columns)
+			assert.Equal(t, 255, schema.Tables[0].Columns[1].Length)
+			assert.Equal(t, 100, schema.Tables[0].Columns[2].Length)
+		})
+	}
+}
@@ -0,0 +1,502 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/mysql"
+	"github.com/mstgnz/sqlmapper/oracle"
+	"github.com/mstgnz/sqlmapper/postgres"
+	"github.com/mstgnz/sqlmapper/sqlite"
+	"github.com/mstgnz/sqlmapper/sqlserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert_MySQLToSQLite(t *testing.T) {
+	// A mysqldump excerpt
+	dump := "CREATE TABLE `users` (\n" +
+		"  `id` int unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `email` varchar(255) NOT NULL,\n" +
+		"  `status` enum('active','banned') NOT NULL DEFAULT 'active',\n" +
+		"  `bio` text,\n" +
+		"  `score` decimal(10,2) DEFAULT '0.00',\n" +
+		"  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  UNIQUE KEY `uq_users_email` (`email`),\n" +
+		"  KEY `idx_users_status` (`status`),\n" +
+		"  FULLTEXT KEY `ft_users_bio` (`bio`)\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=5 DEFAULT CHARSET=utf8mb4 COMMENT='Registered users';\n\n" +
+		"CREATE TABLE `posts` (\n" +
+		"  `id` bigint NOT NULL AUTO_INCREMENT,\n" +
+		"  `user_id` int unsigned NOT NULL,\n" +
+		"  `title` varchar(200) NOT NULL,\n" +
+		"  `order` int NOT NULL,\n" +
+		"  `due` date DEFAULT (CURRENT_DATE + INTERVAL 1 DAY),\n" +
+		"  `expires_at` datetime DEFAULT (NOW() + INTERVAL 1 WEEK),\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `idx_posts_order` (`order`),\n" +
+		"  CONSTRAINT `fk_posts_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE\n" +
+		") ENGINE=InnoDB;\n"
+
+	output, warnings, err := sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, `CREATE TABLE users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT CHECK (id >= 0),
+    email varchar(255) NOT NULL,
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active','banned')),
+    bio text,
+    score decimal(10,2) DEFAULT 0.00,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_users_email UNIQUE (email)
+);
+CREATE INDEX idx_users_status ON users(status);
+CREATE INDEX ft_users_bio ON users(bio);
+
+CREATE TABLE posts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id int NOT NULL CHECK (user_id >= 0),
+    title varchar(200) NOT NULL,
+    "order" int NOT NULL,
+    due date DEFAULT (date('now', '+1 days')),
+    expires_at datetime,
+    CONSTRAINT fk_posts_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_posts_order ON posts("order");
+`, output)
+	assert.Equal(t, []string{
+		"SQLite does not support comments, the comment of table users was dropped",
+		"FULLTEXT index ft_users_bio on users is not supported and was generated as a regular index",
+		"default (NOW() + INTERVAL 1 WEEK) of column posts.expires_at uses INTERVAL, which SQLite does not support, and was dropped",
+	}, warnings)
+
+	// The output loads in SQLite
+	db := openSQLite(t)
+	defer db.Close()
+	_, err = db.Exec(output)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO users (email) VALUES ('a@example.com');
+		INSERT INTO posts (user_id, title, "order") VALUES (1, 'Hello', 1)`)
+	assert.NoError(t, err)
+	var later bool
+	assert.NoError(t, db.QueryRow("SELECT due > date('now') FROM posts").Scan(&later))
+	assert.True(t, later)
+
+	// The output is read back by the SQLite parser
+	schema, err := sqlite.NewSQLite().Parse(output)
+	assert.NoError(t, err)
+	assert.Len(t, schema.Tables, 2)
+}
+
+func TestConvert_MySQLToPostgreSQL_Booleans(t *testing.T) {
+	dump := "CREATE TABLE `flags` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `active` tinyint(1) NOT NULL DEFAULT '1',\n" +
+		"  `deleted` tinyint(1) DEFAULT '0',\n" +
+		"  `level` tinyint(4) DEFAULT '0'\n" +
+		") ENGINE=InnoDB;\n"
+
+	output, _, err := sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, `CREATE TABLE flags (
+    id int NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    deleted BOOLEAN DEFAULT false,
+    "level" tinyint(4) DEFAULT 0
+);
+`, output)
+
+	output, _, err = sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{KeepTinyInt: true})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "active tinyint(1) NOT NULL DEFAULT 1,")
+	assert.Contains(t, output, "deleted tinyint(1) DEFAULT 0,")
+}
+
+func TestConvert_MySQLToPostgreSQL_StringDefaults(t *testing.T) {
+	dump := "CREATE TABLE `posts` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `status` enum('a','b') NOT NULL DEFAULT 'a',\n" +
+		"  `title` varchar(100) DEFAULT 'it''s',\n" +
+		"  `views` int DEFAULT '0',\n" +
+		"  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP\n" +
+		") ENGINE=InnoDB;\n"
+
+	output, _, err := sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "NOT NULL DEFAULT 'a'")
+	assert.Contains(t, output, "DEFAULT 'it''s'")
+	assert.Contains(t, output, "views int DEFAULT 0")
+	assert.Contains(t, output, "DEFAULT CURRENT_TIMESTAMP")
+}
+
+func TestConvert_FractionalSecondsDefaults(t *testing.T) {
+	dump := "CREATE TABLE `events` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `updated` datetime(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)\n" +
+		") ENGINE=InnoDB;\n"
+
+	output, _, err := sqlmapper.Convert(dump, mysql.NewMySQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "updated datetime(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)")
+
+	// CURRENT_TIMESTAMP takes no precision in SQLite and SQL Server
+	output, _, err = sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "DEFAULT CURRENT_TIMESTAMP")
+	assert.NotContains(t, output, "CURRENT_TIMESTAMP(")
+
+	schema, err := mysql.NewMySQL().Parse(dump)
+	assert.NoError(t, err)
+	table, err := sqlmapper.GenerateTable(sqlserver.NewSQLServer(), schema.Tables[0])
+	assert.NoError(t, err)
+	assert.Contains(t, table, "DEFAULT CURRENT_TIMESTAMP")
+	assert.NotContains(t, table, "CURRENT_TIMESTAMP(")
+}
+
+func TestConvert_PostgreSQLCasts(t *testing.T) {
+	dump := `CREATE TABLE events (
+    id INTEGER,
+    payload JSONB DEFAULT '{}'::jsonb,
+    kind VARCHAR(20) DEFAULT 'click'::character varying
+);`
+
+	// The casts are kept as written when generating PostgreSQL
+	output, _, err := sqlmapper.Convert(dump, postgres.NewPostgreSQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "payload JSONB DEFAULT '{}'::jsonb,")
+	assert.Contains(t, output, "kind VARCHAR(20) DEFAULT 'click'::character varying")
+
+	output, _, err = sqlmapper.Convert(dump, postgres.NewPostgreSQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "payload JSONB DEFAULT (CAST('{}' AS JSON)),")
+	assert.Contains(t, output, "kind VARCHAR(20) DEFAULT (CAST('click' AS CHAR))")
+
+	output, _, err = sqlmapper.Convert(dump, postgres.NewPostgreSQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "payload JSONB DEFAULT (CAST('{}' AS TEXT)),")
+	assert.Contains(t, output, "kind VARCHAR(20) DEFAULT (CAST('click' AS TEXT))")
+}
+
+func TestConvert_ExplicitNullability(t *testing.T) {
+	dump := "CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `email` varchar(255) NOT NULL,\n" +
+		"  `bio` text,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n"
+
+	// Only NOT NULL is written by default
+	output, _, err := sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "id int PRIMARY KEY,")
+	assert.Contains(t, output, "bio text\n")
+
+	options := sqlmapper.GenerateOptions{ExplicitNullability: true}
+	output, _, err = sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), options)
+	assert.NoError(t, err)
+	assert.Contains(t, output, "id int PRIMARY KEY NOT NULL,")
+	assert.Contains(t, output, "email varchar(255) NOT NULL,")
+	assert.Contains(t, output, "bio text NULL\n")
+
+	output, _, err = sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), options)
+	assert.NoError(t, err)
+	assert.Contains(t, output, "id int NOT NULL,")
+	assert.Contains(t, output, "bio text NULL\n")
+
+	output, _, err = sqlmapper.Convert(dump, mysql.NewMySQL(), mysql.NewMySQL(), options)
+	assert.NoError(t, err)
+	assert.Contains(t, output, "bio text NULL")
+}
+
+func TestConvertWithReport_MySQLToPostgreSQL(t *testing.T) {
+	dump := "CREATE TABLE `flags` (\n" +
+		"  `id` int unsigned NOT NULL,\n" +
+		"  `active` tinyint(1) NOT NULL DEFAULT '1',\n" +
+		"  `status` enum('active','banned') NOT NULL\n" +
+		") ENGINE=InnoDB;\n"
+
+	output, report, err := sqlmapper.ConvertWithReport(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "active BOOLEAN NOT NULL DEFAULT true,")
+	assert.Contains(t, output, "status TEXT NOT NULL CHECK (status IN ('active','banned'))")
+
+	dropped := "ENUM type of column flags.status was dropped, the column was converted to TEXT with a CHECK constraint"
+	assert.Equal(t, []sqlmapper.ColumnMapping{
+		{Table: "flags", Column: "id", SourceType: "int UNSIGNED", TargetType: "BIGINT"},
+		{Table: "flags", Column: "active", SourceType: "tinyint(1)", TargetType: "BOOLEAN"},
+		{Table: "flags", Column: "status", SourceType: "enum('active','banned')", TargetType: "TEXT", Warnings: []string{dropped}},
+	}, report.Columns)
+	assert.Equal(t, []string{dropped}, report.Dropped)
+	assert.Equal(t, []string{dropped}, report.Warnings)
+
+	assert.Equal(t, "Column flags.id: int UNSIGNED -> BIGINT\n"+
+		"Column flags.active: tinyint(1) -> BOOLEAN\n"+
+		"Column flags.status: enum('active','banned') -> TEXT\n"+
+		"Dropped: "+dropped+"\n", report.String())
+}
+
+func TestConvert_MySQLSetType(t *testing.T) {
+	dump := "CREATE TABLE `posts` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `tags` set('news','sport','tech') NOT NULL\n" +
+		") ENGINE=InnoDB;\n"
+
+	output, warnings, err := sqlmapper.Convert(dump, mysql.NewMySQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "tags SET('news','sport','tech') NOT NULL")
+	assert.Empty(t, warnings)
+
+	// The column holds every member separated by commas at most
+	output, warnings, err = sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "tags VARCHAR(15) NOT NULL")
+	assert.Equal(t, []string{"SET column posts.tags was converted to VARCHAR, its members are not checked and could be moved to a junction table"}, warnings)
+
+	output, warnings, err = sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "tags TEXT NOT NULL")
+	assert.Equal(t, []string{"SET column posts.tags was converted to TEXT, its members are not checked and could be moved to a junction table"}, warnings)
+}
+
+func TestConvert_CheckExpressions(t *testing.T) {
+	dump := "CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `email` varchar(255) NOT NULL CHECK (`email` REGEXP '^[^@]+@[^@]+$'),\n" +
+		"  `code` char(3) CONSTRAINT chk_code CHECK (code NOT REGEXP '[0-9]' AND IFNULL(code, '') <> 'abc')\n" +
+		") ENGINE=InnoDB;\n"
+
+	output, warnings, err := sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "email varchar(255) NOT NULL CHECK (email ~ '^[^@]+@[^@]+$'),")
+	assert.Contains(t, output, "code char(3) CONSTRAINT chk_code CHECK (code !~ '[0-9]' AND COALESCE(code, '') <> 'abc')")
+	assert.Empty(t, warnings)
+
+	// SQLite only matches REGEXP with a function registered by the application
+	output, warnings, err = sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CHECK (email REGEXP '^[^@]+@[^@]+$')")
+	assert.Equal(t, []string{
+		"CHECK constraint on table users uses the REGEXP operator, which has no equivalent and was kept as written",
+		"CHECK constraint on table users uses the NOT REGEXP operator, which has no equivalent and was kept as written",
+	}, warnings)
+}
+
+func TestConvert_IdentityColumns(t *testing.T) {
+	dump := `CREATE TABLE orders (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    total NUMERIC(10,2)
+);
+CREATE TABLE events (
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY (START WITH 100 INCREMENT BY 5) PRIMARY KEY
+);`
+
+	output, warnings, err := sqlmapper.Convert(dump, postgres.NewPostgreSQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "id BIGINT AUTO_INCREMENT PRIMARY KEY,")
+	assert.Contains(t, output, "id INTEGER AUTO_INCREMENT PRIMARY KEY\n) AUTO_INCREMENT=100;")
+	assert.Equal(t, []string{
+		"identity column orders.id was generated ALWAYS, AUTO_INCREMENT accepts explicit values",
+		"increment 5 of identity column events.id was dropped, AUTO_INCREMENT columns are incremented by auto_increment_increment",
+	}, warnings)
+
+	output, warnings, err = sqlmapper.Convert(dump, postgres.NewPostgreSQL(), sqlserver.NewSQLServer(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "id BIGINT PRIMARY KEY IDENTITY(1,1)")
+	assert.Contains(t, output, "id INTEGER PRIMARY KEY IDENTITY(100,5)")
+	assert.Equal(t, []string{
+		"identity column events.id was generated BY DEFAULT, SQL Server accepts explicit values only with SET IDENTITY_INSERT ON",
+	}, warnings)
+}
+
+func TestConvert_IndexPrefixLengths(t *testing.T) {
+	dump := "CREATE TABLE `posts` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `title` varchar(255) NOT NULL,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `idx_title` (`title`(50) DESC)\n" +
+		") ENGINE=InnoDB;"
+	dropped := "prefix length 50 of column title in index idx_title on posts is not supported, the whole column was indexed"
+
+	output, warnings, err := sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE INDEX idx_title ON posts(title DESC);")
+	assert.Equal(t, []string{dropped}, warnings)
+
+	output, warnings, err = sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE INDEX idx_title ON posts(title DESC);")
+	assert.Equal(t, []string{dropped}, warnings)
+
+	output, _, err = sqlmapper.Convert(dump, mysql.NewMySQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE INDEX idx_title ON posts(title(50) DESC);")
+}
+
+func TestConvert_UniqueKeyPrefixLengths(t *testing.T) {
+	dump := "CREATE TABLE `users` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `email` varchar(255) NOT NULL,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  UNIQUE KEY `uq` (`email`(20))\n" +
+		") ENGINE=InnoDB;"
+	dropped := "prefix length 20 of column email in unique index uq on users is not supported, the whole column was indexed, which only rejects duplicates of whole values"
+
+	output, warnings, err := sqlmapper.Convert(dump, mysql.NewMySQL(), sqlite.NewSQLite(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE UNIQUE INDEX uq ON users(email);")
+	assert.NotContains(t, output, "CONSTRAINT uq")
+	assert.Equal(t, []string{dropped}, warnings)
+
+	output, warnings, err = sqlmapper.Convert(dump, mysql.NewMySQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE UNIQUE INDEX uq ON users(email);")
+	assert.Equal(t, []string{dropped}, warnings)
+
+	output, warnings, err = sqlmapper.Convert(dump, mysql.NewMySQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE UNIQUE INDEX uq ON users(email(20));")
+	assert.Empty(t, warnings)
+}
+
+func TestConvert_ReservedWordColumns(t *testing.T) {
+	dump := "CREATE TABLE `lines` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `order` int NOT NULL,\n" +
+		"  `key` int,\n" +
+		"  PRIMARY KEY (`id`, `order`),\n" +
+		"  KEY `idx_order` (`order`),\n" +
+		"  CONSTRAINT `fk_key` FOREIGN KEY (`key`) REFERENCES `keys` (`key`)\n" +
+		") ENGINE=InnoDB;\n"
+
+	tests := []struct {
+		name   string
+		target sqlmapper.Database
+		want   []string
+	}{
+		{
+			name:   "MySQL",
+			target: mysql.NewMySQL(),
+			want:   []string{"    `order` int NOT NULL,", "    `key` int,", "PRIMARY KEY (id, `order`)", "CREATE INDEX idx_order ON lines(`order`);"},
+		},
+		{
+			name:   "PostgreSQL",
+			target: postgres.NewPostgreSQL(),
+			want:   []string{`    "order" int NOT NULL,`, `    "key" int`, `CREATE INDEX idx_order ON lines("order");`},
+		},
+		{
+			name:   "SQLite",
+			target: sqlite.NewSQLite(),
+			want:   []string{`    "order" int NOT NULL,`, `PRIMARY KEY (id, "order")`, `FOREIGN KEY ("key") REFERENCES keys ("key")`},
+		},
+		{
+			name:   "SQL Server",
+			target: sqlserver.NewSQLServer(),
+			want:   []string{"    [order] int NOT NULL,", "    [key] int", "CREATE INDEX idx_order ON lines([order]);"},
+		},
+		{
+			name:   "Oracle",
+			target: oracle.NewOracle(),
+			want:   []string{`    "order" int NOT NULL,`, `FOREIGN KEY ("key") REFERENCES keys("key")`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, _, err := sqlmapper.Convert(dump, mysql.NewMySQL(), tt.target, sqlmapper.GenerateOptions{})
+			assert.NoError(t, err)
+			for _, want := range tt.want {
+				assert.Contains(t, output, want)
+			}
+		})
+	}
+}
+
+func TestConvert_ComputedColumns(t *testing.T) {
+	dump := `CREATE TABLE order_lines (
+    id INT PRIMARY KEY IDENTITY(1,1),
+    price DECIMAL(10,2) NOT NULL,
+    quantity INT NOT NULL,
+    total AS (CAST(price * quantity AS DECIMAL(12,2))) PERSISTED,
+    name_length AS (LEN(name)),
+    name NVARCHAR(50)
+);`
+
+	output, warnings, err := sqlmapper.Convert(dump, sqlserver.NewSQLServer(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "    total DECIMAL(12,2) GENERATED ALWAYS AS (CAST(price * quantity AS DECIMAL(12,2))) STORED,\n")
+	assert.Contains(t, output, "    name_length TEXT GENERATED ALWAYS AS (CHAR_LENGTH(name)) VIRTUAL,\n")
+	assert.Equal(t, []string{"generated column order_lines.name_length has no data type and was generated as TEXT"}, warnings)
+
+	// The generated columns parse back into the same model
+	output, _, err = sqlmapper.Convert(dump, sqlserver.NewSQLServer(), sqlserver.NewSQLServer(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "    total AS (CAST(price * quantity AS DECIMAL(12,2))) PERSISTED,\n")
+	assert.Contains(t, output, "    name_length AS (LEN(name)),\n")
+}
+
+func TestConvert_ParseErrorDetails(t *testing.T) {
+	dump := "CREATE TABLE users (id INT);\nGO\nCREATE INDEX ix_email ON (email);"
+	_, _, err := sqlmapper.Convert(dump, sqlserver.NewSQLServer(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.ErrorContains(t, err, "failed to parse source: error parsing CREATE INDEX: line 3")
+
+	// The parse error is kept through the wrapping of Convert
+	var parseErr *sqlmapper.ParseError
+	if assert.True(t, errors.As(err, &parseErr)) {
+		assert.Equal(t, 3, parseErr.Line)
+	}
+	assert.False(t, errors.Is(err, errors.ErrUnsupported))
+}
+
+func TestConvert_MultiEventTriggers(t *testing.T) {
+	dump := `CREATE TABLE orders (id INTEGER, amount INTEGER);
+CREATE TRIGGER check_amount BEFORE INSERT OR UPDATE ON orders FOR EACH ROW WHEN (NEW.amount > 0) EXECUTE FUNCTION check_amount();`
+
+	output, warnings, err := sqlmapper.Convert(dump, postgres.NewPostgreSQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE TRIGGER check_amount_insert BEFORE INSERT ON orders\nFOR EACH ROW\nBEGIN\nIF NEW.amount > 0 THEN")
+	assert.Contains(t, output, "CREATE TRIGGER check_amount_update BEFORE UPDATE ON orders\nFOR EACH ROW\nBEGIN\nIF NEW.amount > 0 THEN")
+	assert.Contains(t, warnings, "trigger check_amount fires on INSERT OR UPDATE and was split into one trigger per event")
+
+	output, warnings, err = sqlmapper.Convert(dump, postgres.NewPostgreSQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE TRIGGER check_amount BEFORE INSERT OR UPDATE ON orders\nFOR EACH ROW\nWHEN (NEW.amount > 0)\nEXECUTE FUNCTION check_amount();")
+	assert.Empty(t, warnings)
+}
+
+func TestConvertWithReport_SkippedObjects(t *testing.T) {
+	dump := `CREATE TABLE orders (id INTEGER, amount INTEGER);
+CREATE VIEW large_orders AS SELECT id FROM orders WHERE amount > 100;
+CREATE TRIGGER check_amount BEFORE INSERT OR UPDATE ON orders FOR EACH ROW EXECUTE FUNCTION check_amount();`
+
+	output, report, err := sqlmapper.ConvertWithReport(dump, postgres.NewPostgreSQL(), sqlserver.NewSQLServer(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, output, "check_amount")
+	assert.Equal(t, []string{
+		"view large_orders was skipped, views are not generated for this dialect",
+		"trigger check_amount was skipped, triggers are not generated for this dialect",
+	}, report.Dropped)
+	assert.Contains(t, report.String(), "Dropped: trigger check_amount was skipped")
+}
+
+func TestConvert_TemporaryCreateTableAs(t *testing.T) {
+	dump := `CREATE TABLE orders (id INTEGER, total INTEGER);
+CREATE TEMPORARY TABLE staged (id, amount) ON COMMIT PRESERVE ROWS AS SELECT o.id, o.total * 2 FROM orders o;`
+
+	output, _, err := sqlmapper.Convert(dump, postgres.NewPostgreSQL(), postgres.NewPostgreSQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE TEMPORARY TABLE staged (id, amount) ON COMMIT PRESERVE ROWS AS SELECT o.id, o.total * 2 FROM orders o;")
+
+	output, _, err = sqlmapper.Convert(dump, postgres.NewPostgreSQL(), oracle.NewOracle(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE GLOBAL TEMPORARY TABLE staged (id, amount) ON COMMIT PRESERVE ROWS AS SELECT o.id, o.total * 2 FROM orders o;")
+
+	output, _, err = sqlmapper.Convert(dump, postgres.NewPostgreSQL(), mysql.NewMySQL(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "CREATE TEMPORARY TABLE staged AS SELECT o.id, o.total * 2 FROM orders o;")
+
+	output, _, err = sqlmapper.Convert(dump, postgres.NewPostgreSQL(), sqlserver.NewSQLServer(), sqlmapper.GenerateOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "SELECT * INTO #staged FROM (SELECT o.id, o.total * 2 FROM orders o) AS source (id, amount);")
+}
@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/mysql"
+	"github.com/mstgnz/sqlmapper/oracle"
+	"github.com/mstgnz/sqlmapper/postgres"
+	"github.com/mstgnz/sqlmapper/sqlite"
+	"github.com/mstgnz/sqlmapper/sqlserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_NestedParentheses(t *testing.T) {
+	input := `CREATE TABLE orders (
+    id INT NOT NULL,
+    price DECIMAL(10,2) NOT NULL,
+    status INT CHECK (status IN (1,2,3)),
+    CONSTRAINT chk_price CHECK (price > 0 AND status IN (1, 2))
+);
+`
+
+	databases := map[string]sqlmapper.Database{
+		"MySQL":      mysql.NewMySQL(),
+		"PostgreSQL": postgres.NewPostgreSQL(),
+		"SQLite":     sqlite.NewSQLite(),
+		"SQLServer":  sqlserver.NewSQLServer(),
+		"Oracle":     oracle.NewOracle(),
+	}
+
+	for name, db := range databases {
+		t.Run(name, func(t *testing.T) {
+			schema, err := db.Parse(input)
+			assert.NoError(t, err)
+			if !assert.Len(t, schema.Tables, 1) {
+				return
+			}
+			table := schema.Tables[0]
+
+			var columns []string
+			for _, column := range table.Columns {
+				columns = append(columns, column.Name)
+			}
+			assert.Equal(t, []string{"id", "price", "status"}, columns)
+			assert.Equal(t, "DECIMAL", table.Columns[1].DataType)
+			assert.Equal(t, 10, table.Columns[1].Length)
+			assert.Equal(t, 2, table.Columns[1].Scale)
+
+			var checks []string
+			for _, constraint := range table.Constraints {
+				if constraint.Type == "CHECK" {
+					checks = append(checks, constraint.CheckExpression)
+				}
+			}
+			if table.Columns[2].CheckExpression != "" {
+				assert.Equal(t, "status IN (1,2,3)", table.Columns[2].CheckExpression)
+			}
+			assert.Contains(t, checks, "price > 0 AND status IN (1, 2)")
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package integration
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mstgnz/sqlmapper"
+	"github.com/mstgnz/sqlmapper/mysql"
+	"github.com/mstgnz/sqlmapper/oracle"
+	"github.com/mstgnz/sqlmapper/postgres"
+	"github.com/mstgnz/sqlmapper/sqlite"
+	"github.com/mstgnz/sqlmapper/sqlserver"
+	"github.com/mstgnz/sqlmapper/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamParsers_NilArguments(t *testing.T) {
+	parsers := map[string]stream.StreamParser{
+		"MySQL":      mysql.NewMySQLStreamParser(),
+		"PostgreSQL": postgres.NewPostgreSQLStreamParser(),
+		"SQLite":     sqlite.NewSQLiteStreamParser(),
+		"SQL Server": sqlserver.NewSQLServerStreamParser(),
+		"Oracle":     oracle.NewOracleStreamParser(),
+	}
+	discard := func(stream.SchemaObject) error { return nil }
+	input := "CREATE TABLE users (id INT);"
+
+	for name, parser := range parsers {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, parser.ParseStream(nil, discard), "reader cannot be nil")
+			assert.EqualError(t, parser.ParseStream(strings.NewReader(input), nil), "callback cannot be nil")
+			assert.EqualError(t, parser.ParseStreamParallel(nil, discard, 2), "reader cannot be nil")
+			assert.EqualError(t, parser.ParseStreamParallel(strings.NewReader(input), nil, 2), "callback cannot be nil")
+
+			var validationErr *sqlmapper.ValidationError
+			if assert.True(t, errors.As(parser.ParseStream(nil, discard), &validationErr)) {
+				assert.Equal(t, []string{"reader cannot be nil"}, validationErr.Problems)
+			}
+			if assert.ErrorAs(t, parser.GenerateStream(nil, io.Discard), &validationErr) {
+				assert.Equal(t, []string{"schema cannot be nil"}, validationErr.Problems)
+			}
+		})
+	}
+}
+
+func TestStreamParsers_ParseErrorPosition(t *testing.T) {
+	parsers := map[string]stream.StreamParser{
+		"MySQL":      mysql.NewMySQLStreamParser(),
+		"PostgreSQL": postgres.NewPostgreSQLStreamParser(),
+		"SQLite":     sqlite.NewSQLiteStreamParser(),
+		"SQL Server": sqlserver.NewSQLServerStreamParser(),
+		"Oracle":     oracle.NewOracleStreamParser(),
+	}
+	discard := func(stream.SchemaObject) error { return nil }
+	input := "CREATE TABLE users (id INT);\n\nCREATE TABLE broken;"
+
+	for name, parser := range parsers {
+		t.Run(name, func(t *testing.T) {
+			var parseErr *sqlmapper.ParseError
+			if assert.ErrorAs(t, parser.ParseStream(strings.NewReader(input), discard), &parseErr) {
+				assert.Equal(t, 3, parseErr.Line)
+				assert.Equal(t, "CREATE TABLE broken", parseErr.Near)
+			}
+		})
+	}
+}
+
+// failingReader returns its error once the input is read
+type failingReader struct{ err error }
+
+func (r failingReader) Read(p []byte) (int, error) { return 0, r.err }
+
+func TestStreamParsers_ReadErrors(t *testing.T) {
+	parsers := map[string]stream.StreamParser{
+		"MySQL":      mysql.NewMySQLStreamParser(),
+		"PostgreSQL": postgres.NewPostgreSQLStreamParser(),
+		"SQLite":     sqlite.NewSQLiteStreamParser(),
+		"SQL Server": sqlserver.NewSQLServerStreamParser(),
+		"Oracle":     oracle.NewOracleStreamParser(),
+	}
+	discard := func(stream.SchemaObject) error { return nil }
+	cause := errors.New("connection reset")
+
+	for name, parser := range parsers {
+		t.Run(name, func(t *testing.T) {
+			err := parser.ParseStream(failingReader{cause}, discard)
+			assert.ErrorIs(t, err, cause)
+			assert.EqualError(t, err, "error reading statement: connection reset")
+			assert.ErrorIs(t, parser.ParseStreamParallel(failingReader{cause}, discard, 2), cause)
+		})
+	}
+}
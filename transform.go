@@ -0,0 +1,173 @@
+package sqlmapper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transform modifies a schema in place
+type Transform interface {
+	Apply(schema *Schema) error
+}
+
+// TransformFunc adapts an ordinary function to the Transform interface
+type TransformFunc func(schema *Schema) error
+
+// Apply calls f(schema)
+func (f TransformFunc) Apply(schema *Schema) error {
+	return f(schema)
+}
+
+// Pipeline applies a sequence of transforms to a schema
+type Pipeline struct {
+	transforms []Transform
+}
+
+// NewPipeline creates a pipeline applying the given transforms in order
+func NewPipeline(transforms ...Transform) *Pipeline {
+	return &Pipeline{transforms: transforms}
+}
+
+// Add appends a transform to the pipeline
+func (p *Pipeline) Add(transform Transform) *Pipeline {
+	p.transforms = append(p.transforms, transform)
+	return p
+}
+
+// Run applies the transforms in order to a copy of the schema and returns the copy.
+// The given schema is left unchanged.
+func (p *Pipeline) Run(schema *Schema) (*Schema, error) {
+	if schema == nil {
+		return nil, &ValidationError{Problems: []string{"schema cannot be nil"}}
+	}
+
+	result := schema.Clone()
+	for i, transform := range p.transforms {
+		if err := transform.Apply(result); err != nil {
+			return nil, fmt.Errorf("transform %d: %w", i+1, err)
+		}
+	}
+
+	return result, nil
+}
+
+// PrefixTables returns a transform that adds a prefix to every table name and
+// updates the references to the renamed tables
+func PrefixTables(prefix string) Transform {
+	return TransformFunc(func(schema *Schema) error {
+		renamed := make(map[string]string)
+		for _, table := range schema.Tables {
+			renamed[strings.ToLower(table.Name)] = prefix + table.Name
+		}
+
+		rename := func(name string) string {
+			if newName, ok := renamed[strings.ToLower(name)]; ok {
+				return newName
+			}
+			return name
+		}
+
+		if err := schema.Walk(&tableRenamer{rename: rename}); err != nil {
+			return err
+		}
+
+		if schema.Partitions != nil {
+			partitions := make(map[string][]Partition, len(schema.Partitions))
+			for table, parts := range schema.Partitions {
+				partitions[rename(table)] = parts
+			}
+			schema.Partitions = partitions
+		}
+
+		return nil
+	})
+}
+
+// tableRenamer renames tables and the foreign keys and triggers referencing them
+type tableRenamer struct {
+	BaseVisitor
+	rename func(name string) string
+}
+
+func (v *tableRenamer) VisitTable(table *Table) error {
+	table.Name = v.rename(table.Name)
+	return nil
+}
+
+func (v *tableRenamer) VisitForeignKey(table *Table, constraint *Constraint) error {
+	constraint.RefTable = v.rename(constraint.RefTable)
+	return nil
+}
+
+func (v *tableRenamer) VisitTrigger(trigger *Trigger) error {
+	trigger.Table = v.rename(trigger.Table)
+	return nil
+}
+
+// RenameColumn returns a transform that renames a column of a table and updates the
+// indexes, constraints and foreign keys referring to it. It fails if the column does not exist.
+func RenameColumn(table, from, to string) Transform {
+	return TransformFunc(func(schema *Schema) error {
+		renamer := &columnRenamer{table: table, from: from, to: to}
+		if err := schema.Walk(renamer); err != nil {
+			return err
+		}
+		if !renamer.found {
+			return fmt.Errorf("column %s not found in table %s", from, table)
+		}
+		return nil
+	})
+}
+
+// columnRenamer renames a single column and the references to it
+type columnRenamer struct {
+	BaseVisitor
+	table, from, to string
+	found           bool
+}
+
+func (v *columnRenamer) VisitColumn(table *Table, column *Column) error {
+	if strings.EqualFold(table.Name, v.table) && strings.EqualFold(column.Name, v.from) {
+		column.Name = v.to
+		v.found = true
+	}
+	return nil
+}
+
+func (v *columnRenamer) VisitIndex(table *Table, index *Index) error {
+	if strings.EqualFold(table.Name, v.table) {
+		for i := range index.Columns {
+			if strings.EqualFold(index.Columns[i].Name, v.from) {
+				index.Columns[i].Name = v.to
+			}
+		}
+		v.renameIn(index.IncludeColumns)
+	}
+	return nil
+}
+
+func (v *columnRenamer) VisitConstraint(table *Table, constraint *Constraint) error {
+	if strings.EqualFold(table.Name, v.table) {
+		v.renameIn(constraint.Columns)
+	}
+	return nil
+}
+
+func (v *columnRenamer) VisitForeignKey(table *Table, constraint *Constraint) error {
+	if strings.EqualFold(table.Name, v.table) {
+		v.renameIn(constraint.Columns)
+	}
+	if strings.EqualFold(constraint.RefTable, v.table) {
+		v.renameIn(constraint.RefColumns)
+	}
+	return nil
+}
+
+// renameIn renames the column in a list of column names
+func (v *columnRenamer) renameIn(columns []string) {
+	for i, column := range columns {
+		if strings.EqualFold(column, v.from) {
+			columns[i] = v.to
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeline_Run(t *testing.T) {
+	original := &Schema{
+		Tables: []Table{
+			{
+				Name:    "users",
+				Columns: []Column{{Name: "id"}, {Name: "email"}},
+				Indexes: []Index{{Name: "idx_users_email", Columns: IndexColumns("email")}},
+			},
+			{
+				Name:    "orders",
+				Columns: []Column{{Name: "id"}, {Name: "user_email"}},
+				Constraints: []Constraint{
+					{Type: "FOREIGN KEY", Columns: []string{"user_email"}, RefTable: "users", RefColumns: []string{"email"}},
+				},
+			},
+		},
+		Triggers: []Trigger{{Name: "audit_orders", Table: "orders"}},
+	}
+
+	t.Run("Two step pipeline", func(t *testing.T) {
+		pipeline := NewPipeline(PrefixTables("app_")).
+			Add(RenameColumn("app_users", "email", "email_address"))
+
+		result, err := pipeline.Run(original)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "app_users", result.Tables[0].Name)
+		assert.Equal(t, "app_orders", result.Tables[1].Name)
+		assert.Equal(t, "email_address", result.Tables[0].Columns[1].Name)
+		assert.Equal(t, []string{"email_address"}, result.Tables[0].Indexes[0].ColumnNames())
+		assert.Equal(t, "app_users", result.Tables[1].Constraints[0].RefTable)
+		assert.Equal(t, []string{"email_address"}, result.Tables[1].Constraints[0].RefColumns)
+		assert.Equal(t, "app_orders", result.Triggers[0].Table)
+
+		// The input schema is left unchanged
+		assert.Equal(t, "users", original.Tables[0].Name)
+		assert.Equal(t, "email", original.Tables[0].Columns[1].Name)
+		assert.Equal(t, []string{"email"}, original.Tables[0].Indexes[0].ColumnNames())
+		assert.Equal(t, "users", original.Tables[1].Constraints[0].RefTable)
+		assert.Equal(t, []string{"email"}, original.Tables[1].Constraints[0].RefColumns)
+		assert.Equal(t, "orders", original.Triggers[0].Table)
+	})
+
+	t.Run("Unknown column", func(t *testing.T) {
+		result, err := NewPipeline(RenameColumn("users", "missing", "other")).Run(original)
+		assert.EqualError(t, err, "transform 1: column missing not found in table users")
+		assert.Nil(t, result)
+	})
+
+	t.Run("Nil schema", func(t *testing.T) {
+		_, err := NewPipeline().Run(nil)
+		var validationErr *ValidationError
+		if assert.ErrorAs(t, err, &validationErr) {
+			assert.Equal(t, []string{"schema cannot be nil"}, validationErr.Problems)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package sqlmapper
+
+import (
+	"slices"
+	"strings"
+)
+
+// ExpressionRules lists the operators and functions of other dialects that a dialect
+// writes differently, see TranslateExpression
+type ExpressionRules struct {
+	// Operators maps upper-case operators, such as REGEXP, NOT REGEXP or ~*, to those
+	// of the dialect
+	Operators map[string]string
+
+	// Functions maps lower-case function names, such as ifnull, to those of the dialect
+	Functions map[string]string
+
+	// Unsupported lists the upper-case operators the dialect has no equivalent for
+	Unsupported []string
+}
+
+// TranslateExpression rewrites the operators and functions of an expression, such as
+// the expression of a CHECK constraint, as listed in rules. The operators without an
+// equivalent are kept as written and returned, each once. String literals, quoted
+// identifiers and comments are left unchanged. The translation is best effort: only
+// operators with a left operand and function names followed by a parenthesis are
+// rewritten, without regard to the semantics of their arguments.
+func TranslateExpression(expr string, rules ExpressionRules) (string, []string) {
+	tokens := Tokenize(expr)
+
+	var out strings.Builder
+	var unsupported []string
+	last := 0
+	replace := func(from, to int, text string) {
+		out.WriteString(expr[last:tokens[from].Offset])
+		out.WriteString(text)
+		last = tokens[to].Offset + len(tokens[to].Text)
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if token.Type != WordToken && token.Type != SymbolToken {
+			continue
+		}
+
+		// Functions
+		if token.Type == WordToken && i+1 < len(tokens) && tokens[i+1].Text == "(" {
+			if name, ok := rules.Functions[strings.ToLower(token.Text)]; ok {
+				replace(i, i, name)
+				continue
+			}
+		}
+
+		// Operators, of a word such as REGEXP, a negated word such as NOT REGEXP, or of
+		// adjacent symbols such as !~*
+		if i == 0 || !hasOperand(tokens[i-1]) {
+			continue
+		}
+		end, operator := operatorAt(tokens, i)
+		if operator == "" {
+			continue
+		}
+		if translated, ok := rules.Operators[operator]; ok {
+			// Keep a word apart from the operands written next to a symbol operator
+			if start := tokens[i].Offset; start > 0 && !isSpaceByte(expr[start-1]) {
+				translated = " " + translated
+			}
+			if next := tokens[end].Offset + len(tokens[end].Text); next < len(expr) && !isSpaceByte(expr[next]) {
+				translated += " "
+			}
+			replace(i, end, translated)
+			i = end
+			continue
+		}
+		if slices.Contains(rules.Unsupported, operator) {
+			if !slices.Contains(unsupported, operator) {
+				unsupported = append(unsupported, operator)
+			}
+			i = end
+		}
+	}
+
+	out.WriteString(expr[last:])
+	return out.String(), unsupported
+}
+
+// operatorAt returns the index of the last token and the upper-case text of the operator
+// starting at token i, or an empty operator if the token cannot start one
+func operatorAt(tokens []Token, i int) (int, string) {
+	token := tokens[i]
+	if token.Type == WordToken {
+		word := strings.ToUpper(token.Text)
+		if word == "NOT" && i+1 < len(tokens) && tokens[i+1].Type == WordToken {
+			return i + 1, word + " " + strings.ToUpper(tokens[i+1].Text)
+		}
+		return i, word
+	}
+
+	// Symbols written without spaces between them form a single operator
+	end := i
+	for end+1 < len(tokens) && tokens[end+1].Type == SymbolToken && tokens[end+1].Offset == tokens[end].Offset+1 &&
+		!strings.Contains("()'\",;", tokens[end+1].Text) {
+		end++
+	}
+	var operator strings.Builder
+	for _, symbol := range tokens[i : end+1] {
+		operator.WriteString(symbol.Text)
+	}
+	return end, operator.String()
+}
+
+// expressionKeywords are the words of an expression that do not end an operand
+var expressionKeywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "IS": true, "IN": true, "LIKE": true, "BETWEEN": true,
+	"CASE": true, "WHEN": true, "THEN": true, "ELSE": true,
+}
+
+// hasOperand reports whether a token ends an operand, so that an operator following
+// it is a binary operator rather than a unary one
+func hasOperand(token Token) bool {
+	switch token.Type {
+	case SymbolToken:
+		return token.Text == ")"
+	case CommentToken:
+		return false
+	case WordToken:
+		return !expressionKeywords[strings.ToUpper(token.Text)]
+	}
+	return true
+}
+
+// TranslateCheck translates the expression of a CHECK constraint of a table with
+// TranslateExpression, warning about the operators kept as written
+func (o GenerateOptions) TranslateCheck(table, expr string, rules ExpressionRules) string {
+	translated, unsupported := TranslateExpression(expr, rules)
+	for _, operator := range unsupported {
+		o.Warnf("CHECK constraint on table %s uses the %s operator, which has no equivalent and was kept as written", table, operator)
+	}
+	return translated
+}
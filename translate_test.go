@@ -0,0 +1,84 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateExpression(t *testing.T) {
+	rules := ExpressionRules{
+		Operators: map[string]string{
+			"REGEXP":     "~",
+			"NOT REGEXP": "!~",
+			"~":          "REGEXP",
+			"!~*":        "NOT REGEXP",
+			"<=>":        "IS NOT DISTINCT FROM",
+		},
+		Functions:   map[string]string{"ifnull": "COALESCE"},
+		Unsupported: []string{"SOUNDS"},
+	}
+
+	tests := []struct {
+		name        string
+		expr        string
+		want        string
+		unsupported []string
+	}{
+		{
+			name: "Word operator",
+			expr: "email REGEXP '^[a-z]+@[a-z]+$'",
+			want: "email ~ '^[a-z]+@[a-z]+$'",
+		},
+		{
+			name: "Negated operator and function",
+			expr: "code not regexp '[0-9]' AND IFNULL(qty, 0) >= 0",
+			want: "code !~ '[0-9]' AND COALESCE(qty, 0) >= 0",
+		},
+		{
+			name: "Symbol operator",
+			expr: "a <=> b",
+			want: "a IS NOT DISTINCT FROM b",
+		},
+		{
+			name: "Symbol operator without spaces",
+			expr: "(code)!~*'[0-9]'",
+			want: "(code) NOT REGEXP '[0-9]'",
+		},
+		{
+			name: "Literals and quoted identifiers",
+			expr: "`regexp` REGEXP 'a REGEXP b' AND \"ifnull\"(x) = 1",
+			want: "`regexp` ~ 'a REGEXP b' AND \"ifnull\"(x) = 1",
+		},
+		{
+			name: "Unary operator",
+			expr: "~flags = 0 OR ~mask = 1",
+			want: "~flags = 0 OR ~mask = 1",
+		},
+		{
+			name:        "Unsupported operator",
+			expr:        "name SOUNDS LIKE 'a' OR alias sounds like 'b'",
+			want:        "name SOUNDS LIKE 'a' OR alias sounds like 'b'",
+			unsupported: []string{"SOUNDS"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unsupported := TranslateExpression(tt.expr, rules)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.unsupported, unsupported)
+		})
+	}
+}
+
+func TestGenerateOptions_TranslateCheck(t *testing.T) {
+	var warnings []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}}
+	rules := ExpressionRules{Unsupported: []string{"REGEXP"}}
+
+	assert.Equal(t, "code REGEXP '[a-z]'", options.TranslateCheck("items", "code REGEXP '[a-z]'", rules))
+	assert.Equal(t, []string{"CHECK constraint on table items uses the REGEXP operator, which has no equivalent and was kept as written"}, warnings)
+}
@@ -0,0 +1,106 @@
+package sqlmapper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// triggerEventOrRe matches the OR separating the events of a trigger
+var triggerEventOrRe = regexp.MustCompile(`(?i)\s+OR\s+`)
+
+// triggerEvents lists the keywords that start a trigger event
+var triggerEvents = map[string]bool{
+	"INSERT":   true,
+	"UPDATE":   true,
+	"DELETE":   true,
+	"TRUNCATE": true,
+}
+
+// ParseTriggerEvents splits the event clause of a CREATE TRIGGER statement, such as
+// "INSERT OR UPDATE OF name, email" or SQL Server's "INSERT, UPDATE", into its events
+func ParseTriggerEvents(clause string) []string {
+	var events []string
+	for _, part := range triggerEventOrRe.Split(strings.TrimSpace(clause), -1) {
+		for _, item := range strings.Split(part, ",") {
+			item = spacesRe.ReplaceAllString(strings.TrimSpace(item), " ")
+			if item == "" {
+				continue
+			}
+
+			keyword := strings.ToUpper(strings.Fields(item)[0])
+			if !triggerEvents[keyword] && len(events) > 0 {
+				// A further column of the preceding UPDATE OF list
+				events[len(events)-1] += ", " + item
+				continue
+			}
+			events = append(events, item)
+		}
+	}
+	return events
+}
+
+// EventClause joins the events of the trigger with the given separator,
+// e.g. " OR " for PostgreSQL and Oracle or ", " for SQL Server
+func (t Trigger) EventClause(separator string) string {
+	return strings.Join(t.Events, separator)
+}
+
+// SplitEvents returns one trigger per event for dialects that only allow a single
+// event per trigger. The event is appended to the name of each resulting trigger.
+// Triggers with a single event are returned unchanged.
+func (t Trigger) SplitEvents() []Trigger {
+	if len(t.Events) <= 1 {
+		return []Trigger{t}
+	}
+
+	triggers := make([]Trigger, 0, len(t.Events))
+	for _, event := range t.Events {
+		split := t
+		split.Name = t.Name + "_" + strings.ToLower(strings.Fields(event)[0])
+		split.Events = []string{event}
+		triggers = append(triggers, split)
+	}
+	return triggers
+}
+
+// ReplaceBindReferences replaces the :NEW and :OLD references to the row in the body of
+// an Oracle trigger by the NEW and OLD of other dialects. String literals, quoted
+// identifiers and comments are left untouched. It reports whether any reference was
+// replaced.
+func ReplaceBindReferences(body string) (string, bool) {
+	var result strings.Builder
+	last := 0
+	tokens := Tokenize(body)
+	for i := 0; i+1 < len(tokens); i++ {
+		colon, name := tokens[i], tokens[i+1]
+		if colon.Text != ":" || name.Type != WordToken || name.Offset != colon.Offset+1 {
+			continue
+		}
+		// Skip the := assignment operator and named parameters other than NEW and OLD
+		if word := strings.ToUpper(name.Text); word != "NEW" && word != "OLD" {
+			continue
+		}
+		result.WriteString(body[last:colon.Offset])
+		last = name.Offset
+	}
+	if last == 0 {
+		return body, false
+	}
+	result.WriteString(body[last:])
+	return result.String(), true
+}
+
+// SplitTriggerEvents splits the multi-event triggers for dialects that only allow a
+// single event per trigger and reports a warning for every trigger that is split
+func SplitTriggerEvents(triggers []Trigger, options GenerateOptions) []Trigger {
+	var result []Trigger
+	for _, trigger := range triggers {
+		split := trigger.SplitEvents()
+		if len(split) > 1 {
+			options.Warnf("trigger %s fires on %s and was split into one trigger per event",
+				trigger.Name, trigger.EventClause(" OR "))
+		}
+		result = append(result, split...)
+	}
+	return result
+}
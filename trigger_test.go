@@ -0,0 +1,82 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTriggerEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		clause string
+		want   []string
+	}{
+		{name: "Single event", clause: "INSERT", want: []string{"INSERT"}},
+		{name: "OR separated", clause: "INSERT OR UPDATE OR DELETE", want: []string{"INSERT", "UPDATE", "DELETE"}},
+		{name: "Comma separated", clause: "INSERT, UPDATE", want: []string{"INSERT", "UPDATE"}},
+		{name: "Update columns", clause: "UPDATE OF name, email OR delete", want: []string{"UPDATE OF name, email", "delete"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseTriggerEvents(tt.clause))
+		})
+	}
+}
+
+func TestSplitTriggerEvents(t *testing.T) {
+	triggers := []Trigger{
+		{Name: "audit", Table: "users", Events: []string{"INSERT", "UPDATE OF email"}},
+		{Name: "cleanup", Table: "users", Events: []string{"DELETE"}},
+	}
+
+	var warnings []string
+	options := GenerateOptions{OnWarning: func(message string) {
+		warnings = append(warnings, message)
+	}}
+
+	split := SplitTriggerEvents(triggers, options)
+
+	assert.Equal(t, []Trigger{
+		{Name: "audit_insert", Table: "users", Events: []string{"INSERT"}},
+		{Name: "audit_update", Table: "users", Events: []string{"UPDATE OF email"}},
+		{Name: "cleanup", Table: "users", Events: []string{"DELETE"}},
+	}, split)
+	assert.Equal(t, []string{"trigger audit fires on INSERT OR UPDATE OF email and was split into one trigger per event"}, warnings)
+}
+
+func TestReplaceBindReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		want     string
+		replaced bool
+	}{
+		{
+			name:     "Row references",
+			body:     "BEGIN\n  :NEW.total := :old.total + 1;\nEND;",
+			want:     "BEGIN\n  NEW.total := old.total + 1;\nEND;",
+			replaced: true,
+		},
+		{
+			name:     "Literals and other binds are kept",
+			body:     "BEGIN :NEW.note := ':NEW.x'; x := :amount; END;",
+			want:     "BEGIN NEW.note := ':NEW.x'; x := :amount; END;",
+			replaced: true,
+		},
+		{
+			name: "Body without references",
+			body: "SET NEW.total = 0;",
+			want: "SET NEW.total = 0;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, replaced := ReplaceBindReferences(tt.body)
+			assert.Equal(t, tt.want, body)
+			assert.Equal(t, tt.replaced, replaced)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package sqlmapper
+
+import "strings"
+
+// widenedTypes maps unsigned integer types to the smallest signed type holding their range
+var widenedTypes = map[string]string{
+	"TINYINT":   "SMALLINT",
+	"SMALLINT":  "INTEGER",
+	"MEDIUMINT": "INTEGER",
+	"INT":       "BIGINT",
+	"INTEGER":   "BIGINT",
+	"BIGINT":    "NUMERIC",
+}
+
+// WidenUnsigned converts an unsigned column for dialects without unsigned types.
+// Integer types are replaced by the smallest signed type holding their whole range,
+// dropping their display width; BIGINT becomes NUMERIC(20). Other types are kept.
+// The Unsigned and Zerofill flags are cleared, use UnsignedCheck to keep the column
+// non-negative.
+func WidenUnsigned(column Column) Column {
+	if !column.Unsigned {
+		return column
+	}
+	if widened, ok := widenedTypes[strings.ToUpper(column.DataType)]; ok {
+		column.DataType = widened
+		column.Length, column.Scale = 0, 0
+		if widened == "NUMERIC" {
+			column.Length = 20
+		}
+	}
+	column.Unsigned = false
+	column.Zerofill = false
+	return column
+}
+
+// UnsignedCheck returns the CHECK expression keeping an unsigned column non-negative
+// in dialects without unsigned types, or an empty string for a signed column
+func UnsignedCheck(column Column) string {
+	if !column.Unsigned {
+		return ""
+	}
+	return column.Name + " >= 0"
+}
@@ -0,0 +1,52 @@
+package sqlmapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWidenUnsigned(t *testing.T) {
+	tests := []struct {
+		name   string
+		column Column
+		want   Column
+	}{
+		{
+			name:   "Signed column",
+			column: Column{Name: "qty", DataType: "INT", Length: 11},
+			want:   Column{Name: "qty", DataType: "INT", Length: 11},
+		},
+		{
+			name:   "Tiny integer",
+			column: Column{Name: "flags", DataType: "tinyint", Length: 3, Unsigned: true},
+			want:   Column{Name: "flags", DataType: "SMALLINT"},
+		},
+		{
+			name:   "Integer with zerofill",
+			column: Column{Name: "code", DataType: "INT", Length: 5, Unsigned: true, Zerofill: true},
+			want:   Column{Name: "code", DataType: "BIGINT"},
+		},
+		{
+			name:   "Big integer",
+			column: Column{Name: "id", DataType: "BIGINT", Unsigned: true},
+			want:   Column{Name: "id", DataType: "NUMERIC", Length: 20},
+		},
+		{
+			name:   "Decimal keeps its type",
+			column: Column{Name: "price", DataType: "DECIMAL", Length: 10, Scale: 2, Unsigned: true},
+			want:   Column{Name: "price", DataType: "DECIMAL", Length: 10, Scale: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, WidenUnsigned(tt.column))
+		})
+	}
+}
+
+func TestUnsignedCheck(t *testing.T) {
+	assert.Equal(t, "", UnsignedCheck(Column{Name: "qty", DataType: "INT"}))
+	assert.Equal(t, "qty >= 0", UnsignedCheck(Column{Name: "qty", DataType: "INT", Unsigned: true}))
+}
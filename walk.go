@@ -0,0 +1,111 @@
+package sqlmapper
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrStopWalk can be returned by a Visitor method to end a walk early.
+// Walk then returns nil instead of the error.
+var ErrStopWalk = errors.New("stop walk")
+
+// Visitor is called for the objects of a schema during Walk. Objects are passed as
+// pointers into the schema so they can be modified in place. Returning a non-nil
+// error ends the walk; Walk returns that error unless it is ErrStopWalk.
+type Visitor interface {
+	VisitTable(table *Table) error
+	VisitColumn(table *Table, column *Column) error
+	VisitIndex(table *Table, index *Index) error
+	// VisitConstraint is called for every constraint except foreign keys
+	VisitConstraint(table *Table, constraint *Constraint) error
+	VisitForeignKey(table *Table, constraint *Constraint) error
+	VisitView(view *View) error
+	VisitFunction(function *Function) error
+	VisitProcedure(procedure *Procedure) error
+	VisitTrigger(trigger *Trigger) error
+	VisitSequence(sequence *Sequence) error
+}
+
+// BaseVisitor implements Visitor with methods that do nothing. Embed it in a visitor
+// to only implement the methods of interest.
+type BaseVisitor struct{}
+
+func (BaseVisitor) VisitTable(*Table) error                   { return nil }
+func (BaseVisitor) VisitColumn(*Table, *Column) error         { return nil }
+func (BaseVisitor) VisitIndex(*Table, *Index) error           { return nil }
+func (BaseVisitor) VisitConstraint(*Table, *Constraint) error { return nil }
+func (BaseVisitor) VisitForeignKey(*Table, *Constraint) error { return nil }
+func (BaseVisitor) VisitView(*View) error                     { return nil }
+func (BaseVisitor) VisitFunction(*Function) error             { return nil }
+func (BaseVisitor) VisitProcedure(*Procedure) error           { return nil }
+func (BaseVisitor) VisitTrigger(*Trigger) error               { return nil }
+func (BaseVisitor) VisitSequence(*Sequence) error             { return nil }
+
+// Walk visits the objects of the schema in order: each table followed by its columns,
+// indexes and constraints, then views, functions, procedures, triggers and sequences.
+func (s *Schema) Walk(visitor Visitor) error {
+	if err := s.walk(visitor); err != nil && !errors.Is(err, ErrStopWalk) {
+		return err
+	}
+	return nil
+}
+
+// walk visits the objects of the schema and returns the first error of the visitor
+func (s *Schema) walk(visitor Visitor) error {
+	for i := range s.Tables {
+		table := &s.Tables[i]
+		if err := visitor.VisitTable(table); err != nil {
+			return err
+		}
+		for j := range table.Columns {
+			if err := visitor.VisitColumn(table, &table.Columns[j]); err != nil {
+				return err
+			}
+		}
+		for j := range table.Indexes {
+			if err := visitor.VisitIndex(table, &table.Indexes[j]); err != nil {
+				return err
+			}
+		}
+		for j := range table.Constraints {
+			constraint := &table.Constraints[j]
+			var err error
+			if strings.EqualFold(constraint.Type, "FOREIGN KEY") {
+				err = visitor.VisitForeignKey(table, constraint)
+			} else {
+				err = visitor.VisitConstraint(table, constraint)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := range s.Views {
+		if err := visitor.VisitView(&s.Views[i]); err != nil {
+			return err
+		}
+	}
+	for i := range s.Functions {
+		if err := visitor.VisitFunction(&s.Functions[i]); err != nil {
+			return err
+		}
+	}
+	for i := range s.Procedures {
+		if err := visitor.VisitProcedure(&s.Procedures[i]); err != nil {
+			return err
+		}
+	}
+	for i := range s.Triggers {
+		if err := visitor.VisitTrigger(&s.Triggers[i]); err != nil {
+			return err
+		}
+	}
+	for i := range s.Sequences {
+		if err := visitor.VisitSequence(&s.Sequences[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package sqlmapper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// upperTableVisitor uppercases table names and counts the visited objects
+type upperTableVisitor struct {
+	BaseVisitor
+	columns     int
+	foreignKeys int
+	stopAfter   string
+}
+
+func (v *upperTableVisitor) VisitTable(table *Table) error {
+	table.Name = strings.ToUpper(table.Name)
+	if table.Name == v.stopAfter {
+		return ErrStopWalk
+	}
+	return nil
+}
+
+func (v *upperTableVisitor) VisitColumn(table *Table, column *Column) error {
+	v.columns++
+	return nil
+}
+
+func (v *upperTableVisitor) VisitForeignKey(table *Table, constraint *Constraint) error {
+	v.foreignKeys++
+	return nil
+}
+
+func TestSchema_Walk(t *testing.T) {
+	newSchema := func() *Schema {
+		return &Schema{
+			Tables: []Table{
+				{Name: "users", Columns: []Column{{Name: "id"}, {Name: "email"}}},
+				{
+					Name:    "orders",
+					Columns: []Column{{Name: "id"}, {Name: "user_id"}},
+					Constraints: []Constraint{
+						{Type: "PRIMARY KEY", Columns: []string{"id"}},
+						{Type: "FOREIGN KEY", Columns: []string{"user_id"}, RefTable: "users"},
+					},
+				},
+				{Name: "audit_log", Columns: []Column{{Name: "id"}}},
+			},
+		}
+	}
+
+	t.Run("Mutates in place", func(t *testing.T) {
+		schema := newSchema()
+		visitor := &upperTableVisitor{}
+
+		assert.NoError(t, schema.Walk(visitor))
+		assert.Equal(t, "USERS", schema.Tables[0].Name)
+		assert.Equal(t, "ORDERS", schema.Tables[1].Name)
+		assert.Equal(t, "AUDIT_LOG", schema.Tables[2].Name)
+		assert.Equal(t, 5, visitor.columns)
+		assert.Equal(t, 1, visitor.foreignKeys)
+	})
+
+	t.Run("Stops early", func(t *testing.T) {
+		schema := newSchema()
+		visitor := &upperTableVisitor{stopAfter: "ORDERS"}
+
+		assert.NoError(t, schema.Walk(visitor))
+		assert.Equal(t, "ORDERS", schema.Tables[1].Name)
+		assert.Equal(t, "audit_log", schema.Tables[2].Name)
+		assert.Equal(t, 2, visitor.columns)
+	})
+
+	t.Run("Returns visitor errors", func(t *testing.T) {
+		schema := newSchema()
+		err := schema.Walk(errorVisitor{})
+		assert.EqualError(t, err, "audit tables are not allowed")
+	})
+}
+
+// errorVisitor rejects audit tables
+type errorVisitor struct {
+	BaseVisitor
+}
+
+func (errorVisitor) VisitTable(table *Table) error {
+	if table.Name == "audit_log" {
+		return errors.New("audit tables are not allowed")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package sqlmapper
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// maxPooledBuffer is the capacity above which a statement buffer is not kept for
+// reuse, so that a single huge statement does not pin its memory in the pool
+const maxPooledBuffer = 64 << 10
+
+// statementBuffers pools the buffers of WriteStatement
+var statementBuffers = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// WriteStatement writes a generated statement followed by its terminator, such as
+// ";\n\n", in a single write. The two are joined in a buffer taken from a pool, so
+// that streaming a large schema does not allocate a copy of every statement.
+func WriteStatement(writer io.Writer, stmt, terminator string) error {
+	buf := statementBuffers.Get().(*bytes.Buffer)
+	buf.Grow(len(stmt) + len(terminator))
+	buf.WriteString(stmt)
+	buf.WriteString(terminator)
+	_, err := writer.Write(buf.Bytes())
+
+	if buf.Cap() <= maxPooledBuffer {
+		buf.Reset()
+		statementBuffers.Put(buf)
+	}
+	return err
+}
+
+// TableSizeHint estimates the length of the CREATE TABLE statement generated for a
+// table, so that the builder it is written to can be sized once
+func TableSizeHint(table Table) int {
+	return 64 + len(table.Name) + 64*len(table.Columns) + 48*len(table.Constraints)
+}
@@ -0,0 +1,38 @@
+package sqlmapper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingWriter records every write it receives
+type recordingWriter struct {
+	writes []string
+	err    error
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.writes = append(w.writes, string(p))
+	return len(p), nil
+}
+
+func TestWriteStatement(t *testing.T) {
+	writer := &recordingWriter{}
+	assert.NoError(t, WriteStatement(writer, "CREATE TABLE users (id INT)", ";\n\n"))
+	assert.NoError(t, WriteStatement(writer, "CREATE VIEW v AS SELECT 1", "\nGO\n"))
+
+	// Statements too large to be pooled are written all the same
+	large := "SELECT '" + strings.Repeat("x", maxPooledBuffer) + "'"
+	assert.NoError(t, WriteStatement(writer, large, ";\n"))
+
+	assert.Equal(t, []string{"CREATE TABLE users (id INT);\n\n", "CREATE VIEW v AS SELECT 1\nGO\n", large + ";\n"}, writer.writes)
+
+	writer.err = errors.New("disk full")
+	assert.EqualError(t, WriteStatement(writer, "SELECT 1", ";\n"), "disk full")
+}